@@ -136,10 +136,21 @@ type ApicastEnvironmentSpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
 	HPA *HorizontalPodAutoscalerSpec `json:"hpa,omitempty"`
+	// Vertical Pod Autoscaler for the component. By default it only
+	// computes resource recommendations, shown in the status of the resource.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	VPA *VerticalPodAutoscalerSpec `json:"vpa,omitempty"`
 	// Number of replicas (ignored if hpa is enabled) for the component
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
 	Replicas *int32 `json:"replicas,omitempty"`
+	// ScalingSchedule is a list of recurring windows that override the
+	// replicas or the hpa limits of the component. If several windows are
+	// active at the same time, the first one in the list is used.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	ScalingSchedule []ScalingWindow `json:"scalingSchedule,omitempty"`
 	// Resource requirements for the component
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
@@ -161,6 +172,8 @@ type ApicastEnvironmentSpec struct {
 	// If specified, the pod's tolerations.
 	// +optional
 	Tolerations []corev1.Toleration `json:"tolerations,omitempty" protobuf:"bytes,22,opt,name=tolerations"`
+	// Pod-level settings added to the Pods of the component
+	PodTemplateOverrides `json:",inline"`
 	// Canary defines spec changes for the canary Deployment. If
 	// left unset the canary Deployment wil not be created.
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
@@ -231,10 +244,29 @@ func (cfg *ApicastConfig) Default() {
 }
 
 // ApicastStatus defines the observed state of Apicast
-type ApicastStatus struct{}
+type ApicastStatus struct {
+	WorkloadStatus `json:",inline"`
+	// Canaries is the status of the progressive delivery of the canaries
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	// +optional
+	Canaries CanaryStatuses `json:"canaries,omitempty"`
+	// ScalingWindows are the scaling windows currently active for the workloads
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	// +optional
+	ScalingWindows ScalingWindowStatuses `json:"scalingWindows,omitempty"`
+	// Recommendations are the resource recommendations computed by the
+	// Vertical Pod Autoscalers of the workloads
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	// +optional
+	Recommendations ResourceRecommendations `json:"recommendations,omitempty"`
+}
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:JSONPath=`.status.conditions[?(@.type=="Ready")].status`,name=Ready,type=string
+// +kubebuilder:printcolumn:JSONPath=`.status.conditions[?(@.type=="Progressing")].status`,name=Progressing,type=string
+// +kubebuilder:printcolumn:JSONPath=`.status.conditions[?(@.type=="Degraded")].status`,name=Degraded,type=string
+// +kubebuilder:printcolumn:JSONPath=`.metadata.creationTimestamp`,name=Age,type=date
 
 // Apicast is the Schema for the apicasts API
 type Apicast struct {
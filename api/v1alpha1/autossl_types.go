@@ -81,6 +81,11 @@ type AutoSSLSpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
 	HPA *HorizontalPodAutoscalerSpec `json:"hpa,omitempty"`
+	// Vertical Pod Autoscaler for the component. By default it only
+	// computes resource recommendations, shown in the status of the resource.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	VPA *VerticalPodAutoscalerSpec `json:"vpa,omitempty"`
 	// Number of replicas (ignored if hpa is enabled) for the component
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
@@ -110,6 +115,8 @@ type AutoSSLSpec struct {
 	// If specified, the pod's tolerations.
 	// +optional
 	Tolerations []corev1.Toleration `json:"tolerations,omitempty" protobuf:"bytes,22,opt,name=tolerations"`
+	// Pod-level settings added to the Pods of the component
+	PodTemplateOverrides `json:",inline"`
 	// Canary defines spec changes for the canary Deployment. If
 	// left unset the canary Deployment wil not be created.
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
@@ -218,8 +225,15 @@ func (cfg *AutoSSLConfig) Default() {
 
 // AutoSSLStatus defines the observed state of AutoSSL
 type AutoSSLStatus struct {
-	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
-	// Important: Run "make" to regenerate code after modifying this file
+	// Canaries is the status of the progressive delivery of the canaries
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	// +optional
+	Canaries CanaryStatuses `json:"canaries,omitempty"`
+	// Recommendations are the resource recommendations computed by the
+	// Vertical Pod Autoscalers of the workloads
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	// +optional
+	Recommendations ResourceRecommendations `json:"recommendations,omitempty"`
 }
 
 // +kubebuilder:object:root=true
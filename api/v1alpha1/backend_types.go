@@ -152,6 +152,11 @@ type BackendSpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
 	Twemproxy *TwemproxySpec `json:"twemproxy,omitempty"`
+	// Maintenance puts the component in maintenance, scaling to zero the
+	// workloads selected by the maintenance mode
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Maintenance *MaintenanceSpec `json:"maintenance,omitempty"`
 }
 
 // Default implements defaulting for BackendSpec
@@ -212,10 +217,21 @@ type ListenerSpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
 	HPA *HorizontalPodAutoscalerSpec `json:"hpa,omitempty"`
+	// Vertical Pod Autoscaler for the component. By default it only
+	// computes resource recommendations, shown in the status of the resource.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	VPA *VerticalPodAutoscalerSpec `json:"vpa,omitempty"`
 	// Number of replicas (ignored if hpa is enabled) for the component
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
 	Replicas *int32 `json:"replicas,omitempty"`
+	// ScalingSchedule is a list of recurring windows that override the
+	// replicas or the hpa limits of the component. If several windows are
+	// active at the same time, the first one in the list is used.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	ScalingSchedule []ScalingWindow `json:"scalingSchedule,omitempty"`
 	// Resource requirements for the component
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
@@ -236,6 +252,8 @@ type ListenerSpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
 	Tolerations []corev1.Toleration `json:"tolerations,omitempty" protobuf:"bytes,22,opt,name=tolerations"`
+	// Pod-level settings added to the Pods of the component
+	PodTemplateOverrides `json:",inline"`
 	// Canary defines spec changes for the canary Deployment. If
 	// left unset the canary Deployment wil not be created.
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
@@ -294,10 +312,21 @@ type WorkerSpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
 	HPA *HorizontalPodAutoscalerSpec `json:"hpa,omitempty"`
+	// Vertical Pod Autoscaler for the component. By default it only
+	// computes resource recommendations, shown in the status of the resource.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	VPA *VerticalPodAutoscalerSpec `json:"vpa,omitempty"`
 	// Number of replicas (ignored if hpa is enabled) for the component
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
 	Replicas *int32 `json:"replicas,omitempty"`
+	// ScalingSchedule is a list of recurring windows that override the
+	// replicas or the hpa limits of the component. If several windows are
+	// active at the same time, the first one in the list is used.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	ScalingSchedule []ScalingWindow `json:"scalingSchedule,omitempty"`
 	// Resource requirements for the component
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
@@ -318,6 +347,8 @@ type WorkerSpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
 	Tolerations []corev1.Toleration `json:"tolerations,omitempty" protobuf:"bytes,22,opt,name=tolerations"`
+	// Pod-level settings added to the Pods of the component
+	PodTemplateOverrides `json:",inline"`
 	// Canary defines spec changes for the canary Deployment. If
 	// left unset the canary Deployment wil not be created.
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
@@ -350,6 +381,11 @@ type CronSpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
 	Resources *ResourceRequirementsSpec `json:"resources,omitempty"`
+	// Vertical Pod Autoscaler for the component. By default it only
+	// computes resource recommendations, shown in the status of the resource.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	VPA *VerticalPodAutoscalerSpec `json:"vpa,omitempty"`
 	// Describes node affinity scheduling rules for the pod.
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
@@ -358,6 +394,8 @@ type CronSpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
 	Tolerations []corev1.Toleration `json:"tolerations,omitempty" protobuf:"bytes,22,opt,name=tolerations"`
+	// Pod-level settings added to the Pods of the component
+	PodTemplateOverrides `json:",inline"`
 }
 
 // Default implements defaulting for the each backend cron
@@ -466,10 +504,33 @@ func (cfg *WorkerConfig) Default() {
 }
 
 // BackendStatus defines the observed state of Backend
-type BackendStatus struct{}
+type BackendStatus struct {
+	WorkloadStatus `json:",inline"`
+	// Canaries is the status of the progressive delivery of the canaries
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	// +optional
+	Canaries CanaryStatuses `json:"canaries,omitempty"`
+	// ScalingWindows are the scaling windows currently active for the workloads
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	// +optional
+	ScalingWindows ScalingWindowStatuses `json:"scalingWindows,omitempty"`
+	// Maintenance is the status of the maintenance of the component
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	// +optional
+	Maintenance *MaintenanceStatus `json:"maintenance,omitempty"`
+	// Recommendations are the resource recommendations computed by the
+	// Vertical Pod Autoscalers of the workloads
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	// +optional
+	Recommendations ResourceRecommendations `json:"recommendations,omitempty"`
+}
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:JSONPath=`.status.conditions[?(@.type=="Ready")].status`,name=Ready,type=string
+// +kubebuilder:printcolumn:JSONPath=`.status.conditions[?(@.type=="Progressing")].status`,name=Progressing,type=string
+// +kubebuilder:printcolumn:JSONPath=`.status.conditions[?(@.type=="Degraded")].status`,name=Degraded,type=string
+// +kubebuilder:printcolumn:JSONPath=`.metadata.creationTimestamp`,name=Age,type=date
 
 // Backend is the Schema for the backends API
 type Backend struct {
@@ -0,0 +1,176 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	blueGreenDefaultRollbackWindow           time.Duration = 30 * time.Minute
+	blueGreenDefaultSmokeTestDeadlineSeconds int64         = 600
+	blueGreenDefaultSmokeTestBackoffLimit    int32         = 0
+)
+
+// BlueGreenSpec configures the blue/green delivery of a workload. Two full size
+// Deployments are run, one per colour, and the Service selector is switched to the
+// colour that runs the new revision once it is ready and the smoke test passes.
+type BlueGreenSpec struct {
+	// SmokeTest configures a Job that is run against the standby colour, before
+	// switching traffic to it. If unset, traffic is switched once the standby
+	// Deployment is ready.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	SmokeTest *BlueGreenSmokeTest `json:"smokeTest,omitempty"`
+	// RollbackWindow is the time the previous colour is kept running after
+	// the switch. Reverting the spec to the previous revision within the
+	// window switches traffic back instantly. Defaults to 30m.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	RollbackWindow *metav1.Duration `json:"rollbackWindow,omitempty"`
+	// State is the blue/green status computed by the controller
+	// during the reconcile. It is never persisted in the spec.
+	State *BlueGreenStatus `json:"-"`
+}
+
+// GetRollbackWindow returns the configured rollback window or the default one
+func (spec *BlueGreenSpec) GetRollbackWindow() metav1.Duration {
+	if spec.RollbackWindow == nil {
+		return metav1.Duration{Duration: blueGreenDefaultRollbackWindow}
+	}
+	return *spec.RollbackWindow
+}
+
+// BlueGreenSmokeTest configures the Job that validates the standby colour. The Job
+// can reach the standby Pods through the preview Services, named after each of the
+// workload Services with the "-preview" suffix.
+type BlueGreenSmokeTest struct {
+	// Image of the smoke test container. Defaults to the image of the workload.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Image *string `json:"image,omitempty"`
+	// Command of the smoke test container
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Command []string `json:"command,omitempty"`
+	// Args of the smoke test container
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Args []string `json:"args,omitempty"`
+	// Env of the smoke test container
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Env []corev1.EnvVar `json:"env,omitempty"`
+	// ActiveDeadlineSeconds of the smoke test Job. Defaults to 600.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	ActiveDeadlineSeconds *int64 `json:"activeDeadlineSeconds,omitempty"`
+	// BackoffLimit of the smoke test Job. Defaults to 0.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	BackoffLimit *int32 `json:"backoffLimit,omitempty"`
+}
+
+// GetActiveDeadlineSeconds returns the configured deadline or the default one
+func (st *BlueGreenSmokeTest) GetActiveDeadlineSeconds() int64 {
+	if st.ActiveDeadlineSeconds == nil {
+		return blueGreenDefaultSmokeTestDeadlineSeconds
+	}
+	return *st.ActiveDeadlineSeconds
+}
+
+// GetBackoffLimit returns the configured backoff limit or the default one
+func (st *BlueGreenSmokeTest) GetBackoffLimit() int32 {
+	if st.BackoffLimit == nil {
+		return blueGreenDefaultSmokeTestBackoffLimit
+	}
+	return *st.BackoffLimit
+}
+
+// BlueGreenColour identifies each of the Deployments of a blue/green workload
+type BlueGreenColour string
+
+const (
+	// BlueGreenColourBlue is the Deployment that uses the name of the workload
+	BlueGreenColourBlue BlueGreenColour = "blue"
+	// BlueGreenColourGreen is the Deployment that uses the name of
+	// the workload with the "-green" suffix
+	BlueGreenColourGreen BlueGreenColour = "green"
+)
+
+// Other returns the opposite colour
+func (c BlueGreenColour) Other() BlueGreenColour {
+	if c == BlueGreenColourGreen {
+		return BlueGreenColourBlue
+	}
+	return BlueGreenColourGreen
+}
+
+// BlueGreenPhase is the phase of the blue/green delivery of a workload
+type BlueGreenPhase string
+
+const (
+	// BlueGreenPhaseStable means that the active colour runs the desired revision
+	BlueGreenPhaseStable BlueGreenPhase = "Stable"
+	// BlueGreenPhaseDeploying means that the desired revision is being
+	// deployed to the standby colour
+	BlueGreenPhaseDeploying BlueGreenPhase = "Deploying"
+	// BlueGreenPhaseTesting means that the smoke test is being run
+	// against the standby colour
+	BlueGreenPhaseTesting BlueGreenPhase = "Testing"
+	// BlueGreenPhaseFailed means that the smoke test failed. Traffic
+	// stays in the active colour until a new revision is deployed.
+	BlueGreenPhaseFailed BlueGreenPhase = "Failed"
+)
+
+// BlueGreenStatus is the status of the blue/green delivery of a workload
+type BlueGreenStatus struct {
+	// Name of the workload
+	Name string `json:"name"`
+	// Phase of the blue/green delivery
+	Phase BlueGreenPhase `json:"phase"`
+	// Active is the colour that receives traffic
+	Active BlueGreenColour `json:"active"`
+	// ActiveRevision is the revision running in the active colour
+	ActiveRevision string `json:"activeRevision"`
+	// StandbyRevision is the revision running in the standby colour. It is the
+	// revision being deployed, or the previous revision during the rollback window.
+	// +optional
+	StandbyRevision string `json:"standbyRevision,omitempty"`
+	// SwitchTime is the last time traffic was switched between colours
+	// +optional
+	SwitchTime *metav1.Time `json:"switchTime,omitempty"`
+	// Human readable information about the current phase
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// BlueGreenStatuses is the list of statuses of the blue/green workloads of a resource
+type BlueGreenStatuses []BlueGreenStatus
+
+// Get returns the status of the workload with the given name, or nil
+func (l BlueGreenStatuses) Get(name string) *BlueGreenStatus {
+	for i := range l {
+		if l[i].Name == name {
+			return &l[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,242 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	canaryDefaultStepPause time.Duration = 5 * time.Minute
+	// CanaryPromoteAll is the value of the promotion annotation
+	// that promotes all the canaries of a resource
+	CanaryPromoteAll string = "all"
+)
+
+var (
+	// CanaryPromoteAnnotationKey is the annotation used to promote canaries. Its value
+	// is a comma separated list with the names of the canary Deployments to promote, or
+	// "all". The effective spec of each canary is merged into the main spec and the
+	// canary is removed. The annotation is removed once the promotion is done.
+	CanaryPromoteAnnotationKey string = fmt.Sprintf("%s/promote-canary", GroupVersion.Group)
+)
+
+// ProgressiveTrafficMode defines how traffic is shifted to the canary
+type ProgressiveTrafficMode string
+
+const (
+	// ProgressiveTrafficModeReplicas shifts traffic by scaling the canary Deployment
+	// so the ratio of canary/main Pods matches the weight of the step. Traffic is
+	// sent to all Pods through the Service, so the traffic split is approximate.
+	ProgressiveTrafficModeReplicas ProgressiveTrafficMode = "Replicas"
+	// ProgressiveTrafficModeRoute shifts traffic using weighted backends in the
+	// HTTPRoute/TLSRoute of the endpoints published with the 'Route' strategy, and
+	// weighted clusters in the typed routes of the marin3r sidecar that point to
+	// the workload in the same Pod. A separate Service is created for the canary
+	// Pods. The canary is rejected if the workload has no such endpoint.
+	ProgressiveTrafficModeRoute ProgressiveTrafficMode = "Route"
+)
+
+// ProgressiveDeliverySpec configures the progressive delivery of a canary
+type ProgressiveDeliverySpec struct {
+	// TrafficMode defines how traffic is shifted to the canary
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:Enum=Replicas;Route
+	// +optional
+	TrafficMode *ProgressiveTrafficMode `json:"trafficMode,omitempty"`
+	// Steps of the progressive delivery. Each step sends the configured
+	// weight of traffic to the canary and, once the pause has elapsed, runs
+	// the analysis to decide if the canary can progress to the next step.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:MinItems=1
+	Steps []CanaryStep `json:"steps"`
+	// Analysis configures the metric checks run at the end of each step.
+	// If unset, steps progress once their pause elapses.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Analysis *CanaryAnalysisSpec `json:"analysis,omitempty"`
+	// AutoPromote controls whether the main Deployment is automatically updated
+	// with the canary image once all the steps succeed. Defaults to true.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	AutoPromote *bool `json:"autoPromote,omitempty"`
+}
+
+// GetTrafficMode returns the configured traffic mode or the default one
+func (spec *ProgressiveDeliverySpec) GetTrafficMode() ProgressiveTrafficMode {
+	if spec.TrafficMode == nil {
+		return ProgressiveTrafficModeReplicas
+	}
+	return *spec.TrafficMode
+}
+
+// IsAutoPromoteEnabled returns whether the canary should be
+// promoted once all the steps succeed
+func (spec *ProgressiveDeliverySpec) IsAutoPromoteEnabled() bool {
+	return spec.AutoPromote == nil || *spec.AutoPromote
+}
+
+// CanaryStep is a step of the progressive delivery of a canary
+type CanaryStep struct {
+	// Weight is the percentage of traffic sent to the canary during the step
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	Weight int32 `json:"weight"`
+	// Pause is the time the canary stays in the step before the analysis
+	// is run. Defaults to 5m.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Pause *metav1.Duration `json:"pause,omitempty"`
+}
+
+// GetPause returns the pause of the step or the default one
+func (step *CanaryStep) GetPause() metav1.Duration {
+	if step.Pause == nil {
+		return metav1.Duration{Duration: canaryDefaultStepPause}
+	}
+	return *step.Pause
+}
+
+// CanaryAnalysisSpec configures the metric analysis of a canary
+type CanaryAnalysisSpec struct {
+	// PrometheusAddress is the address of the Prometheus API used to
+	// evaluate the metric checks (eg "http://prometheus-operated:9090")
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	PrometheusAddress string `json:"prometheusAddress"`
+	// Metrics are the checks evaluated at the end of each step. All of them
+	// need to pass for the canary to progress, otherwise it is rolled back.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:MinItems=1
+	Metrics []CanaryMetric `json:"metrics"`
+}
+
+// CanaryMetric is a metric check evaluated during the analysis of a canary
+type CanaryMetric struct {
+	// Name of the check, used to report its verdict in the status
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Name string `json:"name"`
+	// Query is the PromQL query evaluated. It must return a scalar or
+	// a vector with a single sample (eg the error rate of the canary Pods).
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Query string `json:"query"`
+	// Min is the minimum value for the check to pass
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:Pattern=`^-?[0-9]+(\.[0-9]+)?$`
+	// +optional
+	Min *string `json:"min,omitempty"`
+	// Max is the maximum value for the check to pass
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:Pattern=`^-?[0-9]+(\.[0-9]+)?$`
+	// +optional
+	Max *string `json:"max,omitempty"`
+}
+
+// Revision returns a hash that identifies the version of the workload deployed
+// as canary. Changes to the revision restart the progressive delivery.
+func (c *Canary) Revision() string {
+	doc, _ := json.Marshal(struct {
+		ImageName *string  `json:"imageName,omitempty"`
+		ImageTag  *string  `json:"imageTag,omitempty"`
+		Patches   []string `json:"patches,omitempty"`
+	}{c.ImageName, c.ImageTag, c.Patches})
+	h := fnv.New32a()
+	h.Write(doc)
+	return fmt.Sprintf("%x", h.Sum32())
+}
+
+// CanaryPhase is the phase of the progressive delivery of a canary
+type CanaryPhase string
+
+const (
+	// CanaryPhaseProgressing means that the canary is going through the steps
+	CanaryPhaseProgressing CanaryPhase = "Progressing"
+	// CanaryPhaseSucceeded means that all the steps succeeded and the canary
+	// is waiting to be promoted
+	CanaryPhaseSucceeded CanaryPhase = "Succeeded"
+	// CanaryPhasePromoted means that the main Deployment has been
+	// updated with the canary image
+	CanaryPhasePromoted CanaryPhase = "Promoted"
+	// CanaryPhaseRolledBack means that the analysis failed and
+	// traffic is no longer sent to the canary
+	CanaryPhaseRolledBack CanaryPhase = "RolledBack"
+	// CanaryPhaseRejected means that traffic can't be sent to the canary
+	// with the configured traffic mode, so the canary is not delivered
+	CanaryPhaseRejected CanaryPhase = "Rejected"
+)
+
+// CanaryStatus is the status of the progressive delivery of a canary
+type CanaryStatus struct {
+	// Name of the canary Deployment
+	Name string `json:"name"`
+	// Revision of the canary being delivered
+	Revision string `json:"revision"`
+	// Phase of the progressive delivery
+	Phase CanaryPhase `json:"phase"`
+	// Current step
+	CurrentStep int32 `json:"currentStep"`
+	// Weight of traffic currently sent to the canary
+	Weight int32 `json:"weight"`
+	// Time when the current step started
+	// +optional
+	StepStartTime *metav1.Time `json:"stepStartTime,omitempty"`
+	// Verdicts of the metric checks of each step
+	// +optional
+	Verdicts []CanaryVerdict `json:"verdicts,omitempty"`
+	// Human readable information about the current phase
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// IsFinished returns true if the progressive delivery reached
+// a phase where traffic is no longer sent to the canary
+func (status *CanaryStatus) IsFinished() bool {
+	return status.Phase == CanaryPhasePromoted || status.Phase == CanaryPhaseRolledBack ||
+		status.Phase == CanaryPhaseRejected
+}
+
+// CanaryVerdict is the result of a metric check
+type CanaryVerdict struct {
+	// Step the check was evaluated in
+	Step int32 `json:"step"`
+	// Name of the metric check
+	Metric string `json:"metric"`
+	// Value returned by the query
+	Value string `json:"value"`
+	// Whether the check passed or not
+	Passed bool `json:"passed"`
+	// Time of the evaluation
+	Time metav1.Time `json:"time"`
+}
+
+// CanaryStatuses is the list of statuses of the canaries of a workload
+type CanaryStatuses []CanaryStatus
+
+// Get returns the status of the canary with the given name, or nil
+func (l CanaryStatuses) Get(name string) *CanaryStatus {
+	for i := range l {
+		if l[i].Name == name {
+			return &l[i]
+		}
+	}
+	return nil
+}
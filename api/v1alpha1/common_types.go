@@ -389,6 +389,7 @@ func InitializePodDisruptionBudgetSpec(spec *PodDisruptionBudgetSpec, def defaul
 }
 
 // HorizontalPodAutoscalerSpec defines the HPA for the component
+// +kubebuilder:validation:XValidation:rule="!has(self.keda) || (!has(self.podsMetrics) && !has(self.externalMetrics))",message="podsMetrics and externalMetrics are not supported with keda, use keda triggers instead"
 type HorizontalPodAutoscalerSpec struct {
 	// Lower limit for the number of replicas to which the autoscaler
 	// can scale down.  It defaults to 1 pod.  minReplicas is allowed to be 0 if the
@@ -417,6 +418,100 @@ type HorizontalPodAutoscalerSpec struct {
 	// If not set, the default HPAScalingRules for scale up and scale down are used.
 	// +optional
 	Behavior *autoscalingv2.HorizontalPodAutoscalerBehavior `json:"behavior,omitempty"`
+	// PodsMetrics are per-pod metrics, averaged across all the Pods of the component,
+	// used to autoscale in addition to the resource metric. Not supported
+	// with keda, use keda triggers instead.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	PodsMetrics []autoscalingv2.PodsMetricSource `json:"podsMetrics,omitempty"`
+	// ExternalMetrics are metrics not related to any Kubernetes object (eg the
+	// length of a queue) used to autoscale in addition to the resource metric.
+	// Not supported with keda, use keda triggers instead.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	ExternalMetrics []autoscalingv2.ExternalMetricSource `json:"externalMetrics,omitempty"`
+	// KEDA autoscales the component with a KEDA ScaledObject instead of
+	// an HorizontalPodAutoscaler. The replica limits, the resource metric and
+	// the behavior are also used to configure the ScaledObject, while podsMetrics
+	// and externalMetrics must not be set. Requires KEDA to be installed in the
+	// cluster.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	KEDA *KEDASpec `json:"keda,omitempty"`
+}
+
+// KEDASpec configures autoscaling with a KEDA ScaledObject
+type KEDASpec struct {
+	// PollingInterval is the interval, in seconds, to check each trigger on.
+	// Defaults to 30 (KEDA default).
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	PollingInterval *int32 `json:"pollingInterval,omitempty"`
+	// CooldownPeriod is the period, in seconds, to wait after the last trigger
+	// reported active before scaling back to minReplicas when it is 0. Defaults
+	// to 300 (KEDA default).
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	CooldownPeriod *int32 `json:"cooldownPeriod,omitempty"`
+	// Triggers are the scalers that activate the scaling of the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:MinItems=1
+	Triggers []KEDATrigger `json:"triggers"`
+}
+
+// KEDATrigger is a KEDA scaler. Only one of the scaler types can be set.
+type KEDATrigger struct {
+	// Name of the trigger
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Name *string `json:"name,omitempty"`
+	// RedisListLength scales on the length of a redis list, such as resque
+	// ("resque:queue:<name>") or sidekiq ("queue:<name>") queues
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	RedisListLength *KEDARedisListLengthTrigger `json:"redisListLength,omitempty"`
+	// Prometheus scales on the result of a Prometheus query
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Prometheus *KEDAPrometheusTrigger `json:"prometheus,omitempty"`
+	// AuthenticationRef is the name of the KEDA TriggerAuthentication that
+	// holds the credentials to access the metric source
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	AuthenticationRef *string `json:"authenticationRef,omitempty"`
+}
+
+// KEDARedisListLengthTrigger scales on the length of a redis list
+type KEDARedisListLengthTrigger struct {
+	// Address of the redis server in host:port format
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Address string `json:"address"`
+	// ListName is the name of the redis list
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	ListName string `json:"listName"`
+	// ListLength is the target length of the list per replica
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:Minimum=1
+	ListLength int32 `json:"listLength"`
+	// DatabaseIndex of the redis database. Defaults to 0.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	DatabaseIndex *int32 `json:"databaseIndex,omitempty"`
+}
+
+// KEDAPrometheusTrigger scales on the result of a Prometheus query
+type KEDAPrometheusTrigger struct {
+	// ServerAddress is the address of the Prometheus API
+	// (eg "http://prometheus-operated:9090")
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	ServerAddress string `json:"serverAddress"`
+	// Query is the PromQL query to run. It must return a single value.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Query string `json:"query"`
+	// Threshold is the target value of the query per replica
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:Pattern=`^[0-9]+(\.[0-9]+)?$`
+	Threshold string `json:"threshold"`
 }
 
 type defaultHorizontalPodAutoscalerSpec struct {
@@ -548,6 +643,93 @@ func InitializeResourceRequirementsSpec(spec *ResourceRequirementsSpec, def defa
 	return spec
 }
 
+// PodTemplateOverrides are pod-level settings that can be added to the
+// Pods of any workload, on top of the ones generated by the operator
+type PodTemplateOverrides struct {
+	// TopologySpreadConstraints for the Pods of the component. If the label
+	// selector is unset, the Pods of the component are selected.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	TopologySpreadConstraints []corev1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
+	// PriorityClassName of the Pods of the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	PriorityClassName *string `json:"priorityClassName,omitempty"`
+	// SecurityContext of the Pods of the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	SecurityContext *corev1.PodSecurityContext `json:"securityContext,omitempty"`
+	// ServiceAccountName of the Pods of the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	ServiceAccountName *string `json:"serviceAccountName,omitempty"`
+	// ExtraVolumes are added to the Pods of the component. The schema of
+	// the volumes is not embedded in the CRD to keep its size down, so they
+	// are validated when the Deployment is applied.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:Type=array
+	// +optional
+	ExtraVolumes []SchemalessVolume `json:"extraVolumes,omitempty"`
+	// ExtraVolumeMounts are added to all the containers generated by the operator
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	ExtraVolumeMounts []corev1.VolumeMount `json:"extraVolumeMounts,omitempty"`
+	// ExtraEnv are added to all the containers generated by the operator,
+	// replacing the variables with the same name
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	ExtraEnv []corev1.EnvVar `json:"extraEnv,omitempty"`
+	// PodAnnotations are added to the Pods of the component. Annotations
+	// set by the operator take precedence.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	PodAnnotations map[string]string `json:"podAnnotations,omitempty"`
+	// InitContainers are run after the init containers generated by the operator.
+	// The schema of the containers is not embedded in the CRD to keep its size
+	// down, so they are validated when the Deployment is applied.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:Type=array
+	// +optional
+	InitContainers []SchemalessContainer `json:"initContainers,omitempty"`
+	// Sidecars are added to the Pods of the component. The schema of the
+	// containers is not embedded in the CRD to keep its size down, so they
+	// are validated when the Deployment is applied.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:Type=array
+	// +optional
+	Sidecars []SchemalessContainer `json:"sidecars,omitempty"`
+}
+
+// SchemalessContainer is a corev1.Container whose schema is not embedded in
+// the CRDs. It implements json.Marshaler so the generated schema is the one
+// set by the markers.
+// +kubebuilder:validation:Type=object
+// +kubebuilder:pruning:PreserveUnknownFields
+type SchemalessContainer corev1.Container
+
+func (c SchemalessContainer) MarshalJSON() ([]byte, error) {
+	return json.Marshal(corev1.Container(c))
+}
+
+func (c *SchemalessContainer) UnmarshalJSON(data []byte) error {
+	return json.Unmarshal(data, (*corev1.Container)(c))
+}
+
+// SchemalessVolume is a corev1.Volume whose schema is not embedded in the
+// CRDs. It implements json.Marshaler so the generated schema is the one
+// set by the markers.
+// +kubebuilder:validation:Type=object
+// +kubebuilder:pruning:PreserveUnknownFields
+type SchemalessVolume corev1.Volume
+
+func (v SchemalessVolume) MarshalJSON() ([]byte, error) {
+	return json.Marshal(corev1.Volume(v))
+}
+
+func (v *SchemalessVolume) UnmarshalJSON(data []byte) error {
+	return json.Unmarshal(data, (*corev1.Volume)(v))
+}
+
 // ExternalSecret is a reference to the ExternalSecret common configuration
 type ExternalSecret struct {
 	// SecretStoreRef defines which SecretStore to use when fetching the secret data
@@ -685,6 +867,13 @@ type Canary struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// + optional
 	Patches []string `json:"patches,omitempty"`
+	// Progressive enables progressive delivery of the canary: traffic is
+	// shifted to the canary in weighted steps, each one of them gated by
+	// metric analysis, and the canary is then either promoted or rolled back.
+	// When set, 'sendTraffic' and 'replicas' are managed by the operator.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Progressive *ProgressiveDeliverySpec `json:"progressive,omitempty"`
 }
 
 // PatchSpec returns a modified spec given the canary configuration
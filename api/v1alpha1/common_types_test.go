@@ -17,6 +17,7 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"encoding/json"
 	"reflect"
 	"testing"
 
@@ -1351,3 +1352,39 @@ func TestInitializeVaultSecretStoreReferenceSpec(t *testing.T) {
 		})
 	}
 }
+
+func TestPodTemplateOverrides_JSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    PodTemplateOverrides
+		wantErr bool
+	}{
+		{
+			name: "Decodes schemaless containers and volumes",
+			data: `{"initContainers":[{"name":"init","image":"busybox"}],"sidecars":[{"name":"sidecar"}],"extraVolumes":[{"name":"data","emptyDir":{}}]}`,
+			want: PodTemplateOverrides{
+				InitContainers: []SchemalessContainer{{Name: "init", Image: "busybox"}},
+				Sidecars:       []SchemalessContainer{{Name: "sidecar"}},
+				ExtraVolumes:   []SchemalessVolume{{Name: "data", VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}}},
+			},
+		},
+		{
+			name:    "Fails if the containers are not a list",
+			data:    `{"initContainers":{"foo":"bar"}}`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := PodTemplateOverrides{}
+			if err := json.Unmarshal([]byte(tt.data), &got); (err != nil) != tt.wantErr {
+				t.Errorf("json.Unmarshal() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("json.Unmarshal() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
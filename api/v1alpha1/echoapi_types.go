@@ -81,6 +81,11 @@ type EchoAPISpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
 	HPA *HorizontalPodAutoscalerSpec `json:"hpa,omitempty"`
+	// Vertical Pod Autoscaler for the component. By default it only
+	// computes resource recommendations, shown in the status of the resource.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	VPA *VerticalPodAutoscalerSpec `json:"vpa,omitempty"`
 	// Number of replicas (ignored if hpa is enabled) for the component
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
@@ -103,6 +108,8 @@ type EchoAPISpec struct {
 	// If specified, the pod's tolerations.
 	// +optional
 	Tolerations []corev1.Toleration `json:"tolerations,omitempty" protobuf:"bytes,22,opt,name=tolerations"`
+	// Pod-level settings added to the Pods of the component
+	PodTemplateOverrides `json:",inline"`
 	// Describes how the services provided by this workload are exposed to its consumers
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
@@ -140,10 +147,21 @@ func (spec *EchoAPISpec) Default() {
 }
 
 // EchoAPIStatus defines the observed state of EchoAPI
-type EchoAPIStatus struct{}
+type EchoAPIStatus struct {
+	WorkloadStatus `json:",inline"`
+	// Recommendations are the resource recommendations computed by the
+	// Vertical Pod Autoscalers of the workloads
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	// +optional
+	Recommendations ResourceRecommendations `json:"recommendations,omitempty"`
+}
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:JSONPath=`.status.conditions[?(@.type=="Ready")].status`,name=Ready,type=string
+// +kubebuilder:printcolumn:JSONPath=`.status.conditions[?(@.type=="Progressing")].status`,name=Progressing,type=string
+// +kubebuilder:printcolumn:JSONPath=`.status.conditions[?(@.type=="Degraded")].status`,name=Degraded,type=string
+// +kubebuilder:printcolumn:JSONPath=`.metadata.creationTimestamp`,name=Age,type=date
 
 // EchoAPI is the Schema for the echoapis API
 type EchoAPI struct {
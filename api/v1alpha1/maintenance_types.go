@@ -0,0 +1,80 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// MaintenanceMode selects the workloads that are stopped during a maintenance
+// +kubebuilder:validation:Enum=drain-workers;full
+type MaintenanceMode string
+
+const (
+	// MaintenanceModeDrainWorkers stops the background processing workloads
+	// (workers and crons), leaving the workloads that serve traffic up
+	MaintenanceModeDrainWorkers MaintenanceMode = "drain-workers"
+	// MaintenanceModeFull stops all the workloads, eg to run a database migration
+	MaintenanceModeFull MaintenanceMode = "full"
+)
+
+// MaintenanceSpec puts the workloads of a custom resource in maintenance. The workloads
+// stopped by the selected mode are scaled to zero, with their autoscaling disabled. The
+// replicas they had are stored in the status and restored when the maintenance ends.
+type MaintenanceSpec struct {
+	// Mode selects the workloads that are stopped
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Mode MaintenanceMode `json:"mode"`
+}
+
+// MaintenanceStatus is the status of a maintenance
+type MaintenanceStatus struct {
+	// Mode is the current maintenance mode
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	Mode MaintenanceMode `json:"mode"`
+	// Since is the time the maintenance started
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	Since metav1.Time `json:"since"`
+	// PreviousReplicas are the replicas of the stopped workloads
+	// before the maintenance, which are restored afterwards
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	// +optional
+	PreviousReplicas []MaintenanceReplicas `json:"previousReplicas,omitempty"`
+}
+
+// MaintenanceReplicas are the replicas of a Deployment before a maintenance
+type MaintenanceReplicas struct {
+	// Name of the Deployment
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	Name string `json:"name"`
+	// Replicas of the Deployment
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	Replicas int32 `json:"replicas"`
+}
+
+// GetPreviousReplicas returns the replicas stored for the given Deployment, if any
+func (status *MaintenanceStatus) GetPreviousReplicas(name string) *MaintenanceReplicas {
+	if status == nil {
+		return nil
+	}
+	for i := range status.PreviousReplicas {
+		if status.PreviousReplicas[i].Name == name {
+			return &status.PreviousReplicas[i]
+		}
+	}
+	return nil
+}
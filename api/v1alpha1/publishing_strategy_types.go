@@ -2,6 +2,7 @@ package v1alpha1
 
 import (
 	"context"
+	"fmt"
 	"reflect"
 	"sort"
 
@@ -62,12 +63,13 @@ type Strategy string
 const (
 	SimpleStrategy         Strategy = "Simple"
 	Marin3rSidecarStrategy Strategy = "Marin3rSidecar"
+	RouteStrategy          Strategy = "Route"
 )
 
 type PublishingStrategy struct {
 	// Strategy defines the type of publishing strategy
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
-	// +kubebuilder:validation:Enum=Simple;Marin3rSidecar
+	// +kubebuilder:validation:Enum=Simple;Marin3rSidecar;Route
 	Strategy Strategy `json:"strategy"`
 	// EndpointName defines the endpoint affected by this publishing strategy
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
@@ -80,6 +82,10 @@ type PublishingStrategy struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
 	Marin3rSidecar *Marin3rSidecarSpec `json:"marin3rSidecar,omitempty"`
+	// Route holds configuration for the Route publishing strategy
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Route *RouteStrategySpec `json:"route,omitempty"`
 	// Create explicitely tells the controller that this is a new endpoint that
 	// should be added. Default is false, causing the controller to error when seeing
 	// an unknown endpoint.
@@ -134,6 +140,100 @@ func (s *Simple) Default() {
 	}
 }
 
+type RouteKind string
+
+const (
+	RouteKindHTTPRoute RouteKind = "HTTPRoute"
+	RouteKindTLSRoute  RouteKind = "TLSRoute"
+	RouteKindIngress   RouteKind = "Ingress"
+)
+
+// RouteStrategySpec publishes the endpoint using a Gateway API route or an
+// Ingress that points to the endpoint's Service. The options of the Service
+// are the same as in the Simple strategy.
+type RouteStrategySpec struct {
+	*Simple `json:",inline"`
+	// The kind of object used to publish the endpoint. HTTPRoute and TLSRoute
+	// are Gateway API resources. Ingress can be used in clusters without the
+	// Gateway API installed. Defaults to HTTPRoute.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:Enum=HTTPRoute;TLSRoute;Ingress
+	// +optional
+	Kind *RouteKind `json:"kind,omitempty"`
+	// The Gateways the route attaches to. Required for HTTPRoute and TLSRoute.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	ParentRefs []RouteParentRef `json:"parentRefs,omitempty"`
+	// The IngressClass of the Ingress. Only used if kind is Ingress.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	IngressClassName *string `json:"ingressClassName,omitempty"`
+	// The hostnames the endpoint is published at. Required for TLSRoute.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Hostnames []string `json:"hostnames,omitempty"`
+	// The name of a Secret of type 'kubernetes.io/tls' with the certificate
+	// for the hostnames. Only used if kind is Ingress, as TLS termination is
+	// configured in the listeners of the Gateway when using the Gateway API.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	TLSSecretName *string `json:"tlsSecretName,omitempty"`
+	// The path prefix to route to the endpoint. Not used by TLSRoute. Defaults to "/".
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	PathPrefix *string `json:"pathPrefix,omitempty"`
+	// The name of the Service port to route traffic to.
+	// Defaults to the first port of the Service.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Port *string `json:"port,omitempty"`
+	// The weight of the endpoint's Service when ExtraBackends are defined.
+	// Not used by Ingress. Defaults to 1.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	Weight *int32 `json:"weight,omitempty"`
+	// Other Services that receive part of the traffic, according to their
+	// weights. Not used by Ingress.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	ExtraBackends []RouteBackend `json:"extraBackends,omitempty"`
+	// CanaryWeight is the percentage of traffic sent to the canary Service
+	// during progressive delivery. It is set by the operator and not exposed.
+	CanaryWeight *int32 `json:"-"`
+}
+
+// RouteParentRef is a reference to a Gateway
+type RouteParentRef struct {
+	// The name of the Gateway
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Name string `json:"name"`
+	// The namespace of the Gateway. Defaults to the namespace of the route.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Namespace *string `json:"namespace,omitempty"`
+	// The name of a listener of the Gateway. If unset, the
+	// route attaches to all the compatible listeners.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	SectionName *string `json:"sectionName,omitempty"`
+}
+
+// RouteBackend is a Service that receives part of the traffic of a route
+type RouteBackend struct {
+	// The name of the Service
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Name string `json:"name"`
+	// The port of the Service
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Port int32 `json:"port"`
+	// The weight of the backend. Defaults to 1.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	Weight *int32 `json:"weight,omitempty"`
+}
+
 // Marin3rSidecarSpec defines the marin3r sidecar for the component
 type Marin3rSidecarSpec struct {
 	*Simple `json:",inline"`
@@ -176,6 +276,261 @@ type Marin3rSidecarSpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
 	EnvoyDynamicConfig MapOfEnvoyDynamicConfig `json:"dynamicConfigs,omitempty"`
+	// Tracing configuration for the sidecar. If set, spans are generated for
+	// the requests handled by all the http listeners and sent to the collector.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Tracing *TracingOptions `json:"tracing,omitempty"`
+	// Stats sink configuration for the sidecar. If set, the envoy stats are
+	// pushed to an OpenTelemetry collector. Stats sinks are part of the envoy
+	// bootstrap, so they are passed to marin3r in the
+	// 'marin3r.3scale.net/envoy-extra-args' annotation.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	StatsSink *StatsSinkOptions `json:"statsSink,omitempty"`
+	// CanaryWeight is the percentage of the traffic of the routes to the local
+	// clusters that is sent to the canary during progressive delivery. It is set
+	// by the operator and not exposed.
+	CanaryWeight *int32 `json:"-"`
+	// CanaryHost is the address of the Service of the canary Pods, which the
+	// canary clusters point to. It is set by the operator and not exposed.
+	CanaryHost *string `json:"-"`
+}
+
+// StatsCollectorClusterName is the name of the static cluster that
+// is added to the bootstrap to send the stats to the stats collector
+const StatsCollectorClusterName string = "stats_collector"
+
+// StatsSinkOptions configures an OpenTelemetry stats sink that
+// pushes the envoy stats to a collector using OTLP over gRPC
+type StatsSinkOptions struct {
+	// The host of the OpenTelemetry collector. A static cluster
+	// named "stats_collector" is added to the bootstrap to reach it.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:MinLength=1
+	CollectorHost string `json:"collectorHost"`
+	// The OTLP gRPC port of the OpenTelemetry collector
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:Minimum=1
+	CollectorPort uint32 `json:"collectorPort"`
+	// The interval between flushes of the stats to
+	// the collector. Defaults to envoy's default of 5s.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	FlushInterval *metav1.Duration `json:"flushInterval,omitempty"`
+	// Report the counters as the delta since the last flush
+	// instead of cumulative values. Defaults to false.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	ReportCountersAsDeltas *bool `json:"reportCountersAsDeltas,omitempty"`
+}
+
+// TracingCollectorClusterName is the name of the cluster that
+// is generated to send the spans to the tracing collector
+const TracingCollectorClusterName string = "tracing_collector"
+
+// CanaryClusterSuffix is appended to the name of the local clusters to name
+// the clusters that point to the canary Pods during progressive delivery
+const CanaryClusterSuffix string = "_canary"
+
+// DynamicConfigs returns the list of envoy dynamic configurations of the sidecar. If
+// tracing is enabled, the tracing options are added to the http listeners and a cluster
+// pointing to the tracing collector is added to the list. During the progressive delivery
+// of a canary, the routes to the local clusters are split between them and a copy of each
+// cluster that points to the canary Pods. An error is returned if a dynamic config uses the
+// name reserved for the cluster of the tracing collector.
+func (spec *Marin3rSidecarSpec) DynamicConfigs() ([]envoyconfig.EnvoyDynamicConfigDescriptor, error) {
+	list := spec.EnvoyDynamicConfig.AsList()
+	if len(list) == 0 {
+		return list, nil
+	}
+
+	if spec.Tracing != nil {
+		if _, ok := spec.EnvoyDynamicConfig[TracingCollectorClusterName]; ok {
+			return nil, fmt.Errorf("dynamic config name '%s' is reserved for the cluster of the tracing collector",
+				TracingCollectorClusterName)
+		}
+		for _, desc := range list {
+			if conf, ok := desc.(*EnvoyDynamicConfig); ok && conf.ListenerHttp != nil {
+				conf.ListenerHttp.Tracing = spec.Tracing.DeepCopy()
+			}
+		}
+
+		collector := &EnvoyDynamicConfig{
+			GeneratorVersion: util.Pointer("v1"),
+			Cluster: &Cluster{
+				Host:    spec.Tracing.CollectorHost,
+				Port:    spec.Tracing.CollectorPort,
+				IsHttp2: util.Pointer(spec.Tracing.Provider == TracingProviderOpenTelemetry),
+			},
+		}
+		list = append(list, collector.AsEnvoyDynamicConfigDescriptor(TracingCollectorClusterName))
+	}
+
+	if spec.CanaryWeight != nil && spec.CanaryHost != nil {
+		list = append(list, spec.splitCanaryTraffic(list)...)
+	}
+
+	sort.Slice(list, func(a, b int) bool {
+		return list[a].GetName() < list[b].GetName()
+	})
+
+	return list, nil
+}
+
+// CanaryClusters returns the clusters whose traffic can be split with the canary: the ones
+// that point to the workload in the same Pod and are the target of a typed route. Routes
+// in the raw virtual hosts can't be split.
+func (spec *Marin3rSidecarSpec) CanaryClusters() map[string]*Cluster {
+	clusters := map[string]*Cluster{}
+	for _, conf := range spec.EnvoyDynamicConfig {
+		if conf.RouteConfiguration == nil {
+			continue
+		}
+		for _, vhost := range conf.RouteConfiguration.TypedVirtualHosts {
+			for _, route := range vhost.Routes {
+				if route.Route == nil || route.Route.Cluster == nil {
+					continue
+				}
+				if target, ok := spec.EnvoyDynamicConfig[*route.Route.Cluster]; ok && target.Cluster.isLocal() {
+					clusters[*route.Route.Cluster] = target.Cluster
+				}
+			}
+		}
+	}
+	return clusters
+}
+
+// splitCanaryTraffic sends the canary weight of the routes to the local clusters to a copy
+// of each cluster that points to the canary Pods. The route configurations of the list are
+// modified and the canary clusters are returned.
+func (spec *Marin3rSidecarSpec) splitCanaryTraffic(list []envoyconfig.EnvoyDynamicConfigDescriptor) []envoyconfig.EnvoyDynamicConfigDescriptor {
+	clusters := spec.CanaryClusters()
+	weight := min(max(*spec.CanaryWeight, 0), 100)
+
+	for _, desc := range list {
+		conf, ok := desc.(*EnvoyDynamicConfig)
+		if !ok || conf.RouteConfiguration == nil {
+			continue
+		}
+		for i := range conf.RouteConfiguration.TypedVirtualHosts {
+			routes := conf.RouteConfiguration.TypedVirtualHosts[i].Routes
+			for j := range routes {
+				action := routes[j].Route
+				if action == nil || action.Cluster == nil {
+					continue
+				}
+				if _, ok := clusters[*action.Cluster]; !ok {
+					continue
+				}
+				action.WeightedClusters = []WeightedCluster{
+					{Name: *action.Cluster, Weight: uint32(100 - weight)},
+					{Name: *action.Cluster + CanaryClusterSuffix, Weight: uint32(weight)},
+				}
+				action.Cluster = nil
+			}
+		}
+	}
+
+	canaries := make([]envoyconfig.EnvoyDynamicConfigDescriptor, 0, len(clusters))
+	for name := range clusters {
+		cluster := spec.EnvoyDynamicConfig[name]
+		conf := cluster.DeepCopy()
+		conf.Default()
+		if conf.Cluster.Host != "" {
+			conf.Cluster.Host = *spec.CanaryHost
+		}
+		for i := range conf.Cluster.Endpoints {
+			conf.Cluster.Endpoints[i].Host = *spec.CanaryHost
+		}
+		canaries = append(canaries, conf.AsEnvoyDynamicConfigDescriptor(name+CanaryClusterSuffix))
+	}
+	return canaries
+}
+
+// isLocal returns true if the cluster points to the same Pod
+func (c *Cluster) isLocal() bool {
+	if c == nil {
+		return false
+	}
+	isLocalHost := func(host string) bool { return host == "127.0.0.1" || host == "localhost" }
+	if len(c.Endpoints) == 0 {
+		return isLocalHost(c.Host)
+	}
+	for _, ep := range c.Endpoints {
+		if !isLocalHost(ep.Host) {
+			return false
+		}
+	}
+	return true
+}
+
+type TracingProvider string
+
+const (
+	TracingProviderOpenTelemetry TracingProvider = "OpenTelemetry"
+	TracingProviderZipkin        TracingProvider = "Zipkin"
+)
+
+// TracingOptions configures the generation of spans for the
+// requests handled by envoy
+type TracingOptions struct {
+	// The tracing provider. OpenTelemetry spans are sent to the collector
+	// using OTLP over gRPC and propagate the W3C trace context, while Zipkin
+	// spans are sent using the Zipkin HTTP API and propagate B3 headers.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:Enum=OpenTelemetry;Zipkin
+	Provider TracingProvider `json:"provider"`
+	// The host of the tracing collector. A cluster named
+	// "tracing_collector" is generated to reach it.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:MinLength=1
+	CollectorHost string `json:"collectorHost"`
+	// The port of the tracing collector
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:Minimum=1
+	CollectorPort uint32 `json:"collectorPort"`
+	// The service name reported in the OpenTelemetry spans.
+	// Only used by the OpenTelemetry provider.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	ServiceName *string `json:"serviceName,omitempty"`
+	// The API endpoint of the Zipkin collector. Only used by the
+	// Zipkin provider. Defaults to "/api/v2/spans".
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	ZipkinCollectorEndpoint *string `json:"zipkinCollectorEndpoint,omitempty"`
+	// The percentage of requests that are traced when the incoming
+	// request is not already part of a trace. Defaults to 100.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	SamplingPercent *uint32 `json:"samplingPercent,omitempty"`
+	// Custom tags to add to the spans
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	CustomTags []TracingCustomTag `json:"customTags,omitempty"`
+}
+
+// TracingCustomTag is a tag added to the spans. The value of the
+// tag is taken from one of literal, requestHeader or environment.
+type TracingCustomTag struct {
+	// The name of the tag
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Tag string `json:"tag"`
+	// A literal value
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Literal *string `json:"literal,omitempty"`
+	// The name of a request header whose value is used
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	RequestHeader *string `json:"requestHeader,omitempty"`
+	// The name of an environment variable of the envoy
+	// container whose value is used
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Environment *string `json:"environment,omitempty"`
 }
 
 type defaultMarin3rSidecarSpec struct {
@@ -244,7 +599,9 @@ func (mapofconfs MapOfEnvoyDynamicConfig) AsList() []envoyconfig.EnvoyDynamicCon
 	list := make([]envoyconfig.EnvoyDynamicConfigDescriptor, 0, len(mapofconfs))
 
 	for name, conf := range mapofconfs {
-		list = append(list, conf.DeepCopy().AsEnvoyDynamicConfigDescriptor(name))
+		c := conf.DeepCopy()
+		c.Default()
+		list = append(list, c.AsEnvoyDynamicConfigDescriptor(name))
 	}
 
 	// ensure consistent order of configs
@@ -270,6 +627,10 @@ type EnvoyDynamicConfig struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
 	ListenerHttp *ListenerHttp `json:"listenerHttp,omitempty"`
+	// ListenerTcp contains options for a TCP proxy listener
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	ListenerTcp *ListenerTcp `json:"listenerTcp,omitempty"`
 	// RouteConfiguration contains options for an Envoy route_configuration
 	// protobuffer message
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
@@ -288,6 +649,14 @@ type EnvoyDynamicConfig struct {
 	RawConfig *RawConfig `json:"rawConfig,omitempty"`
 }
 
+// Default sets default values for any value not specifically set in the
+// options of the EnvoyDynamicConfig that are not defaulted by the API server
+func (config *EnvoyDynamicConfig) Default() {
+	if config.ListenerTcp != nil {
+		config.ListenerTcp.Default()
+	}
+}
+
 // AsEnvoyDynamicConfigDescriptor converts the external API type into the internal EnvoyDynamicConfigDescriptor
 // interface. The name field is populated with the parameter passed to the function.
 func (config *EnvoyDynamicConfig) AsEnvoyDynamicConfigDescriptor(name string) envoyconfig.EnvoyDynamicConfigDescriptor {
@@ -307,6 +676,8 @@ func (config *EnvoyDynamicConfig) GetGeneratorVersion() string {
 func (config *EnvoyDynamicConfig) GetOptions() interface{} {
 	if config.ListenerHttp != nil {
 		return config.ListenerHttp
+	} else if config.ListenerTcp != nil {
+		return config.ListenerTcp
 	} else if config.RouteConfiguration != nil {
 		return config.RouteConfiguration
 	} else if config.Cluster != nil {
@@ -343,6 +714,21 @@ type ListenerHttp struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
 	RateLimitOptions *RateLimitOptions `json:"rateLimitOptions,omitempty"`
+	// Local (in-sidecar) rate limit options. Local rate limiting must be
+	// enabled in the listener for the per route local rate limits to apply.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	LocalRateLimit *LocalRateLimit `json:"localRateLimit,omitempty"`
+	// External authorization options. If set, each request is
+	// authorized by an external service before being routed.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	ExtAuthz *ExtAuthz `json:"extAuthz,omitempty"`
+	// JWT authentication options. If set, requests must carry a
+	// valid JWT issued by one of the providers.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	JwtAuthn *JwtAuthn `json:"jwtAuthn,omitempty"`
 	// If this filed is set, http 1.0 will be enabled and this will be
 	// the default hostname to use.
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
@@ -362,6 +748,200 @@ type ListenerHttp struct {
 	// +optional
 	// Max connection duration. If unset no max connection duration will be applied.
 	MaxConnectionDuration *metav1.Duration `json:"maxConnectionDuration,omitempty"`
+	// Access log options. If unset, every request is logged to stdout
+	// using the default set of fields.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	AccessLog *AccessLogOptions `json:"accessLog,omitempty"`
+	// hidden field, populated from the tracing
+	// options of the Marin3rSidecarSpec
+	Tracing *TracingOptions `json:"-"`
+}
+
+// AccessLogOptions contains options for the access logs of a listener
+type AccessLogOptions struct {
+	// Additional fields to add to the access log. Values can use any of the
+	// envoy command operators (eg "%REQ(X-REQUEST-ID)%"). Fields with the same
+	// name as the default ones override them.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	ExtraFields map[string]string `json:"extraFields,omitempty"`
+	// Request headers to add to the access log. Each header is logged in a field
+	// named after the header, lowercased and with dashes replaced by underscores.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	RequestHeaders []string `json:"requestHeaders,omitempty"`
+	// Response headers to add to the access log. Each header is logged in a field
+	// named after the header, lowercased, with dashes replaced by underscores and
+	// prefixed with "response_".
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	ResponseHeaders []string `json:"responseHeaders,omitempty"`
+	// Only log requests whose response status code matches the filter
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	StatusCodeFilter *AccessLogComparisonFilter `json:"statusCodeFilter,omitempty"`
+	// Only log requests whose duration, in milliseconds, matches the filter
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	DurationFilter *AccessLogComparisonFilter `json:"durationFilter,omitempty"`
+	// Only log a percentage of the requests. The percentage can be
+	// overridden at runtime using the runtime key.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Sampling *AccessLogSampling `json:"sampling,omitempty"`
+	// How to combine the filters when more than one is set. Use "Or" to, for example,
+	// log all the errors but only a sample of the successful requests. Defaults to "And".
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:Enum=And;Or
+	// +optional
+	FilterOperator *AccessLogFilterOperator `json:"filterOperator,omitempty"`
+	// Also send the access logs to a gRPC access log service or to an
+	// OpenTelemetry collector. The same filters apply to both types of sink.
+	// The OpenTelemetry sink logs the same fields, as attributes. The gRPC
+	// access log service uses the fixed schema of envoy's HTTPAccessLogEntry,
+	// which includes RequestHeaders and ResponseHeaders but not ExtraFields.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	GrpcSink *AccessLogGrpcSink `json:"grpcSink,omitempty"`
+}
+
+type AccessLogFilterOperator string
+
+const (
+	AccessLogFilterOperatorAnd AccessLogFilterOperator = "And"
+	AccessLogFilterOperatorOr  AccessLogFilterOperator = "Or"
+)
+
+type AccessLogComparisonOperator string
+
+const (
+	AccessLogComparisonOperatorEQ AccessLogComparisonOperator = "EQ"
+	AccessLogComparisonOperatorGE AccessLogComparisonOperator = "GE"
+	AccessLogComparisonOperatorLE AccessLogComparisonOperator = "LE"
+)
+
+// AccessLogComparisonFilter compares a request property with a value
+type AccessLogComparisonFilter struct {
+	// The comparison operator
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:Enum=EQ;GE;LE
+	Operator AccessLogComparisonOperator `json:"operator"`
+	// The value to compare with
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Value uint32 `json:"value"`
+	// The runtime key that can be used to override the value. Defaults
+	// to "access_log.<listener>.status_code" or "access_log.<listener>.duration".
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	RuntimeKey *string `json:"runtimeKey,omitempty"`
+}
+
+// AccessLogSampling configures the sampling of the access logs
+type AccessLogSampling struct {
+	// The percentage of requests to log
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:Maximum=100
+	Percent uint32 `json:"percent"`
+	// The runtime key that can be used to override the percentage.
+	// Defaults to "access_log.<listener>.sampling".
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	RuntimeKey *string `json:"runtimeKey,omitempty"`
+}
+
+type AccessLogGrpcSinkType string
+
+const (
+	AccessLogGrpcSinkTypeGrpcALS       AccessLogGrpcSinkType = "GrpcALS"
+	AccessLogGrpcSinkTypeOpenTelemetry AccessLogGrpcSinkType = "OpenTelemetry"
+)
+
+// AccessLogGrpcSink configures a gRPC access log sink
+type AccessLogGrpcSink struct {
+	// The type of sink, either an envoy gRPC access log service
+	// ("GrpcALS") or an OpenTelemetry collector ("OpenTelemetry")
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:Enum=GrpcALS;OpenTelemetry
+	Type AccessLogGrpcSinkType `json:"type"`
+	// The cluster where the sink is located. Must point
+	// to one of the defined clusters.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Cluster string `json:"cluster"`
+	// The name of the log. Defaults to the name of the listener.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	LogName *string `json:"logName,omitempty"`
+}
+
+// ListenerTcp contains options for a TCP proxy listener
+type ListenerTcp struct {
+	// The port where the listener listens for new connections
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Port uint32 `json:"port"`
+	// Whether proxy protocol should be enabled or not. Defaults to true.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:default:=true
+	// +optional
+	ProxyProtocol *bool `json:"proxyProtocol,omitempty"`
+	// The cluster where connections are proxied to when they don't
+	// match any of the filter chains. Must point to one of the defined
+	// clusters. At least one of Cluster or FilterChains must be set.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Cluster *string `json:"cluster,omitempty"`
+	// Filter chains that select the upstream cluster using the SNI of
+	// the TLS connection. TLS is not terminated in the listener, connections
+	// are proxied as is to the upstream cluster (TLS passthrough).
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	FilterChains []TcpFilterChain `json:"filterChains,omitempty"`
+	// The idle timeout for connections. The connection is closed if there
+	// are no bytes sent or received during this time. Defaults to 1h.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	IdleTimeout *metav1.Duration `json:"idleTimeout,omitempty"`
+	// Access log options. If unset, every connection is logged to stdout
+	// using the default set of fields.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	AccessLog *TcpAccessLogOptions `json:"accessLog,omitempty"`
+}
+
+// Default sets default values for any value not specifically set in the ListenerTcp struct
+func (l *ListenerTcp) Default() {
+	if l.ProxyProtocol == nil {
+		l.ProxyProtocol = util.Pointer(true)
+	}
+}
+
+// TcpFilterChain routes TLS connections to an upstream
+// cluster based on the requested server name (SNI)
+type TcpFilterChain struct {
+	// The list of server names that match this filter chain.
+	// Wildcards like "*.example.com" are supported.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:MinItems=1
+	ServerNames []string `json:"serverNames"`
+	// The cluster where matching connections are proxied to.
+	// Must point to one of the defined clusters.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Cluster string `json:"cluster"`
+}
+
+// TcpAccessLogOptions contains options for the access logs of a TCP listener
+type TcpAccessLogOptions struct {
+	// Additional fields to add to the access log. Values can use any of the
+	// envoy command operators (eg "%UPSTREAM_LOCAL_ADDRESS%"). Fields with the
+	// same name as the default ones override them.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	ExtraFields map[string]string `json:"extraFields,omitempty"`
+	// Only log a percentage of the connections. The percentage can be
+	// overridden at runtime using the runtime key.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Sampling *AccessLogSampling `json:"sampling,omitempty"`
 }
 
 // RateLimitOptions contains options for the ratelimit filter of the
@@ -386,28 +966,788 @@ type RateLimitOptions struct {
 	RateLimitCluster string `json:"rateLimitCluster"`
 }
 
-// Cluster contains options for an Envoy cluster protobuffer message
-type Cluster struct {
-	// The upstream host
+// LocalRateLimit contains options for the local
+// rate limit filter of the http connection manager. The
+// filter can be disabled or enforced at runtime with the
+// 'local_rate_limit.<listener>.enabled|enforced' keys, or
+// 'local_rate_limit.<route_config>.<virtual_host>.<route>.enabled|enforced'
+// for routes, where <route> is the route name or its index if unnamed.
+type LocalRateLimit struct {
+	// The token bucket. In a listener, if unset, only the routes with
+	// a local rate limit are rate limited. Required in routes.
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
-	Host string `json:"host"`
-	// The upstream port
+	// +optional
+	TokenBucket *TokenBucket `json:"tokenBucket,omitempty"`
+	// Only report the requests that would be rate limited in the
+	// stats, without rejecting them. Defaults to false.
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
-	Port uint32 `json:"port"`
-	// Specifies if the upstream cluster is http2 or not (default).
+	// +optional
+	DryRun *bool `json:"dryRun,omitempty"`
+}
+
+// TokenBucket configures a token bucket. Each request consumes
+// a token and requests are rejected with a 429 if no tokens are left.
+type TokenBucket struct {
+	// The maximum number of tokens in the bucket. The
+	// bucket starts full.
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
-	// +kubebuilder:default:=false
+	// +kubebuilder:validation:Minimum=1
+	MaxTokens uint32 `json:"maxTokens"`
+	// The number of tokens added to the bucket on each
+	// fill interval. Defaults to 1.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:Minimum=1
 	// +optional
-	IsHttp2 *bool `json:"isHttp2"`
+	TokensPerFill *uint32 `json:"tokensPerFill,omitempty"`
+	// The fill interval of the bucket
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	FillInterval metav1.Duration `json:"fillInterval"`
 }
 
-// RouteConfiguration contains options for an Envoy route_configuration
-// protobuffer message
-type RouteConfiguration struct {
-	// The virtual_hosts definitions for this route configuration.
-	// Virtual hosts must be specified using directly Envoy's API
+type ExtAuthzType string
+
+const (
+	ExtAuthzTypeGrpc ExtAuthzType = "Grpc"
+	ExtAuthzTypeHttp ExtAuthzType = "Http"
+)
+
+// ExtAuthz contains options for the external
+// authorization filter of the http connection manager
+type ExtAuthz struct {
+	// The protocol of the authorization service
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:Enum=Grpc;Http
+	Type ExtAuthzType `json:"type"`
+	// Location of the authorization service. Must point to one of
+	// the defined clusters.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Cluster string `json:"cluster"`
+	// The timeout for the authorization requests. Defaults to 200ms.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+	// Let requests through when the authorization service fails
+	// or can't be reached. Defaults to false.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	FailureModeAllow *bool `json:"failureModeAllow,omitempty"`
+	// Send the client certificate to the authorization service, so it
+	// can authorize based on the mTLS identity. Defaults to false.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	IncludePeerCertificate *bool `json:"includePeerCertificate,omitempty"`
+	// Prefix for the path of the authorization requests. Only
+	// used with the "Http" type.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	PathPrefix *string `json:"pathPrefix,omitempty"`
+	// Request headers sent to the authorization service besides the
+	// default ones. Only used with the "Http" type.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	AllowedRequestHeaders []string `json:"allowedRequestHeaders,omitempty"`
+	// Headers of the authorization response that are added to the
+	// upstream request. Only used with the "Http" type.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	AllowedUpstreamHeaders []string `json:"allowedUpstreamHeaders,omitempty"`
+}
+
+// JwtAuthn contains options for the JWT
+// authentication filter of the http connection manager
+type JwtAuthn struct {
+	// The JWT providers. A request is accepted if it carries a
+	// valid JWT from any of them.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:MinItems=1
+	Providers []JwtProvider `json:"providers"`
+	// Accept requests without a JWT. Requests with an invalid
+	// JWT are still rejected. Defaults to false.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	AllowMissing *bool `json:"allowMissing,omitempty"`
+}
+
+// JwtProvider configures how to verify the JWTs
+// issued by a provider. One of RemoteJwks or
+// LocalJwks must be set.
+type JwtProvider struct {
+	// The name of the provider
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Name string `json:"name"`
+	// The expected issuer of the JWTs. If unset, the issuer
+	// is not checked.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Issuer *string `json:"issuer,omitempty"`
+	// The allowed audiences. If unset, the audience is not checked.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Audiences []string `json:"audiences,omitempty"`
+	// Fetch the JWKS from a remote server
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	RemoteJwks *JwtRemoteJwks `json:"remoteJwks,omitempty"`
+	// Inline JWKS, in JSON format
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	LocalJwks *string `json:"localJwks,omitempty"`
+	// The headers the JWT is read from. Defaults to the
+	// "Authorization" header with the "Bearer " prefix.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	FromHeaders []JwtHeader `json:"fromHeaders,omitempty"`
+	// Keep the JWT in the request forwarded upstream. Defaults to false.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Forward *bool `json:"forward,omitempty"`
+	// If set, the base64 encoded payload of a verified JWT is
+	// forwarded upstream in this header.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	ForwardPayloadHeader *string `json:"forwardPayloadHeader,omitempty"`
+	// Claims of a verified JWT that are forwarded upstream as headers
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	ClaimToHeaders []JwtClaimToHeader `json:"claimToHeaders,omitempty"`
+}
+
+// JwtRemoteJwks configures the fetching of a remote JWKS
+type JwtRemoteJwks struct {
+	// The URI of the JWKS
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	URI string `json:"uri"`
+	// The cluster used to fetch the JWKS. Must point to one of
+	// the defined clusters.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Cluster string `json:"cluster"`
+	// The timeout for the fetch requests. Defaults to 1s.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+	// How long the JWKS is cached. Defaults to 5m.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	CacheDuration *metav1.Duration `json:"cacheDuration,omitempty"`
+}
+
+// JwtHeader is a header a JWT is read from
+type JwtHeader struct {
+	// The name of the header
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Name string `json:"name"`
+	// The prefix that precedes the JWT in the header value (e.g "Bearer ")
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	ValuePrefix *string `json:"valuePrefix,omitempty"`
+}
+
+// JwtClaimToHeader forwards a claim of a JWT as a header
+type JwtClaimToHeader struct {
+	// The name of the header
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	HeaderName string `json:"headerName"`
+	// The name of the claim. Nested claims use dots as
+	// separator (e.g "address.country").
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	ClaimName string `json:"claimName"`
+}
+
+// Cluster contains options for an Envoy cluster protobuffer message
+type Cluster struct {
+	// The upstream host. Required by the "v1" generator. The "v2" generator
+	// accepts either this field or the Endpoints field.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Host string `json:"host,omitempty"`
+	// The upstream port. Required by the "v1" generator. The "v2" generator
+	// accepts either this field or the Endpoints field.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Port uint32 `json:"port,omitempty"`
+	// Specifies if the upstream cluster is http2 or not (default).
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:default:=false
+	// +optional
+	IsHttp2 *bool `json:"isHttp2"`
+	// The list of upstream endpoints of the cluster. Only
+	// used by the "v2" generator.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Endpoints []ClusterEndpoint `json:"endpoints,omitempty"`
+	// The service discovery type used to resolve the endpoints. Defaults
+	// to "StrictDNS". Only used by the "v2" generator.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:Enum=StrictDNS;LogicalDNS;Static
+	// +optional
+	DiscoveryType *ClusterDiscoveryType `json:"discoveryType,omitempty"`
+	// The timeout for new network connections to the upstream hosts.
+	// Defaults to 1s. Only used by the "v2" generator.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	ConnectTimeout *metav1.Duration `json:"connectTimeout,omitempty"`
+	// The load balancing policy of the cluster. Defaults to
+	// "RoundRobin". Only used by the "v2" generator.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:Enum=RoundRobin;LeastRequest;RingHash;Maglev;Random
+	// +optional
+	LbPolicy *ClusterLbPolicy `json:"lbPolicy,omitempty"`
+	// Active health checks for the upstream hosts. Only
+	// used by the "v2" generator.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	HealthChecks []ClusterHealthCheck `json:"healthChecks,omitempty"`
+	// Outlier detection (passive health checking) for the upstream
+	// hosts. Only used by the "v2" generator.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	OutlierDetection *ClusterOutlierDetection `json:"outlierDetection,omitempty"`
+	// Circuit breaker thresholds for the cluster. Only
+	// used by the "v2" generator.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	CircuitBreakers *ClusterCircuitBreakers `json:"circuitBreakers,omitempty"`
+	// Configures TLS for the connections to the upstream hosts. Only
+	// used by the "v2" generator.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	UpstreamTLS *ClusterUpstreamTLS `json:"upstreamTLS,omitempty"`
+}
+
+type ClusterDiscoveryType string
+
+const (
+	ClusterDiscoveryTypeStrictDNS  ClusterDiscoveryType = "StrictDNS"
+	ClusterDiscoveryTypeLogicalDNS ClusterDiscoveryType = "LogicalDNS"
+	ClusterDiscoveryTypeStatic     ClusterDiscoveryType = "Static"
+)
+
+type ClusterLbPolicy string
+
+const (
+	ClusterLbPolicyRoundRobin   ClusterLbPolicy = "RoundRobin"
+	ClusterLbPolicyLeastRequest ClusterLbPolicy = "LeastRequest"
+	ClusterLbPolicyRingHash     ClusterLbPolicy = "RingHash"
+	ClusterLbPolicyMaglev       ClusterLbPolicy = "Maglev"
+	ClusterLbPolicyRandom       ClusterLbPolicy = "Random"
+)
+
+// ClusterEndpoint is an upstream endpoint of a cluster
+type ClusterEndpoint struct {
+	// The upstream host
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Host string `json:"host"`
+	// The upstream port
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Port uint32 `json:"port"`
+	// The load balancing weight of the endpoint
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	Weight *uint32 `json:"weight,omitempty"`
+}
+
+// ClusterHealthCheck configures an active health check. One
+// of Http or Tcp must be set.
+type ClusterHealthCheck struct {
+	// The time to wait for a health check response
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Timeout metav1.Duration `json:"timeout"`
+	// The interval between health checks
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Interval metav1.Duration `json:"interval"`
+	// The number of unhealthy health checks required before
+	// a host is marked unhealthy
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	UnhealthyThreshold uint32 `json:"unhealthyThreshold"`
+	// The number of healthy health checks required before
+	// a host is marked healthy
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	HealthyThreshold uint32 `json:"healthyThreshold"`
+	// Http health check
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Http *ClusterHttpHealthCheck `json:"http,omitempty"`
+	// Tcp health check. An empty object performs a
+	// connect only health check.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Tcp *ClusterTcpHealthCheck `json:"tcp,omitempty"`
+}
+
+// ClusterHttpHealthCheck configures an HTTP health check
+type ClusterHttpHealthCheck struct {
+	// The path to request
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Path string `json:"path"`
+	// The value of the host header. Defaults to the name of the cluster.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Host *string `json:"host,omitempty"`
+}
+
+// ClusterTcpHealthCheck configures a TCP health check
+type ClusterTcpHealthCheck struct {
+	// Hex encoded payload to send. If unset, a connect
+	// only health check is performed.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Send *string `json:"send,omitempty"`
+	// Hex encoded payloads that must be found in the
+	// response for the check to succeed
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Receive []string `json:"receive,omitempty"`
+}
+
+// ClusterOutlierDetection configures outlier detection for a cluster
+type ClusterOutlierDetection struct {
+	// The number of consecutive 5xx responses before
+	// an ejection occurs. Defaults to 5.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Consecutive5xx *uint32 `json:"consecutive5xx,omitempty"`
+	// The number of consecutive gateway failures (502, 503, 504)
+	// before an ejection occurs. Defaults to 5.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	ConsecutiveGatewayFailure *uint32 `json:"consecutiveGatewayFailure,omitempty"`
+	// The time interval between ejection analysis sweeps. Defaults to 10s.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Interval *metav1.Duration `json:"interval,omitempty"`
+	// The base time that a host is ejected for. Defaults to 30s.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	BaseEjectionTime *metav1.Duration `json:"baseEjectionTime,omitempty"`
+	// The maximum % of the hosts that can be ejected. Defaults to 10%.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	MaxEjectionPercent *uint32 `json:"maxEjectionPercent,omitempty"`
+}
+
+// ClusterCircuitBreakers configures the circuit breaker
+// thresholds of a cluster
+type ClusterCircuitBreakers struct {
+	// The maximum number of connections to the upstream cluster
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	MaxConnections *uint32 `json:"maxConnections,omitempty"`
+	// The maximum number of pending requests to the upstream cluster
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	MaxPendingRequests *uint32 `json:"maxPendingRequests,omitempty"`
+	// The maximum number of parallel requests to the upstream cluster
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	MaxRequests *uint32 `json:"maxRequests,omitempty"`
+	// The maximum number of parallel retries to the upstream cluster.
+	// Ignored if RetryBudget is set.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	MaxRetries *uint32 `json:"maxRetries,omitempty"`
+	// Limits the number of active retries as a
+	// proportion of the active requests
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	RetryBudget *ClusterRetryBudget `json:"retryBudget,omitempty"`
+}
+
+// ClusterRetryBudget limits the number of active retries
+type ClusterRetryBudget struct {
+	// The % of active requests that can be retries. Defaults to 20%.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	BudgetPercent *uint32 `json:"budgetPercent,omitempty"`
+	// The minimum number of retries that are always allowed,
+	// regardless of the budget. Defaults to 3.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	MinRetryConcurrency *uint32 `json:"minRetryConcurrency,omitempty"`
+}
+
+// ClusterUpstreamTLS configures TLS for the connections
+// to the upstream hosts
+type ClusterUpstreamTLS struct {
+	// The SNI to use during the TLS handshake
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	SNI *string `json:"sni,omitempty"`
+	// Validation of the certificate presented by the upstream hosts. If
+	// unset, the upstream certificate is not verified.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Validation *UpstreamTLSValidation `json:"validation,omitempty"`
+	// The name of a Secret of type 'kubernetes.io/tls' holding the client
+	// certificate and key that envoy presents to the upstream hosts (mutual TLS).
+	// The Secret is served to envoy through the discovery service.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	ClientCertificateSecretName *string `json:"clientCertificateSecretName,omitempty"`
+}
+
+// UpstreamTLSValidation configures the validation of the
+// certificates presented by the upstream hosts
+type UpstreamTLSValidation struct {
+	// Path to the bundle of trusted CA certificates within the envoy
+	// container. Defaults to the system bundle. Ignored if
+	// trustedCASecretName is set.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	TrustedCAFile *string `json:"trustedCAFile,omitempty"`
+	// The name of a Secret of type 'kubernetes.io/tls' whose 'tls.crt' key
+	// holds the trusted CA certificates. The Secret is served to envoy through
+	// the discovery service, so private CAs can be used without mounting
+	// them in the envoy container.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	TrustedCASecretName *string `json:"trustedCASecretName,omitempty"`
+	// If set, the upstream certificate must have a DNS subject
+	// alternative name that exactly matches one of these values
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	MatchSubjectAltNames []string `json:"matchSubjectAltNames,omitempty"`
+}
+
+// RouteConfiguration contains options for an Envoy route_configuration
+// protobuffer message
+type RouteConfiguration struct {
+	// The virtual_hosts definitions for this route configuration.
+	// Virtual hosts must be specified using directly Envoy's API. Use
+	// TypedVirtualHosts instead unless a feature is not supported there.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	VirtualHosts []runtime.RawExtension `json:"virtualHosts,omitempty"`
+	// The virtual hosts of this route configuration, using the operator's typed
+	// API. Typed virtual hosts are added before the ones in VirtualHosts.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	TypedVirtualHosts []VirtualHost `json:"typedVirtualHosts,omitempty"`
+}
+
+// VirtualHost contains options for an Envoy virtual host
+type VirtualHost struct {
+	// The name of the virtual host
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Name string `json:"name"`
+	// The list of domains that match this virtual host. Wildcards
+	// like "*.example.com" or "*" are supported.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:MinItems=1
+	Domains []string `json:"domains"`
+	// The list of routes of the virtual host. The first route
+	// that matches the request is used.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Routes []Route `json:"routes"`
+	// Rate limit actions that apply to all the routes of the virtual host
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	RateLimits []RateLimit `json:"rateLimits,omitempty"`
+}
+
+// Route contains options for an Envoy route. Exactly one
+// of Route, Redirect or DirectResponse must be set.
+type Route struct {
+	// The name of the route
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Name *string `json:"name,omitempty"`
+	// The conditions a request must match to use this route
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Match RouteMatch `json:"match"`
+	// Send the request to an upstream cluster
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Route *RouteAction `json:"route,omitempty"`
+	// Reply with a redirect
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Redirect *RedirectAction `json:"redirect,omitempty"`
+	// Reply directly with the given status and body
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	DirectResponse *DirectResponseAction `json:"directResponse,omitempty"`
+	// Local (in-sidecar) rate limit for the route. Local rate limiting
+	// must also be enabled in the listener.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	LocalRateLimit *LocalRateLimit `json:"localRateLimit,omitempty"`
+	// Disables the external authorization for the route
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	DisableExtAuthz *bool `json:"disableExtAuthz,omitempty"`
+	// Disables the JWT authentication for the route
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	DisableJwtAuthn *bool `json:"disableJwtAuthn,omitempty"`
+}
+
+// RouteMatch contains the conditions to match a route. Exactly
+// one of Prefix, Path or Regex must be set.
+type RouteMatch struct {
+	// Match if the path starts with this prefix
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Prefix *string `json:"prefix,omitempty"`
+	// Match if the path is exactly this one
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Path *string `json:"path,omitempty"`
+	// Match if the path matches this RE2 regular expression
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Regex *string `json:"regex,omitempty"`
+	// Whether the path match is case sensitive. Defaults to true.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	CaseSensitive *bool `json:"caseSensitive,omitempty"`
+	// Additional headers that the request must match
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Headers []HeaderMatcher `json:"headers,omitempty"`
+}
+
+// HeaderMatcher matches a request header. Exactly one of
+// Exact, Prefix, Regex or Present must be set.
+type HeaderMatcher struct {
+	// The name of the header
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Name string `json:"name"`
+	// Match if the header value is exactly this one
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Exact *string `json:"exact,omitempty"`
+	// Match if the header value starts with this prefix
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Prefix *string `json:"prefix,omitempty"`
+	// Match if the header value matches this RE2 regular expression
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Regex *string `json:"regex,omitempty"`
+	// Match if the header is present (true) or absent (false)
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Present *bool `json:"present,omitempty"`
+	// Invert the result of the match
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Invert *bool `json:"invert,omitempty"`
+}
+
+// RouteAction sends requests to an upstream cluster. Exactly
+// one of Cluster or WeightedClusters must be set.
+type RouteAction struct {
+	// The upstream cluster. Must point to one of the defined clusters.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Cluster *string `json:"cluster,omitempty"`
+	// Split the traffic between several upstream clusters
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	WeightedClusters []WeightedCluster `json:"weightedClusters,omitempty"`
+	// The timeout for the whole request, including retries. Envoy
+	// uses 15s if unset. Use 0s to disable the timeout.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+	// The idle timeout for the request stream
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	IdleTimeout *metav1.Duration `json:"idleTimeout,omitempty"`
+	// Replace the matched prefix of the path with this value
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	PrefixRewrite *string `json:"prefixRewrite,omitempty"`
+	// Rewrite the path using a regular expression
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	RegexRewrite *RegexRewrite `json:"regexRewrite,omitempty"`
+	// Replace the host header with this value
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	HostRewrite *string `json:"hostRewrite,omitempty"`
+	// Retry policy for the route
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	RetryPolicy *RetryPolicy `json:"retryPolicy,omitempty"`
+	// Rate limit actions for the route
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	RateLimits []RateLimit `json:"rateLimits,omitempty"`
+}
+
+// WeightedCluster is an upstream cluster that receives
+// a share of the traffic of a route
+type WeightedCluster struct {
+	// The upstream cluster. Must point to one of the defined clusters.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Name string `json:"name"`
+	// The share of the traffic that the cluster receives,
+	// relative to the sum of all the weights
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Weight uint32 `json:"weight"`
+}
+
+// RegexRewrite rewrites the path using a regular expression
+type RegexRewrite struct {
+	// The RE2 regular expression
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Pattern string `json:"pattern"`
+	// The substitution string. Capture groups can be referenced with "\1", "\2", etc.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Substitution string `json:"substitution"`
+}
+
+// RetryPolicy contains options for the retries of a route
+type RetryPolicy struct {
+	// Comma separated list of the conditions that trigger a retry
+	// (eg "5xx,reset,connect-failure,retriable-status-codes")
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	RetryOn string `json:"retryOn"`
+	// The number of retries. Defaults to 1.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	NumRetries *uint32 `json:"numRetries,omitempty"`
+	// The timeout of each try, including the first one
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	PerTryTimeout *metav1.Duration `json:"perTryTimeout,omitempty"`
+	// Status codes that trigger a retry when "retriable-status-codes"
+	// is in the list of retry conditions
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	RetriableStatusCodes []uint32 `json:"retriableStatusCodes,omitempty"`
+}
+
+type RedirectResponseCode string
+
+const (
+	RedirectResponseCodeMovedPermanently  RedirectResponseCode = "MovedPermanently"
+	RedirectResponseCodeFound             RedirectResponseCode = "Found"
+	RedirectResponseCodeSeeOther          RedirectResponseCode = "SeeOther"
+	RedirectResponseCodeTemporaryRedirect RedirectResponseCode = "TemporaryRedirect"
+	RedirectResponseCodePermanentRedirect RedirectResponseCode = "PermanentRedirect"
+)
+
+// RedirectAction replies to requests with a redirect
+type RedirectAction struct {
+	// Redirect to https
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	HttpsRedirect *bool `json:"httpsRedirect,omitempty"`
+	// Replace the host with this value
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	HostRedirect *string `json:"hostRedirect,omitempty"`
+	// Replace the path with this value
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	PathRedirect *string `json:"pathRedirect,omitempty"`
+	// Replace the matched prefix of the path with this value
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	PrefixRewrite *string `json:"prefixRewrite,omitempty"`
+	// The response code of the redirect. Defaults to "MovedPermanently".
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:Enum=MovedPermanently;Found;SeeOther;TemporaryRedirect;PermanentRedirect
+	// +optional
+	ResponseCode *RedirectResponseCode `json:"responseCode,omitempty"`
+	// Remove the query string from the redirect
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	StripQuery *bool `json:"stripQuery,omitempty"`
+}
+
+// DirectResponseAction replies directly to requests
+type DirectResponseAction struct {
+	// The response status code
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:Minimum=200
+	// +kubebuilder:validation:Maximum=599
+	Status uint32 `json:"status"`
+	// The response body
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Body *string `json:"body,omitempty"`
+}
+
+// RateLimit is a set of actions that generate a descriptor
+// that is sent to the rate limit service
+type RateLimit struct {
+	// The list of actions. A descriptor entry is added
+	// for each of them.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:MinItems=1
+	Actions []RateLimitAction `json:"actions"`
+}
+
+// RateLimitAction generates a descriptor entry. Exactly one
+// of the fields must be set.
+type RateLimitAction struct {
+	// Adds a "remote_address" entry with the client IP
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	RemoteAddress *bool `json:"remoteAddress,omitempty"`
+	// Adds an entry with the value of a request header
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	RequestHeader *RateLimitRequestHeader `json:"requestHeader,omitempty"`
+	// Adds an entry with a fixed value
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	GenericKey *RateLimitGenericKey `json:"genericKey,omitempty"`
+	// Adds a "header_match" entry if the request headers match
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	HeaderValueMatch *RateLimitHeaderValueMatch `json:"headerValueMatch,omitempty"`
+}
+
+// RateLimitRequestHeader adds a descriptor entry with
+// the value of a request header
+type RateLimitRequestHeader struct {
+	// The name of the header
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	HeaderName string `json:"headerName"`
+	// The key of the descriptor entry
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	DescriptorKey string `json:"descriptorKey"`
+	// Don't generate the descriptor if the header is absent
+	// instead of skipping the whole rate limit
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	SkipIfAbsent *bool `json:"skipIfAbsent,omitempty"`
+}
+
+// RateLimitGenericKey adds a descriptor entry with a fixed value
+type RateLimitGenericKey struct {
+	// The key of the descriptor entry. Defaults to "generic_key".
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	DescriptorKey *string `json:"descriptorKey,omitempty"`
+	// The value of the descriptor entry
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	DescriptorValue string `json:"descriptorValue"`
+}
+
+// RateLimitHeaderValueMatch adds a descriptor entry if
+// the request headers match
+type RateLimitHeaderValueMatch struct {
+	// The value of the descriptor entry
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	DescriptorValue string `json:"descriptorValue"`
+	// Whether the entry is added when the headers match (true)
+	// or when they don't match (false). Defaults to true.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	ExpectMatch *bool `json:"expectMatch,omitempty"`
+	// The headers to match
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
-	VirtualHosts []runtime.RawExtension `json:"virtualHosts"`
+	// +kubebuilder:validation:MinItems=1
+	Headers []HeaderMatcher `json:"headers"`
 }
 
 // Runtime contains options for an Envoy runtime protobuffer message
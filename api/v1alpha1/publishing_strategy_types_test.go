@@ -260,6 +260,22 @@ func TestMapOfEnvoyDynamicConfig_AsList(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Defaults the options of the configs",
+			mapofconfs: map[string]EnvoyDynamicConfig{
+				"tcp": {
+					GeneratorVersion: new(string),
+					ListenerTcp:      &ListenerTcp{Port: 5000},
+				},
+			},
+			want: []envoyconfig.EnvoyDynamicConfigDescriptor{
+				&EnvoyDynamicConfig{
+					Name:             "tcp",
+					GeneratorVersion: new(string),
+					ListenerTcp:      &ListenerTcp{Port: 5000, ProxyProtocol: util.Pointer(true)},
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -270,6 +286,112 @@ func TestMapOfEnvoyDynamicConfig_AsList(t *testing.T) {
 	}
 }
 
+func TestMarin3rSidecarSpec_DynamicConfigs(t *testing.T) {
+	tracing := &TracingOptions{
+		Provider:      TracingProviderOpenTelemetry,
+		CollectorHost: "otel-collector",
+		CollectorPort: 4317,
+	}
+	tests := []struct {
+		name    string
+		spec    *Marin3rSidecarSpec
+		want    []envoyconfig.EnvoyDynamicConfigDescriptor
+		wantErr bool
+	}{
+		{
+			name: "Returns the dynamic configs as a list",
+			spec: &Marin3rSidecarSpec{
+				EnvoyDynamicConfig: map[string]EnvoyDynamicConfig{
+					"http": {GeneratorVersion: util.Pointer("v1"), ListenerHttp: &ListenerHttp{}},
+				},
+			},
+			want: []envoyconfig.EnvoyDynamicConfigDescriptor{
+				&EnvoyDynamicConfig{Name: "http", GeneratorVersion: util.Pointer("v1"), ListenerHttp: &ListenerHttp{}},
+			},
+		},
+		{
+			name: "Adds tracing to the http listeners and the collector cluster",
+			spec: &Marin3rSidecarSpec{
+				EnvoyDynamicConfig: map[string]EnvoyDynamicConfig{
+					"http":    {GeneratorVersion: util.Pointer("v1"), ListenerHttp: &ListenerHttp{}},
+					"backend": {GeneratorVersion: util.Pointer("v1"), Cluster: &Cluster{Host: "127.0.0.1", Port: 3000}},
+				},
+				Tracing: tracing,
+			},
+			want: []envoyconfig.EnvoyDynamicConfigDescriptor{
+				&EnvoyDynamicConfig{Name: "backend", GeneratorVersion: util.Pointer("v1"),
+					Cluster: &Cluster{Host: "127.0.0.1", Port: 3000}},
+				&EnvoyDynamicConfig{Name: "http", GeneratorVersion: util.Pointer("v1"),
+					ListenerHttp: &ListenerHttp{Tracing: tracing}},
+				&EnvoyDynamicConfig{Name: TracingCollectorClusterName, GeneratorVersion: util.Pointer("v1"),
+					Cluster: &Cluster{Host: "otel-collector", Port: 4317, IsHttp2: util.Pointer(true)}},
+			},
+		},
+		{
+			name: "Splits the routes to the local clusters with the canary",
+			spec: &Marin3rSidecarSpec{
+				EnvoyDynamicConfig: map[string]EnvoyDynamicConfig{
+					"backend": {GeneratorVersion: util.Pointer("v1"), Cluster: &Cluster{Host: "127.0.0.1", Port: 3000}},
+					"remote":  {GeneratorVersion: util.Pointer("v1"), Cluster: &Cluster{Host: "remote", Port: 80}},
+					"router": {GeneratorVersion: util.Pointer("v1"), RouteConfiguration: &RouteConfiguration{
+						TypedVirtualHosts: []VirtualHost{{Name: "vhost", Domains: []string{"*"}, Routes: []Route{
+							{Match: RouteMatch{Prefix: util.Pointer("/remote")}, Route: &RouteAction{Cluster: util.Pointer("remote")}},
+							{Match: RouteMatch{Prefix: util.Pointer("/")}, Route: &RouteAction{Cluster: util.Pointer("backend")}},
+						}}},
+					}},
+				},
+				CanaryWeight: util.Pointer[int32](20),
+				CanaryHost:   util.Pointer("canary.ns.svc.cluster.local"),
+			},
+			want: []envoyconfig.EnvoyDynamicConfigDescriptor{
+				&EnvoyDynamicConfig{Name: "backend", GeneratorVersion: util.Pointer("v1"),
+					Cluster: &Cluster{Host: "127.0.0.1", Port: 3000}},
+				&EnvoyDynamicConfig{Name: "backend" + CanaryClusterSuffix, GeneratorVersion: util.Pointer("v1"),
+					Cluster: &Cluster{Host: "canary.ns.svc.cluster.local", Port: 3000}},
+				&EnvoyDynamicConfig{Name: "remote", GeneratorVersion: util.Pointer("v1"),
+					Cluster: &Cluster{Host: "remote", Port: 80}},
+				&EnvoyDynamicConfig{Name: "router", GeneratorVersion: util.Pointer("v1"), RouteConfiguration: &RouteConfiguration{
+					TypedVirtualHosts: []VirtualHost{{Name: "vhost", Domains: []string{"*"}, Routes: []Route{
+						{Match: RouteMatch{Prefix: util.Pointer("/remote")}, Route: &RouteAction{Cluster: util.Pointer("remote")}},
+						{Match: RouteMatch{Prefix: util.Pointer("/")}, Route: &RouteAction{WeightedClusters: []WeightedCluster{
+							{Name: "backend", Weight: 80},
+							{Name: "backend" + CanaryClusterSuffix, Weight: 20},
+						}}},
+					}}},
+				}},
+			},
+		},
+		{
+			name: "Does not add the collector cluster if there are no dynamic configs",
+			spec: &Marin3rSidecarSpec{Tracing: tracing},
+			want: []envoyconfig.EnvoyDynamicConfigDescriptor{},
+		},
+		{
+			name: "Fails if a dynamic config uses the name of the tracing collector cluster",
+			spec: &Marin3rSidecarSpec{
+				EnvoyDynamicConfig: map[string]EnvoyDynamicConfig{
+					"http":                      {GeneratorVersion: util.Pointer("v1"), ListenerHttp: &ListenerHttp{}},
+					TracingCollectorClusterName: {GeneratorVersion: util.Pointer("v1"), Cluster: &Cluster{Host: "127.0.0.1", Port: 3000}},
+				},
+				Tracing: tracing,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.spec.DynamicConfigs()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Marin3rSidecarSpec.DynamicConfigs() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if diff := cmp.Diff(got, tt.want); len(diff) > 0 {
+				t.Errorf("Marin3rSidecarSpec.DynamicConfigs() = diff %v", diff)
+			}
+		})
+	}
+}
+
 func TestWorkloadPublishingStrategyUpgrader_Build(t *testing.T) {
 	type fields struct {
 		EndpointName         string
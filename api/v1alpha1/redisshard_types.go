@@ -31,9 +31,10 @@ var (
 		Tag:        util.Pointer("4.0.11-alpine"),
 		PullPolicy: (*corev1.PullPolicy)(util.Pointer(string(corev1.PullIfNotPresent))),
 	}
-	redisShardDefaultMasterIndex int32  = 0
-	redisShardDefaultCommand     string = "redis-server /redis/redis.conf"
-	RedisShardDefaultReplicas    int32  = 3
+	redisShardDefaultMasterIndex       int32  = 0
+	redisShardDefaultCommand           string = "redis-server /redis/redis.conf"
+	redisShardDefaultAnnounceHostnames bool   = false
+	RedisShardDefaultReplicas          int32  = 3
 )
 
 // RedisShardSpec defines the desired state of RedisShard
@@ -55,6 +56,14 @@ type RedisShardSpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
 	Command *string `json:"command,omitempty"`
+	// AnnounceHostnames configures the redis servers to announce themselves
+	// using the stable DNS name that the headless Service provides for each
+	// Pod instead of the Pod IP, so the identity of each server survives
+	// Pod restarts. Requires redis 6.2 or higher and the Sentinel resource
+	// monitoring the shard must have 'resolveHostnames' enabled.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	AnnounceHostnames *bool `json:"announceHostnames,omitempty"`
 }
 
 // Default implements defaulting for RedisShardSpec
@@ -64,6 +73,7 @@ func (spec *RedisShardSpec) Default() {
 	spec.MasterIndex = intOrDefault(spec.MasterIndex, &redisShardDefaultMasterIndex)
 	spec.SlaveCount = intOrDefault(spec.SlaveCount, util.Pointer(RedisShardDefaultReplicas-1))
 	spec.Command = stringOrDefault(spec.Command, &redisShardDefaultCommand)
+	spec.AnnounceHostnames = boolOrDefault(spec.AnnounceHostnames, util.Pointer(redisShardDefaultAnnounceHostnames))
 }
 
 type RedisShardNodes struct {
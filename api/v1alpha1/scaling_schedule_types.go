@@ -0,0 +1,81 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ScalingWindow overrides the replicas of a workload during a recurring period
+// of time, so capacity can follow predictable (eg diurnal) traffic patterns.
+// Windows with an invalid schedule or duration are skipped and reported with
+// a warning Event.
+type ScalingWindow struct {
+	// Name of the window
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Name string `json:"name"`
+	// Schedule is a cron expression for the start of the window. Times are UTC
+	// unless the expression is prefixed with "CRON_TZ=<zone> " (eg
+	// "CRON_TZ=Europe/Madrid 0 8 * * 1-5").
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Schedule string `json:"schedule"`
+	// Duration of the window
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Duration metav1.Duration `json:"duration"`
+	// Replicas overrides the number of replicas during the window.
+	// Ignored if the hpa is enabled.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Replicas *int32 `json:"replicas,omitempty"`
+	// MinReplicas overrides the lower limit of the hpa during the window
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	MinReplicas *int32 `json:"minReplicas,omitempty"`
+	// MaxReplicas overrides the upper limit of the hpa during the window
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	MaxReplicas *int32 `json:"maxReplicas,omitempty"`
+}
+
+// ScalingWindowStatus is the scaling window currently active for a workload
+type ScalingWindowStatus struct {
+	// Name of the workload
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	Name string `json:"name"`
+	// Window is the name of the active scaling window
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	Window string `json:"window"`
+	// Start is the time the window started
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	Start metav1.Time `json:"start"`
+	// End is the time the window ends
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	End metav1.Time `json:"end"`
+}
+
+// ScalingWindowStatuses is the list of the active scaling windows of a custom resource
+type ScalingWindowStatuses []ScalingWindowStatus
+
+// Get returns the active scaling window of the given workload, or nil if there is none
+func (l ScalingWindowStatuses) Get(name string) *ScalingWindowStatus {
+	for i := range l {
+		if l[i].Name == name {
+			return &l[i]
+		}
+	}
+	return nil
+}
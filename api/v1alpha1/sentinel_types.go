@@ -72,6 +72,7 @@ var (
 	}
 	sentinelDefaultStorageSize            string        = "10Mi"
 	sentinelDefaultMetricsRefreshInterval time.Duration = 30 * time.Second
+	sentinelDefaultResolveHostnames       bool          = false
 )
 
 // SentinelConfig defines configuration options for the component
@@ -103,6 +104,14 @@ type SentinelConfig struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
 	MetricsRefreshInterval *time.Duration `json:"metricsRefreshInterval,omitempty"`
+	// ResolveHostnames enables the 'resolve-hostnames' and 'announce-hostnames'
+	// sentinel options, so redis servers can be monitored using DNS names
+	// instead of IP addresses. When enabled, the hostnames in the monitored
+	// shards are no longer resolved to IPs by the operator. Requires redis 6.2
+	// or higher.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	ResolveHostnames *bool `json:"resolveHostnames,omitempty"`
 }
 
 // Default sets default values for any value not specifically set in the AutoSSLConfig struct
@@ -115,6 +124,8 @@ func (cfg *SentinelConfig) Default() {
 	if cfg.MetricsRefreshInterval == nil {
 		cfg.MetricsRefreshInterval = &sentinelDefaultMetricsRefreshInterval
 	}
+
+	cfg.ResolveHostnames = boolOrDefault(cfg.ResolveHostnames, util.Pointer(sentinelDefaultResolveHostnames))
 }
 
 // SentinelSpec defines the desired state of Sentinel
@@ -205,7 +216,9 @@ func (ss *SentinelStatus) ShardedCluster(ctx context.Context, pool *redis.Server
 			if err != nil {
 				return nil, err
 			}
-			servers = append(servers, sharded.NewRedisServerFromParams(srv, rsd.Role, rsd.Config))
+			rs := sharded.NewRedisServerFromParams(srv, rsd.Role, rsd.Config)
+			rs.IP = rsd.IP
+			servers = append(servers, rs)
 		}
 		sort.Slice(servers, func(i, j int) bool {
 			return servers[i].ID() < servers[j].ID()
@@ -246,6 +259,11 @@ type RedisServerDetails struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=status
 	// +optional
 	Address string `json:"address,omitempty"`
+	// IP is the resolved IP of the server. It is only relevant when
+	// the server is addressed using a hostname.
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	// +optional
+	IP string `json:"ip,omitempty"`
 	// +operator-sdk:csv:customresourcedefinitions:type=status
 	// +optional
 	Config map[string]string `json:"config,omitempty"`
@@ -313,6 +313,11 @@ type SystemSpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
 	Twemproxy *TwemproxySpec `json:"twemproxy,omitempty"`
+	// Maintenance puts the component in maintenance, scaling to zero the
+	// workloads selected by the maintenance mode
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Maintenance *MaintenanceSpec `json:"maintenance,omitempty"`
 }
 
 // Default implements defaulting for SystemSpec
@@ -729,6 +734,11 @@ type SystemAppSpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
 	HPA *HorizontalPodAutoscalerSpec `json:"hpa,omitempty"`
+	// Vertical Pod Autoscaler for the component. By default it only
+	// computes resource recommendations, shown in the status of the resource.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	VPA *VerticalPodAutoscalerSpec `json:"vpa,omitempty"`
 	// Number of replicas (ignored if hpa is enabled) for the component
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
@@ -751,6 +761,8 @@ type SystemAppSpec struct {
 	// If specified, the pod's tolerations.
 	// +optional
 	Tolerations []corev1.Toleration `json:"tolerations,omitempty" protobuf:"bytes,22,opt,name=tolerations"`
+	// Pod-level settings added to the Pods of the component
+	PodTemplateOverrides `json:",inline"`
 	// Configures the TerminationGracePeriodSeconds
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
@@ -760,6 +772,11 @@ type SystemAppSpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
 	Canary *Canary `json:"canary,omitempty"`
+	// BlueGreen enables the blue/green delivery of the component. It
+	// can't be used at the same time as the canary.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	BlueGreen *BlueGreenSpec `json:"blueGreen,omitempty"`
 	// Describes how the services provided by this workload are exposed to its consumers
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
@@ -799,6 +816,11 @@ type SystemSidekiqSpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
 	HPA *HorizontalPodAutoscalerSpec `json:"hpa,omitempty"`
+	// Vertical Pod Autoscaler for the component. By default it only
+	// computes resource recommendations, shown in the status of the resource.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	VPA *VerticalPodAutoscalerSpec `json:"vpa,omitempty"`
 	// Number of replicas (ignored if hpa is enabled) for the component
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
@@ -821,6 +843,8 @@ type SystemSidekiqSpec struct {
 	// If specified, the pod's tolerations.
 	// +optional
 	Tolerations []corev1.Toleration `json:"tolerations,omitempty" protobuf:"bytes,22,opt,name=tolerations"`
+	// Pod-level settings added to the Pods of the component
+	PodTemplateOverrides `json:",inline"`
 	// Canary defines spec changes for the canary Deployment. If
 	// left unset the canary Deployment wil not be created.
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
@@ -999,7 +1023,25 @@ func (spec *SystemRailsConsoleSpec) Default(systemDefaultImage *ImageSpec) {
 }
 
 // SystemStatus defines the observed state of System
-type SystemStatus struct{}
+type SystemStatus struct {
+	// Canaries is the status of the progressive delivery of the canaries
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	// +optional
+	Canaries CanaryStatuses `json:"canaries,omitempty"`
+	// BlueGreen is the status of the blue/green delivery of the workloads
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	// +optional
+	BlueGreen BlueGreenStatuses `json:"blueGreen,omitempty"`
+	// Maintenance is the status of the maintenance of the component
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	// +optional
+	Maintenance *MaintenanceStatus `json:"maintenance,omitempty"`
+	// Recommendations are the resource recommendations computed by the
+	// Vertical Pod Autoscalers of the workloads
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	// +optional
+	Recommendations ResourceRecommendations `json:"recommendations,omitempty"`
+}
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
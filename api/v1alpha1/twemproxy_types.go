@@ -47,6 +47,14 @@ type TwemproxySpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
 	Resources *ResourceRequirementsSpec `json:"resources,omitempty"`
+	// VPA configures the resource recommendations of the twemproxy container
+	// in the Vertical Pod Autoscaler of the Deployments it is added to. If the
+	// Deployment has no Vertical Pod Autoscaler, one is created that only
+	// computes recommendations for the twemproxy container. StatefulSets,
+	// like the system console, are not covered.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	VPA *VerticalPodAutoscalerResourcePolicy `json:"vpa,omitempty"`
 	// Liveness probe for the component
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
@@ -63,8 +71,14 @@ type TwemproxySpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
 	Options *TwemproxyOptions `json:"options,omitempty"`
+	// ConfigSecretName is the name of the Secret that holds the twemproxy
+	// config when the referenced TwemproxyConfig uses redis authentication.
+	// It is resolved by the controller from the TwemproxyConfig status.
+	ConfigSecretName *string `json:"-"`
 }
 
+// ConfigMapName returns the name of the ConfigMap that holds the twemproxy
+// config file generated by the referenced TwemproxyConfig
 func (spec *TwemproxySpec) ConfigMapName() string {
 	return spec.TwemproxyConfigRef
 }
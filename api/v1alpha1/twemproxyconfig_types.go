@@ -20,6 +20,7 @@ import (
 	"fmt"
 
 	"github.com/3scale-ops/basereconciler/util"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -27,6 +28,25 @@ import (
 var (
 	TwemproxyPodSyncLabelKey   string = fmt.Sprintf("%s/twemproxyconfig.sync", GroupVersion.Group)
 	TwemproxySyncAnnotationKey string = fmt.Sprintf("%s/twemproxyconfig.configmap-hash", GroupVersion.Group)
+	// TwemproxyApproveKeyDistributionChangeAnnotationKey is the annotation used to approve a change
+	// in the key distribution of the server pools. Its value must match the hash of the change, as
+	// reported in the status of the TwemproxyConfig. It is removed once the change is applied.
+	TwemproxyApproveKeyDistributionChangeAnnotationKey string = fmt.Sprintf("%s/twemproxyconfig.approve-key-distribution-change", GroupVersion.Group)
+	// TwemproxyApproveTopologyChangeAnnotationKey is the annotation used to approve a change in the
+	// logical to physical shard mapping of the server pools. Its value must match the hash of the
+	// change, as reported in the status of the TwemproxyConfig. It is removed once the change is applied.
+	TwemproxyApproveTopologyChangeAnnotationKey string = fmt.Sprintf("%s/twemproxyconfig.approve-topology-change", GroupVersion.Group)
+	// TwemproxyTopologyAnnotationKey is the annotation used to store the shard
+	// mapping applied in the ConfigMap managed by a TwemproxyConfig
+	TwemproxyTopologyAnnotationKey string = fmt.Sprintf("%s/twemproxyconfig.topology", GroupVersion.Group)
+
+	// Default values of the parameters that determine the distribution of keys
+	// across the shards of a server pool
+	TwemproxyDefaultHash         TwemproxyHash         = "fnv1a_64"
+	TwemproxyDefaultHashTag      string                = "{}"
+	TwemproxyDefaultDistribution TwemproxyDistribution = "ketama"
+
+	twemproxyDefaultMaxReplicationLag int = 1048576
 
 	twemproxyDefaultGrafanaDashboard defaultGrafanaDashboardSpec = defaultGrafanaDashboardSpec{
 		SelectorKey:   util.Pointer("monitoring-key"),
@@ -42,9 +62,14 @@ type TwemproxyConfigSpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
 	SentinelURIs []string `json:"sentinelURIs,omitempty"`
-	// ServerPools is the list of Twemproxy server pools
-	// WARNING: only 1 pool is supported at this time
+	// ServerPools is the list of Twemproxy server pools. Each pool
+	// must have a unique name and bind address. Several pools can
+	// be used to serve different targets of the same topology, for
+	// example a pool of masters for writes and a pool of read-write
+	// slaves for reads.
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +listType=map
+	// +listMapKey=name
 	ServerPools []TwemproxyServerPool `json:"serverPools"`
 	// ReconcileServerPools is a flag that allows to deactivate
 	// the reconcile of the contents of the managed ConfigMap. This is
@@ -53,6 +78,13 @@ type TwemproxyConfigSpec struct {
 	// are changed, even if they are manually changed.
 	// This switch defaults to "true".
 	ReconcileServerPools *bool `json:"reconcileServerPools,omitempty"`
+	// MaxReplicationLag is the maximum number of bytes that a read-write
+	// slave can be behind its master to be considered in sync by the
+	// "slaves-rw-least-lag" target. Defaults to 1048576 (1MiB).
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxReplicationLag *int `json:"maxReplicationLag,omitempty"`
 	// Configures the Grafana Dashboard for the component
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
@@ -66,6 +98,9 @@ func (spec *TwemproxyConfigSpec) Default() {
 	if spec.ReconcileServerPools == nil {
 		spec.ReconcileServerPools = util.Pointer(true)
 	}
+	if spec.MaxReplicationLag == nil {
+		spec.MaxReplicationLag = util.Pointer(twemproxyDefaultMaxReplicationLag)
+	}
 	spec.GrafanaDashboard = InitializeGrafanaDashboardSpec(spec.GrafanaDashboard, twemproxyDefaultGrafanaDashboard)
 }
 
@@ -97,11 +132,73 @@ type TwemproxyServerPool struct {
 	// through this option. If read-write slaves are configured but there are none
 	// available, the config will fall back to masters. The masters never fall back
 	// to slaves though and will just wait for sentinel triggered failovers to solve
-	// the unavailability.
+	// the unavailability. The "slaves-rw-least-lag" target selects, amongst the
+	// read-write slaves in sync with the master (link to the master up and lag
+	// within MaxReplicationLag), the one with the highest replication offset, and
+	// falls back to the master when there are none. The selected slave is kept as
+	// target for as long as it stays in sync.
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
-	// +kubebuilder:validation:Enum=masters;slaves-rw
 	// +optional
 	Target *TargetRedisServers `json:"target,omitempty"`
+	// ShardTargets allows to override the Target for specific physical
+	// shards. The keys are the names of the physical shards, which must
+	// be part of the Topology of the pool.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	ShardTargets map[string]TargetRedisServers `json:"shardTargets,omitempty"`
+	// The hash function used to hash the keys. Together with HashTag, Distribution
+	// and AutoEjectHosts, this determines the distribution of keys across shards, so
+	// changing any of these in a running pool needs to be approved by setting
+	// the "saas.3scale.net/twemproxyconfig.approve-key-distribution-change"
+	// annotation to the hash of the change reported in the status of the
+	// TwemproxyConfig. Defaults to "fnv1a_64".
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:Enum=one_at_a_time;md5;crc16;crc32;crc32a;fnv1_64;fnv1a_64;fnv1_32;fnv1a_32;hsieh;murmur;jenkins
+	// +optional
+	Hash *TwemproxyHash `json:"hash,omitempty"`
+	// A two character string that specifies the part of the key
+	// used for hashing. Defaults to "{}".
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:MinLength=2
+	// +kubebuilder:validation:MaxLength=2
+	// +optional
+	HashTag *string `json:"hashTag,omitempty"`
+	// The key distribution mode. Defaults to "ketama".
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:Enum=ketama;modula;random
+	// +optional
+	Distribution *TwemproxyDistribution `json:"distribution,omitempty"`
+	// Authenticate to the redis servers on connect. The twemproxy config
+	// that includes the password is stored in a Secret with the same name
+	// as the TwemproxyConfig, the ConfigMap never contains the password.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	RedisAuth *corev1.SecretKeySelector `json:"redisAuth,omitempty"`
+	// Timeout in milliseconds to wait for before retrying to connect
+	// to a server that has been temporarily ejected. Only used when
+	// AutoEjectHosts is set.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	ServerRetryTimeout *int `json:"serverRetryTimeout,omitempty"`
+	// The number of consecutive failures on a server that would lead to
+	// it being temporarily ejected. Only used when AutoEjectHosts is set.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	ServerFailureLimit *int `json:"serverFailureLimit,omitempty"`
+	// Temporarily eject a server when it fails ServerFailureLimit times
+	// in a row. Ejecting a server changes the distribution of keys across
+	// shards, so this is disabled by default.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	AutoEjectHosts *bool `json:"autoEjectHosts,omitempty"`
+	// The maximum number of connections allowed from redis clients.
+	// Unlimited by default.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	ClientConnections *int `json:"clientConnections,omitempty"`
 }
 
 func (pool *TwemproxyServerPool) Default() {
@@ -109,13 +206,51 @@ func (pool *TwemproxyServerPool) Default() {
 		t := Masters
 		pool.Target = &t
 	}
+	if pool.Hash == nil {
+		pool.Hash = util.Pointer(TwemproxyDefaultHash)
+	}
+	pool.HashTag = stringOrDefault(pool.HashTag, util.Pointer(TwemproxyDefaultHashTag))
+	if pool.Distribution == nil {
+		pool.Distribution = util.Pointer(TwemproxyDefaultDistribution)
+	}
+	pool.AutoEjectHosts = boolOrDefault(pool.AutoEjectHosts, util.Pointer(false))
+}
+
+// GetTarget returns the target servers for the given physical shard
+func (pool *TwemproxyServerPool) GetTarget(shard string) TargetRedisServers {
+	if target, ok := pool.ShardTargets[shard]; ok {
+		return target
+	}
+	return *pool.Target
 }
 
+// TargetsSlaves returns true if any of the shards of
+// the pool targets slaves
+func (pool *TwemproxyServerPool) TargetsSlaves() bool {
+	if *pool.Target != Masters {
+		return true
+	}
+	for _, target := range pool.ShardTargets {
+		if target != Masters {
+			return true
+		}
+	}
+	return false
+}
+
+// TwemproxyHash is the hash function used by twemproxy to hash the keys
+type TwemproxyHash string
+
+// TwemproxyDistribution is the key distribution mode of a twemproxy server pool
+type TwemproxyDistribution string
+
+// +kubebuilder:validation:Enum=masters;slaves-rw;slaves-rw-least-lag
 type TargetRedisServers string
 
 const (
-	Masters  TargetRedisServers = "masters"
-	SlavesRW TargetRedisServers = "slaves-rw"
+	Masters          TargetRedisServers = "masters"
+	SlavesRW         TargetRedisServers = "slaves-rw"
+	SlavesRWLeastLag TargetRedisServers = "slaves-rw-least-lag"
 )
 
 type ShardedRedisTopology struct {
@@ -129,23 +264,92 @@ type ShardedRedisTopology struct {
 	PhysicalShard string `json:"physicalShard"`
 }
 
+// GetServerPool returns the server pool with the given name
+// or nil if it does not exist
+func (spec *TwemproxyConfigSpec) GetServerPool(name string) *TwemproxyServerPool {
+	for idx := range spec.ServerPools {
+		if spec.ServerPools[idx].Name == name {
+			return &spec.ServerPools[idx]
+		}
+	}
+	return nil
+}
+
 // TwemproxyConfigStatus defines the observed state of TwemproxyConfig
 type TwemproxyConfigStatus struct {
-	// The list of serves currently targeted by this TwemproxyConfig
+	// The list of servers currently targeted by each of the
+	// server pools of this TwemproxyConfig, indexed by pool name
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	// +optional
+	SelectedTargets map[string]ServerPoolTargets `json:"serverPoolTargets,omitempty"`
+	// The list of servers currently targeted by the first server pool
+	// of this TwemproxyConfig. Deprecated: kept for backwards compatibility
+	// with existing consumers of the status, use serverPoolTargets instead.
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	// +optional
+	Targets map[string]TargetServer `json:"targets,omitempty"`
+	// A change in the logical to physical shard mapping that is pending
+	// approval and has not been applied to the twemproxy configuration.
 	// +operator-sdk:csv:customresourcedefinitions:type=status
 	// +optional
-	SelectedTargets map[string]TargetServer `json:"targets,omitempty"`
+	PendingTopologyChange *TopologyChange `json:"pendingTopologyChange,omitempty"`
+	// A change in the key distribution of the server pools that is pending
+	// approval and has not been applied to the twemproxy configuration.
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	// +optional
+	PendingKeyDistributionChange *KeyDistributionChange `json:"pendingKeyDistributionChange,omitempty"`
+	// The name of the Secret that holds the twemproxy configuration when
+	// any of the server pools uses redis authentication. Workloads mount
+	// this Secret instead of the ConfigMap once it is reported here.
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	// +optional
+	ConfigSecretName *string `json:"configSecretName,omitempty"`
+}
+
+// KeyDistributionChange describes a change in the parameters that determine
+// the distribution of keys across the shards of the server pools
+type KeyDistributionChange struct {
+	// Hash identifies the change. Set it as the value of the
+	// "saas.3scale.net/twemproxyconfig.approve-key-distribution-change"
+	// annotation to approve the change.
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	Hash string `json:"hash"`
+	// The list of changes in the key distribution, in the format
+	// "<pool>: <current key distribution> -> <new key distribution>"
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	Changes []string `json:"changes"`
 }
 
+// TopologyChange describes a change in the logical to physical shard mapping
+// of the server pools of a TwemproxyConfig
+type TopologyChange struct {
+	// Hash identifies the change. Set it as the value of the
+	// "saas.3scale.net/twemproxyconfig.approve-topology-change"
+	// annotation to approve the change.
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	Hash string `json:"hash"`
+	// The list of changes in the mapping, in the format
+	// "<pool>/<logical shard>: <current physical shard> -> <new physical shard>"
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	Changes []string `json:"changes"`
+}
+
+// ServerPoolTargets are the servers targeted by a server pool, indexed
+// by the name of the physical shard
+type ServerPoolTargets map[string]TargetServer
+
 // Defines a server targeted by one of the TwemproxyConfig server pools
 type TargetServer struct {
 	ServerAlias   *string `json:"serverAlias,omitempty"`
 	ServerAddress string  `json:"serverAddress"`
+	// The reason why this server was selected as target
+	// +optional
+	Reason string `json:"reason,omitempty"`
 }
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
-// +kubebuilder:printcolumn:JSONPath=`.status.targets`,name=Selected Targets,type=string
+// +kubebuilder:printcolumn:JSONPath=`.status.serverPoolTargets`,name=Selected Targets,type=string
 // TwemproxyConfig is the Schema for the twemproxyconfigs API
 type TwemproxyConfig struct {
 	metav1.TypeMeta   `json:",inline"`
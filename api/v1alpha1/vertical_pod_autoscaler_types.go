@@ -0,0 +1,101 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
+// VerticalPodAutoscalerUpdateMode controls when the VerticalPodAutoscaler
+// applies its recommendations to the Pods
+// +kubebuilder:validation:Enum=Off;Initial;Recreate;Auto
+type VerticalPodAutoscalerUpdateMode string
+
+const (
+	// VerticalPodAutoscalerUpdateModeOff only computes recommendations,
+	// the resources of the Pods are never changed
+	VerticalPodAutoscalerUpdateModeOff VerticalPodAutoscalerUpdateMode = "Off"
+	// VerticalPodAutoscalerUpdateModeInitial applies the
+	// recommendations only when the Pods are created
+	VerticalPodAutoscalerUpdateModeInitial VerticalPodAutoscalerUpdateMode = "Initial"
+	// VerticalPodAutoscalerUpdateModeRecreate applies the recommendations
+	// when the Pods are created and evicts running Pods to update them
+	VerticalPodAutoscalerUpdateModeRecreate VerticalPodAutoscalerUpdateMode = "Recreate"
+	// VerticalPodAutoscalerUpdateModeAuto applies the recommendations
+	// using any of the update methods available
+	VerticalPodAutoscalerUpdateModeAuto VerticalPodAutoscalerUpdateMode = "Auto"
+)
+
+// VerticalPodAutoscalerSpec defines the VerticalPodAutoscaler for the component.
+// Requires the VerticalPodAutoscaler to be installed in the cluster.
+type VerticalPodAutoscalerSpec struct {
+	// UpdateMode controls whether the recommendations are applied to the Pods.
+	// Defaults to "Off", so the VerticalPodAutoscaler only computes recommendations,
+	// which are shown in the status of the custom resource.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	UpdateMode *VerticalPodAutoscalerUpdateMode `json:"updateMode,omitempty"`
+	// VerticalPodAutoscalerResourcePolicy applies to all the containers of the Pod
+	VerticalPodAutoscalerResourcePolicy `json:",inline"`
+}
+
+// VerticalPodAutoscalerResourcePolicy controls how the VerticalPodAutoscaler
+// computes the recommendations for a container
+type VerticalPodAutoscalerResourcePolicy struct {
+	// MinAllowed is the minimum amount of resources that will be recommended
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	MinAllowed corev1.ResourceList `json:"minAllowed,omitempty"`
+	// MaxAllowed is the maximum amount of resources that will be recommended
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	MaxAllowed corev1.ResourceList `json:"maxAllowed,omitempty"`
+	// ControlledResources are the resources for which recommendations are
+	// computed. Defaults to cpu and memory.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	ControlledResources []corev1.ResourceName `json:"controlledResources,omitempty"`
+}
+
+// ResourceRecommendation is the recommendation of resources computed
+// by the VerticalPodAutoscaler for a container of a Deployment
+type ResourceRecommendation struct {
+	// Deployment is the name of the Deployment
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	Deployment string `json:"deployment"`
+	// Container is the name of the container
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	Container string `json:"container"`
+	// Requests are the resource requests currently set for the container
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	// +optional
+	Requests corev1.ResourceList `json:"requests,omitempty"`
+	// Target are the recommended resource requests
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	Target corev1.ResourceList `json:"target"`
+	// LowerBound are the minimum recommended resource requests
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	// +optional
+	LowerBound corev1.ResourceList `json:"lowerBound,omitempty"`
+	// UpperBound are the maximum recommended resource requests
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	// +optional
+	UpperBound corev1.ResourceList `json:"upperBound,omitempty"`
+}
+
+// ResourceRecommendations is the list of resource recommendations of a custom resource
+type ResourceRecommendations []ResourceRecommendation
@@ -0,0 +1,99 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// WorkloadReadyCondition is true when all the Deployments of
+	// the custom resource are up to date and available
+	WorkloadReadyCondition string = "Ready"
+	// WorkloadProgressingCondition is true while any of the Deployments of the
+	// custom resource is rolling out or a canary is being delivered
+	WorkloadProgressingCondition string = "Progressing"
+	// WorkloadDegradedCondition is true when any of the Deployments of the custom
+	// resource has exceeded its progress deadline or is unable to create Pods
+	WorkloadDegradedCondition string = "Degraded"
+)
+
+// WorkloadStatus is the observed state of the workloads of a custom resource,
+// computed from the resources owned by it
+type WorkloadStatus struct {
+	// ObservedGeneration is the most recent generation of the
+	// custom resource that has been reconciled
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// Conditions represent the latest observations of the state of the workloads
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+	// Deployments is a summary of the state of each one of the Deployments
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	// +optional
+	Deployments []WorkloadDeploymentStatus `json:"deployments,omitempty"`
+}
+
+// WorkloadDeploymentStatus is a summary of the state of a Deployment
+type WorkloadDeploymentStatus struct {
+	// Name of the Deployment
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	Name string `json:"name"`
+	// Image of the main container
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	// +optional
+	Image string `json:"image,omitempty"`
+	// Replicas is the desired number of replicas
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	Replicas int32 `json:"replicas"`
+	// ReadyReplicas is the number of ready replicas
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	ReadyReplicas int32 `json:"readyReplicas"`
+	// UpdatedReplicas is the number of replicas running the desired Pod template
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	UpdatedReplicas int32 `json:"updatedReplicas"`
+	// CanaryPhase is the phase of the progressive delivery, if the Deployment is a canary
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	// +optional
+	CanaryPhase CanaryPhase `json:"canaryPhase,omitempty"`
+	// Endpoints are the Services that send traffic to the Deployment
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	// +optional
+	Endpoints []WorkloadEndpointStatus `json:"endpoints,omitempty"`
+}
+
+// WorkloadEndpointStatus is a Service that publishes a Deployment
+type WorkloadEndpointStatus struct {
+	// Service is the name of the Service
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	Service string `json:"service"`
+	// Type of the Service
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	Type corev1.ServiceType `json:"type"`
+	// LoadBalancer are the hostnames (or IPs) assigned to
+	// the load balancer of the Service, if any
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	// +optional
+	LoadBalancer []string `json:"loadBalancer,omitempty"`
+}
@@ -144,6 +144,11 @@ type ZyncSpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
 	Console *ZyncRailsConsoleSpec `json:"console,omitempty"`
+	// Maintenance puts the component in maintenance, scaling to zero the
+	// workloads selected by the maintenance mode
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Maintenance *MaintenanceSpec `json:"maintenance,omitempty"`
 }
 
 // Default implements defaulting for ZyncSpec
@@ -177,6 +182,11 @@ type APISpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
 	HPA *HorizontalPodAutoscalerSpec `json:"hpa,omitempty"`
+	// Vertical Pod Autoscaler for the component. By default it only
+	// computes resource recommendations, shown in the status of the resource.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	VPA *VerticalPodAutoscalerSpec `json:"vpa,omitempty"`
 	// Number of replicas (ignored if hpa is enabled) for the component
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
@@ -199,6 +209,12 @@ type APISpec struct {
 	// If specified, the pod's tolerations.
 	// +optional
 	Tolerations []corev1.Toleration `json:"tolerations,omitempty" protobuf:"bytes,22,opt,name=tolerations"`
+	// Pod-level settings added to the Pods of the component
+	PodTemplateOverrides `json:",inline"`
+	// BlueGreen enables the blue/green delivery of the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	BlueGreen *BlueGreenSpec `json:"blueGreen,omitempty"`
 	// Describes how the services provided by this workload are exposed to its consumers
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
@@ -226,6 +242,11 @@ type QueSpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
 	HPA *HorizontalPodAutoscalerSpec `json:"hpa,omitempty"`
+	// Vertical Pod Autoscaler for the component. By default it only
+	// computes resource recommendations, shown in the status of the resource.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	VPA *VerticalPodAutoscalerSpec `json:"vpa,omitempty"`
 	// Number of replicas (ignored if hpa is enabled) for the component
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
@@ -248,6 +269,8 @@ type QueSpec struct {
 	// If specified, the pod's tolerations.
 	// +optional
 	Tolerations []corev1.Toleration `json:"tolerations,omitempty" protobuf:"bytes,22,opt,name=tolerations"`
+	// Pod-level settings added to the Pods of the component
+	PodTemplateOverrides `json:",inline"`
 }
 
 // Default implements defaulting for the each zync que
@@ -353,10 +376,29 @@ func (spec *ZyncRailsConsoleSpec) Default(zyncDefaultImage *ImageSpec) {
 }
 
 // ZyncStatus defines the observed state of Zync
-type ZyncStatus struct{}
+type ZyncStatus struct {
+	WorkloadStatus `json:",inline"`
+	// BlueGreen is the status of the blue/green delivery of the workloads
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	// +optional
+	BlueGreen BlueGreenStatuses `json:"blueGreen,omitempty"`
+	// Maintenance is the status of the maintenance of the component
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	// +optional
+	Maintenance *MaintenanceStatus `json:"maintenance,omitempty"`
+	// Recommendations are the resource recommendations computed by the
+	// Vertical Pod Autoscalers of the workloads
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	// +optional
+	Recommendations ResourceRecommendations `json:"recommendations,omitempty"`
+}
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:JSONPath=`.status.conditions[?(@.type=="Ready")].status`,name=Ready,type=string
+// +kubebuilder:printcolumn:JSONPath=`.status.conditions[?(@.type=="Progressing")].status`,name=Progressing,type=string
+// +kubebuilder:printcolumn:JSONPath=`.status.conditions[?(@.type=="Degraded")].status`,name=Degraded,type=string
+// +kubebuilder:printcolumn:JSONPath=`.metadata.creationTimestamp`,name=Age,type=date
 
 // Zync is the Schema for the zyncs API
 type Zync struct {
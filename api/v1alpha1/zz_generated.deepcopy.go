@@ -44,6 +44,11 @@ func (in *APISpec) DeepCopyInto(out *APISpec) {
 		*out = new(HorizontalPodAutoscalerSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.VPA != nil {
+		in, out := &in.VPA, &out.VPA
+		*out = new(VerticalPodAutoscalerSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Replicas != nil {
 		in, out := &in.Replicas, &out.Replicas
 		*out = new(int32)
@@ -76,6 +81,12 @@ func (in *APISpec) DeepCopyInto(out *APISpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	in.PodTemplateOverrides.DeepCopyInto(&out.PodTemplateOverrides)
+	if in.BlueGreen != nil {
+		in, out := &in.BlueGreen, &out.BlueGreen
+		*out = new(BlueGreenSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.PublishingStrategies != nil {
 		in, out := &in.PublishingStrategies, &out.PublishingStrategies
 		*out = new(PublishingStrategies)
@@ -93,6 +104,123 @@ func (in *APISpec) DeepCopy() *APISpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessLogComparisonFilter) DeepCopyInto(out *AccessLogComparisonFilter) {
+	*out = *in
+	if in.RuntimeKey != nil {
+		in, out := &in.RuntimeKey, &out.RuntimeKey
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessLogComparisonFilter.
+func (in *AccessLogComparisonFilter) DeepCopy() *AccessLogComparisonFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessLogComparisonFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessLogGrpcSink) DeepCopyInto(out *AccessLogGrpcSink) {
+	*out = *in
+	if in.LogName != nil {
+		in, out := &in.LogName, &out.LogName
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessLogGrpcSink.
+func (in *AccessLogGrpcSink) DeepCopy() *AccessLogGrpcSink {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessLogGrpcSink)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessLogOptions) DeepCopyInto(out *AccessLogOptions) {
+	*out = *in
+	if in.ExtraFields != nil {
+		in, out := &in.ExtraFields, &out.ExtraFields
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.RequestHeaders != nil {
+		in, out := &in.RequestHeaders, &out.RequestHeaders
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ResponseHeaders != nil {
+		in, out := &in.ResponseHeaders, &out.ResponseHeaders
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.StatusCodeFilter != nil {
+		in, out := &in.StatusCodeFilter, &out.StatusCodeFilter
+		*out = new(AccessLogComparisonFilter)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DurationFilter != nil {
+		in, out := &in.DurationFilter, &out.DurationFilter
+		*out = new(AccessLogComparisonFilter)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Sampling != nil {
+		in, out := &in.Sampling, &out.Sampling
+		*out = new(AccessLogSampling)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FilterOperator != nil {
+		in, out := &in.FilterOperator, &out.FilterOperator
+		*out = new(AccessLogFilterOperator)
+		**out = **in
+	}
+	if in.GrpcSink != nil {
+		in, out := &in.GrpcSink, &out.GrpcSink
+		*out = new(AccessLogGrpcSink)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessLogOptions.
+func (in *AccessLogOptions) DeepCopy() *AccessLogOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessLogOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessLogSampling) DeepCopyInto(out *AccessLogSampling) {
+	*out = *in
+	if in.RuntimeKey != nil {
+		in, out := &in.RuntimeKey, &out.RuntimeKey
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessLogSampling.
+func (in *AccessLogSampling) DeepCopy() *AccessLogSampling {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessLogSampling)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AddressSpec) DeepCopyInto(out *AddressSpec) {
 	*out = *in
@@ -124,7 +252,7 @@ func (in *Apicast) DeepCopyInto(out *Apicast) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Apicast.
@@ -188,11 +316,23 @@ func (in *ApicastEnvironmentSpec) DeepCopyInto(out *ApicastEnvironmentSpec) {
 		*out = new(HorizontalPodAutoscalerSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.VPA != nil {
+		in, out := &in.VPA, &out.VPA
+		*out = new(VerticalPodAutoscalerSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Replicas != nil {
 		in, out := &in.Replicas, &out.Replicas
 		*out = new(int32)
 		**out = **in
 	}
+	if in.ScalingSchedule != nil {
+		in, out := &in.ScalingSchedule, &out.ScalingSchedule
+		*out = make([]ScalingWindow, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Resources != nil {
 		in, out := &in.Resources, &out.Resources
 		*out = new(ResourceRequirementsSpec)
@@ -221,6 +361,7 @@ func (in *ApicastEnvironmentSpec) DeepCopyInto(out *ApicastEnvironmentSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	in.PodTemplateOverrides.DeepCopyInto(&out.PodTemplateOverrides)
 	if in.Canary != nil {
 		in, out := &in.Canary, &out.Canary
 		*out = new(Canary)
@@ -315,6 +456,28 @@ func (in *ApicastSpec) DeepCopy() *ApicastSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApicastStatus) DeepCopyInto(out *ApicastStatus) {
 	*out = *in
+	in.WorkloadStatus.DeepCopyInto(&out.WorkloadStatus)
+	if in.Canaries != nil {
+		in, out := &in.Canaries, &out.Canaries
+		*out = make(CanaryStatuses, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ScalingWindows != nil {
+		in, out := &in.ScalingWindows, &out.ScalingWindows
+		*out = make(ScalingWindowStatuses, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Recommendations != nil {
+		in, out := &in.Recommendations, &out.Recommendations
+		*out = make(ResourceRecommendations, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApicastStatus.
@@ -360,7 +523,7 @@ func (in *AutoSSL) DeepCopyInto(out *AutoSSL) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoSSL.
@@ -471,6 +634,11 @@ func (in *AutoSSLSpec) DeepCopyInto(out *AutoSSLSpec) {
 		*out = new(HorizontalPodAutoscalerSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.VPA != nil {
+		in, out := &in.VPA, &out.VPA
+		*out = new(VerticalPodAutoscalerSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Replicas != nil {
 		in, out := &in.Replicas, &out.Replicas
 		*out = new(int32)
@@ -509,6 +677,7 @@ func (in *AutoSSLSpec) DeepCopyInto(out *AutoSSLSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	in.PodTemplateOverrides.DeepCopyInto(&out.PodTemplateOverrides)
 	if in.Canary != nil {
 		in, out := &in.Canary, &out.Canary
 		*out = new(Canary)
@@ -544,6 +713,20 @@ func (in *AutoSSLSpec) DeepCopy() *AutoSSLSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AutoSSLStatus) DeepCopyInto(out *AutoSSLStatus) {
 	*out = *in
+	if in.Canaries != nil {
+		in, out := &in.Canaries, &out.Canaries
+		*out = make(CanaryStatuses, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Recommendations != nil {
+		in, out := &in.Recommendations, &out.Recommendations
+		*out = make(ResourceRecommendations, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoSSLStatus.
@@ -562,7 +745,7 @@ func (in *Backend) DeepCopyInto(out *Backend) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Backend.
@@ -685,6 +868,11 @@ func (in *BackendSpec) DeepCopyInto(out *BackendSpec) {
 		*out = new(TwemproxySpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Maintenance != nil {
+		in, out := &in.Maintenance, &out.Maintenance
+		*out = new(MaintenanceSpec)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackendSpec.
@@ -700,6 +888,33 @@ func (in *BackendSpec) DeepCopy() *BackendSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BackendStatus) DeepCopyInto(out *BackendStatus) {
 	*out = *in
+	in.WorkloadStatus.DeepCopyInto(&out.WorkloadStatus)
+	if in.Canaries != nil {
+		in, out := &in.Canaries, &out.Canaries
+		*out = make(CanaryStatuses, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ScalingWindows != nil {
+		in, out := &in.ScalingWindows, &out.ScalingWindows
+		*out = make(ScalingWindowStatuses, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Maintenance != nil {
+		in, out := &in.Maintenance, &out.Maintenance
+		*out = new(MaintenanceStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Recommendations != nil {
+		in, out := &in.Recommendations, &out.Recommendations
+		*out = make(ResourceRecommendations, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackendStatus.
@@ -777,6 +992,123 @@ func (in BackupStatusList) DeepCopy() BackupStatusList {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BlueGreenSmokeTest) DeepCopyInto(out *BlueGreenSmokeTest) {
+	*out = *in
+	if in.Image != nil {
+		in, out := &in.Image, &out.Image
+		*out = new(string)
+		**out = **in
+	}
+	if in.Command != nil {
+		in, out := &in.Command, &out.Command
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Args != nil {
+		in, out := &in.Args, &out.Args
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make([]v1.EnvVar, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ActiveDeadlineSeconds != nil {
+		in, out := &in.ActiveDeadlineSeconds, &out.ActiveDeadlineSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.BackoffLimit != nil {
+		in, out := &in.BackoffLimit, &out.BackoffLimit
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BlueGreenSmokeTest.
+func (in *BlueGreenSmokeTest) DeepCopy() *BlueGreenSmokeTest {
+	if in == nil {
+		return nil
+	}
+	out := new(BlueGreenSmokeTest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BlueGreenSpec) DeepCopyInto(out *BlueGreenSpec) {
+	*out = *in
+	if in.SmokeTest != nil {
+		in, out := &in.SmokeTest, &out.SmokeTest
+		*out = new(BlueGreenSmokeTest)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RollbackWindow != nil {
+		in, out := &in.RollbackWindow, &out.RollbackWindow
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.State != nil {
+		in, out := &in.State, &out.State
+		*out = new(BlueGreenStatus)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BlueGreenSpec.
+func (in *BlueGreenSpec) DeepCopy() *BlueGreenSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(BlueGreenSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BlueGreenStatus) DeepCopyInto(out *BlueGreenStatus) {
+	*out = *in
+	if in.SwitchTime != nil {
+		in, out := &in.SwitchTime, &out.SwitchTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BlueGreenStatus.
+func (in *BlueGreenStatus) DeepCopy() *BlueGreenStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BlueGreenStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in BlueGreenStatuses) DeepCopyInto(out *BlueGreenStatuses) {
+	{
+		in := &in
+		*out = make(BlueGreenStatuses, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BlueGreenStatuses.
+func (in BlueGreenStatuses) DeepCopy() BlueGreenStatuses {
+	if in == nil {
+		return nil
+	}
+	out := new(BlueGreenStatuses)
+	in.DeepCopyInto(out)
+	return *out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BugsnagSpec) DeepCopyInto(out *BugsnagSpec) {
 	*out = *in
@@ -804,7 +1136,7 @@ func (in *CORSProxy) DeepCopyInto(out *CORSProxy) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CORSProxy.
@@ -892,6 +1224,11 @@ func (in *CORSProxySpec) DeepCopyInto(out *CORSProxySpec) {
 		*out = new(HorizontalPodAutoscalerSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.VPA != nil {
+		in, out := &in.VPA, &out.VPA
+		*out = new(VerticalPodAutoscalerSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Replicas != nil {
 		in, out := &in.Replicas, &out.Replicas
 		*out = new(int32)
@@ -930,6 +1267,7 @@ func (in *CORSProxySpec) DeepCopyInto(out *CORSProxySpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	in.PodTemplateOverrides.DeepCopyInto(&out.PodTemplateOverrides)
 	if in.PublishingStrategies != nil {
 		in, out := &in.PublishingStrategies, &out.PublishingStrategies
 		*out = new(PublishingStrategies)
@@ -950,6 +1288,14 @@ func (in *CORSProxySpec) DeepCopy() *CORSProxySpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CORSProxyStatus) DeepCopyInto(out *CORSProxyStatus) {
 	*out = *in
+	in.WorkloadStatus.DeepCopyInto(&out.WorkloadStatus)
+	if in.Recommendations != nil {
+		in, out := &in.Recommendations, &out.Recommendations
+		*out = make(ResourceRecommendations, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CORSProxyStatus.
@@ -985,6 +1331,11 @@ func (in *Canary) DeepCopyInto(out *Canary) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Progressive != nil {
+		in, out := &in.Progressive, &out.Progressive
+		*out = new(ProgressiveDeliverySpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Canary.
@@ -998,632 +1349,595 @@ func (in *Canary) DeepCopy() *Canary {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Cluster) DeepCopyInto(out *Cluster) {
+func (in *CanaryAnalysisSpec) DeepCopyInto(out *CanaryAnalysisSpec) {
 	*out = *in
-	if in.IsHttp2 != nil {
-		in, out := &in.IsHttp2, &out.IsHttp2
-		*out = new(bool)
-		**out = **in
+	if in.Metrics != nil {
+		in, out := &in.Metrics, &out.Metrics
+		*out = make([]CanaryMetric, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Cluster.
-func (in *Cluster) DeepCopy() *Cluster {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CanaryAnalysisSpec.
+func (in *CanaryAnalysisSpec) DeepCopy() *CanaryAnalysisSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(Cluster)
+	out := new(CanaryAnalysisSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CronSpec) DeepCopyInto(out *CronSpec) {
+func (in *CanaryMetric) DeepCopyInto(out *CanaryMetric) {
 	*out = *in
-	if in.Replicas != nil {
-		in, out := &in.Replicas, &out.Replicas
-		*out = new(int32)
+	if in.Min != nil {
+		in, out := &in.Min, &out.Min
+		*out = new(string)
 		**out = **in
 	}
-	if in.Resources != nil {
-		in, out := &in.Resources, &out.Resources
-		*out = new(ResourceRequirementsSpec)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.NodeAffinity != nil {
-		in, out := &in.NodeAffinity, &out.NodeAffinity
-		*out = new(v1.NodeAffinity)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Tolerations != nil {
-		in, out := &in.Tolerations, &out.Tolerations
-		*out = make([]v1.Toleration, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.Max != nil {
+		in, out := &in.Max, &out.Max
+		*out = new(string)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CronSpec.
-func (in *CronSpec) DeepCopy() *CronSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CanaryMetric.
+func (in *CanaryMetric) DeepCopy() *CanaryMetric {
 	if in == nil {
 		return nil
 	}
-	out := new(CronSpec)
+	out := new(CanaryMetric)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DeploymentStrategySpec) DeepCopyInto(out *DeploymentStrategySpec) {
+func (in *CanaryStatus) DeepCopyInto(out *CanaryStatus) {
 	*out = *in
-	if in.RollingUpdate != nil {
-		in, out := &in.RollingUpdate, &out.RollingUpdate
-		*out = new(appsv1.RollingUpdateDeployment)
-		(*in).DeepCopyInto(*out)
+	if in.StepStartTime != nil {
+		in, out := &in.StepStartTime, &out.StepStartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Verdicts != nil {
+		in, out := &in.Verdicts, &out.Verdicts
+		*out = make([]CanaryVerdict, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentStrategySpec.
-func (in *DeploymentStrategySpec) DeepCopy() *DeploymentStrategySpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CanaryStatus.
+func (in *CanaryStatus) DeepCopy() *CanaryStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(DeploymentStrategySpec)
+	out := new(CanaryStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EchoAPI) DeepCopyInto(out *EchoAPI) {
-	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+func (in CanaryStatuses) DeepCopyInto(out *CanaryStatuses) {
+	{
+		in := &in
+		*out = make(CanaryStatuses, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EchoAPI.
-func (in *EchoAPI) DeepCopy() *EchoAPI {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CanaryStatuses.
+func (in CanaryStatuses) DeepCopy() CanaryStatuses {
 	if in == nil {
 		return nil
 	}
-	out := new(EchoAPI)
+	out := new(CanaryStatuses)
 	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *EchoAPI) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
+	return *out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EchoAPIList) DeepCopyInto(out *EchoAPIList) {
+func (in *CanaryStep) DeepCopyInto(out *CanaryStep) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]EchoAPI, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.Pause != nil {
+		in, out := &in.Pause, &out.Pause
+		*out = new(metav1.Duration)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EchoAPIList.
-func (in *EchoAPIList) DeepCopy() *EchoAPIList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CanaryStep.
+func (in *CanaryStep) DeepCopy() *CanaryStep {
 	if in == nil {
 		return nil
 	}
-	out := new(EchoAPIList)
+	out := new(CanaryStep)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *EchoAPIList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CanaryVerdict) DeepCopyInto(out *CanaryVerdict) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CanaryVerdict.
+func (in *CanaryVerdict) DeepCopy() *CanaryVerdict {
+	if in == nil {
+		return nil
 	}
-	return nil
+	out := new(CanaryVerdict)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EchoAPISpec) DeepCopyInto(out *EchoAPISpec) {
+func (in *Cluster) DeepCopyInto(out *Cluster) {
 	*out = *in
-	if in.Image != nil {
-		in, out := &in.Image, &out.Image
-		*out = new(ImageSpec)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Replicas != nil {
-		in, out := &in.Replicas, &out.Replicas
-		*out = new(int32)
+	if in.IsHttp2 != nil {
+		in, out := &in.IsHttp2, &out.IsHttp2
+		*out = new(bool)
 		**out = **in
 	}
-	if in.HPA != nil {
-		in, out := &in.HPA, &out.HPA
-		*out = new(HorizontalPodAutoscalerSpec)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.PDB != nil {
-		in, out := &in.PDB, &out.PDB
-		*out = new(PodDisruptionBudgetSpec)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Resources != nil {
-		in, out := &in.Resources, &out.Resources
-		*out = new(ResourceRequirementsSpec)
-		(*in).DeepCopyInto(*out)
+	if in.Endpoints != nil {
+		in, out := &in.Endpoints, &out.Endpoints
+		*out = make([]ClusterEndpoint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.LivenessProbe != nil {
-		in, out := &in.LivenessProbe, &out.LivenessProbe
-		*out = new(ProbeSpec)
-		(*in).DeepCopyInto(*out)
+	if in.DiscoveryType != nil {
+		in, out := &in.DiscoveryType, &out.DiscoveryType
+		*out = new(ClusterDiscoveryType)
+		**out = **in
 	}
-	if in.ReadinessProbe != nil {
-		in, out := &in.ReadinessProbe, &out.ReadinessProbe
-		*out = new(ProbeSpec)
-		(*in).DeepCopyInto(*out)
+	if in.ConnectTimeout != nil {
+		in, out := &in.ConnectTimeout, &out.ConnectTimeout
+		*out = new(metav1.Duration)
+		**out = **in
 	}
-	if in.NodeAffinity != nil {
-		in, out := &in.NodeAffinity, &out.NodeAffinity
-		*out = new(v1.NodeAffinity)
-		(*in).DeepCopyInto(*out)
+	if in.LbPolicy != nil {
+		in, out := &in.LbPolicy, &out.LbPolicy
+		*out = new(ClusterLbPolicy)
+		**out = **in
 	}
-	if in.Tolerations != nil {
-		in, out := &in.Tolerations, &out.Tolerations
-		*out = make([]v1.Toleration, len(*in))
+	if in.HealthChecks != nil {
+		in, out := &in.HealthChecks, &out.HealthChecks
+		*out = make([]ClusterHealthCheck, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.PublishingStrategies != nil {
-		in, out := &in.PublishingStrategies, &out.PublishingStrategies
-		*out = new(PublishingStrategies)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Endpoint != nil {
-		in, out := &in.Endpoint, &out.Endpoint
-		*out = new(Endpoint)
+	if in.OutlierDetection != nil {
+		in, out := &in.OutlierDetection, &out.OutlierDetection
+		*out = new(ClusterOutlierDetection)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.Marin3r != nil {
-		in, out := &in.Marin3r, &out.Marin3r
-		*out = new(Marin3rSidecarSpec)
+	if in.CircuitBreakers != nil {
+		in, out := &in.CircuitBreakers, &out.CircuitBreakers
+		*out = new(ClusterCircuitBreakers)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.LoadBalancer != nil {
-		in, out := &in.LoadBalancer, &out.LoadBalancer
-		*out = new(NetworkLoadBalancerSpec)
+	if in.UpstreamTLS != nil {
+		in, out := &in.UpstreamTLS, &out.UpstreamTLS
+		*out = new(ClusterUpstreamTLS)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EchoAPISpec.
-func (in *EchoAPISpec) DeepCopy() *EchoAPISpec {
-	if in == nil {
-		return nil
-	}
-	out := new(EchoAPISpec)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EchoAPIStatus) DeepCopyInto(out *EchoAPIStatus) {
-	*out = *in
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EchoAPIStatus.
-func (in *EchoAPIStatus) DeepCopy() *EchoAPIStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Cluster.
+func (in *Cluster) DeepCopy() *Cluster {
 	if in == nil {
 		return nil
 	}
-	out := new(EchoAPIStatus)
+	out := new(Cluster)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ElasticLoadBalancerSpec) DeepCopyInto(out *ElasticLoadBalancerSpec) {
+func (in *ClusterCircuitBreakers) DeepCopyInto(out *ClusterCircuitBreakers) {
 	*out = *in
-	if in.ProxyProtocol != nil {
-		in, out := &in.ProxyProtocol, &out.ProxyProtocol
-		*out = new(bool)
-		**out = **in
-	}
-	if in.CrossZoneLoadBalancingEnabled != nil {
-		in, out := &in.CrossZoneLoadBalancingEnabled, &out.CrossZoneLoadBalancingEnabled
-		*out = new(bool)
-		**out = **in
-	}
-	if in.ConnectionDrainingEnabled != nil {
-		in, out := &in.ConnectionDrainingEnabled, &out.ConnectionDrainingEnabled
-		*out = new(bool)
-		**out = **in
-	}
-	if in.ConnectionDrainingTimeout != nil {
-		in, out := &in.ConnectionDrainingTimeout, &out.ConnectionDrainingTimeout
-		*out = new(int32)
+	if in.MaxConnections != nil {
+		in, out := &in.MaxConnections, &out.MaxConnections
+		*out = new(uint32)
 		**out = **in
 	}
-	if in.HealthcheckHealthyThreshold != nil {
-		in, out := &in.HealthcheckHealthyThreshold, &out.HealthcheckHealthyThreshold
-		*out = new(int32)
+	if in.MaxPendingRequests != nil {
+		in, out := &in.MaxPendingRequests, &out.MaxPendingRequests
+		*out = new(uint32)
 		**out = **in
 	}
-	if in.HealthcheckUnhealthyThreshold != nil {
-		in, out := &in.HealthcheckUnhealthyThreshold, &out.HealthcheckUnhealthyThreshold
-		*out = new(int32)
+	if in.MaxRequests != nil {
+		in, out := &in.MaxRequests, &out.MaxRequests
+		*out = new(uint32)
 		**out = **in
 	}
-	if in.HealthcheckInterval != nil {
-		in, out := &in.HealthcheckInterval, &out.HealthcheckInterval
-		*out = new(int32)
+	if in.MaxRetries != nil {
+		in, out := &in.MaxRetries, &out.MaxRetries
+		*out = new(uint32)
 		**out = **in
 	}
-	if in.HealthcheckTimeout != nil {
-		in, out := &in.HealthcheckTimeout, &out.HealthcheckTimeout
-		*out = new(int32)
-		**out = **in
+	if in.RetryBudget != nil {
+		in, out := &in.RetryBudget, &out.RetryBudget
+		*out = new(ClusterRetryBudget)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ElasticLoadBalancerSpec.
-func (in *ElasticLoadBalancerSpec) DeepCopy() *ElasticLoadBalancerSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterCircuitBreakers.
+func (in *ClusterCircuitBreakers) DeepCopy() *ClusterCircuitBreakers {
 	if in == nil {
 		return nil
 	}
-	out := new(ElasticLoadBalancerSpec)
+	out := new(ClusterCircuitBreakers)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Endpoint) DeepCopyInto(out *Endpoint) {
+func (in *ClusterEndpoint) DeepCopyInto(out *ClusterEndpoint) {
 	*out = *in
-	if in.DNS != nil {
-		in, out := &in.DNS, &out.DNS
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	if in.Weight != nil {
+		in, out := &in.Weight, &out.Weight
+		*out = new(uint32)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Endpoint.
-func (in *Endpoint) DeepCopy() *Endpoint {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterEndpoint.
+func (in *ClusterEndpoint) DeepCopy() *ClusterEndpoint {
 	if in == nil {
 		return nil
 	}
-	out := new(Endpoint)
+	out := new(ClusterEndpoint)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EnvoyDynamicConfig) DeepCopyInto(out *EnvoyDynamicConfig) {
+func (in *ClusterHealthCheck) DeepCopyInto(out *ClusterHealthCheck) {
 	*out = *in
-	if in.GeneratorVersion != nil {
-		in, out := &in.GeneratorVersion, &out.GeneratorVersion
-		*out = new(string)
-		**out = **in
-	}
-	if in.ListenerHttp != nil {
-		in, out := &in.ListenerHttp, &out.ListenerHttp
-		*out = new(ListenerHttp)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.RouteConfiguration != nil {
-		in, out := &in.RouteConfiguration, &out.RouteConfiguration
-		*out = new(RouteConfiguration)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Cluster != nil {
-		in, out := &in.Cluster, &out.Cluster
-		*out = new(Cluster)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Runtime != nil {
-		in, out := &in.Runtime, &out.Runtime
-		*out = new(Runtime)
+	out.Timeout = in.Timeout
+	out.Interval = in.Interval
+	if in.Http != nil {
+		in, out := &in.Http, &out.Http
+		*out = new(ClusterHttpHealthCheck)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.RawConfig != nil {
-		in, out := &in.RawConfig, &out.RawConfig
-		*out = new(RawConfig)
+	if in.Tcp != nil {
+		in, out := &in.Tcp, &out.Tcp
+		*out = new(ClusterTcpHealthCheck)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvoyDynamicConfig.
-func (in *EnvoyDynamicConfig) DeepCopy() *EnvoyDynamicConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterHealthCheck.
+func (in *ClusterHealthCheck) DeepCopy() *ClusterHealthCheck {
 	if in == nil {
 		return nil
 	}
-	out := new(EnvoyDynamicConfig)
+	out := new(ClusterHealthCheck)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ExternalSecret) DeepCopyInto(out *ExternalSecret) {
+func (in *ClusterHttpHealthCheck) DeepCopyInto(out *ClusterHttpHealthCheck) {
 	*out = *in
-	if in.SecretStoreRef != nil {
-		in, out := &in.SecretStoreRef, &out.SecretStoreRef
-		*out = new(ExternalSecretSecretStoreReferenceSpec)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.RefreshInterval != nil {
-		in, out := &in.RefreshInterval, &out.RefreshInterval
-		*out = new(metav1.Duration)
+	if in.Host != nil {
+		in, out := &in.Host, &out.Host
+		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalSecret.
-func (in *ExternalSecret) DeepCopy() *ExternalSecret {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterHttpHealthCheck.
+func (in *ClusterHttpHealthCheck) DeepCopy() *ClusterHttpHealthCheck {
 	if in == nil {
 		return nil
 	}
-	out := new(ExternalSecret)
+	out := new(ClusterHttpHealthCheck)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ExternalSecretSecretStoreReferenceSpec) DeepCopyInto(out *ExternalSecretSecretStoreReferenceSpec) {
+func (in *ClusterOutlierDetection) DeepCopyInto(out *ClusterOutlierDetection) {
 	*out = *in
-	if in.Name != nil {
-		in, out := &in.Name, &out.Name
-		*out = new(string)
+	if in.Consecutive5xx != nil {
+		in, out := &in.Consecutive5xx, &out.Consecutive5xx
+		*out = new(uint32)
 		**out = **in
 	}
-	if in.Kind != nil {
-		in, out := &in.Kind, &out.Kind
-		*out = new(string)
+	if in.ConsecutiveGatewayFailure != nil {
+		in, out := &in.ConsecutiveGatewayFailure, &out.ConsecutiveGatewayFailure
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.BaseEjectionTime != nil {
+		in, out := &in.BaseEjectionTime, &out.BaseEjectionTime
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.MaxEjectionPercent != nil {
+		in, out := &in.MaxEjectionPercent, &out.MaxEjectionPercent
+		*out = new(uint32)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalSecretSecretStoreReferenceSpec.
-func (in *ExternalSecretSecretStoreReferenceSpec) DeepCopy() *ExternalSecretSecretStoreReferenceSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterOutlierDetection.
+func (in *ClusterOutlierDetection) DeepCopy() *ClusterOutlierDetection {
 	if in == nil {
 		return nil
 	}
-	out := new(ExternalSecretSecretStoreReferenceSpec)
+	out := new(ClusterOutlierDetection)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GithubSpec) DeepCopyInto(out *GithubSpec) {
+func (in *ClusterRetryBudget) DeepCopyInto(out *ClusterRetryBudget) {
 	*out = *in
-	in.ClientID.DeepCopyInto(&out.ClientID)
-	in.ClientSecret.DeepCopyInto(&out.ClientSecret)
+	if in.BudgetPercent != nil {
+		in, out := &in.BudgetPercent, &out.BudgetPercent
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.MinRetryConcurrency != nil {
+		in, out := &in.MinRetryConcurrency, &out.MinRetryConcurrency
+		*out = new(uint32)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GithubSpec.
-func (in *GithubSpec) DeepCopy() *GithubSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterRetryBudget.
+func (in *ClusterRetryBudget) DeepCopy() *ClusterRetryBudget {
 	if in == nil {
 		return nil
 	}
-	out := new(GithubSpec)
+	out := new(ClusterRetryBudget)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GrafanaDashboardSpec) DeepCopyInto(out *GrafanaDashboardSpec) {
+func (in *ClusterTcpHealthCheck) DeepCopyInto(out *ClusterTcpHealthCheck) {
 	*out = *in
-	if in.SelectorKey != nil {
-		in, out := &in.SelectorKey, &out.SelectorKey
+	if in.Send != nil {
+		in, out := &in.Send, &out.Send
 		*out = new(string)
 		**out = **in
 	}
-	if in.SelectorValue != nil {
-		in, out := &in.SelectorValue, &out.SelectorValue
-		*out = new(string)
-		**out = **in
+	if in.Receive != nil {
+		in, out := &in.Receive, &out.Receive
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GrafanaDashboardSpec.
-func (in *GrafanaDashboardSpec) DeepCopy() *GrafanaDashboardSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterTcpHealthCheck.
+func (in *ClusterTcpHealthCheck) DeepCopy() *ClusterTcpHealthCheck {
 	if in == nil {
 		return nil
 	}
-	out := new(GrafanaDashboardSpec)
+	out := new(ClusterTcpHealthCheck)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *HorizontalPodAutoscalerSpec) DeepCopyInto(out *HorizontalPodAutoscalerSpec) {
+func (in *ClusterUpstreamTLS) DeepCopyInto(out *ClusterUpstreamTLS) {
 	*out = *in
-	if in.MinReplicas != nil {
-		in, out := &in.MinReplicas, &out.MinReplicas
-		*out = new(int32)
+	if in.SNI != nil {
+		in, out := &in.SNI, &out.SNI
+		*out = new(string)
 		**out = **in
 	}
-	if in.MaxReplicas != nil {
-		in, out := &in.MaxReplicas, &out.MaxReplicas
-		*out = new(int32)
-		**out = **in
+	if in.Validation != nil {
+		in, out := &in.Validation, &out.Validation
+		*out = new(UpstreamTLSValidation)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.ResourceName != nil {
-		in, out := &in.ResourceName, &out.ResourceName
+	if in.ClientCertificateSecretName != nil {
+		in, out := &in.ClientCertificateSecretName, &out.ClientCertificateSecretName
 		*out = new(string)
 		**out = **in
 	}
-	if in.ResourceUtilization != nil {
-		in, out := &in.ResourceUtilization, &out.ResourceUtilization
-		*out = new(int32)
-		**out = **in
-	}
-	if in.Behavior != nil {
-		in, out := &in.Behavior, &out.Behavior
-		*out = new(v2.HorizontalPodAutoscalerBehavior)
-		(*in).DeepCopyInto(*out)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HorizontalPodAutoscalerSpec.
-func (in *HorizontalPodAutoscalerSpec) DeepCopy() *HorizontalPodAutoscalerSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterUpstreamTLS.
+func (in *ClusterUpstreamTLS) DeepCopy() *ClusterUpstreamTLS {
 	if in == nil {
 		return nil
 	}
-	out := new(HorizontalPodAutoscalerSpec)
+	out := new(ClusterUpstreamTLS)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ImageSpec) DeepCopyInto(out *ImageSpec) {
+func (in *CronSpec) DeepCopyInto(out *CronSpec) {
 	*out = *in
-	if in.Name != nil {
-		in, out := &in.Name, &out.Name
-		*out = new(string)
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
 		**out = **in
 	}
-	if in.Tag != nil {
-		in, out := &in.Tag, &out.Tag
-		*out = new(string)
-		**out = **in
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(ResourceRequirementsSpec)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.PullSecretName != nil {
-		in, out := &in.PullSecretName, &out.PullSecretName
-		*out = new(string)
-		**out = **in
+	if in.VPA != nil {
+		in, out := &in.VPA, &out.VPA
+		*out = new(VerticalPodAutoscalerSpec)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.PullPolicy != nil {
-		in, out := &in.PullPolicy, &out.PullPolicy
-		*out = new(v1.PullPolicy)
-		**out = **in
+	if in.NodeAffinity != nil {
+		in, out := &in.NodeAffinity, &out.NodeAffinity
+		*out = new(v1.NodeAffinity)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]v1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
+	in.PodTemplateOverrides.DeepCopyInto(&out.PodTemplateOverrides)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageSpec.
-func (in *ImageSpec) DeepCopy() *ImageSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CronSpec.
+func (in *CronSpec) DeepCopy() *CronSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ImageSpec)
+	out := new(CronSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ListenerConfig) DeepCopyInto(out *ListenerConfig) {
+func (in *DeploymentStrategySpec) DeepCopyInto(out *DeploymentStrategySpec) {
 	*out = *in
-	if in.LogFormat != nil {
-		in, out := &in.LogFormat, &out.LogFormat
-		*out = new(string)
-		**out = **in
-	}
-	if in.RedisAsync != nil {
-		in, out := &in.RedisAsync, &out.RedisAsync
-		*out = new(bool)
-		**out = **in
-	}
-	if in.ListenerWorkers != nil {
-		in, out := &in.ListenerWorkers, &out.ListenerWorkers
-		*out = new(int32)
-		**out = **in
-	}
-	if in.LegacyReferrerFilters != nil {
-		in, out := &in.LegacyReferrerFilters, &out.LegacyReferrerFilters
-		*out = new(bool)
-		**out = **in
+	if in.RollingUpdate != nil {
+		in, out := &in.RollingUpdate, &out.RollingUpdate
+		*out = new(appsv1.RollingUpdateDeployment)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListenerConfig.
-func (in *ListenerConfig) DeepCopy() *ListenerConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentStrategySpec.
+func (in *DeploymentStrategySpec) DeepCopy() *DeploymentStrategySpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ListenerConfig)
+	out := new(DeploymentStrategySpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ListenerHttp) DeepCopyInto(out *ListenerHttp) {
+func (in *DirectResponseAction) DeepCopyInto(out *DirectResponseAction) {
 	*out = *in
-	if in.ProxyProtocol != nil {
-		in, out := &in.ProxyProtocol, &out.ProxyProtocol
-		*out = new(bool)
-		**out = **in
-	}
-	if in.CertificateSecretName != nil {
-		in, out := &in.CertificateSecretName, &out.CertificateSecretName
+	if in.Body != nil {
+		in, out := &in.Body, &out.Body
 		*out = new(string)
 		**out = **in
 	}
-	if in.RateLimitOptions != nil {
-		in, out := &in.RateLimitOptions, &out.RateLimitOptions
-		*out = new(RateLimitOptions)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.DefaultHostForHttp10 != nil {
-		in, out := &in.DefaultHostForHttp10, &out.DefaultHostForHttp10
-		*out = new(string)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectResponseAction.
+func (in *DirectResponseAction) DeepCopy() *DirectResponseAction {
+	if in == nil {
+		return nil
 	}
-	if in.EnableHttp2 != nil {
-		in, out := &in.EnableHttp2, &out.EnableHttp2
-		*out = new(bool)
-		**out = **in
+	out := new(DirectResponseAction)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EchoAPI) DeepCopyInto(out *EchoAPI) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EchoAPI.
+func (in *EchoAPI) DeepCopy() *EchoAPI {
+	if in == nil {
+		return nil
 	}
-	if in.AllowHeadersWithUnderscores != nil {
-		in, out := &in.AllowHeadersWithUnderscores, &out.AllowHeadersWithUnderscores
-		*out = new(bool)
-		**out = **in
+	out := new(EchoAPI)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EchoAPI) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	if in.MaxConnectionDuration != nil {
-		in, out := &in.MaxConnectionDuration, &out.MaxConnectionDuration
-		*out = new(metav1.Duration)
-		**out = **in
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EchoAPIList) DeepCopyInto(out *EchoAPIList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]EchoAPI, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListenerHttp.
-func (in *ListenerHttp) DeepCopy() *ListenerHttp {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EchoAPIList.
+func (in *EchoAPIList) DeepCopy() *EchoAPIList {
 	if in == nil {
 		return nil
 	}
-	out := new(ListenerHttp)
+	out := new(EchoAPIList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EchoAPIList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ListenerSpec) DeepCopyInto(out *ListenerSpec) {
+func (in *EchoAPISpec) DeepCopyInto(out *EchoAPISpec) {
 	*out = *in
-	if in.Config != nil {
-		in, out := &in.Config, &out.Config
-		*out = new(ListenerConfig)
+	if in.Image != nil {
+		in, out := &in.Image, &out.Image
+		*out = new(ImageSpec)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.PDB != nil {
-		in, out := &in.PDB, &out.PDB
-		*out = new(PodDisruptionBudgetSpec)
-		(*in).DeepCopyInto(*out)
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
 	}
 	if in.HPA != nil {
 		in, out := &in.HPA, &out.HPA
 		*out = new(HorizontalPodAutoscalerSpec)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.Replicas != nil {
-		in, out := &in.Replicas, &out.Replicas
-		*out = new(int32)
-		**out = **in
+	if in.VPA != nil {
+		in, out := &in.VPA, &out.VPA
+		*out = new(VerticalPodAutoscalerSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PDB != nil {
+		in, out := &in.PDB, &out.PDB
+		*out = new(PodDisruptionBudgetSpec)
+		(*in).DeepCopyInto(*out)
 	}
 	if in.Resources != nil {
 		in, out := &in.Resources, &out.Resources
@@ -1652,11 +1966,7 @@ func (in *ListenerSpec) DeepCopyInto(out *ListenerSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.Canary != nil {
-		in, out := &in.Canary, &out.Canary
-		*out = new(Canary)
-		(*in).DeepCopyInto(*out)
-	}
+	in.PodTemplateOverrides.DeepCopyInto(&out.PodTemplateOverrides)
 	if in.PublishingStrategies != nil {
 		in, out := &in.PublishingStrategies, &out.PublishingStrategies
 		*out = new(PublishingStrategies)
@@ -1679,8 +1989,903 @@ func (in *ListenerSpec) DeepCopyInto(out *ListenerSpec) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListenerSpec.
-func (in *ListenerSpec) DeepCopy() *ListenerSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EchoAPISpec.
+func (in *EchoAPISpec) DeepCopy() *EchoAPISpec {
+	if in == nil {
+		return nil
+	}
+	out := new(EchoAPISpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EchoAPIStatus) DeepCopyInto(out *EchoAPIStatus) {
+	*out = *in
+	in.WorkloadStatus.DeepCopyInto(&out.WorkloadStatus)
+	if in.Recommendations != nil {
+		in, out := &in.Recommendations, &out.Recommendations
+		*out = make(ResourceRecommendations, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EchoAPIStatus.
+func (in *EchoAPIStatus) DeepCopy() *EchoAPIStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(EchoAPIStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ElasticLoadBalancerSpec) DeepCopyInto(out *ElasticLoadBalancerSpec) {
+	*out = *in
+	if in.ProxyProtocol != nil {
+		in, out := &in.ProxyProtocol, &out.ProxyProtocol
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CrossZoneLoadBalancingEnabled != nil {
+		in, out := &in.CrossZoneLoadBalancingEnabled, &out.CrossZoneLoadBalancingEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ConnectionDrainingEnabled != nil {
+		in, out := &in.ConnectionDrainingEnabled, &out.ConnectionDrainingEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ConnectionDrainingTimeout != nil {
+		in, out := &in.ConnectionDrainingTimeout, &out.ConnectionDrainingTimeout
+		*out = new(int32)
+		**out = **in
+	}
+	if in.HealthcheckHealthyThreshold != nil {
+		in, out := &in.HealthcheckHealthyThreshold, &out.HealthcheckHealthyThreshold
+		*out = new(int32)
+		**out = **in
+	}
+	if in.HealthcheckUnhealthyThreshold != nil {
+		in, out := &in.HealthcheckUnhealthyThreshold, &out.HealthcheckUnhealthyThreshold
+		*out = new(int32)
+		**out = **in
+	}
+	if in.HealthcheckInterval != nil {
+		in, out := &in.HealthcheckInterval, &out.HealthcheckInterval
+		*out = new(int32)
+		**out = **in
+	}
+	if in.HealthcheckTimeout != nil {
+		in, out := &in.HealthcheckTimeout, &out.HealthcheckTimeout
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ElasticLoadBalancerSpec.
+func (in *ElasticLoadBalancerSpec) DeepCopy() *ElasticLoadBalancerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ElasticLoadBalancerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Endpoint) DeepCopyInto(out *Endpoint) {
+	*out = *in
+	if in.DNS != nil {
+		in, out := &in.DNS, &out.DNS
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Endpoint.
+func (in *Endpoint) DeepCopy() *Endpoint {
+	if in == nil {
+		return nil
+	}
+	out := new(Endpoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EnvoyDynamicConfig) DeepCopyInto(out *EnvoyDynamicConfig) {
+	*out = *in
+	if in.GeneratorVersion != nil {
+		in, out := &in.GeneratorVersion, &out.GeneratorVersion
+		*out = new(string)
+		**out = **in
+	}
+	if in.ListenerHttp != nil {
+		in, out := &in.ListenerHttp, &out.ListenerHttp
+		*out = new(ListenerHttp)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ListenerTcp != nil {
+		in, out := &in.ListenerTcp, &out.ListenerTcp
+		*out = new(ListenerTcp)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RouteConfiguration != nil {
+		in, out := &in.RouteConfiguration, &out.RouteConfiguration
+		*out = new(RouteConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Cluster != nil {
+		in, out := &in.Cluster, &out.Cluster
+		*out = new(Cluster)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Runtime != nil {
+		in, out := &in.Runtime, &out.Runtime
+		*out = new(Runtime)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RawConfig != nil {
+		in, out := &in.RawConfig, &out.RawConfig
+		*out = new(RawConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvoyDynamicConfig.
+func (in *EnvoyDynamicConfig) DeepCopy() *EnvoyDynamicConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(EnvoyDynamicConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExtAuthz) DeepCopyInto(out *ExtAuthz) {
+	*out = *in
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.FailureModeAllow != nil {
+		in, out := &in.FailureModeAllow, &out.FailureModeAllow
+		*out = new(bool)
+		**out = **in
+	}
+	if in.IncludePeerCertificate != nil {
+		in, out := &in.IncludePeerCertificate, &out.IncludePeerCertificate
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PathPrefix != nil {
+		in, out := &in.PathPrefix, &out.PathPrefix
+		*out = new(string)
+		**out = **in
+	}
+	if in.AllowedRequestHeaders != nil {
+		in, out := &in.AllowedRequestHeaders, &out.AllowedRequestHeaders
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedUpstreamHeaders != nil {
+		in, out := &in.AllowedUpstreamHeaders, &out.AllowedUpstreamHeaders
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExtAuthz.
+func (in *ExtAuthz) DeepCopy() *ExtAuthz {
+	if in == nil {
+		return nil
+	}
+	out := new(ExtAuthz)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalSecret) DeepCopyInto(out *ExternalSecret) {
+	*out = *in
+	if in.SecretStoreRef != nil {
+		in, out := &in.SecretStoreRef, &out.SecretStoreRef
+		*out = new(ExternalSecretSecretStoreReferenceSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RefreshInterval != nil {
+		in, out := &in.RefreshInterval, &out.RefreshInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalSecret.
+func (in *ExternalSecret) DeepCopy() *ExternalSecret {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalSecret)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalSecretSecretStoreReferenceSpec) DeepCopyInto(out *ExternalSecretSecretStoreReferenceSpec) {
+	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Kind != nil {
+		in, out := &in.Kind, &out.Kind
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalSecretSecretStoreReferenceSpec.
+func (in *ExternalSecretSecretStoreReferenceSpec) DeepCopy() *ExternalSecretSecretStoreReferenceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalSecretSecretStoreReferenceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GithubSpec) DeepCopyInto(out *GithubSpec) {
+	*out = *in
+	in.ClientID.DeepCopyInto(&out.ClientID)
+	in.ClientSecret.DeepCopyInto(&out.ClientSecret)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GithubSpec.
+func (in *GithubSpec) DeepCopy() *GithubSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GithubSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GrafanaDashboardSpec) DeepCopyInto(out *GrafanaDashboardSpec) {
+	*out = *in
+	if in.SelectorKey != nil {
+		in, out := &in.SelectorKey, &out.SelectorKey
+		*out = new(string)
+		**out = **in
+	}
+	if in.SelectorValue != nil {
+		in, out := &in.SelectorValue, &out.SelectorValue
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GrafanaDashboardSpec.
+func (in *GrafanaDashboardSpec) DeepCopy() *GrafanaDashboardSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GrafanaDashboardSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HeaderMatcher) DeepCopyInto(out *HeaderMatcher) {
+	*out = *in
+	if in.Exact != nil {
+		in, out := &in.Exact, &out.Exact
+		*out = new(string)
+		**out = **in
+	}
+	if in.Prefix != nil {
+		in, out := &in.Prefix, &out.Prefix
+		*out = new(string)
+		**out = **in
+	}
+	if in.Regex != nil {
+		in, out := &in.Regex, &out.Regex
+		*out = new(string)
+		**out = **in
+	}
+	if in.Present != nil {
+		in, out := &in.Present, &out.Present
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Invert != nil {
+		in, out := &in.Invert, &out.Invert
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HeaderMatcher.
+func (in *HeaderMatcher) DeepCopy() *HeaderMatcher {
+	if in == nil {
+		return nil
+	}
+	out := new(HeaderMatcher)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HorizontalPodAutoscalerSpec) DeepCopyInto(out *HorizontalPodAutoscalerSpec) {
+	*out = *in
+	if in.MinReplicas != nil {
+		in, out := &in.MinReplicas, &out.MinReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxReplicas != nil {
+		in, out := &in.MaxReplicas, &out.MaxReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ResourceName != nil {
+		in, out := &in.ResourceName, &out.ResourceName
+		*out = new(string)
+		**out = **in
+	}
+	if in.ResourceUtilization != nil {
+		in, out := &in.ResourceUtilization, &out.ResourceUtilization
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Behavior != nil {
+		in, out := &in.Behavior, &out.Behavior
+		*out = new(v2.HorizontalPodAutoscalerBehavior)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PodsMetrics != nil {
+		in, out := &in.PodsMetrics, &out.PodsMetrics
+		*out = make([]v2.PodsMetricSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ExternalMetrics != nil {
+		in, out := &in.ExternalMetrics, &out.ExternalMetrics
+		*out = make([]v2.ExternalMetricSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.KEDA != nil {
+		in, out := &in.KEDA, &out.KEDA
+		*out = new(KEDASpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HorizontalPodAutoscalerSpec.
+func (in *HorizontalPodAutoscalerSpec) DeepCopy() *HorizontalPodAutoscalerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(HorizontalPodAutoscalerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageSpec) DeepCopyInto(out *ImageSpec) {
+	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Tag != nil {
+		in, out := &in.Tag, &out.Tag
+		*out = new(string)
+		**out = **in
+	}
+	if in.PullSecretName != nil {
+		in, out := &in.PullSecretName, &out.PullSecretName
+		*out = new(string)
+		**out = **in
+	}
+	if in.PullPolicy != nil {
+		in, out := &in.PullPolicy, &out.PullPolicy
+		*out = new(v1.PullPolicy)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageSpec.
+func (in *ImageSpec) DeepCopy() *ImageSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JwtAuthn) DeepCopyInto(out *JwtAuthn) {
+	*out = *in
+	if in.Providers != nil {
+		in, out := &in.Providers, &out.Providers
+		*out = make([]JwtProvider, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AllowMissing != nil {
+		in, out := &in.AllowMissing, &out.AllowMissing
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JwtAuthn.
+func (in *JwtAuthn) DeepCopy() *JwtAuthn {
+	if in == nil {
+		return nil
+	}
+	out := new(JwtAuthn)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JwtClaimToHeader) DeepCopyInto(out *JwtClaimToHeader) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JwtClaimToHeader.
+func (in *JwtClaimToHeader) DeepCopy() *JwtClaimToHeader {
+	if in == nil {
+		return nil
+	}
+	out := new(JwtClaimToHeader)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JwtHeader) DeepCopyInto(out *JwtHeader) {
+	*out = *in
+	if in.ValuePrefix != nil {
+		in, out := &in.ValuePrefix, &out.ValuePrefix
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JwtHeader.
+func (in *JwtHeader) DeepCopy() *JwtHeader {
+	if in == nil {
+		return nil
+	}
+	out := new(JwtHeader)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JwtProvider) DeepCopyInto(out *JwtProvider) {
+	*out = *in
+	if in.Issuer != nil {
+		in, out := &in.Issuer, &out.Issuer
+		*out = new(string)
+		**out = **in
+	}
+	if in.Audiences != nil {
+		in, out := &in.Audiences, &out.Audiences
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RemoteJwks != nil {
+		in, out := &in.RemoteJwks, &out.RemoteJwks
+		*out = new(JwtRemoteJwks)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LocalJwks != nil {
+		in, out := &in.LocalJwks, &out.LocalJwks
+		*out = new(string)
+		**out = **in
+	}
+	if in.FromHeaders != nil {
+		in, out := &in.FromHeaders, &out.FromHeaders
+		*out = make([]JwtHeader, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Forward != nil {
+		in, out := &in.Forward, &out.Forward
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ForwardPayloadHeader != nil {
+		in, out := &in.ForwardPayloadHeader, &out.ForwardPayloadHeader
+		*out = new(string)
+		**out = **in
+	}
+	if in.ClaimToHeaders != nil {
+		in, out := &in.ClaimToHeaders, &out.ClaimToHeaders
+		*out = make([]JwtClaimToHeader, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JwtProvider.
+func (in *JwtProvider) DeepCopy() *JwtProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(JwtProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JwtRemoteJwks) DeepCopyInto(out *JwtRemoteJwks) {
+	*out = *in
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.CacheDuration != nil {
+		in, out := &in.CacheDuration, &out.CacheDuration
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JwtRemoteJwks.
+func (in *JwtRemoteJwks) DeepCopy() *JwtRemoteJwks {
+	if in == nil {
+		return nil
+	}
+	out := new(JwtRemoteJwks)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KEDAPrometheusTrigger) DeepCopyInto(out *KEDAPrometheusTrigger) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KEDAPrometheusTrigger.
+func (in *KEDAPrometheusTrigger) DeepCopy() *KEDAPrometheusTrigger {
+	if in == nil {
+		return nil
+	}
+	out := new(KEDAPrometheusTrigger)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KEDARedisListLengthTrigger) DeepCopyInto(out *KEDARedisListLengthTrigger) {
+	*out = *in
+	if in.DatabaseIndex != nil {
+		in, out := &in.DatabaseIndex, &out.DatabaseIndex
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KEDARedisListLengthTrigger.
+func (in *KEDARedisListLengthTrigger) DeepCopy() *KEDARedisListLengthTrigger {
+	if in == nil {
+		return nil
+	}
+	out := new(KEDARedisListLengthTrigger)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KEDASpec) DeepCopyInto(out *KEDASpec) {
+	*out = *in
+	if in.PollingInterval != nil {
+		in, out := &in.PollingInterval, &out.PollingInterval
+		*out = new(int32)
+		**out = **in
+	}
+	if in.CooldownPeriod != nil {
+		in, out := &in.CooldownPeriod, &out.CooldownPeriod
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Triggers != nil {
+		in, out := &in.Triggers, &out.Triggers
+		*out = make([]KEDATrigger, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KEDASpec.
+func (in *KEDASpec) DeepCopy() *KEDASpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KEDASpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KEDATrigger) DeepCopyInto(out *KEDATrigger) {
+	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.RedisListLength != nil {
+		in, out := &in.RedisListLength, &out.RedisListLength
+		*out = new(KEDARedisListLengthTrigger)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Prometheus != nil {
+		in, out := &in.Prometheus, &out.Prometheus
+		*out = new(KEDAPrometheusTrigger)
+		**out = **in
+	}
+	if in.AuthenticationRef != nil {
+		in, out := &in.AuthenticationRef, &out.AuthenticationRef
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KEDATrigger.
+func (in *KEDATrigger) DeepCopy() *KEDATrigger {
+	if in == nil {
+		return nil
+	}
+	out := new(KEDATrigger)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeyDistributionChange) DeepCopyInto(out *KeyDistributionChange) {
+	*out = *in
+	if in.Changes != nil {
+		in, out := &in.Changes, &out.Changes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyDistributionChange.
+func (in *KeyDistributionChange) DeepCopy() *KeyDistributionChange {
+	if in == nil {
+		return nil
+	}
+	out := new(KeyDistributionChange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ListenerConfig) DeepCopyInto(out *ListenerConfig) {
+	*out = *in
+	if in.LogFormat != nil {
+		in, out := &in.LogFormat, &out.LogFormat
+		*out = new(string)
+		**out = **in
+	}
+	if in.RedisAsync != nil {
+		in, out := &in.RedisAsync, &out.RedisAsync
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ListenerWorkers != nil {
+		in, out := &in.ListenerWorkers, &out.ListenerWorkers
+		*out = new(int32)
+		**out = **in
+	}
+	if in.LegacyReferrerFilters != nil {
+		in, out := &in.LegacyReferrerFilters, &out.LegacyReferrerFilters
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListenerConfig.
+func (in *ListenerConfig) DeepCopy() *ListenerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ListenerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ListenerHttp) DeepCopyInto(out *ListenerHttp) {
+	*out = *in
+	if in.ProxyProtocol != nil {
+		in, out := &in.ProxyProtocol, &out.ProxyProtocol
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CertificateSecretName != nil {
+		in, out := &in.CertificateSecretName, &out.CertificateSecretName
+		*out = new(string)
+		**out = **in
+	}
+	if in.RateLimitOptions != nil {
+		in, out := &in.RateLimitOptions, &out.RateLimitOptions
+		*out = new(RateLimitOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LocalRateLimit != nil {
+		in, out := &in.LocalRateLimit, &out.LocalRateLimit
+		*out = new(LocalRateLimit)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ExtAuthz != nil {
+		in, out := &in.ExtAuthz, &out.ExtAuthz
+		*out = new(ExtAuthz)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.JwtAuthn != nil {
+		in, out := &in.JwtAuthn, &out.JwtAuthn
+		*out = new(JwtAuthn)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DefaultHostForHttp10 != nil {
+		in, out := &in.DefaultHostForHttp10, &out.DefaultHostForHttp10
+		*out = new(string)
+		**out = **in
+	}
+	if in.EnableHttp2 != nil {
+		in, out := &in.EnableHttp2, &out.EnableHttp2
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AllowHeadersWithUnderscores != nil {
+		in, out := &in.AllowHeadersWithUnderscores, &out.AllowHeadersWithUnderscores
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MaxConnectionDuration != nil {
+		in, out := &in.MaxConnectionDuration, &out.MaxConnectionDuration
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.AccessLog != nil {
+		in, out := &in.AccessLog, &out.AccessLog
+		*out = new(AccessLogOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tracing != nil {
+		in, out := &in.Tracing, &out.Tracing
+		*out = new(TracingOptions)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListenerHttp.
+func (in *ListenerHttp) DeepCopy() *ListenerHttp {
+	if in == nil {
+		return nil
+	}
+	out := new(ListenerHttp)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ListenerSpec) DeepCopyInto(out *ListenerSpec) {
+	*out = *in
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
+		*out = new(ListenerConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PDB != nil {
+		in, out := &in.PDB, &out.PDB
+		*out = new(PodDisruptionBudgetSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HPA != nil {
+		in, out := &in.HPA, &out.HPA
+		*out = new(HorizontalPodAutoscalerSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VPA != nil {
+		in, out := &in.VPA, &out.VPA
+		*out = new(VerticalPodAutoscalerSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ScalingSchedule != nil {
+		in, out := &in.ScalingSchedule, &out.ScalingSchedule
+		*out = make([]ScalingWindow, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(ResourceRequirementsSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LivenessProbe != nil {
+		in, out := &in.LivenessProbe, &out.LivenessProbe
+		*out = new(ProbeSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ReadinessProbe != nil {
+		in, out := &in.ReadinessProbe, &out.ReadinessProbe
+		*out = new(ProbeSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NodeAffinity != nil {
+		in, out := &in.NodeAffinity, &out.NodeAffinity
+		*out = new(v1.NodeAffinity)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]v1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.PodTemplateOverrides.DeepCopyInto(&out.PodTemplateOverrides)
+	if in.Canary != nil {
+		in, out := &in.Canary, &out.Canary
+		*out = new(Canary)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PublishingStrategies != nil {
+		in, out := &in.PublishingStrategies, &out.PublishingStrategies
+		*out = new(PublishingStrategies)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Endpoint != nil {
+		in, out := &in.Endpoint, &out.Endpoint
+		*out = new(Endpoint)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Marin3r != nil {
+		in, out := &in.Marin3r, &out.Marin3r
+		*out = new(Marin3rSidecarSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LoadBalancer != nil {
+		in, out := &in.LoadBalancer, &out.LoadBalancer
+		*out = new(NetworkLoadBalancerSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListenerSpec.
+func (in *ListenerSpec) DeepCopy() *ListenerSpec {
 	if in == nil {
 		return nil
 	}
@@ -1690,120 +2895,749 @@ func (in *ListenerSpec) DeepCopy() *ListenerSpec {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in MapOfEnvoyDynamicConfig) DeepCopyInto(out *MapOfEnvoyDynamicConfig) {
-	{
-		in := &in
-		*out = make(MapOfEnvoyDynamicConfig, len(*in))
+func (in *ListenerTcp) DeepCopyInto(out *ListenerTcp) {
+	*out = *in
+	if in.ProxyProtocol != nil {
+		in, out := &in.ProxyProtocol, &out.ProxyProtocol
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Cluster != nil {
+		in, out := &in.Cluster, &out.Cluster
+		*out = new(string)
+		**out = **in
+	}
+	if in.FilterChains != nil {
+		in, out := &in.FilterChains, &out.FilterChains
+		*out = make([]TcpFilterChain, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.IdleTimeout != nil {
+		in, out := &in.IdleTimeout, &out.IdleTimeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.AccessLog != nil {
+		in, out := &in.AccessLog, &out.AccessLog
+		*out = new(TcpAccessLogOptions)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListenerTcp.
+func (in *ListenerTcp) DeepCopy() *ListenerTcp {
+	if in == nil {
+		return nil
+	}
+	out := new(ListenerTcp)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocalRateLimit) DeepCopyInto(out *LocalRateLimit) {
+	*out = *in
+	if in.TokenBucket != nil {
+		in, out := &in.TokenBucket, &out.TokenBucket
+		*out = new(TokenBucket)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DryRun != nil {
+		in, out := &in.DryRun, &out.DryRun
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalRateLimit.
+func (in *LocalRateLimit) DeepCopy() *LocalRateLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(LocalRateLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceReplicas) DeepCopyInto(out *MaintenanceReplicas) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceReplicas.
+func (in *MaintenanceReplicas) DeepCopy() *MaintenanceReplicas {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceReplicas)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceSpec) DeepCopyInto(out *MaintenanceSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceSpec.
+func (in *MaintenanceSpec) DeepCopy() *MaintenanceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceStatus) DeepCopyInto(out *MaintenanceStatus) {
+	*out = *in
+	in.Since.DeepCopyInto(&out.Since)
+	if in.PreviousReplicas != nil {
+		in, out := &in.PreviousReplicas, &out.PreviousReplicas
+		*out = make([]MaintenanceReplicas, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceStatus.
+func (in *MaintenanceStatus) DeepCopy() *MaintenanceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in MapOfEnvoyDynamicConfig) DeepCopyInto(out *MapOfEnvoyDynamicConfig) {
+	{
+		in := &in
+		*out = make(MapOfEnvoyDynamicConfig, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MapOfEnvoyDynamicConfig.
+func (in MapOfEnvoyDynamicConfig) DeepCopy() MapOfEnvoyDynamicConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(MapOfEnvoyDynamicConfig)
+	in.DeepCopyInto(out)
+	return *out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MappingService) DeepCopyInto(out *MappingService) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MappingService.
+func (in *MappingService) DeepCopy() *MappingService {
+	if in == nil {
+		return nil
+	}
+	out := new(MappingService)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MappingService) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MappingServiceConfig) DeepCopyInto(out *MappingServiceConfig) {
+	*out = *in
+	if in.PreviewBaseDomain != nil {
+		in, out := &in.PreviewBaseDomain, &out.PreviewBaseDomain
+		*out = new(string)
+		**out = **in
+	}
+	if in.LogLevel != nil {
+		in, out := &in.LogLevel, &out.LogLevel
+		*out = new(string)
+		**out = **in
+	}
+	in.ExternalSecret.DeepCopyInto(&out.ExternalSecret)
+	in.SystemAdminToken.DeepCopyInto(&out.SystemAdminToken)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MappingServiceConfig.
+func (in *MappingServiceConfig) DeepCopy() *MappingServiceConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(MappingServiceConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MappingServiceList) DeepCopyInto(out *MappingServiceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MappingService, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MappingServiceList.
+func (in *MappingServiceList) DeepCopy() *MappingServiceList {
+	if in == nil {
+		return nil
+	}
+	out := new(MappingServiceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MappingServiceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MappingServiceSpec) DeepCopyInto(out *MappingServiceSpec) {
+	*out = *in
+	if in.Image != nil {
+		in, out := &in.Image, &out.Image
+		*out = new(ImageSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PDB != nil {
+		in, out := &in.PDB, &out.PDB
+		*out = new(PodDisruptionBudgetSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HPA != nil {
+		in, out := &in.HPA, &out.HPA
+		*out = new(HorizontalPodAutoscalerSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VPA != nil {
+		in, out := &in.VPA, &out.VPA
+		*out = new(VerticalPodAutoscalerSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(ResourceRequirementsSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LivenessProbe != nil {
+		in, out := &in.LivenessProbe, &out.LivenessProbe
+		*out = new(ProbeSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ReadinessProbe != nil {
+		in, out := &in.ReadinessProbe, &out.ReadinessProbe
+		*out = new(ProbeSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GrafanaDashboard != nil {
+		in, out := &in.GrafanaDashboard, &out.GrafanaDashboard
+		*out = new(GrafanaDashboardSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	in.Config.DeepCopyInto(&out.Config)
+	if in.NodeAffinity != nil {
+		in, out := &in.NodeAffinity, &out.NodeAffinity
+		*out = new(v1.NodeAffinity)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]v1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.PodTemplateOverrides.DeepCopyInto(&out.PodTemplateOverrides)
+	if in.PublishingStrategies != nil {
+		in, out := &in.PublishingStrategies, &out.PublishingStrategies
+		*out = new(PublishingStrategies)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MappingServiceSpec.
+func (in *MappingServiceSpec) DeepCopy() *MappingServiceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MappingServiceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MappingServiceStatus) DeepCopyInto(out *MappingServiceStatus) {
+	*out = *in
+	if in.Recommendations != nil {
+		in, out := &in.Recommendations, &out.Recommendations
+		*out = make(ResourceRecommendations, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MappingServiceStatus.
+func (in *MappingServiceStatus) DeepCopy() *MappingServiceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MappingServiceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Marin3rSidecarSpec) DeepCopyInto(out *Marin3rSidecarSpec) {
+	*out = *in
+	if in.Simple != nil {
+		in, out := &in.Simple, &out.Simple
+		*out = new(Simple)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NodeID != nil {
+		in, out := &in.NodeID, &out.NodeID
+		*out = new(string)
+		**out = **in
+	}
+	if in.EnvoyAPIVersion != nil {
+		in, out := &in.EnvoyAPIVersion, &out.EnvoyAPIVersion
+		*out = new(string)
+		**out = **in
+	}
+	if in.EnvoyImage != nil {
+		in, out := &in.EnvoyImage, &out.EnvoyImage
+		*out = new(string)
+		**out = **in
+	}
+	if in.Ports != nil {
+		in, out := &in.Ports, &out.Ports
+		*out = make([]SidecarPort, len(*in))
+		copy(*out, *in)
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(ResourceRequirementsSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ShutdownManagerPort != nil {
+		in, out := &in.ShutdownManagerPort, &out.ShutdownManagerPort
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.ShutdownManagerExtraLifecycleHooks != nil {
+		in, out := &in.ShutdownManagerExtraLifecycleHooks, &out.ShutdownManagerExtraLifecycleHooks
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExtraPodAnnotations != nil {
+		in, out := &in.ExtraPodAnnotations, &out.ExtraPodAnnotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.EnvoyDynamicConfig != nil {
+		in, out := &in.EnvoyDynamicConfig, &out.EnvoyDynamicConfig
+		*out = make(MapOfEnvoyDynamicConfig, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	if in.Tracing != nil {
+		in, out := &in.Tracing, &out.Tracing
+		*out = new(TracingOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StatsSink != nil {
+		in, out := &in.StatsSink, &out.StatsSink
+		*out = new(StatsSinkOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CanaryWeight != nil {
+		in, out := &in.CanaryWeight, &out.CanaryWeight
+		*out = new(int32)
+		**out = **in
+	}
+	if in.CanaryHost != nil {
+		in, out := &in.CanaryHost, &out.CanaryHost
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Marin3rSidecarSpec.
+func (in *Marin3rSidecarSpec) DeepCopy() *Marin3rSidecarSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(Marin3rSidecarSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MonitoredShard) DeepCopyInto(out *MonitoredShard) {
+	*out = *in
+	if in.Servers != nil {
+		in, out := &in.Servers, &out.Servers
+		*out = make(map[string]RedisServerDetails, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MonitoredShard.
+func (in *MonitoredShard) DeepCopy() *MonitoredShard {
+	if in == nil {
+		return nil
+	}
+	out := new(MonitoredShard)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in MonitoredShards) DeepCopyInto(out *MonitoredShards) {
+	{
+		in := &in
+		*out = make(MonitoredShards, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MonitoredShards.
+func (in MonitoredShards) DeepCopy() MonitoredShards {
+	if in == nil {
+		return nil
+	}
+	out := new(MonitoredShards)
+	in.DeepCopyInto(out)
+	return *out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkLoadBalancerSpec) DeepCopyInto(out *NetworkLoadBalancerSpec) {
+	*out = *in
+	if in.ProxyProtocol != nil {
+		in, out := &in.ProxyProtocol, &out.ProxyProtocol
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CrossZoneLoadBalancingEnabled != nil {
+		in, out := &in.CrossZoneLoadBalancingEnabled, &out.CrossZoneLoadBalancingEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EIPAllocations != nil {
+		in, out := &in.EIPAllocations, &out.EIPAllocations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.LoadBalancerName != nil {
+		in, out := &in.LoadBalancerName, &out.LoadBalancerName
+		*out = new(string)
+		**out = **in
+	}
+	if in.DeletionProtection != nil {
+		in, out := &in.DeletionProtection, &out.DeletionProtection
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkLoadBalancerSpec.
+func (in *NetworkLoadBalancerSpec) DeepCopy() *NetworkLoadBalancerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkLoadBalancerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodDisruptionBudgetSpec) DeepCopyInto(out *PodDisruptionBudgetSpec) {
+	*out = *in
+	if in.MinAvailable != nil {
+		in, out := &in.MinAvailable, &out.MinAvailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodDisruptionBudgetSpec.
+func (in *PodDisruptionBudgetSpec) DeepCopy() *PodDisruptionBudgetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PodDisruptionBudgetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodTemplateOverrides) DeepCopyInto(out *PodTemplateOverrides) {
+	*out = *in
+	if in.TopologySpreadConstraints != nil {
+		in, out := &in.TopologySpreadConstraints, &out.TopologySpreadConstraints
+		*out = make([]v1.TopologySpreadConstraint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PriorityClassName != nil {
+		in, out := &in.PriorityClassName, &out.PriorityClassName
+		*out = new(string)
+		**out = **in
+	}
+	if in.SecurityContext != nil {
+		in, out := &in.SecurityContext, &out.SecurityContext
+		*out = new(v1.PodSecurityContext)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServiceAccountName != nil {
+		in, out := &in.ServiceAccountName, &out.ServiceAccountName
+		*out = new(string)
+		**out = **in
+	}
+	if in.ExtraVolumes != nil {
+		in, out := &in.ExtraVolumes, &out.ExtraVolumes
+		*out = make([]SchemalessVolume, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ExtraVolumeMounts != nil {
+		in, out := &in.ExtraVolumeMounts, &out.ExtraVolumeMounts
+		*out = make([]v1.VolumeMount, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ExtraEnv != nil {
+		in, out := &in.ExtraEnv, &out.ExtraEnv
+		*out = make([]v1.EnvVar, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PodAnnotations != nil {
+		in, out := &in.PodAnnotations, &out.PodAnnotations
+		*out = make(map[string]string, len(*in))
 		for key, val := range *in {
-			(*out)[key] = *val.DeepCopy()
+			(*out)[key] = val
+		}
+	}
+	if in.InitContainers != nil {
+		in, out := &in.InitContainers, &out.InitContainers
+		*out = make([]SchemalessContainer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Sidecars != nil {
+		in, out := &in.Sidecars, &out.Sidecars
+		*out = make([]SchemalessContainer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MapOfEnvoyDynamicConfig.
-func (in MapOfEnvoyDynamicConfig) DeepCopy() MapOfEnvoyDynamicConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodTemplateOverrides.
+func (in *PodTemplateOverrides) DeepCopy() *PodTemplateOverrides {
 	if in == nil {
 		return nil
 	}
-	out := new(MapOfEnvoyDynamicConfig)
+	out := new(PodTemplateOverrides)
 	in.DeepCopyInto(out)
-	return *out
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MappingService) DeepCopyInto(out *MappingService) {
+func (in *ProbeSpec) DeepCopyInto(out *ProbeSpec) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	if in.InitialDelaySeconds != nil {
+		in, out := &in.InitialDelaySeconds, &out.InitialDelaySeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TimeoutSeconds != nil {
+		in, out := &in.TimeoutSeconds, &out.TimeoutSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.PeriodSeconds != nil {
+		in, out := &in.PeriodSeconds, &out.PeriodSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.SuccessThreshold != nil {
+		in, out := &in.SuccessThreshold, &out.SuccessThreshold
+		*out = new(int32)
+		**out = **in
+	}
+	if in.FailureThreshold != nil {
+		in, out := &in.FailureThreshold, &out.FailureThreshold
+		*out = new(int32)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MappingService.
-func (in *MappingService) DeepCopy() *MappingService {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProbeSpec.
+func (in *ProbeSpec) DeepCopy() *ProbeSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(MappingService)
+	out := new(ProbeSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *MappingService) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MappingServiceConfig) DeepCopyInto(out *MappingServiceConfig) {
+func (in *ProgressiveDeliverySpec) DeepCopyInto(out *ProgressiveDeliverySpec) {
 	*out = *in
-	if in.PreviewBaseDomain != nil {
-		in, out := &in.PreviewBaseDomain, &out.PreviewBaseDomain
-		*out = new(string)
+	if in.TrafficMode != nil {
+		in, out := &in.TrafficMode, &out.TrafficMode
+		*out = new(ProgressiveTrafficMode)
 		**out = **in
 	}
-	if in.LogLevel != nil {
-		in, out := &in.LogLevel, &out.LogLevel
-		*out = new(string)
+	if in.Steps != nil {
+		in, out := &in.Steps, &out.Steps
+		*out = make([]CanaryStep, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Analysis != nil {
+		in, out := &in.Analysis, &out.Analysis
+		*out = new(CanaryAnalysisSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AutoPromote != nil {
+		in, out := &in.AutoPromote, &out.AutoPromote
+		*out = new(bool)
 		**out = **in
 	}
-	in.ExternalSecret.DeepCopyInto(&out.ExternalSecret)
-	in.SystemAdminToken.DeepCopyInto(&out.SystemAdminToken)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MappingServiceConfig.
-func (in *MappingServiceConfig) DeepCopy() *MappingServiceConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProgressiveDeliverySpec.
+func (in *ProgressiveDeliverySpec) DeepCopy() *ProgressiveDeliverySpec {
 	if in == nil {
 		return nil
 	}
-	out := new(MappingServiceConfig)
+	out := new(ProgressiveDeliverySpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MappingServiceList) DeepCopyInto(out *MappingServiceList) {
+func (in *PublishingStrategies) DeepCopyInto(out *PublishingStrategies) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]MappingService, len(*in))
+	if in.Mode != nil {
+		in, out := &in.Mode, &out.Mode
+		*out = new(PublishingStrategiesReconcileMode)
+		**out = **in
+	}
+	if in.Endpoints != nil {
+		in, out := &in.Endpoints, &out.Endpoints
+		*out = make([]PublishingStrategy, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MappingServiceList.
-func (in *MappingServiceList) DeepCopy() *MappingServiceList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublishingStrategies.
+func (in *PublishingStrategies) DeepCopy() *PublishingStrategies {
 	if in == nil {
 		return nil
 	}
-	out := new(MappingServiceList)
+	out := new(PublishingStrategies)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *MappingServiceList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PublishingStrategy) DeepCopyInto(out *PublishingStrategy) {
+	*out = *in
+	if in.Simple != nil {
+		in, out := &in.Simple, &out.Simple
+		*out = new(Simple)
+		(*in).DeepCopyInto(*out)
 	}
-	return nil
+	if in.Marin3rSidecar != nil {
+		in, out := &in.Marin3rSidecar, &out.Marin3rSidecar
+		*out = new(Marin3rSidecarSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Route != nil {
+		in, out := &in.Route, &out.Route
+		*out = new(RouteStrategySpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Create != nil {
+		in, out := &in.Create, &out.Create
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublishingStrategy.
+func (in *PublishingStrategy) DeepCopy() *PublishingStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(PublishingStrategy)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MappingServiceSpec) DeepCopyInto(out *MappingServiceSpec) {
+func (in *QueSpec) DeepCopyInto(out *QueSpec) {
 	*out = *in
-	if in.Image != nil {
-		in, out := &in.Image, &out.Image
-		*out = new(ImageSpec)
-		(*in).DeepCopyInto(*out)
-	}
 	if in.PDB != nil {
 		in, out := &in.PDB, &out.PDB
 		*out = new(PodDisruptionBudgetSpec)
@@ -1814,6 +3648,11 @@ func (in *MappingServiceSpec) DeepCopyInto(out *MappingServiceSpec) {
 		*out = new(HorizontalPodAutoscalerSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.VPA != nil {
+		in, out := &in.VPA, &out.VPA
+		*out = new(VerticalPodAutoscalerSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Replicas != nil {
 		in, out := &in.Replicas, &out.Replicas
 		*out = new(int32)
@@ -1834,12 +3673,6 @@ func (in *MappingServiceSpec) DeepCopyInto(out *MappingServiceSpec) {
 		*out = new(ProbeSpec)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.GrafanaDashboard != nil {
-		in, out := &in.GrafanaDashboard, &out.GrafanaDashboard
-		*out = new(GrafanaDashboardSpec)
-		(*in).DeepCopyInto(*out)
-	}
-	in.Config.DeepCopyInto(&out.Config)
 	if in.NodeAffinity != nil {
 		in, out := &in.NodeAffinity, &out.NodeAffinity
 		*out = new(v1.NodeAffinity)
@@ -1852,761 +3685,1152 @@ func (in *MappingServiceSpec) DeepCopyInto(out *MappingServiceSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.PublishingStrategies != nil {
-		in, out := &in.PublishingStrategies, &out.PublishingStrategies
-		*out = new(PublishingStrategies)
-		(*in).DeepCopyInto(*out)
+	in.PodTemplateOverrides.DeepCopyInto(&out.PodTemplateOverrides)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueSpec.
+func (in *QueSpec) DeepCopy() *QueSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(QueSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RateLimit) DeepCopyInto(out *RateLimit) {
+	*out = *in
+	if in.Actions != nil {
+		in, out := &in.Actions, &out.Actions
+		*out = make([]RateLimitAction, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimit.
+func (in *RateLimit) DeepCopy() *RateLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(RateLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RateLimitAction) DeepCopyInto(out *RateLimitAction) {
+	*out = *in
+	if in.RemoteAddress != nil {
+		in, out := &in.RemoteAddress, &out.RemoteAddress
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RequestHeader != nil {
+		in, out := &in.RequestHeader, &out.RequestHeader
+		*out = new(RateLimitRequestHeader)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GenericKey != nil {
+		in, out := &in.GenericKey, &out.GenericKey
+		*out = new(RateLimitGenericKey)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HeaderValueMatch != nil {
+		in, out := &in.HeaderValueMatch, &out.HeaderValueMatch
+		*out = new(RateLimitHeaderValueMatch)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitAction.
+func (in *RateLimitAction) DeepCopy() *RateLimitAction {
+	if in == nil {
+		return nil
+	}
+	out := new(RateLimitAction)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RateLimitGenericKey) DeepCopyInto(out *RateLimitGenericKey) {
+	*out = *in
+	if in.DescriptorKey != nil {
+		in, out := &in.DescriptorKey, &out.DescriptorKey
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitGenericKey.
+func (in *RateLimitGenericKey) DeepCopy() *RateLimitGenericKey {
+	if in == nil {
+		return nil
+	}
+	out := new(RateLimitGenericKey)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RateLimitHeaderValueMatch) DeepCopyInto(out *RateLimitHeaderValueMatch) {
+	*out = *in
+	if in.ExpectMatch != nil {
+		in, out := &in.ExpectMatch, &out.ExpectMatch
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make([]HeaderMatcher, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitHeaderValueMatch.
+func (in *RateLimitHeaderValueMatch) DeepCopy() *RateLimitHeaderValueMatch {
+	if in == nil {
+		return nil
 	}
+	out := new(RateLimitHeaderValueMatch)
+	in.DeepCopyInto(out)
+	return out
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MappingServiceSpec.
-func (in *MappingServiceSpec) DeepCopy() *MappingServiceSpec {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RateLimitOptions) DeepCopyInto(out *RateLimitOptions) {
+	*out = *in
+	if in.FailureModeDeny != nil {
+		in, out := &in.FailureModeDeny, &out.FailureModeDeny
+		*out = new(bool)
+		**out = **in
+	}
+	out.Timeout = in.Timeout
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitOptions.
+func (in *RateLimitOptions) DeepCopy() *RateLimitOptions {
 	if in == nil {
 		return nil
 	}
-	out := new(MappingServiceSpec)
+	out := new(RateLimitOptions)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MappingServiceStatus) DeepCopyInto(out *MappingServiceStatus) {
+func (in *RateLimitRequestHeader) DeepCopyInto(out *RateLimitRequestHeader) {
 	*out = *in
+	if in.SkipIfAbsent != nil {
+		in, out := &in.SkipIfAbsent, &out.SkipIfAbsent
+		*out = new(bool)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MappingServiceStatus.
-func (in *MappingServiceStatus) DeepCopy() *MappingServiceStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitRequestHeader.
+func (in *RateLimitRequestHeader) DeepCopy() *RateLimitRequestHeader {
 	if in == nil {
 		return nil
 	}
-	out := new(MappingServiceStatus)
+	out := new(RateLimitRequestHeader)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Marin3rSidecarSpec) DeepCopyInto(out *Marin3rSidecarSpec) {
+func (in *RawConfig) DeepCopyInto(out *RawConfig) {
 	*out = *in
-	if in.Simple != nil {
-		in, out := &in.Simple, &out.Simple
-		*out = new(Simple)
-		(*in).DeepCopyInto(*out)
+	in.Value.DeepCopyInto(&out.Value)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RawConfig.
+func (in *RawConfig) DeepCopy() *RawConfig {
+	if in == nil {
+		return nil
 	}
-	if in.NodeID != nil {
-		in, out := &in.NodeID, &out.NodeID
+	out := new(RawConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RedHatCustomerPortalSpec) DeepCopyInto(out *RedHatCustomerPortalSpec) {
+	*out = *in
+	in.ClientID.DeepCopyInto(&out.ClientID)
+	in.ClientSecret.DeepCopyInto(&out.ClientSecret)
+	if in.Realm != nil {
+		in, out := &in.Realm, &out.Realm
 		*out = new(string)
 		**out = **in
 	}
-	if in.EnvoyAPIVersion != nil {
-		in, out := &in.EnvoyAPIVersion, &out.EnvoyAPIVersion
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedHatCustomerPortalSpec.
+func (in *RedHatCustomerPortalSpec) DeepCopy() *RedHatCustomerPortalSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RedHatCustomerPortalSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RedirectAction) DeepCopyInto(out *RedirectAction) {
+	*out = *in
+	if in.HttpsRedirect != nil {
+		in, out := &in.HttpsRedirect, &out.HttpsRedirect
+		*out = new(bool)
+		**out = **in
+	}
+	if in.HostRedirect != nil {
+		in, out := &in.HostRedirect, &out.HostRedirect
 		*out = new(string)
 		**out = **in
 	}
-	if in.EnvoyImage != nil {
-		in, out := &in.EnvoyImage, &out.EnvoyImage
+	if in.PathRedirect != nil {
+		in, out := &in.PathRedirect, &out.PathRedirect
 		*out = new(string)
 		**out = **in
 	}
-	if in.Ports != nil {
-		in, out := &in.Ports, &out.Ports
-		*out = make([]SidecarPort, len(*in))
-		copy(*out, *in)
+	if in.PrefixRewrite != nil {
+		in, out := &in.PrefixRewrite, &out.PrefixRewrite
+		*out = new(string)
+		**out = **in
 	}
-	if in.Resources != nil {
-		in, out := &in.Resources, &out.Resources
-		*out = new(ResourceRequirementsSpec)
-		(*in).DeepCopyInto(*out)
+	if in.ResponseCode != nil {
+		in, out := &in.ResponseCode, &out.ResponseCode
+		*out = new(RedirectResponseCode)
+		**out = **in
 	}
-	if in.ShutdownManagerPort != nil {
-		in, out := &in.ShutdownManagerPort, &out.ShutdownManagerPort
-		*out = new(uint32)
+	if in.StripQuery != nil {
+		in, out := &in.StripQuery, &out.StripQuery
+		*out = new(bool)
 		**out = **in
 	}
-	if in.ShutdownManagerExtraLifecycleHooks != nil {
-		in, out := &in.ShutdownManagerExtraLifecycleHooks, &out.ShutdownManagerExtraLifecycleHooks
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedirectAction.
+func (in *RedirectAction) DeepCopy() *RedirectAction {
+	if in == nil {
+		return nil
 	}
-	if in.ExtraPodAnnotations != nil {
-		in, out := &in.ExtraPodAnnotations, &out.ExtraPodAnnotations
+	out := new(RedirectAction)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RedisServerDetails) DeepCopyInto(out *RedisServerDetails) {
+	*out = *in
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
 		*out = make(map[string]string, len(*in))
 		for key, val := range *in {
 			(*out)[key] = val
 		}
 	}
-	if in.EnvoyDynamicConfig != nil {
-		in, out := &in.EnvoyDynamicConfig, &out.EnvoyDynamicConfig
-		*out = make(MapOfEnvoyDynamicConfig, len(*in))
+	if in.Info != nil {
+		in, out := &in.Info, &out.Info
+		*out = make(map[string]string, len(*in))
 		for key, val := range *in {
-			(*out)[key] = *val.DeepCopy()
+			(*out)[key] = val
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Marin3rSidecarSpec.
-func (in *Marin3rSidecarSpec) DeepCopy() *Marin3rSidecarSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisServerDetails.
+func (in *RedisServerDetails) DeepCopy() *RedisServerDetails {
 	if in == nil {
 		return nil
 	}
-	out := new(Marin3rSidecarSpec)
+	out := new(RedisServerDetails)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MonitoredShard) DeepCopyInto(out *MonitoredShard) {
+func (in *RedisShard) DeepCopyInto(out *RedisShard) {
 	*out = *in
-	if in.Servers != nil {
-		in, out := &in.Servers, &out.Servers
-		*out = make(map[string]RedisServerDetails, len(*in))
-		for key, val := range *in {
-			(*out)[key] = *val.DeepCopy()
-		}
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MonitoredShard.
-func (in *MonitoredShard) DeepCopy() *MonitoredShard {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisShard.
+func (in *RedisShard) DeepCopy() *RedisShard {
 	if in == nil {
 		return nil
 	}
-	out := new(MonitoredShard)
+	out := new(RedisShard)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RedisShard) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in MonitoredShards) DeepCopyInto(out *MonitoredShards) {
-	{
-		in := &in
-		*out = make(MonitoredShards, len(*in))
+func (in *RedisShardList) DeepCopyInto(out *RedisShardList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RedisShard, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MonitoredShards.
-func (in MonitoredShards) DeepCopy() MonitoredShards {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisShardList.
+func (in *RedisShardList) DeepCopy() *RedisShardList {
 	if in == nil {
 		return nil
 	}
-	out := new(MonitoredShards)
+	out := new(RedisShardList)
 	in.DeepCopyInto(out)
-	return *out
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RedisShardList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkLoadBalancerSpec) DeepCopyInto(out *NetworkLoadBalancerSpec) {
+func (in *RedisShardNodes) DeepCopyInto(out *RedisShardNodes) {
 	*out = *in
-	if in.ProxyProtocol != nil {
-		in, out := &in.ProxyProtocol, &out.ProxyProtocol
-		*out = new(bool)
-		**out = **in
+	if in.Master != nil {
+		in, out := &in.Master, &out.Master
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
 	}
-	if in.CrossZoneLoadBalancingEnabled != nil {
-		in, out := &in.CrossZoneLoadBalancingEnabled, &out.CrossZoneLoadBalancingEnabled
-		*out = new(bool)
+	if in.Slaves != nil {
+		in, out := &in.Slaves, &out.Slaves
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisShardNodes.
+func (in *RedisShardNodes) DeepCopy() *RedisShardNodes {
+	if in == nil {
+		return nil
+	}
+	out := new(RedisShardNodes)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RedisShardSpec) DeepCopyInto(out *RedisShardSpec) {
+	*out = *in
+	if in.Image != nil {
+		in, out := &in.Image, &out.Image
+		*out = new(ImageSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MasterIndex != nil {
+		in, out := &in.MasterIndex, &out.MasterIndex
+		*out = new(int32)
 		**out = **in
 	}
-	if in.EIPAllocations != nil {
-		in, out := &in.EIPAllocations, &out.EIPAllocations
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	if in.SlaveCount != nil {
+		in, out := &in.SlaveCount, &out.SlaveCount
+		*out = new(int32)
+		**out = **in
 	}
-	if in.LoadBalancerName != nil {
-		in, out := &in.LoadBalancerName, &out.LoadBalancerName
+	if in.Command != nil {
+		in, out := &in.Command, &out.Command
 		*out = new(string)
 		**out = **in
 	}
-	if in.DeletionProtection != nil {
-		in, out := &in.DeletionProtection, &out.DeletionProtection
+	if in.AnnounceHostnames != nil {
+		in, out := &in.AnnounceHostnames, &out.AnnounceHostnames
 		*out = new(bool)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkLoadBalancerSpec.
-func (in *NetworkLoadBalancerSpec) DeepCopy() *NetworkLoadBalancerSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisShardSpec.
+func (in *RedisShardSpec) DeepCopy() *RedisShardSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RedisShardSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RedisShardStatus) DeepCopyInto(out *RedisShardStatus) {
+	*out = *in
+	if in.ShardNodes != nil {
+		in, out := &in.ShardNodes, &out.ShardNodes
+		*out = new(RedisShardNodes)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisShardStatus.
+func (in *RedisShardStatus) DeepCopy() *RedisShardStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkLoadBalancerSpec)
+	out := new(RedisShardStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PodDisruptionBudgetSpec) DeepCopyInto(out *PodDisruptionBudgetSpec) {
+func (in *RedisSpec) DeepCopyInto(out *RedisSpec) {
 	*out = *in
-	if in.MinAvailable != nil {
-		in, out := &in.MinAvailable, &out.MinAvailable
-		*out = new(intstr.IntOrString)
-		**out = **in
-	}
-	if in.MaxUnavailable != nil {
-		in, out := &in.MaxUnavailable, &out.MaxUnavailable
-		*out = new(intstr.IntOrString)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodDisruptionBudgetSpec.
-func (in *PodDisruptionBudgetSpec) DeepCopy() *PodDisruptionBudgetSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisSpec.
+func (in *RedisSpec) DeepCopy() *RedisSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(PodDisruptionBudgetSpec)
+	out := new(RedisSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProbeSpec) DeepCopyInto(out *ProbeSpec) {
+func (in *RegexRewrite) DeepCopyInto(out *RegexRewrite) {
 	*out = *in
-	if in.InitialDelaySeconds != nil {
-		in, out := &in.InitialDelaySeconds, &out.InitialDelaySeconds
-		*out = new(int32)
-		**out = **in
-	}
-	if in.TimeoutSeconds != nil {
-		in, out := &in.TimeoutSeconds, &out.TimeoutSeconds
-		*out = new(int32)
-		**out = **in
-	}
-	if in.PeriodSeconds != nil {
-		in, out := &in.PeriodSeconds, &out.PeriodSeconds
-		*out = new(int32)
-		**out = **in
-	}
-	if in.SuccessThreshold != nil {
-		in, out := &in.SuccessThreshold, &out.SuccessThreshold
-		*out = new(int32)
-		**out = **in
-	}
-	if in.FailureThreshold != nil {
-		in, out := &in.FailureThreshold, &out.FailureThreshold
-		*out = new(int32)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProbeSpec.
-func (in *ProbeSpec) DeepCopy() *ProbeSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegexRewrite.
+func (in *RegexRewrite) DeepCopy() *RegexRewrite {
 	if in == nil {
 		return nil
 	}
-	out := new(ProbeSpec)
+	out := new(RegexRewrite)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PublishingStrategies) DeepCopyInto(out *PublishingStrategies) {
+func (in *ResourceRecommendation) DeepCopyInto(out *ResourceRecommendation) {
 	*out = *in
-	if in.Mode != nil {
-		in, out := &in.Mode, &out.Mode
-		*out = new(PublishingStrategiesReconcileMode)
-		**out = **in
+	if in.Requests != nil {
+		in, out := &in.Requests, &out.Requests
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
 	}
-	if in.Endpoints != nil {
-		in, out := &in.Endpoints, &out.Endpoints
-		*out = make([]PublishingStrategy, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
+	if in.Target != nil {
+		in, out := &in.Target, &out.Target
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.LowerBound != nil {
+		in, out := &in.LowerBound, &out.LowerBound
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.UpperBound != nil {
+		in, out := &in.UpperBound, &out.UpperBound
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublishingStrategies.
-func (in *PublishingStrategies) DeepCopy() *PublishingStrategies {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceRecommendation.
+func (in *ResourceRecommendation) DeepCopy() *ResourceRecommendation {
 	if in == nil {
 		return nil
 	}
-	out := new(PublishingStrategies)
+	out := new(ResourceRecommendation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PublishingStrategy) DeepCopyInto(out *PublishingStrategy) {
-	*out = *in
-	if in.Simple != nil {
-		in, out := &in.Simple, &out.Simple
-		*out = new(Simple)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Marin3rSidecar != nil {
-		in, out := &in.Marin3rSidecar, &out.Marin3rSidecar
-		*out = new(Marin3rSidecarSpec)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Create != nil {
-		in, out := &in.Create, &out.Create
-		*out = new(bool)
-		**out = **in
+func (in ResourceRecommendations) DeepCopyInto(out *ResourceRecommendations) {
+	{
+		in := &in
+		*out = make(ResourceRecommendations, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublishingStrategy.
-func (in *PublishingStrategy) DeepCopy() *PublishingStrategy {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceRecommendations.
+func (in ResourceRecommendations) DeepCopy() ResourceRecommendations {
 	if in == nil {
 		return nil
 	}
-	out := new(PublishingStrategy)
+	out := new(ResourceRecommendations)
 	in.DeepCopyInto(out)
-	return out
+	return *out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *QueSpec) DeepCopyInto(out *QueSpec) {
+func (in *ResourceRequirementsSpec) DeepCopyInto(out *ResourceRequirementsSpec) {
 	*out = *in
-	if in.PDB != nil {
-		in, out := &in.PDB, &out.PDB
-		*out = new(PodDisruptionBudgetSpec)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.HPA != nil {
-		in, out := &in.HPA, &out.HPA
-		*out = new(HorizontalPodAutoscalerSpec)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Replicas != nil {
-		in, out := &in.Replicas, &out.Replicas
-		*out = new(int32)
-		**out = **in
-	}
-	if in.Resources != nil {
-		in, out := &in.Resources, &out.Resources
-		*out = new(ResourceRequirementsSpec)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.LivenessProbe != nil {
-		in, out := &in.LivenessProbe, &out.LivenessProbe
-		*out = new(ProbeSpec)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.ReadinessProbe != nil {
-		in, out := &in.ReadinessProbe, &out.ReadinessProbe
-		*out = new(ProbeSpec)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.NodeAffinity != nil {
-		in, out := &in.NodeAffinity, &out.NodeAffinity
-		*out = new(v1.NodeAffinity)
-		(*in).DeepCopyInto(*out)
+	if in.Limits != nil {
+		in, out := &in.Limits, &out.Limits
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
 	}
-	if in.Tolerations != nil {
-		in, out := &in.Tolerations, &out.Tolerations
-		*out = make([]v1.Toleration, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
+	if in.Requests != nil {
+		in, out := &in.Requests, &out.Requests
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
 		}
 	}
+	if in.Claims != nil {
+		in, out := &in.Claims, &out.Claims
+		*out = make([]v1.ResourceClaim, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueSpec.
-func (in *QueSpec) DeepCopy() *QueSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceRequirementsSpec.
+func (in *ResourceRequirementsSpec) DeepCopy() *ResourceRequirementsSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(QueSpec)
+	out := new(ResourceRequirementsSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RateLimitOptions) DeepCopyInto(out *RateLimitOptions) {
+func (in *RetryPolicy) DeepCopyInto(out *RetryPolicy) {
 	*out = *in
-	if in.FailureModeDeny != nil {
-		in, out := &in.FailureModeDeny, &out.FailureModeDeny
-		*out = new(bool)
+	if in.NumRetries != nil {
+		in, out := &in.NumRetries, &out.NumRetries
+		*out = new(uint32)
 		**out = **in
 	}
-	out.Timeout = in.Timeout
+	if in.PerTryTimeout != nil {
+		in, out := &in.PerTryTimeout, &out.PerTryTimeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.RetriableStatusCodes != nil {
+		in, out := &in.RetriableStatusCodes, &out.RetriableStatusCodes
+		*out = make([]uint32, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitOptions.
-func (in *RateLimitOptions) DeepCopy() *RateLimitOptions {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetryPolicy.
+func (in *RetryPolicy) DeepCopy() *RetryPolicy {
 	if in == nil {
 		return nil
 	}
-	out := new(RateLimitOptions)
+	out := new(RetryPolicy)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RawConfig) DeepCopyInto(out *RawConfig) {
+func (in *Route) DeepCopyInto(out *Route) {
 	*out = *in
-	in.Value.DeepCopyInto(&out.Value)
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	in.Match.DeepCopyInto(&out.Match)
+	if in.Route != nil {
+		in, out := &in.Route, &out.Route
+		*out = new(RouteAction)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Redirect != nil {
+		in, out := &in.Redirect, &out.Redirect
+		*out = new(RedirectAction)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DirectResponse != nil {
+		in, out := &in.DirectResponse, &out.DirectResponse
+		*out = new(DirectResponseAction)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LocalRateLimit != nil {
+		in, out := &in.LocalRateLimit, &out.LocalRateLimit
+		*out = new(LocalRateLimit)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DisableExtAuthz != nil {
+		in, out := &in.DisableExtAuthz, &out.DisableExtAuthz
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DisableJwtAuthn != nil {
+		in, out := &in.DisableJwtAuthn, &out.DisableJwtAuthn
+		*out = new(bool)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RawConfig.
-func (in *RawConfig) DeepCopy() *RawConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Route.
+func (in *Route) DeepCopy() *Route {
 	if in == nil {
 		return nil
 	}
-	out := new(RawConfig)
+	out := new(Route)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RedHatCustomerPortalSpec) DeepCopyInto(out *RedHatCustomerPortalSpec) {
+func (in *RouteAction) DeepCopyInto(out *RouteAction) {
 	*out = *in
-	in.ClientID.DeepCopyInto(&out.ClientID)
-	in.ClientSecret.DeepCopyInto(&out.ClientSecret)
-	if in.Realm != nil {
-		in, out := &in.Realm, &out.Realm
+	if in.Cluster != nil {
+		in, out := &in.Cluster, &out.Cluster
+		*out = new(string)
+		**out = **in
+	}
+	if in.WeightedClusters != nil {
+		in, out := &in.WeightedClusters, &out.WeightedClusters
+		*out = make([]WeightedCluster, len(*in))
+		copy(*out, *in)
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.IdleTimeout != nil {
+		in, out := &in.IdleTimeout, &out.IdleTimeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.PrefixRewrite != nil {
+		in, out := &in.PrefixRewrite, &out.PrefixRewrite
+		*out = new(string)
+		**out = **in
+	}
+	if in.RegexRewrite != nil {
+		in, out := &in.RegexRewrite, &out.RegexRewrite
+		*out = new(RegexRewrite)
+		**out = **in
+	}
+	if in.HostRewrite != nil {
+		in, out := &in.HostRewrite, &out.HostRewrite
 		*out = new(string)
 		**out = **in
 	}
+	if in.RetryPolicy != nil {
+		in, out := &in.RetryPolicy, &out.RetryPolicy
+		*out = new(RetryPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RateLimits != nil {
+		in, out := &in.RateLimits, &out.RateLimits
+		*out = make([]RateLimit, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedHatCustomerPortalSpec.
-func (in *RedHatCustomerPortalSpec) DeepCopy() *RedHatCustomerPortalSpec {
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteAction.
+func (in *RouteAction) DeepCopy() *RouteAction {
 	if in == nil {
 		return nil
 	}
-	out := new(RedHatCustomerPortalSpec)
+	out := new(RouteAction)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RedisServerDetails) DeepCopyInto(out *RedisServerDetails) {
+func (in *RouteBackend) DeepCopyInto(out *RouteBackend) {
 	*out = *in
-	if in.Config != nil {
-		in, out := &in.Config, &out.Config
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
-	}
-	if in.Info != nil {
-		in, out := &in.Info, &out.Info
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
+	if in.Weight != nil {
+		in, out := &in.Weight, &out.Weight
+		*out = new(int32)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisServerDetails.
-func (in *RedisServerDetails) DeepCopy() *RedisServerDetails {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteBackend.
+func (in *RouteBackend) DeepCopy() *RouteBackend {
 	if in == nil {
 		return nil
 	}
-	out := new(RedisServerDetails)
+	out := new(RouteBackend)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RedisShard) DeepCopyInto(out *RedisShard) {
+func (in *RouteConfiguration) DeepCopyInto(out *RouteConfiguration) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	if in.VirtualHosts != nil {
+		in, out := &in.VirtualHosts, &out.VirtualHosts
+		*out = make([]runtime.RawExtension, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TypedVirtualHosts != nil {
+		in, out := &in.TypedVirtualHosts, &out.TypedVirtualHosts
+		*out = make([]VirtualHost, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisShard.
-func (in *RedisShard) DeepCopy() *RedisShard {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteConfiguration.
+func (in *RouteConfiguration) DeepCopy() *RouteConfiguration {
 	if in == nil {
 		return nil
 	}
-	out := new(RedisShard)
+	out := new(RouteConfiguration)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *RedisShard) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RedisShardList) DeepCopyInto(out *RedisShardList) {
+func (in *RouteMatch) DeepCopyInto(out *RouteMatch) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]RedisShard, len(*in))
+	if in.Prefix != nil {
+		in, out := &in.Prefix, &out.Prefix
+		*out = new(string)
+		**out = **in
+	}
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = new(string)
+		**out = **in
+	}
+	if in.Regex != nil {
+		in, out := &in.Regex, &out.Regex
+		*out = new(string)
+		**out = **in
+	}
+	if in.CaseSensitive != nil {
+		in, out := &in.CaseSensitive, &out.CaseSensitive
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make([]HeaderMatcher, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisShardList.
-func (in *RedisShardList) DeepCopy() *RedisShardList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteMatch.
+func (in *RouteMatch) DeepCopy() *RouteMatch {
 	if in == nil {
 		return nil
 	}
-	out := new(RedisShardList)
+	out := new(RouteMatch)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *RedisShardList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RedisShardNodes) DeepCopyInto(out *RedisShardNodes) {
+func (in *RouteParentRef) DeepCopyInto(out *RouteParentRef) {
 	*out = *in
-	if in.Master != nil {
-		in, out := &in.Master, &out.Master
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
+	if in.Namespace != nil {
+		in, out := &in.Namespace, &out.Namespace
+		*out = new(string)
+		**out = **in
 	}
-	if in.Slaves != nil {
-		in, out := &in.Slaves, &out.Slaves
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
+	if in.SectionName != nil {
+		in, out := &in.SectionName, &out.SectionName
+		*out = new(string)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisShardNodes.
-func (in *RedisShardNodes) DeepCopy() *RedisShardNodes {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteParentRef.
+func (in *RouteParentRef) DeepCopy() *RouteParentRef {
 	if in == nil {
 		return nil
 	}
-	out := new(RedisShardNodes)
+	out := new(RouteParentRef)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RedisShardSpec) DeepCopyInto(out *RedisShardSpec) {
+func (in *RouteStrategySpec) DeepCopyInto(out *RouteStrategySpec) {
 	*out = *in
-	if in.Image != nil {
-		in, out := &in.Image, &out.Image
-		*out = new(ImageSpec)
+	if in.Simple != nil {
+		in, out := &in.Simple, &out.Simple
+		*out = new(Simple)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.MasterIndex != nil {
-		in, out := &in.MasterIndex, &out.MasterIndex
-		*out = new(int32)
+	if in.Kind != nil {
+		in, out := &in.Kind, &out.Kind
+		*out = new(RouteKind)
 		**out = **in
 	}
-	if in.SlaveCount != nil {
-		in, out := &in.SlaveCount, &out.SlaveCount
-		*out = new(int32)
+	if in.ParentRefs != nil {
+		in, out := &in.ParentRefs, &out.ParentRefs
+		*out = make([]RouteParentRef, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.IngressClassName != nil {
+		in, out := &in.IngressClassName, &out.IngressClassName
+		*out = new(string)
 		**out = **in
 	}
-	if in.Command != nil {
-		in, out := &in.Command, &out.Command
+	if in.Hostnames != nil {
+		in, out := &in.Hostnames, &out.Hostnames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TLSSecretName != nil {
+		in, out := &in.TLSSecretName, &out.TLSSecretName
+		*out = new(string)
+		**out = **in
+	}
+	if in.PathPrefix != nil {
+		in, out := &in.PathPrefix, &out.PathPrefix
+		*out = new(string)
+		**out = **in
+	}
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
 		*out = new(string)
 		**out = **in
 	}
+	if in.Weight != nil {
+		in, out := &in.Weight, &out.Weight
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ExtraBackends != nil {
+		in, out := &in.ExtraBackends, &out.ExtraBackends
+		*out = make([]RouteBackend, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.CanaryWeight != nil {
+		in, out := &in.CanaryWeight, &out.CanaryWeight
+		*out = new(int32)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisShardSpec.
-func (in *RedisShardSpec) DeepCopy() *RedisShardSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteStrategySpec.
+func (in *RouteStrategySpec) DeepCopy() *RouteStrategySpec {
 	if in == nil {
 		return nil
 	}
-	out := new(RedisShardSpec)
+	out := new(RouteStrategySpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RedisShardStatus) DeepCopyInto(out *RedisShardStatus) {
+func (in *Runtime) DeepCopyInto(out *Runtime) {
 	*out = *in
-	if in.ShardNodes != nil {
-		in, out := &in.ShardNodes, &out.ShardNodes
-		*out = new(RedisShardNodes)
-		(*in).DeepCopyInto(*out)
+	if in.ListenerNames != nil {
+		in, out := &in.ListenerNames, &out.ListenerNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisShardStatus.
-func (in *RedisShardStatus) DeepCopy() *RedisShardStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Runtime.
+func (in *Runtime) DeepCopy() *Runtime {
 	if in == nil {
 		return nil
 	}
-	out := new(RedisShardStatus)
+	out := new(Runtime)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RedisSpec) DeepCopyInto(out *RedisSpec) {
+func (in *S3Options) DeepCopyInto(out *S3Options) {
 	*out = *in
+	out.CredentialsSecretRef = in.CredentialsSecretRef
+	if in.ServiceEndpoint != nil {
+		in, out := &in.ServiceEndpoint, &out.ServiceEndpoint
+		*out = new(string)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisSpec.
-func (in *RedisSpec) DeepCopy() *RedisSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new S3Options.
+func (in *S3Options) DeepCopy() *S3Options {
 	if in == nil {
 		return nil
 	}
-	out := new(RedisSpec)
+	out := new(S3Options)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ResourceRequirementsSpec) DeepCopyInto(out *ResourceRequirementsSpec) {
+func (in *SMTPSpec) DeepCopyInto(out *SMTPSpec) {
 	*out = *in
-	if in.Limits != nil {
-		in, out := &in.Limits, &out.Limits
-		*out = make(v1.ResourceList, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val.DeepCopy()
-		}
+	in.User.DeepCopyInto(&out.User)
+	in.Password.DeepCopyInto(&out.Password)
+	if in.STARTTLS != nil {
+		in, out := &in.STARTTLS, &out.STARTTLS
+		*out = new(bool)
+		**out = **in
 	}
-	if in.Requests != nil {
-		in, out := &in.Requests, &out.Requests
-		*out = make(v1.ResourceList, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val.DeepCopy()
-		}
+	if in.STARTTLSAuto != nil {
+		in, out := &in.STARTTLSAuto, &out.STARTTLSAuto
+		*out = new(bool)
+		**out = **in
 	}
-	if in.Claims != nil {
-		in, out := &in.Claims, &out.Claims
-		*out = make([]v1.ResourceClaim, len(*in))
-		copy(*out, *in)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SMTPSpec.
+func (in *SMTPSpec) DeepCopy() *SMTPSpec {
+	if in == nil {
+		return nil
 	}
+	out := new(SMTPSpec)
+	in.DeepCopyInto(out)
+	return out
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceRequirementsSpec.
-func (in *ResourceRequirementsSpec) DeepCopy() *ResourceRequirementsSpec {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SSHOptions) DeepCopyInto(out *SSHOptions) {
+	*out = *in
+	out.PrivateKeySecretRef = in.PrivateKeySecretRef
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.Sudo != nil {
+		in, out := &in.Sudo, &out.Sudo
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSHOptions.
+func (in *SSHOptions) DeepCopy() *SSHOptions {
 	if in == nil {
 		return nil
 	}
-	out := new(ResourceRequirementsSpec)
+	out := new(SSHOptions)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RouteConfiguration) DeepCopyInto(out *RouteConfiguration) {
+func (in *ScalingWindow) DeepCopyInto(out *ScalingWindow) {
 	*out = *in
-	if in.VirtualHosts != nil {
-		in, out := &in.VirtualHosts, &out.VirtualHosts
-		*out = make([]runtime.RawExtension, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	out.Duration = in.Duration
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MinReplicas != nil {
+		in, out := &in.MinReplicas, &out.MinReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxReplicas != nil {
+		in, out := &in.MaxReplicas, &out.MaxReplicas
+		*out = new(int32)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteConfiguration.
-func (in *RouteConfiguration) DeepCopy() *RouteConfiguration {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScalingWindow.
+func (in *ScalingWindow) DeepCopy() *ScalingWindow {
 	if in == nil {
 		return nil
 	}
-	out := new(RouteConfiguration)
+	out := new(ScalingWindow)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Runtime) DeepCopyInto(out *Runtime) {
+func (in *ScalingWindowStatus) DeepCopyInto(out *ScalingWindowStatus) {
 	*out = *in
-	if in.ListenerNames != nil {
-		in, out := &in.ListenerNames, &out.ListenerNames
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
+	in.Start.DeepCopyInto(&out.Start)
+	in.End.DeepCopyInto(&out.End)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Runtime.
-func (in *Runtime) DeepCopy() *Runtime {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScalingWindowStatus.
+func (in *ScalingWindowStatus) DeepCopy() *ScalingWindowStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(Runtime)
+	out := new(ScalingWindowStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *S3Options) DeepCopyInto(out *S3Options) {
-	*out = *in
-	out.CredentialsSecretRef = in.CredentialsSecretRef
-	if in.ServiceEndpoint != nil {
-		in, out := &in.ServiceEndpoint, &out.ServiceEndpoint
-		*out = new(string)
-		**out = **in
+func (in ScalingWindowStatuses) DeepCopyInto(out *ScalingWindowStatuses) {
+	{
+		in := &in
+		*out = make(ScalingWindowStatuses, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new S3Options.
-func (in *S3Options) DeepCopy() *S3Options {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScalingWindowStatuses.
+func (in ScalingWindowStatuses) DeepCopy() ScalingWindowStatuses {
 	if in == nil {
 		return nil
 	}
-	out := new(S3Options)
+	out := new(ScalingWindowStatuses)
 	in.DeepCopyInto(out)
-	return out
+	return *out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SMTPSpec) DeepCopyInto(out *SMTPSpec) {
+func (in *SchemalessContainer) DeepCopyInto(out *SchemalessContainer) {
 	*out = *in
-	in.User.DeepCopyInto(&out.User)
-	in.Password.DeepCopyInto(&out.Password)
-	if in.STARTTLS != nil {
-		in, out := &in.STARTTLS, &out.STARTTLS
-		*out = new(bool)
-		**out = **in
+	if in.Command != nil {
+		in, out := &in.Command, &out.Command
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
-	if in.STARTTLSAuto != nil {
-		in, out := &in.STARTTLSAuto, &out.STARTTLSAuto
-		*out = new(bool)
+	if in.Args != nil {
+		in, out := &in.Args, &out.Args
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Ports != nil {
+		in, out := &in.Ports, &out.Ports
+		*out = make([]v1.ContainerPort, len(*in))
+		copy(*out, *in)
+	}
+	if in.EnvFrom != nil {
+		in, out := &in.EnvFrom, &out.EnvFrom
+		*out = make([]v1.EnvFromSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make([]v1.EnvVar, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.Resources.DeepCopyInto(&out.Resources)
+	if in.ResizePolicy != nil {
+		in, out := &in.ResizePolicy, &out.ResizePolicy
+		*out = make([]v1.ContainerResizePolicy, len(*in))
+		copy(*out, *in)
+	}
+	if in.RestartPolicy != nil {
+		in, out := &in.RestartPolicy, &out.RestartPolicy
+		*out = new(v1.ContainerRestartPolicy)
 		**out = **in
 	}
+	if in.VolumeMounts != nil {
+		in, out := &in.VolumeMounts, &out.VolumeMounts
+		*out = make([]v1.VolumeMount, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.VolumeDevices != nil {
+		in, out := &in.VolumeDevices, &out.VolumeDevices
+		*out = make([]v1.VolumeDevice, len(*in))
+		copy(*out, *in)
+	}
+	if in.LivenessProbe != nil {
+		in, out := &in.LivenessProbe, &out.LivenessProbe
+		*out = new(v1.Probe)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ReadinessProbe != nil {
+		in, out := &in.ReadinessProbe, &out.ReadinessProbe
+		*out = new(v1.Probe)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StartupProbe != nil {
+		in, out := &in.StartupProbe, &out.StartupProbe
+		*out = new(v1.Probe)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Lifecycle != nil {
+		in, out := &in.Lifecycle, &out.Lifecycle
+		*out = new(v1.Lifecycle)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecurityContext != nil {
+		in, out := &in.SecurityContext, &out.SecurityContext
+		*out = new(v1.SecurityContext)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SMTPSpec.
-func (in *SMTPSpec) DeepCopy() *SMTPSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchemalessContainer.
+func (in *SchemalessContainer) DeepCopy() *SchemalessContainer {
 	if in == nil {
 		return nil
 	}
-	out := new(SMTPSpec)
+	out := new(SchemalessContainer)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SSHOptions) DeepCopyInto(out *SSHOptions) {
+func (in *SchemalessVolume) DeepCopyInto(out *SchemalessVolume) {
 	*out = *in
-	out.PrivateKeySecretRef = in.PrivateKeySecretRef
-	if in.Port != nil {
-		in, out := &in.Port, &out.Port
-		*out = new(uint32)
-		**out = **in
-	}
-	if in.Sudo != nil {
-		in, out := &in.Sudo, &out.Sudo
-		*out = new(bool)
-		**out = **in
-	}
+	in.VolumeSource.DeepCopyInto(&out.VolumeSource)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSHOptions.
-func (in *SSHOptions) DeepCopy() *SSHOptions {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchemalessVolume.
+func (in *SchemalessVolume) DeepCopy() *SchemalessVolume {
 	if in == nil {
 		return nil
 	}
-	out := new(SSHOptions)
+	out := new(SchemalessVolume)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -2811,6 +5035,11 @@ func (in *SentinelConfig) DeepCopyInto(out *SentinelConfig) {
 		*out = new(timex.Duration)
 		**out = **in
 	}
+	if in.ResolveHostnames != nil {
+		in, out := &in.ResolveHostnames, &out.ResolveHostnames
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SentinelConfig.
@@ -2949,6 +5178,27 @@ func (in *SentinelStatus) DeepCopy() *SentinelStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in ServerPoolTargets) DeepCopyInto(out *ServerPoolTargets) {
+	{
+		in := &in
+		*out = make(ServerPoolTargets, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServerPoolTargets.
+func (in ServerPoolTargets) DeepCopy() ServerPoolTargets {
+	if in == nil {
+		return nil
+	}
+	out := new(ServerPoolTargets)
+	in.DeepCopyInto(out)
+	return *out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShardedRedisBackup) DeepCopyInto(out *ShardedRedisBackup) {
 	*out = *in
@@ -3169,13 +5419,38 @@ func (in *Simple) DeepCopy() *Simple {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StatsSinkOptions) DeepCopyInto(out *StatsSinkOptions) {
+	*out = *in
+	if in.FlushInterval != nil {
+		in, out := &in.FlushInterval, &out.FlushInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.ReportCountersAsDeltas != nil {
+		in, out := &in.ReportCountersAsDeltas, &out.ReportCountersAsDeltas
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StatsSinkOptions.
+func (in *StatsSinkOptions) DeepCopy() *StatsSinkOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(StatsSinkOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *System) DeepCopyInto(out *System) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new System.
@@ -3229,6 +5504,11 @@ func (in *SystemAppSpec) DeepCopyInto(out *SystemAppSpec) {
 		*out = new(HorizontalPodAutoscalerSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.VPA != nil {
+		in, out := &in.VPA, &out.VPA
+		*out = new(VerticalPodAutoscalerSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Replicas != nil {
 		in, out := &in.Replicas, &out.Replicas
 		*out = new(int32)
@@ -3261,6 +5541,7 @@ func (in *SystemAppSpec) DeepCopyInto(out *SystemAppSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	in.PodTemplateOverrides.DeepCopyInto(&out.PodTemplateOverrides)
 	if in.TerminationGracePeriodSeconds != nil {
 		in, out := &in.TerminationGracePeriodSeconds, &out.TerminationGracePeriodSeconds
 		*out = new(int64)
@@ -3271,6 +5552,11 @@ func (in *SystemAppSpec) DeepCopyInto(out *SystemAppSpec) {
 		*out = new(Canary)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.BlueGreen != nil {
+		in, out := &in.BlueGreen, &out.BlueGreen
+		*out = new(BlueGreenSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.PublishingStrategies != nil {
 		in, out := &in.PublishingStrategies, &out.PublishingStrategies
 		*out = new(PublishingStrategies)
@@ -3587,6 +5873,11 @@ func (in *SystemSidekiqSpec) DeepCopyInto(out *SystemSidekiqSpec) {
 		*out = new(HorizontalPodAutoscalerSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.VPA != nil {
+		in, out := &in.VPA, &out.VPA
+		*out = new(VerticalPodAutoscalerSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Replicas != nil {
 		in, out := &in.Replicas, &out.Replicas
 		*out = new(int32)
@@ -3619,6 +5910,7 @@ func (in *SystemSidekiqSpec) DeepCopyInto(out *SystemSidekiqSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	in.PodTemplateOverrides.DeepCopyInto(&out.PodTemplateOverrides)
 	if in.Canary != nil {
 		in, out := &in.Canary, &out.Canary
 		*out = new(Canary)
@@ -3697,6 +5989,11 @@ func (in *SystemSpec) DeepCopyInto(out *SystemSpec) {
 		*out = new(TwemproxySpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Maintenance != nil {
+		in, out := &in.Maintenance, &out.Maintenance
+		*out = new(MaintenanceSpec)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SystemSpec.
@@ -3712,6 +6009,32 @@ func (in *SystemSpec) DeepCopy() *SystemSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SystemStatus) DeepCopyInto(out *SystemStatus) {
 	*out = *in
+	if in.Canaries != nil {
+		in, out := &in.Canaries, &out.Canaries
+		*out = make(CanaryStatuses, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.BlueGreen != nil {
+		in, out := &in.BlueGreen, &out.BlueGreen
+		*out = make(BlueGreenStatuses, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Maintenance != nil {
+		in, out := &in.Maintenance, &out.Maintenance
+		*out = new(MaintenanceStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Recommendations != nil {
+		in, out := &in.Recommendations, &out.Recommendations
+		*out = make(ResourceRecommendations, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SystemStatus.
@@ -3806,55 +6129,210 @@ func (in *SystemTektonTaskSpec) DeepCopyInto(out *SystemTektonTaskSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.TerminationGracePeriodSeconds != nil {
-		in, out := &in.TerminationGracePeriodSeconds, &out.TerminationGracePeriodSeconds
-		*out = new(int64)
-		**out = **in
+	if in.TerminationGracePeriodSeconds != nil {
+		in, out := &in.TerminationGracePeriodSeconds, &out.TerminationGracePeriodSeconds
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SystemTektonTaskSpec.
+func (in *SystemTektonTaskSpec) DeepCopy() *SystemTektonTaskSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SystemTektonTaskSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SystemZyncSpec) DeepCopyInto(out *SystemZyncSpec) {
+	*out = *in
+	in.AuthToken.DeepCopyInto(&out.AuthToken)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SystemZyncSpec.
+func (in *SystemZyncSpec) DeepCopy() *SystemZyncSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SystemZyncSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetServer) DeepCopyInto(out *TargetServer) {
+	*out = *in
+	if in.ServerAlias != nil {
+		in, out := &in.ServerAlias, &out.ServerAlias
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetServer.
+func (in *TargetServer) DeepCopy() *TargetServer {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetServer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TcpAccessLogOptions) DeepCopyInto(out *TcpAccessLogOptions) {
+	*out = *in
+	if in.ExtraFields != nil {
+		in, out := &in.ExtraFields, &out.ExtraFields
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Sampling != nil {
+		in, out := &in.Sampling, &out.Sampling
+		*out = new(AccessLogSampling)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TcpAccessLogOptions.
+func (in *TcpAccessLogOptions) DeepCopy() *TcpAccessLogOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(TcpAccessLogOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TcpFilterChain) DeepCopyInto(out *TcpFilterChain) {
+	*out = *in
+	if in.ServerNames != nil {
+		in, out := &in.ServerNames, &out.ServerNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TcpFilterChain.
+func (in *TcpFilterChain) DeepCopy() *TcpFilterChain {
+	if in == nil {
+		return nil
+	}
+	out := new(TcpFilterChain)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TokenBucket) DeepCopyInto(out *TokenBucket) {
+	*out = *in
+	if in.TokensPerFill != nil {
+		in, out := &in.TokensPerFill, &out.TokensPerFill
+		*out = new(uint32)
+		**out = **in
+	}
+	out.FillInterval = in.FillInterval
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TokenBucket.
+func (in *TokenBucket) DeepCopy() *TokenBucket {
+	if in == nil {
+		return nil
+	}
+	out := new(TokenBucket)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TopologyChange) DeepCopyInto(out *TopologyChange) {
+	*out = *in
+	if in.Changes != nil {
+		in, out := &in.Changes, &out.Changes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SystemTektonTaskSpec.
-func (in *SystemTektonTaskSpec) DeepCopy() *SystemTektonTaskSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TopologyChange.
+func (in *TopologyChange) DeepCopy() *TopologyChange {
 	if in == nil {
 		return nil
 	}
-	out := new(SystemTektonTaskSpec)
+	out := new(TopologyChange)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SystemZyncSpec) DeepCopyInto(out *SystemZyncSpec) {
+func (in *TracingCustomTag) DeepCopyInto(out *TracingCustomTag) {
 	*out = *in
-	in.AuthToken.DeepCopyInto(&out.AuthToken)
+	if in.Literal != nil {
+		in, out := &in.Literal, &out.Literal
+		*out = new(string)
+		**out = **in
+	}
+	if in.RequestHeader != nil {
+		in, out := &in.RequestHeader, &out.RequestHeader
+		*out = new(string)
+		**out = **in
+	}
+	if in.Environment != nil {
+		in, out := &in.Environment, &out.Environment
+		*out = new(string)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SystemZyncSpec.
-func (in *SystemZyncSpec) DeepCopy() *SystemZyncSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TracingCustomTag.
+func (in *TracingCustomTag) DeepCopy() *TracingCustomTag {
 	if in == nil {
 		return nil
 	}
-	out := new(SystemZyncSpec)
+	out := new(TracingCustomTag)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *TargetServer) DeepCopyInto(out *TargetServer) {
+func (in *TracingOptions) DeepCopyInto(out *TracingOptions) {
 	*out = *in
-	if in.ServerAlias != nil {
-		in, out := &in.ServerAlias, &out.ServerAlias
+	if in.ServiceName != nil {
+		in, out := &in.ServiceName, &out.ServiceName
 		*out = new(string)
 		**out = **in
 	}
+	if in.ZipkinCollectorEndpoint != nil {
+		in, out := &in.ZipkinCollectorEndpoint, &out.ZipkinCollectorEndpoint
+		*out = new(string)
+		**out = **in
+	}
+	if in.SamplingPercent != nil {
+		in, out := &in.SamplingPercent, &out.SamplingPercent
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.CustomTags != nil {
+		in, out := &in.CustomTags, &out.CustomTags
+		*out = make([]TracingCustomTag, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetServer.
-func (in *TargetServer) DeepCopy() *TargetServer {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TracingOptions.
+func (in *TracingOptions) DeepCopy() *TracingOptions {
 	if in == nil {
 		return nil
 	}
-	out := new(TargetServer)
+	out := new(TracingOptions)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -3938,6 +6416,11 @@ func (in *TwemproxyConfigSpec) DeepCopyInto(out *TwemproxyConfigSpec) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.MaxReplicationLag != nil {
+		in, out := &in.MaxReplicationLag, &out.MaxReplicationLag
+		*out = new(int)
+		**out = **in
+	}
 	if in.GrafanaDashboard != nil {
 		in, out := &in.GrafanaDashboard, &out.GrafanaDashboard
 		*out = new(GrafanaDashboardSpec)
@@ -3960,11 +6443,43 @@ func (in *TwemproxyConfigStatus) DeepCopyInto(out *TwemproxyConfigStatus) {
 	*out = *in
 	if in.SelectedTargets != nil {
 		in, out := &in.SelectedTargets, &out.SelectedTargets
+		*out = make(map[string]ServerPoolTargets, len(*in))
+		for key, val := range *in {
+			var outVal map[string]TargetServer
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				in, out := &val, &outVal
+				*out = make(ServerPoolTargets, len(*in))
+				for key, val := range *in {
+					(*out)[key] = *val.DeepCopy()
+				}
+			}
+			(*out)[key] = outVal
+		}
+	}
+	if in.Targets != nil {
+		in, out := &in.Targets, &out.Targets
 		*out = make(map[string]TargetServer, len(*in))
 		for key, val := range *in {
 			(*out)[key] = *val.DeepCopy()
 		}
 	}
+	if in.PendingTopologyChange != nil {
+		in, out := &in.PendingTopologyChange, &out.PendingTopologyChange
+		*out = new(TopologyChange)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PendingKeyDistributionChange != nil {
+		in, out := &in.PendingKeyDistributionChange, &out.PendingKeyDistributionChange
+		*out = new(KeyDistributionChange)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ConfigSecretName != nil {
+		in, out := &in.ConfigSecretName, &out.ConfigSecretName
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TwemproxyConfigStatus.
@@ -4020,6 +6535,53 @@ func (in *TwemproxyServerPool) DeepCopyInto(out *TwemproxyServerPool) {
 		*out = new(TargetRedisServers)
 		**out = **in
 	}
+	if in.ShardTargets != nil {
+		in, out := &in.ShardTargets, &out.ShardTargets
+		*out = make(map[string]TargetRedisServers, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Hash != nil {
+		in, out := &in.Hash, &out.Hash
+		*out = new(TwemproxyHash)
+		**out = **in
+	}
+	if in.HashTag != nil {
+		in, out := &in.HashTag, &out.HashTag
+		*out = new(string)
+		**out = **in
+	}
+	if in.Distribution != nil {
+		in, out := &in.Distribution, &out.Distribution
+		*out = new(TwemproxyDistribution)
+		**out = **in
+	}
+	if in.RedisAuth != nil {
+		in, out := &in.RedisAuth, &out.RedisAuth
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServerRetryTimeout != nil {
+		in, out := &in.ServerRetryTimeout, &out.ServerRetryTimeout
+		*out = new(int)
+		**out = **in
+	}
+	if in.ServerFailureLimit != nil {
+		in, out := &in.ServerFailureLimit, &out.ServerFailureLimit
+		*out = new(int)
+		**out = **in
+	}
+	if in.AutoEjectHosts != nil {
+		in, out := &in.AutoEjectHosts, &out.AutoEjectHosts
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ClientConnections != nil {
+		in, out := &in.ClientConnections, &out.ClientConnections
+		*out = new(int)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TwemproxyServerPool.
@@ -4045,6 +6607,11 @@ func (in *TwemproxySpec) DeepCopyInto(out *TwemproxySpec) {
 		*out = new(ResourceRequirementsSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.VPA != nil {
+		in, out := &in.VPA, &out.VPA
+		*out = new(VerticalPodAutoscalerResourcePolicy)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.LivenessProbe != nil {
 		in, out := &in.LivenessProbe, &out.LivenessProbe
 		*out = new(ProbeSpec)
@@ -4060,6 +6627,11 @@ func (in *TwemproxySpec) DeepCopyInto(out *TwemproxySpec) {
 		*out = new(TwemproxyOptions)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ConfigSecretName != nil {
+		in, out := &in.ConfigSecretName, &out.ConfigSecretName
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TwemproxySpec.
@@ -4072,6 +6644,36 @@ func (in *TwemproxySpec) DeepCopy() *TwemproxySpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpstreamTLSValidation) DeepCopyInto(out *UpstreamTLSValidation) {
+	*out = *in
+	if in.TrustedCAFile != nil {
+		in, out := &in.TrustedCAFile, &out.TrustedCAFile
+		*out = new(string)
+		**out = **in
+	}
+	if in.TrustedCASecretName != nil {
+		in, out := &in.TrustedCASecretName, &out.TrustedCASecretName
+		*out = new(string)
+		**out = **in
+	}
+	if in.MatchSubjectAltNames != nil {
+		in, out := &in.MatchSubjectAltNames, &out.MatchSubjectAltNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpstreamTLSValidation.
+func (in *UpstreamTLSValidation) DeepCopy() *UpstreamTLSValidation {
+	if in == nil {
+		return nil
+	}
+	out := new(UpstreamTLSValidation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VaultSecretReference) DeepCopyInto(out *VaultSecretReference) {
 	*out = *in
@@ -4087,6 +6689,110 @@ func (in *VaultSecretReference) DeepCopy() *VaultSecretReference {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VerticalPodAutoscalerResourcePolicy) DeepCopyInto(out *VerticalPodAutoscalerResourcePolicy) {
+	*out = *in
+	if in.MinAllowed != nil {
+		in, out := &in.MinAllowed, &out.MinAllowed
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.MaxAllowed != nil {
+		in, out := &in.MaxAllowed, &out.MaxAllowed
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.ControlledResources != nil {
+		in, out := &in.ControlledResources, &out.ControlledResources
+		*out = make([]v1.ResourceName, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VerticalPodAutoscalerResourcePolicy.
+func (in *VerticalPodAutoscalerResourcePolicy) DeepCopy() *VerticalPodAutoscalerResourcePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(VerticalPodAutoscalerResourcePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VerticalPodAutoscalerSpec) DeepCopyInto(out *VerticalPodAutoscalerSpec) {
+	*out = *in
+	if in.UpdateMode != nil {
+		in, out := &in.UpdateMode, &out.UpdateMode
+		*out = new(VerticalPodAutoscalerUpdateMode)
+		**out = **in
+	}
+	in.VerticalPodAutoscalerResourcePolicy.DeepCopyInto(&out.VerticalPodAutoscalerResourcePolicy)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VerticalPodAutoscalerSpec.
+func (in *VerticalPodAutoscalerSpec) DeepCopy() *VerticalPodAutoscalerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VerticalPodAutoscalerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualHost) DeepCopyInto(out *VirtualHost) {
+	*out = *in
+	if in.Domains != nil {
+		in, out := &in.Domains, &out.Domains
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]Route, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.RateLimits != nil {
+		in, out := &in.RateLimits, &out.RateLimits
+		*out = make([]RateLimit, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualHost.
+func (in *VirtualHost) DeepCopy() *VirtualHost {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualHost)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WeightedCluster) DeepCopyInto(out *WeightedCluster) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WeightedCluster.
+func (in *WeightedCluster) DeepCopy() *WeightedCluster {
+	if in == nil {
+		return nil
+	}
+	out := new(WeightedCluster)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkerConfig) DeepCopyInto(out *WorkerConfig) {
 	*out = *in
@@ -4130,11 +6836,23 @@ func (in *WorkerSpec) DeepCopyInto(out *WorkerSpec) {
 		*out = new(HorizontalPodAutoscalerSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.VPA != nil {
+		in, out := &in.VPA, &out.VPA
+		*out = new(VerticalPodAutoscalerSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Replicas != nil {
 		in, out := &in.Replicas, &out.Replicas
 		*out = new(int32)
 		**out = **in
 	}
+	if in.ScalingSchedule != nil {
+		in, out := &in.ScalingSchedule, &out.ScalingSchedule
+		*out = make([]ScalingWindow, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Resources != nil {
 		in, out := &in.Resources, &out.Resources
 		*out = new(ResourceRequirementsSpec)
@@ -4162,6 +6880,7 @@ func (in *WorkerSpec) DeepCopyInto(out *WorkerSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	in.PodTemplateOverrides.DeepCopyInto(&out.PodTemplateOverrides)
 	if in.Canary != nil {
 		in, out := &in.Canary, &out.Canary
 		*out = new(Canary)
@@ -4179,6 +6898,48 @@ func (in *WorkerSpec) DeepCopy() *WorkerSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadDeploymentStatus) DeepCopyInto(out *WorkloadDeploymentStatus) {
+	*out = *in
+	if in.Endpoints != nil {
+		in, out := &in.Endpoints, &out.Endpoints
+		*out = make([]WorkloadEndpointStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadDeploymentStatus.
+func (in *WorkloadDeploymentStatus) DeepCopy() *WorkloadDeploymentStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadDeploymentStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadEndpointStatus) DeepCopyInto(out *WorkloadEndpointStatus) {
+	*out = *in
+	if in.LoadBalancer != nil {
+		in, out := &in.LoadBalancer, &out.LoadBalancer
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadEndpointStatus.
+func (in *WorkloadEndpointStatus) DeepCopy() *WorkloadEndpointStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadEndpointStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkloadPublishingStrategyUpgrader) DeepCopyInto(out *WorkloadPublishingStrategyUpgrader) {
 	*out = *in
@@ -4221,13 +6982,42 @@ func (in *WorkloadPublishingStrategyUpgrader) DeepCopy() *WorkloadPublishingStra
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadStatus) DeepCopyInto(out *WorkloadStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Deployments != nil {
+		in, out := &in.Deployments, &out.Deployments
+		*out = make([]WorkloadDeploymentStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadStatus.
+func (in *WorkloadStatus) DeepCopy() *WorkloadStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Zync) DeepCopyInto(out *Zync) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Zync.
@@ -4410,6 +7200,11 @@ func (in *ZyncSpec) DeepCopyInto(out *ZyncSpec) {
 		*out = new(ZyncRailsConsoleSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Maintenance != nil {
+		in, out := &in.Maintenance, &out.Maintenance
+		*out = new(MaintenanceSpec)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ZyncSpec.
@@ -4425,6 +7220,26 @@ func (in *ZyncSpec) DeepCopy() *ZyncSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ZyncStatus) DeepCopyInto(out *ZyncStatus) {
 	*out = *in
+	in.WorkloadStatus.DeepCopyInto(&out.WorkloadStatus)
+	if in.BlueGreen != nil {
+		in, out := &in.BlueGreen, &out.BlueGreen
+		*out = make(BlueGreenStatuses, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Maintenance != nil {
+		in, out := &in.Maintenance, &out.Maintenance
+		*out = new(MaintenanceStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Recommendations != nil {
+		in, out := &in.Recommendations, &out.Recommendations
+		*out = make(ResourceRecommendations, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ZyncStatus.
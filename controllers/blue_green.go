@@ -0,0 +1,53 @@
+package controllers
+
+import (
+	"context"
+	"time"
+
+	"github.com/3scale-ops/basereconciler/reconciler"
+	saasv1alpha1 "github.com/3scale-ops/saas-operator/api/v1alpha1"
+	"github.com/3scale-ops/saas-operator/pkg/bluegreen"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// blueGreenDelivery holds the state of the blue/green delivery of the
+// workloads of a custom resource during a reconcile loop
+type blueGreenDelivery struct {
+	statuses saasv1alpha1.BlueGreenStatuses
+	requeue  time.Duration
+}
+
+// newBlueGreenDelivery evaluates the blue/green delivery of the given workloads and sets
+// their state in the in-memory copy of the spec. It must be called before generating the
+// resources of the custom resource.
+func newBlueGreenDelivery(ctx context.Context, cl client.Client, namespace string, targets []bluegreen.Target,
+	current saasv1alpha1.BlueGreenStatuses) (*blueGreenDelivery, error) {
+
+	statuses, requeue, err := bluegreen.NewSwitcher(cl).Progress(ctx, namespace, targets, current)
+	if err != nil {
+		return nil, err
+	}
+	bluegreen.Apply(targets, statuses)
+	return &blueGreenDelivery{statuses: statuses, requeue: requeue}, nil
+}
+
+// reconcile sets the blue/green status in the in-memory status of the custom resource
+func (bg *blueGreenDelivery) reconcile(status *saasv1alpha1.BlueGreenStatuses) reconciler.Result {
+	if !equality.Semantic.DeepEqual(*status, bg.statuses) {
+		*status = bg.statuses
+	}
+
+	return reconciler.Result{Action: reconciler.ContinueAction, RequeueAfter: bg.requeue}
+}
+
+// minRequeue returns the shortest of the non zero requeue intervals
+func minRequeue(intervals ...time.Duration) time.Duration {
+	var requeue time.Duration
+	for _, after := range intervals {
+		if after > 0 && (requeue == 0 || after < requeue) {
+			requeue = after
+		}
+	}
+	return requeue
+}
@@ -23,8 +23,10 @@ import (
 	"github.com/3scale-ops/basereconciler/util"
 	saasv1alpha1 "github.com/3scale-ops/saas-operator/api/v1alpha1"
 	"github.com/3scale-ops/saas-operator/pkg/generators/echoapi"
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -32,21 +34,27 @@ import (
 // EchoAPIReconciler reconciles a EchoAPI object
 type EchoAPIReconciler struct {
 	*reconciler.Reconciler
+	Recorder record.EventRecorder
 }
 
 // +kubebuilder:rbac:groups=saas.3scale.net,namespace=placeholder,resources=echoapis,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=saas.3scale.net,namespace=placeholder,resources=echoapis/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=saas.3scale.net,namespace=placeholder,resources=echoapis/finalizers,verbs=update
+// +kubebuilder:rbac:groups="core",namespace=placeholder,resources=events,verbs=create;patch
 // +kubebuilder:rbac:groups="core",namespace=placeholder,resources=services,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="gateway.networking.k8s.io",namespace=placeholder,resources=httproutes;tlsroutes,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="networking.k8s.io",namespace=placeholder,resources=ingresses,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="apps",namespace=placeholder,resources=deployments,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="monitoring.coreos.com",namespace=placeholder,resources=podmonitors,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="autoscaling",namespace=placeholder,resources=horizontalpodautoscalers,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="keda.sh",namespace=placeholder,resources=scaledobjects,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="autoscaling.k8s.io",namespace=placeholder,resources=verticalpodautoscalers,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="policy",namespace=placeholder,resources=poddisruptionbudgets,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="marin3r.3scale.net",namespace=placeholder,resources=envoyconfigs,verbs=get;list;watch;create;update;patch;delete
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
-func (r *EchoAPIReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+func (r *EchoAPIReconciler) Reconcile(ctx context.Context, req ctrl.Request) (res ctrl.Result, err error) {
 
 	ctx, _ = r.Logger(ctx, "name", req.Name, "namespace", req.Namespace)
 	instance := &saasv1alpha1.EchoAPI{}
@@ -57,6 +65,13 @@ func (r *EchoAPIReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 		return result.Values()
 	}
 
+	// The helpers below only modify the in-memory status,
+	// which is written once at the end of the reconcile
+	defer newStatusWriter(r.Client, instance).write(ctx, &res, &err)
+
+	// Report the errors that the (opt-in) validation webhook would have rejected
+	reportInvalidSpec(ctx, r.Recorder, instance)
+
 	gen := echoapi.NewGenerator(instance.GetName(), instance.GetNamespace(), instance.Spec)
 
 	resources, err := gen.Resources()
@@ -69,14 +84,26 @@ func (r *EchoAPIReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 		return result.Values()
 	}
 
-	return ctrl.Result{}, nil
+	result = reconcileWorkloadStatus(ctx, r.Reconciler, instance, &instance.Status.WorkloadStatus, nil)
+	if result.ShouldReturn() {
+		return result.Values()
+	}
+
+	result = reconcileResourceRecommendations(ctx, r.Reconciler, instance, &instance.Status.Recommendations)
+	if result.ShouldReturn() {
+		return result.Values()
+	}
+
+	return ctrl.Result{RequeueAfter: result.RequeueAfter}, nil
 }
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *EchoAPIReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return reconciler.SetupWithDynamicTypeWatches(r,
 		ctrl.NewControllerManagedBy(mgr).
-			For(&saasv1alpha1.EchoAPI{}),
+			For(&saasv1alpha1.EchoAPI{}).
+			Owns(&appsv1.Deployment{}).
+			Owns(&corev1.Service{}),
 	)
 }
 
@@ -0,0 +1,33 @@
+package controllers
+
+import (
+	"context"
+
+	"github.com/3scale-ops/basereconciler/reconciler"
+	saasv1alpha1 "github.com/3scale-ops/saas-operator/api/v1alpha1"
+	"github.com/3scale-ops/saas-operator/pkg/maintenance"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// reconcileMaintenance evaluates the maintenance of the given workloads and scales to zero
+// the ones that are stopped in the in-memory copy of the spec. It must be called before
+// generating the resources of the custom resource. When the maintenance status changes the
+// reconcile loop returns and requeues, so the status is persisted before the workloads are
+// stopped and the replicas they had are never lost.
+func reconcileMaintenance(ctx context.Context, r *reconciler.Reconciler, instance client.Object,
+	spec *saasv1alpha1.MaintenanceSpec, targets []maintenance.Target, status **saasv1alpha1.MaintenanceStatus) reconciler.Result {
+
+	desired, err := maintenance.NewManager(r.Client).Progress(ctx, instance.GetNamespace(), spec, targets, *status)
+	if err != nil {
+		return reconciler.Result{Error: err}
+	}
+
+	if !equality.Semantic.DeepEqual(*status, desired) {
+		*status = desired
+		return reconciler.Result{Action: reconciler.ReturnAndRequeueAction}
+	}
+
+	maintenance.Apply(spec, targets)
+	return reconciler.Result{Action: reconciler.ContinueAction}
+}
@@ -0,0 +1,105 @@
+package controllers
+
+import (
+	"context"
+	"time"
+
+	"github.com/3scale-ops/basereconciler/reconciler"
+	saasv1alpha1 "github.com/3scale-ops/saas-operator/api/v1alpha1"
+	"github.com/3scale-ops/saas-operator/pkg/canary"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// progressiveDelivery holds the state of the progressive delivery of the
+// canaries of a custom resource during a reconcile loop
+type progressiveDelivery struct {
+	targets  []canary.Target
+	statuses saasv1alpha1.CanaryStatuses
+	requeue  time.Duration
+}
+
+// newProgressiveDelivery evaluates the progressive delivery of the given canaries and
+// sets their traffic weights in the in-memory copy of the spec. It must be called before
+// generating the resources of the custom resource.
+func newProgressiveDelivery(ctx context.Context, targets []canary.Target,
+	current saasv1alpha1.CanaryStatuses) *progressiveDelivery {
+
+	statuses, requeue := canary.NewAnalyzer().Progress(ctx, targets, current)
+	canary.Apply(targets, statuses)
+	return &progressiveDelivery{targets: targets, statuses: statuses, requeue: requeue}
+}
+
+// reconcile promotes the canaries that succeeded, patching the main spec
+// with the canary image, and sets the canary statuses in the in-memory
+// status of the custom resource
+func (pd *progressiveDelivery) reconcile(ctx context.Context, r *reconciler.Reconciler,
+	instance client.Object, status *saasv1alpha1.CanaryStatuses) reconciler.Result {
+	logger := logr.FromContextOrDiscard(ctx)
+
+	// Take a copy before promoting so only the image
+	// changes are included in the patch
+	original := instance.DeepCopyObject().(client.Object)
+	promoted := canary.Promote(pd.targets, pd.statuses)
+	if promoted {
+		// Patch a copy, as the patch overwrites the object with the one stored
+		// in the API, which would discard the changes made to the in-memory status
+		o := instance.DeepCopyObject().(client.Object)
+		if err := r.Client.Patch(ctx, o, client.MergeFrom(original)); err != nil {
+			logger.Error(err, "unable to promote canary")
+			return reconciler.Result{Error: err}
+		}
+		instance.SetResourceVersion(o.GetResourceVersion())
+	}
+
+	if !equality.Semantic.DeepEqual(*status, pd.statuses) {
+		*status = pd.statuses
+	}
+
+	if promoted {
+		// The spec has changed, reconcile again
+		return reconciler.Result{Action: reconciler.ReturnAndRequeueAction}
+	}
+
+	return reconciler.Result{Action: reconciler.ContinueAction, RequeueAfter: pd.requeue}
+}
+
+// canaryPromoter returns an initialization function that promotes the canaries listed in
+// the promotion annotation of the custom resource. The effective spec of each canary is
+// merged into the main spec, the canary is removed and an Event records the changes.
+// The annotation is always removed, failed promotions are reported with a warning Event.
+func canaryPromoter[T any](recorder record.EventRecorder, specOf func(client.Object) *T,
+	targetsOf func(*T) []canary.Target) func(context.Context, client.Client, client.Object) error {
+
+	return func(ctx context.Context, cl client.Client, o client.Object) error {
+		value, ok := o.GetAnnotations()[saasv1alpha1.CanaryPromoteAnnotationKey]
+		if !ok {
+			return nil
+		}
+
+		defaulted := func() client.Object {
+			obj := o.DeepCopyObject().(client.Object)
+			obj.(interface{ Default() }).Default()
+			return obj
+		}
+
+		for _, name := range canary.CanariesToPromote(value, targetsOf(specOf(defaulted()))) {
+			patch, err := canary.PromoteSpec(specOf(o), specOf(defaulted()), targetsOf, name)
+			if err != nil {
+				recorder.Eventf(o, corev1.EventTypeWarning, "CanaryPromotionFailed",
+					"unable to promote canary '%s': %s", name, err.Error())
+				continue
+			}
+			recorder.Eventf(o, corev1.EventTypeNormal, "CanaryPromoted",
+				"canary '%s' promoted, changes applied to the spec: %s", name, string(patch))
+		}
+
+		annotations := o.GetAnnotations()
+		delete(annotations, saasv1alpha1.CanaryPromoteAnnotationKey)
+		o.SetAnnotations(annotations)
+		return nil
+	}
+}
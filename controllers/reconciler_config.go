@@ -157,6 +157,16 @@ func init() {
 				"spec.finally",
 			},
 		})
+	config.SetDefaultReconcileConfigForGVK(
+		schema.FromAPIVersionAndKind("batch/v1", "Job"),
+		config.ReconcileConfigForGVK{
+			// Job specs are immutable, Jobs that
+			// need to change use a different name
+			EnsureProperties: []string{
+				"metadata.annotations",
+				"metadata.labels",
+			},
+		})
 	// default config for any GVK not explicitely declared in the config
 	config.SetDefaultReconcileConfigForGVK(
 		schema.GroupVersionKind{},
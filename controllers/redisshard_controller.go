@@ -68,7 +68,7 @@ func (r *RedisShardReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 	}
 
 	shard, result := r.setRedisRoles(ctx, types.NamespacedName{Name: req.Name, Namespace: req.Namespace},
-		*instance.Spec.MasterIndex, *instance.Spec.SlaveCount+1, gen.ServiceName(), logger)
+		*instance.Spec.MasterIndex, *instance.Spec.SlaveCount+1, &gen, logger)
 	if result.ShouldReturn() {
 		return result.Values()
 	}
@@ -89,13 +89,13 @@ func (r *RedisShardReconciler) SetupWithManager(mgr ctrl.Manager) error {
 }
 
 func (r *RedisShardReconciler) setRedisRoles(ctx context.Context, key types.NamespacedName,
-	masterIndex, replicas int32, serviceName string, log logr.Logger) (*sharded.Shard, reconciler.Result) {
+	masterIndex, replicas int32, gen *redisshard.Generator, log logr.Logger) (*sharded.Shard, reconciler.Result) {
 
 	var masterHostPort string
 	redisURLs := make(map[string]string, replicas)
 	for i := 0; i < int(replicas); i++ {
 		pod := &corev1.Pod{}
-		key := types.NamespacedName{Name: fmt.Sprintf("%s-%d", serviceName, i), Namespace: key.Namespace}
+		key := types.NamespacedName{Name: fmt.Sprintf("%s-%d", gen.ServiceName(), i), Namespace: key.Namespace}
 		err := r.Client.Get(ctx, key, pod)
 		if err != nil {
 			return &sharded.Shard{Name: key.Name}, reconciler.Result{Error: err}
@@ -106,9 +106,15 @@ func (r *RedisShardReconciler) setRedisRoles(ctx context.Context, key types.Name
 			return &sharded.Shard{Name: key.Name}, reconciler.Result{Action: reconciler.ReturnAndRequeueAction, RequeueAfter: 5 * time.Second}
 		}
 
-		redisURLs[fmt.Sprintf("%s-%d", serviceName, i)] = fmt.Sprintf("redis://%s:%d", pod.Status.PodIP, 6379)
+		// use the stable Pod hostname instead of the IP if configured to do so
+		host := pod.Status.PodIP
+		if gen.AnnounceHostnames {
+			host = gen.PodHostname(i)
+		}
+
+		redisURLs[fmt.Sprintf("%s-%d", gen.ServiceName(), i)] = fmt.Sprintf("redis://%s:%d", host, 6379)
 		if int(masterIndex) == i {
-			masterHostPort = fmt.Sprintf("%s:%d", pod.Status.PodIP, 6379)
+			masterHostPort = fmt.Sprintf("%s:%d", host, 6379)
 		}
 	}
 
@@ -0,0 +1,66 @@
+package controllers
+
+import (
+	"context"
+	"time"
+
+	"github.com/3scale-ops/basereconciler/reconciler"
+	saasv1alpha1 "github.com/3scale-ops/saas-operator/api/v1alpha1"
+	"github.com/3scale-ops/saas-operator/pkg/status"
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	vpav1 "k8s.io/autoscaler/vertical-pod-autoscaler/pkg/apis/autoscaling.k8s.io/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// recommendationsRefreshInterval is how often the recommendations of the
+// VerticalPodAutoscalers are projected into the status. VerticalPodAutoscalers
+// are not watched because their CRDs are not required in the cluster.
+const recommendationsRefreshInterval = 5 * time.Minute
+
+// reconcileResourceRecommendations projects the recommendations of the VerticalPodAutoscalers
+// owned by the custom resource into its in-memory status. It must be called after the owned
+// resources have been reconciled.
+func reconcileResourceRecommendations(ctx context.Context, r *reconciler.Reconciler, instance client.Object,
+	current *saasv1alpha1.ResourceRecommendations) reconciler.Result {
+
+	vpaList := &vpav1.VerticalPodAutoscalerList{}
+	if err := r.Client.List(ctx, vpaList, client.InNamespace(instance.GetNamespace())); err != nil {
+		// the VerticalPodAutoscaler is not installed in the cluster,
+		// so there cannot be any recommendations
+		if !meta.IsNoMatchError(err) {
+			return reconciler.Result{Error: err}
+		}
+	}
+	vpas := []vpav1.VerticalPodAutoscaler{}
+	for _, vpa := range vpaList.Items {
+		if metav1.IsControlledBy(&vpa, instance) {
+			vpas = append(vpas, vpa)
+		}
+	}
+
+	deployments := []appsv1.Deployment{}
+	if len(vpas) > 0 {
+		deploymentList := &appsv1.DeploymentList{}
+		if err := r.Client.List(ctx, deploymentList, client.InNamespace(instance.GetNamespace())); err != nil {
+			return reconciler.Result{Error: err}
+		}
+		for _, dep := range deploymentList.Items {
+			if metav1.IsControlledBy(&dep, instance) {
+				deployments = append(deployments, dep)
+			}
+		}
+	}
+
+	desired := status.Recommendations(vpas, deployments)
+	if !equality.Semantic.DeepEqual(*current, desired) {
+		*current = desired
+	}
+
+	if len(vpas) == 0 {
+		return reconciler.Result{Action: reconciler.ContinueAction}
+	}
+	return reconciler.Result{Action: reconciler.ContinueAction, RequeueAfter: recommendationsRefreshInterval}
+}
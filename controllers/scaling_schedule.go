@@ -0,0 +1,51 @@
+package controllers
+
+import (
+	"context"
+	"time"
+
+	"github.com/3scale-ops/basereconciler/reconciler"
+	saasv1alpha1 "github.com/3scale-ops/saas-operator/api/v1alpha1"
+	"github.com/3scale-ops/saas-operator/pkg/scaling"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// scalingSchedule holds the scaling windows active for the
+// workloads of a custom resource during a reconcile loop
+type scalingSchedule struct {
+	statuses saasv1alpha1.ScalingWindowStatuses
+	requeue  time.Duration
+}
+
+// newScalingSchedule evaluates the scaling schedules of the given workloads and overrides
+// their replicas in the in-memory copy of the spec. It must be called before generating
+// the resources of the custom resource. Invalid windows are skipped and reported with
+// a warning Event so they don't block the reconcile of the custom resource.
+func newScalingSchedule(ctx context.Context, recorder record.EventRecorder, instance client.Object,
+	targets []scaling.Target) *scalingSchedule {
+	logger := logr.FromContextOrDiscard(ctx)
+
+	statuses, requeue, errs := scaling.Evaluate(targets, time.Now())
+	for _, err := range errs {
+		logger.Error(err, "invalid scaling window")
+		recorder.Event(instance, corev1.EventTypeWarning, "InvalidScalingWindow", err.Error())
+	}
+	scaling.Apply(targets, statuses)
+	return &scalingSchedule{statuses: statuses, requeue: requeue}
+}
+
+// reconcile sets the active scaling windows in the in-memory status of the custom resource
+func (ss *scalingSchedule) reconcile(ctx context.Context, status *saasv1alpha1.ScalingWindowStatuses) reconciler.Result {
+	logger := logr.FromContextOrDiscard(ctx)
+
+	if !equality.Semantic.DeepEqual(*status, ss.statuses) {
+		logger.Info("active scaling windows changed", "windows", ss.statuses)
+		*status = ss.statuses
+	}
+
+	return reconciler.Result{Action: reconciler.ContinueAction, RequeueAfter: ss.requeue}
+}
@@ -146,7 +146,8 @@ func (r *SentinelReconciler) reconcileStatus(ctx context.Context, instance *saas
 
 	// redis shards info to the status
 	merr := cluster.SentinelDiscover(ctx, sharded.SlaveReadOnlyDiscoveryOpt,
-		sharded.SaveConfigDiscoveryOpt, sharded.SlavePriorityDiscoveryOpt, sharded.ReplicationInfoDiscoveryOpt)
+		sharded.SaveConfigDiscoveryOpt, sharded.SlavePriorityDiscoveryOpt, sharded.ReplicationInfoDiscoveryOpt,
+		sharded.ResolveIPDiscoveryOpt)
 	// if the failure occurred calling sentinel discard the result and return error
 	// otherwise keep going on and use the information that was returned, even if there were some
 	// other errors
@@ -179,6 +180,7 @@ func (r *SentinelReconciler) reconcileStatus(ctx context.Context, instance *saas
 			shards[idx].Servers[srv.GetAlias()] = saasv1alpha1.RedisServerDetails{
 				Role:    srv.Role,
 				Address: srv.ID(),
+				IP:      srv.IP,
 				Config:  srv.Config,
 				Info:    srv.Info,
 			}
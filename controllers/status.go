@@ -0,0 +1,55 @@
+package controllers
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// statusWriter collects the changes made to the status of a custom resource
+// during a reconcile loop so they are written with a single update at the end
+type statusWriter struct {
+	cl       client.Client
+	instance client.Object
+	original interface{}
+}
+
+// newStatusWriter takes a snapshot of the status of the custom resource. The
+// helpers of the reconcile loop only modify the in-memory status, which is
+// written by the write method, usually deferred in the Reconcile function.
+func newStatusWriter(cl client.Client, instance client.Object) *statusWriter {
+	return &statusWriter{cl: cl, instance: instance, original: statusOf(instance)}
+}
+
+// write updates the status of the custom resource if it has changed since the
+// snapshot was taken. Errors are logged and returned through err unless the
+// reconcile loop already failed.
+func (sw *statusWriter) write(ctx context.Context, result *ctrl.Result, err *error) {
+	if equality.Semantic.DeepEqual(sw.original, statusOf(sw.instance)) {
+		return
+	}
+
+	// Update a copy, as the update overwrites the object with the one stored
+	// in the API, which would discard the changes made to the in-memory spec
+	o := sw.instance.DeepCopyObject().(client.Object)
+	if e := sw.cl.Status().Update(ctx, o); e != nil {
+		logr.FromContextOrDiscard(ctx).Error(e, "unable to update status")
+		if *err == nil {
+			*result, *err = ctrl.Result{}, e
+		}
+		return
+	}
+	sw.instance.SetResourceVersion(o.GetResourceVersion())
+}
+
+func statusOf(o client.Object) interface{} {
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(o)
+	if err != nil {
+		return nil
+	}
+	return u["status"]
+}
@@ -28,8 +28,10 @@ import (
 	"github.com/3scale-ops/saas-operator/pkg/reconcilers/threads"
 	redis "github.com/3scale-ops/saas-operator/pkg/redis/server"
 	"github.com/goombaio/namegenerator"
+	kedav1alpha1 "github.com/kedacore/keda/v2/apis/keda/v1alpha1"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	vpav1 "k8s.io/autoscaler/vertical-pod-autoscaler/pkg/apis/autoscaling.k8s.io/v1"
 	"k8s.io/client-go/kubernetes/scheme"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -37,6 +39,8 @@ import (
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
 
 	marin3rv1alpha1 "github.com/3scale-ops/marin3r/apis/marin3r/v1alpha1"
 	saasv1alpha1 "github.com/3scale-ops/saas-operator/api/v1alpha1"
@@ -97,6 +101,15 @@ var _ = BeforeSuite(func() {
 	Expect(err).NotTo(HaveOccurred())
 	err = pipelinev1beta1.AddToScheme(scheme.Scheme)
 	Expect(err).NotTo(HaveOccurred())
+	err = gatewayv1.AddToScheme(scheme.Scheme)
+	Expect(err).NotTo(HaveOccurred())
+	err = gatewayv1alpha2.AddToScheme(scheme.Scheme)
+	Expect(err).NotTo(HaveOccurred())
+	err = kedav1alpha1.AddToScheme(scheme.Scheme)
+	Expect(err).NotTo(HaveOccurred())
+
+	err = vpav1.AddToScheme(scheme.Scheme)
+	Expect(err).NotTo(HaveOccurred())
 
 	mgr, err := ctrl.NewManager(cfg, ctrl.Options{
 		Scheme: scheme.Scheme,
@@ -125,36 +138,42 @@ var _ = BeforeSuite(func() {
 	err = (&AutoSSLReconciler{
 		Reconciler: reconciler.NewFromManager(mgr).
 			WithLogger(ctrl.Log.WithName("controllers").WithName("AutoSSL")),
+		Recorder: mgr.GetEventRecorderFor("autossl-controller"),
 	}).SetupWithManager(mgr)
 	Expect(err).ToNot(HaveOccurred())
 
 	err = (&ApicastReconciler{
 		Reconciler: reconciler.NewFromManager(mgr).
 			WithLogger(ctrl.Log.WithName("controllers").WithName("Apicast")),
+		Recorder: mgr.GetEventRecorderFor("apicast-controller"),
 	}).SetupWithManager(mgr)
 	Expect(err).ToNot(HaveOccurred())
 
 	err = (&EchoAPIReconciler{
 		Reconciler: reconciler.NewFromManager(mgr).
 			WithLogger(ctrl.Log.WithName("controllers").WithName("EchoAPI")),
+		Recorder: mgr.GetEventRecorderFor("echoapi-controller"),
 	}).SetupWithManager(mgr)
 	Expect(err).ToNot(HaveOccurred())
 
 	err = (&MappingServiceReconciler{
 		Reconciler: reconciler.NewFromManager(mgr).
 			WithLogger(ctrl.Log.WithName("controllers").WithName("MappingService")),
+		Recorder: mgr.GetEventRecorderFor("mappingservice-controller"),
 	}).SetupWithManager(mgr)
 	Expect(err).ToNot(HaveOccurred())
 
 	err = (&CORSProxyReconciler{
 		Reconciler: reconciler.NewFromManager(mgr).
 			WithLogger(ctrl.Log.WithName("controllers").WithName("CORSProxy")),
+		Recorder: mgr.GetEventRecorderFor("corsproxy-controller"),
 	}).SetupWithManager(mgr)
 	Expect(err).ToNot(HaveOccurred())
 
 	err = (&BackendReconciler{
 		Reconciler: reconciler.NewFromManager(mgr).
 			WithLogger(ctrl.Log.WithName("controllers").WithName("Backend")),
+		Recorder: mgr.GetEventRecorderFor("backend-controller"),
 	}).SetupWithManager(mgr)
 	Expect(err).ToNot(HaveOccurred())
 
@@ -177,12 +196,14 @@ var _ = BeforeSuite(func() {
 	err = (&SystemReconciler{
 		Reconciler: reconciler.NewFromManager(mgr).
 			WithLogger(ctrl.Log.WithName("controllers").WithName("System")),
+		Recorder: mgr.GetEventRecorderFor("system-controller"),
 	}).SetupWithManager(mgr)
 	Expect(err).ToNot(HaveOccurred())
 
 	err = (&ZyncReconciler{
 		Reconciler: reconciler.NewFromManager(mgr).
 			WithLogger(ctrl.Log.WithName("controllers").WithName("Zync")),
+		Recorder: mgr.GetEventRecorderFor("zync-controller"),
 	}).SetupWithManager(mgr)
 	Expect(err).ToNot(HaveOccurred())
 
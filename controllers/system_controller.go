@@ -24,6 +24,7 @@ import (
 	saasv1alpha1 "github.com/3scale-ops/saas-operator/api/v1alpha1"
 	"github.com/3scale-ops/saas-operator/pkg/generators/system"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -31,16 +32,24 @@ import (
 // SystemReconciler reconciles a System object
 type SystemReconciler struct {
 	*reconciler.Reconciler
+	Recorder record.EventRecorder
 }
 
 // +kubebuilder:rbac:groups=saas.3scale.net,namespace=placeholder,resources=systems,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=saas.3scale.net,namespace=placeholder,resources=systems/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=saas.3scale.net,namespace=placeholder,resources=systems/finalizers,verbs=update
+// +kubebuilder:rbac:groups=saas.3scale.net,namespace=placeholder,resources=twemproxyconfigs,verbs=get;list;watch
+// +kubebuilder:rbac:groups="core",namespace=placeholder,resources=events,verbs=create;patch
 // +kubebuilder:rbac:groups="core",namespace=placeholder,resources=services,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="gateway.networking.k8s.io",namespace=placeholder,resources=httproutes;tlsroutes,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="networking.k8s.io",namespace=placeholder,resources=ingresses,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="apps",namespace=placeholder,resources=deployments,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="apps",namespace=placeholder,resources=statefulsets,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="batch",namespace=placeholder,resources=jobs,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="monitoring.coreos.com",namespace=placeholder,resources=podmonitors,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="autoscaling",namespace=placeholder,resources=horizontalpodautoscalers,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="keda.sh",namespace=placeholder,resources=scaledobjects,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="autoscaling.k8s.io",namespace=placeholder,resources=verticalpodautoscalers,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="policy",namespace=placeholder,resources=poddisruptionbudgets,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="grafana.integreatly.org",namespace=placeholder,resources=grafanadashboards,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="external-secrets.io",namespace=placeholder,resources=externalsecrets,verbs=get;list;watch;create;update;patch;delete
@@ -49,13 +58,35 @@ type SystemReconciler struct {
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
-func (r *SystemReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+func (r *SystemReconciler) Reconcile(ctx context.Context, req ctrl.Request) (res ctrl.Result, err error) {
 
 	ctx, _ = r.Logger(ctx, "name", req.Name, "namespace", req.Namespace)
 	instance := &saasv1alpha1.System{}
 	result := r.ManageResourceLifecycle(ctx, req, instance,
 		reconciler.WithInMemoryInitializationFunc(util.ResourceDefaulter(instance)),
-		reconciler.WithInitializationFunc(SystemResourceUpgrader))
+		reconciler.WithInitializationFunc(SystemResourceUpgrader),
+		reconciler.WithInitializationFunc(canaryPromoter(r.Recorder,
+			func(o client.Object) *saasv1alpha1.SystemSpec { return &o.(*saasv1alpha1.System).Spec },
+			system.CanaryTargets)))
+	if result.ShouldReturn() {
+		return result.Values()
+	}
+
+	// The helpers below only modify the in-memory status,
+	// which is written once at the end of the reconcile
+	defer newStatusWriter(r.Client, instance).write(ctx, &res, &err)
+
+	// Report the errors that the (opt-in) validation webhook would have rejected
+	reportInvalidSpec(ctx, r.Recorder, instance)
+
+	// Evaluate the progressive delivery of canaries. Traffic weights
+	// are set in the in-memory spec before generating the resources.
+	delivery := newProgressiveDelivery(ctx, system.CanaryTargets(&instance.Spec), instance.Status.Canaries)
+
+	// Stop the workloads in maintenance. This takes precedence
+	// over the replicas set by the canary delivery.
+	result = reconcileMaintenance(ctx, r.Reconciler, instance, instance.Spec.Maintenance,
+		system.MaintenanceTargets(&instance.Spec, instance.Status.BlueGreen), &instance.Status.Maintenance)
 	if result.ShouldReturn() {
 		return result.Values()
 	}
@@ -64,6 +95,23 @@ func (r *SystemReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 	if err != nil {
 		return ctrl.Result{}, err
 	}
+
+	// Mount the twemproxy config from a Secret when the TwemproxyConfig reports one
+	if err := resolveTwemproxyConfigSecrets(ctx, r.Client, instance.GetNamespace(), gen.TwemproxySpecs()); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Evaluate the blue/green delivery of workloads. The state is set
+	// in the in-memory spec before generating the resources.
+	targets, err := gen.BlueGreenTargets()
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	blueGreen, err := newBlueGreenDelivery(ctx, r.Client, instance.GetNamespace(), targets, instance.Status.BlueGreen)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
 	resources, err := gen.Resources()
 	if err != nil {
 		return ctrl.Result{}, err
@@ -74,7 +122,22 @@ func (r *SystemReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 		return result.Values()
 	}
 
-	return ctrl.Result{}, nil
+	result = blueGreen.reconcile(&instance.Status.BlueGreen)
+	if result.ShouldReturn() {
+		return result.Values()
+	}
+
+	result = delivery.reconcile(ctx, r.Reconciler, instance, &instance.Status.Canaries)
+	if result.ShouldReturn() {
+		return result.Values()
+	}
+
+	result = reconcileResourceRecommendations(ctx, r.Reconciler, instance, &instance.Status.Recommendations)
+	if result.ShouldReturn() {
+		return result.Values()
+	}
+
+	return ctrl.Result{RequeueAfter: minRequeue(delivery.requeue, blueGreen.requeue, result.RequeueAfter)}, nil
 }
 
 // SetupWithManager sets up the controller with the Manager.
@@ -82,7 +145,8 @@ func (r *SystemReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return reconciler.SetupWithDynamicTypeWatches(r,
 		ctrl.NewControllerManagedBy(mgr).
 			For(&saasv1alpha1.System{}).
-			Watches(&corev1.Secret{}, r.FilteredEventHandler(&saasv1alpha1.SystemList{}, nil, r.Log)),
+			Watches(&corev1.Secret{}, r.FilteredEventHandler(&saasv1alpha1.SystemList{}, nil, r.Log)).
+			Watches(&saasv1alpha1.TwemproxyConfig{}, r.FilteredEventHandler(&saasv1alpha1.SystemList{}, inNamespace, r.Log)),
 	)
 }
 
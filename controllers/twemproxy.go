@@ -0,0 +1,40 @@
+package controllers
+
+import (
+	"context"
+
+	saasv1alpha1 "github.com/3scale-ops/saas-operator/api/v1alpha1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// resolveTwemproxyConfigSecrets sets in the in-memory twemproxy specs the Secret that
+// holds the config of the referenced TwemproxyConfig. The Secret is only reported in the
+// TwemproxyConfig status when redis authentication is used and once the Secret exists, so
+// the sidecars mount the ConfigMap otherwise. It must be called before generating the resources.
+func resolveTwemproxyConfigSecrets(ctx context.Context, cl client.Client, namespace string,
+	specs []*saasv1alpha1.TwemproxySpec) error {
+
+	for _, spec := range specs {
+		if spec == nil {
+			continue
+		}
+		tc := &saasv1alpha1.TwemproxyConfig{}
+		key := types.NamespacedName{Name: spec.TwemproxyConfigRef, Namespace: namespace}
+		if err := cl.Get(ctx, key, tc); err != nil {
+			if apierrors.IsNotFound(err) {
+				spec.ConfigSecretName = nil
+				continue
+			}
+			return err
+		}
+		spec.ConfigSecretName = tc.Status.ConfigSecretName
+	}
+	return nil
+}
+
+// inNamespace filters the events of objects in the namespace of the custom resource
+func inNamespace(event client.Object, o client.Object) bool {
+	return event.GetNamespace() == o.GetNamespace()
+}
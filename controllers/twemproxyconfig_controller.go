@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"strings"
 	"sync"
 	"time"
 
@@ -37,11 +38,13 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	"sigs.k8s.io/controller-runtime/pkg/source"
 )
 
@@ -50,6 +53,7 @@ type TwemproxyConfigReconciler struct {
 	*reconciler.Reconciler
 	SentinelEvents threads.Manager
 	Pool           *redis.ServerPool
+	Recorder       record.EventRecorder
 }
 
 // +kubebuilder:rbac:groups=saas.3scale.net,namespace=placeholder,resources=twemproxyconfigs,verbs=get;list;watch;create;update;patch;delete
@@ -57,6 +61,8 @@ type TwemproxyConfigReconciler struct {
 // +kubebuilder:rbac:groups=saas.3scale.net,namespace=placeholder,resources=twemproxyconfigs/finalizers,verbs=update
 // +kubebuilder:rbac:groups="core",namespace=placeholder,resources=configmaps,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="core",namespace=placeholder,resources=pods,verbs=list;patch
+// +kubebuilder:rbac:groups="core",namespace=placeholder,resources=secrets,verbs=get;list;watch;create;patch
+// +kubebuilder:rbac:groups="core",namespace=placeholder,resources=events,verbs=create;patch
 // +kubebuilder:rbac:groups="grafana.integreatly.org",namespace=placeholder,resources=grafanadashboards,verbs=get;list;watch;create;update;patch;delete
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
@@ -82,19 +88,72 @@ func (r *TwemproxyConfigReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 		return ctrl.Result{}, err
 	}
 
+	// Get the currently applied ConfigMap, if any
+	current, err := r.appliedConfigMap(ctx, instance)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Hold back changes in the logical to physical shard mapping until approved
+	pendingTopologyChange, err := r.guardTopologyChanges(ctx, instance, &gen, current, logger)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Hold back changes in the key distribution until approved
+	pendingKeyDistributionChange, err := r.guardKeyDistributionChanges(ctx, instance, &gen, current, logger)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
 	cm, err := gen.ConfigMap().Build(ctx, r.Client, nil)
 	if err != nil {
 		return ctrl.Result{}, err
 	}
 
 	// Reconcile the ConfigMap
-	hash, err := r.reconcileConfigMap(ctx, instance, cm.(*corev1.ConfigMap), *instance.Spec.ReconcileServerPools, logger)
+	applied, err := r.reconcileConfigMap(ctx, instance, cm.(*corev1.ConfigMap), *instance.Spec.ReconcileServerPools, logger)
 	if err != nil {
 		return ctrl.Result{}, err
 	}
 
+	// The redis passwords can't be stored in the ConfigMap, so when the server pools use
+	// redis authentication the config is also written to a Secret, which is mounted by the
+	// twemproxy sidecars once it is reported in the status. A Secret that already exists is
+	// kept up to date even if redis authentication is no longer used, as there might still
+	// be Pods mounting it.
+	hash := util.Hash(applied.Data)
+	secret, err := gen.Secret(applied)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	secretHash, exists, err := r.reconcileSecret(ctx, instance, secret, gen.UsesRedisAuth(), logger)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	var configSecretName *string
+	if exists {
+		hash = secretHash
+		if gen.UsesRedisAuth() {
+			configSecretName = util.Pointer(secret.GetName())
+		}
+	}
+
+	// An approval can only be used once, so it is removed when
+	// there is no longer a change of its kind pending approval
+	if pendingTopologyChange == nil {
+		if err := r.clearApproval(ctx, instance, saasv1alpha1.TwemproxyApproveTopologyChangeAnnotationKey); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+	if pendingKeyDistributionChange == nil {
+		if err := r.clearApproval(ctx, instance, saasv1alpha1.TwemproxyApproveKeyDistributionChangeAnnotationKey); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
 	// Reconcile sync annotations in pods. This is done to force a change in the target
-	// Pods annotations so the ConfigMap is re-synced inside the container. Otherwide kubelet
+	// Pods annotations so the config is re-synced inside the container. Otherwide kubelet
 	// would re-sync the file asynchronously depending on its configured refresh time, which might
 	// take several seconds.
 	if err := r.reconcileSyncAnnotations(ctx, instance, hash, logger); err != nil {
@@ -117,7 +176,8 @@ func (r *TwemproxyConfigReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 	}
 
 	// Reconcile status of the TwemproxyConfig resource
-	if err := r.reconcileStatus(ctx, &gen, instance, logger); err != nil {
+	if err := r.reconcileStatus(ctx, &gen, instance, pendingTopologyChange, pendingKeyDistributionChange,
+		configSecretName, logger); err != nil {
 		return ctrl.Result{}, err
 	}
 
@@ -125,8 +185,21 @@ func (r *TwemproxyConfigReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 	return ctrl.Result{RequeueAfter: 30 * time.Second}, nil
 }
 
+func (r *TwemproxyConfigReconciler) appliedConfigMap(ctx context.Context,
+	instance *saasv1alpha1.TwemproxyConfig) (*corev1.ConfigMap, error) {
+
+	current := &corev1.ConfigMap{}
+	if err := r.Client.Get(ctx, client.ObjectKeyFromObject(instance), current); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return current, nil
+}
+
 func (r *TwemproxyConfigReconciler) reconcileConfigMap(ctx context.Context, owner client.Object,
-	desired *corev1.ConfigMap, reconcileData bool, log logr.Logger) (string, error) {
+	desired *corev1.ConfigMap, reconcileData bool, log logr.Logger) (*corev1.ConfigMap, error) {
 	logger := log.WithValues("kind", "ConfigMap", "resource", desired.GetName())
 
 	current := &corev1.ConfigMap{}
@@ -135,33 +208,175 @@ func (r *TwemproxyConfigReconciler) reconcileConfigMap(ctx context.Context, owne
 		if apierrors.IsNotFound(err) {
 			// Create
 			if err := controllerutil.SetControllerReference(owner, desired, r.Scheme); err != nil {
-				return "", err
+				return nil, err
 			}
 			if err := r.Client.Create(ctx, desired); err != nil {
-				return "", err
+				return nil, err
 			}
 			logger.Info("created ConfigMap")
-			return util.Hash(desired.Data), nil
+			return desired, nil
 		}
-		return "", err
+		return nil, err
 	}
 
 	if reconcileData {
 		// Compare .data field of both ConfigMaps and patch if required.
 		// We use patch to avoid failures due to having an older version
 		// of the configmap so the config changes are propagated faster.
-		if !reflect.DeepEqual(desired.Data, current.Data) {
+		topologyKey := saasv1alpha1.TwemproxyTopologyAnnotationKey
+		if !reflect.DeepEqual(desired.Data, current.Data) ||
+			desired.GetAnnotations()[topologyKey] != current.GetAnnotations()[topologyKey] {
 			patch := client.MergeFrom(current.DeepCopy())
 			current.Data = desired.Data
+			if current.GetAnnotations() == nil {
+				current.ObjectMeta.Annotations = map[string]string{}
+			}
+			current.ObjectMeta.Annotations[topologyKey] = desired.GetAnnotations()[topologyKey]
 			if err := r.Client.Patch(ctx, current, patch); err != nil {
 				logger.Error(err, "unable to patch ConfigMap")
-				return "", err
+				return nil, err
 			}
 			logger.Info("patched ConfigMap")
 		}
 	}
 
-	return util.Hash(current.Data), nil
+	return current, nil
+}
+
+// reconcileSecret keeps the Secret with the twemproxy config up to date. The Secret is only
+// created if create is true. It returns the hash of the Secret data and whether it exists.
+func (r *TwemproxyConfigReconciler) reconcileSecret(ctx context.Context, owner client.Object,
+	desired *corev1.Secret, create bool, log logr.Logger) (string, bool, error) {
+	logger := log.WithValues("kind", "Secret", "resource", desired.GetName())
+
+	current := &corev1.Secret{}
+	err := r.Client.Get(ctx, client.ObjectKeyFromObject(desired), current)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			if !create {
+				return "", false, nil
+			}
+			// Create
+			if err := controllerutil.SetControllerReference(owner, desired, r.Scheme); err != nil {
+				return "", false, err
+			}
+			if err := r.Client.Create(ctx, desired); err != nil {
+				return "", false, err
+			}
+			logger.Info("created Secret")
+			return util.Hash(desired.Data), true, nil
+		}
+		return "", false, err
+	}
+
+	if !reflect.DeepEqual(desired.Data, current.Data) {
+		patch := client.MergeFrom(current.DeepCopy())
+		current.Data = desired.Data
+		if err := r.Client.Patch(ctx, current, patch); err != nil {
+			logger.Error(err, "unable to patch Secret")
+			return "", false, err
+		}
+		logger.Info("patched Secret")
+	}
+
+	return util.Hash(current.Data), true, nil
+}
+
+// guardKeyDistributionChanges compares the key distribution of the server pools applied in the
+// current ConfigMap with the desired one. Changes in the key distribution make the keys already
+// stored unreachable, so they are only applied if the approval annotation matches the hash of
+// the change. Otherwise the generator is configured to keep the applied key distribution and
+// the pending change is returned.
+func (r *TwemproxyConfigReconciler) guardKeyDistributionChanges(ctx context.Context,
+	instance *saasv1alpha1.TwemproxyConfig, gen *twemproxyconfig.Generator, current *corev1.ConfigMap,
+	log logr.Logger) (*saasv1alpha1.KeyDistributionChange, error) {
+
+	if current == nil || !*instance.Spec.ReconcileServerPools {
+		return nil, nil
+	}
+
+	changes, err := gen.KeyDistributionChanges(current)
+	if err != nil || len(changes) == 0 {
+		return nil, err
+	}
+
+	change := &saasv1alpha1.KeyDistributionChange{Hash: util.Hash(changes), Changes: changes}
+	if instance.GetAnnotations()[saasv1alpha1.TwemproxyApproveKeyDistributionChangeAnnotationKey] == change.Hash {
+		log.Info("applying approved key distribution change", "hash", change.Hash, "changes", changes)
+		return nil, nil
+	}
+
+	if err := gen.KeepKeyDistribution(current); err != nil {
+		return nil, err
+	}
+	if instance.Status.PendingKeyDistributionChange == nil || instance.Status.PendingKeyDistributionChange.Hash != change.Hash {
+		log.Info("key distribution change blocked, pending approval", "hash", change.Hash, "changes", changes)
+		r.Recorder.Eventf(instance, corev1.EventTypeWarning, "KeyDistributionChangeBlocked",
+			"change in key distribution requires approval (set annotation %s=%s): %s",
+			saasv1alpha1.TwemproxyApproveKeyDistributionChangeAnnotationKey, change.Hash, strings.Join(changes, ", "))
+	}
+
+	return change, nil
+}
+
+// clearApproval removes the given approval annotation from the TwemproxyConfig
+func (r *TwemproxyConfigReconciler) clearApproval(ctx context.Context,
+	instance *saasv1alpha1.TwemproxyConfig, key string) error {
+
+	if _, ok := instance.GetAnnotations()[key]; !ok {
+		return nil
+	}
+	patch := client.MergeFrom(instance.DeepCopy())
+	delete(instance.ObjectMeta.Annotations, key)
+	return r.Client.Patch(ctx, instance, patch)
+}
+
+// guardTopologyChanges compares the shard mapping applied in the current ConfigMap with the
+// desired one. Changes in the mapping are only applied if the approval annotation matches the
+// hash of the change. Otherwise the generator is configured to keep the applied mapping, so
+// master changes are still propagated, and the pending change is returned.
+func (r *TwemproxyConfigReconciler) guardTopologyChanges(ctx context.Context,
+	instance *saasv1alpha1.TwemproxyConfig, gen *twemproxyconfig.Generator, current *corev1.ConfigMap,
+	log logr.Logger) (*saasv1alpha1.TopologyChange, error) {
+
+	if current == nil || !*instance.Spec.ReconcileServerPools {
+		return nil, nil
+	}
+
+	applied, err := twemproxyconfig.AppliedTopology(current)
+	if err != nil {
+		return nil, err
+	}
+	if applied == nil {
+		// The ConfigMap was generated before the mapping was tracked, so the applied
+		// mapping is seeded from its config. The annotation is then written along with
+		// the config, which keeps the current targets until a change is approved.
+		if applied, err = gen.SeedTopology(current); err != nil {
+			return nil, err
+		}
+		log.Info("seeding applied topology", "topology", applied)
+	}
+
+	changes := twemproxyconfig.TopologyChanges(applied, gen.Topology())
+	if len(changes) == 0 {
+		return nil, nil
+	}
+
+	change := &saasv1alpha1.TopologyChange{Hash: util.Hash(changes), Changes: changes}
+	if instance.GetAnnotations()[saasv1alpha1.TwemproxyApproveTopologyChangeAnnotationKey] == change.Hash {
+		log.Info("applying approved topology change", "hash", change.Hash, "changes", changes)
+		return nil, nil
+	}
+
+	gen.KeepTopology(applied)
+	if instance.Status.PendingTopologyChange == nil || instance.Status.PendingTopologyChange.Hash != change.Hash {
+		log.Info("topology change blocked, pending approval", "hash", change.Hash, "changes", changes)
+		r.Recorder.Eventf(instance, corev1.EventTypeWarning, "TopologyChangeBlocked",
+			"change in shard mapping requires approval (set annotation %s=%s): %s",
+			saasv1alpha1.TwemproxyApproveTopologyChangeAnnotationKey, change.Hash, strings.Join(changes, ", "))
+	}
+
+	return change, nil
 }
 
 func (r *TwemproxyConfigReconciler) reconcileSyncAnnotations(ctx context.Context,
@@ -223,25 +438,34 @@ func (r *TwemproxyConfigReconciler) syncPod(ctx context.Context, pod corev1.Pod,
 		if err := r.Client.Patch(ctx, &pod, patch); err != nil {
 			errCh <- err
 		}
-		log.V(1).Info(fmt.Sprintf("configmap re-sync forced in target pod %s", client.ObjectKeyFromObject(&pod)))
+		log.V(1).Info(fmt.Sprintf("config re-sync forced in target pod %s", client.ObjectKeyFromObject(&pod)))
 	}
 }
 
 func (r *TwemproxyConfigReconciler) reconcileStatus(ctx context.Context, gen *twemproxyconfig.Generator,
-	instance *saasv1alpha1.TwemproxyConfig, log logr.Logger) error {
-	selectedTargets := map[string]saasv1alpha1.TargetServer{}
-
-	// The TwemproxyConfig api was initially conceived to support several server pools
-	// but this is actually not used, so just assume there's only one pool for simplicity
-	for pshard, server := range gen.GetTargets(gen.Spec.ServerPools[0].Name) {
-		selectedTargets[pshard] = saasv1alpha1.TargetServer{
-			ServerAlias:   util.Pointer(server.Alias()),
-			ServerAddress: server.Address,
+	instance *saasv1alpha1.TwemproxyConfig, pendingTopologyChange *saasv1alpha1.TopologyChange,
+	pendingKeyDistributionChange *saasv1alpha1.KeyDistributionChange, configSecretName *string,
+	log logr.Logger) error {
+	selectedTargets := make(map[string]saasv1alpha1.ServerPoolTargets, len(gen.Spec.ServerPools))
+
+	for _, pool := range gen.Spec.ServerPools {
+		targets := saasv1alpha1.ServerPoolTargets{}
+		for pshard, server := range gen.GetTargets(pool.Name) {
+			targets[pshard] = saasv1alpha1.TargetServer{
+				ServerAlias:   util.Pointer(server.Alias()),
+				ServerAddress: server.Address,
+				Reason:        server.Reason(),
+			}
 		}
+		selectedTargets[pool.Name] = targets
 	}
 
 	status := saasv1alpha1.TwemproxyConfigStatus{
-		SelectedTargets: selectedTargets,
+		SelectedTargets:              selectedTargets,
+		Targets:                      selectedTargets[gen.Spec.ServerPools[0].Name],
+		PendingTopologyChange:        pendingTopologyChange,
+		PendingKeyDistributionChange: pendingKeyDistributionChange,
+		ConfigSecretName:             configSecretName,
 	}
 	if !equality.Semantic.DeepEqual(status, instance.Status) {
 		instance.Status = status
@@ -254,11 +478,33 @@ func (r *TwemproxyConfigReconciler) reconcileStatus(ctx context.Context, gen *tw
 	return nil
 }
 
+// redisAuthSecretToTwemproxyConfigs maps a Secret to the TwemproxyConfigs that read
+// redis passwords from it, so changes in the passwords are propagated to twemproxy
+func (r *TwemproxyConfigReconciler) redisAuthSecretToTwemproxyConfigs(ctx context.Context, o client.Object) []reconcile.Request {
+	list := &saasv1alpha1.TwemproxyConfigList{}
+	if err := r.Client.List(ctx, list, client.InNamespace(o.GetNamespace())); err != nil {
+		return nil
+	}
+
+	requests := []reconcile.Request{}
+	for _, tc := range list.Items {
+		for _, pool := range tc.Spec.ServerPools {
+			if pool.RedisAuth != nil && pool.RedisAuth.Name == o.GetName() {
+				requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&tc)})
+				break
+			}
+		}
+	}
+	return requests
+}
+
 // SetupWithManager sets up the controller with the Manager.
 func (r *TwemproxyConfigReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&saasv1alpha1.TwemproxyConfig{}).
 		Owns(&corev1.ConfigMap{}).
+		Owns(&corev1.Secret{}).
+		Watches(&corev1.Secret{}, handler.EnqueueRequestsFromMapFunc(r.redisAuthSecretToTwemproxyConfigs)).
 		Owns(&grafanav1beta1.GrafanaDashboard{}).
 		WatchesRawSource(&source.Channel{Source: r.SentinelEvents.GetChannel()}, &handler.EnqueueRequestForObject{}).
 		WithOptions(controller.Options{
@@ -0,0 +1,30 @@
+package controllers
+
+import (
+	"context"
+
+	"github.com/3scale-ops/saas-operator/pkg/resource_builders/envoyconfig/factory"
+	"github.com/3scale-ops/saas-operator/pkg/webhooks"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// reportInvalidSpec runs the validations of the admission webhook against the custom
+// resource and reports the errors with a warning Event. The webhook is opt-in, so without
+// it an invalid marin3r sidecar or autoscaling configuration would only show up as a
+// rejected EnvoyConfig or a broken workload. The errors don't block the reconcile of the
+// custom resource, as the resources that are not affected by them must still be reconciled.
+// It must be called before the in-memory spec is modified by the other helpers.
+func reportInvalidSpec(ctx context.Context, recorder record.EventRecorder, instance client.Object) {
+	logger := logr.FromContextOrDiscard(ctx)
+
+	errs, _, _ := webhooks.Validate(instance, factory.Default())
+	if len(errs) == 0 {
+		return
+	}
+	err := errs.ToAggregate()
+	logger.Error(err, "invalid spec")
+	recorder.Event(instance, corev1.EventTypeWarning, "InvalidSpec", err.Error())
+}
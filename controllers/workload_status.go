@@ -0,0 +1,50 @@
+package controllers
+
+import (
+	"context"
+
+	"github.com/3scale-ops/basereconciler/reconciler"
+	saasv1alpha1 "github.com/3scale-ops/saas-operator/api/v1alpha1"
+	"github.com/3scale-ops/saas-operator/pkg/status"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// reconcileWorkloadStatus computes the status of the workloads from the Deployments and
+// Services owned by the custom resource and sets it in the in-memory status. It must be
+// called after the owned resources have been reconciled.
+func reconcileWorkloadStatus(ctx context.Context, r *reconciler.Reconciler, instance client.Object,
+	current *saasv1alpha1.WorkloadStatus, canaries saasv1alpha1.CanaryStatuses) reconciler.Result {
+
+	deploymentList := &appsv1.DeploymentList{}
+	if err := r.Client.List(ctx, deploymentList, client.InNamespace(instance.GetNamespace())); err != nil {
+		return reconciler.Result{Error: err}
+	}
+	deployments := []appsv1.Deployment{}
+	for _, dep := range deploymentList.Items {
+		if metav1.IsControlledBy(&dep, instance) {
+			deployments = append(deployments, dep)
+		}
+	}
+
+	serviceList := &corev1.ServiceList{}
+	if err := r.Client.List(ctx, serviceList, client.InNamespace(instance.GetNamespace())); err != nil {
+		return reconciler.Result{Error: err}
+	}
+	services := []corev1.Service{}
+	for _, svc := range serviceList.Items {
+		if metav1.IsControlledBy(&svc, instance) {
+			services = append(services, svc)
+		}
+	}
+
+	desired := status.Workload(instance.GetGeneration(), *current, deployments, services, canaries)
+	if !equality.Semantic.DeepEqual(*current, desired) {
+		*current = desired
+	}
+
+	return reconciler.Result{Action: reconciler.ContinueAction}
+}
@@ -24,7 +24,9 @@ import (
 	saasv1alpha1 "github.com/3scale-ops/saas-operator/api/v1alpha1"
 	"github.com/3scale-ops/saas-operator/pkg/generators/zync"
 	"github.com/go-logr/logr"
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -32,24 +34,31 @@ import (
 // ZyncReconciler reconciles a Zync object
 type ZyncReconciler struct {
 	*reconciler.Reconciler
-	Log logr.Logger
+	Log      logr.Logger
+	Recorder record.EventRecorder
 }
 
 // +kubebuilder:rbac:groups=saas.3scale.net,namespace=placeholder,resources=zyncs,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=saas.3scale.net,namespace=placeholder,resources=zyncs/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=saas.3scale.net,namespace=placeholder,resources=zyncs/finalizers,verbs=update
+// +kubebuilder:rbac:groups="core",namespace=placeholder,resources=events,verbs=create;patch
 // +kubebuilder:rbac:groups="core",namespace=placeholder,resources=services,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="gateway.networking.k8s.io",namespace=placeholder,resources=httproutes;tlsroutes,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="networking.k8s.io",namespace=placeholder,resources=ingresses,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="core",namespace=placeholder,resources=secrets,verbs=get;list;watch
 // +kubebuilder:rbac:groups="apps",namespace=placeholder,resources=deployments,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="batch",namespace=placeholder,resources=jobs,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="monitoring.coreos.com",namespace=placeholder,resources=podmonitors,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="autoscaling",namespace=placeholder,resources=horizontalpodautoscalers,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="keda.sh",namespace=placeholder,resources=scaledobjects,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="autoscaling.k8s.io",namespace=placeholder,resources=verticalpodautoscalers,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="policy",namespace=placeholder,resources=poddisruptionbudgets,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="grafana.integreatly.org",namespace=placeholder,resources=grafanadashboards,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="external-secrets.io",namespace=placeholder,resources=externalsecrets,verbs=get;list;watch;create;update;patch;delete
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
-func (r *ZyncReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+func (r *ZyncReconciler) Reconcile(ctx context.Context, req ctrl.Request) (res ctrl.Result, err error) {
 
 	ctx, _ = r.Logger(ctx, "name", req.Name, "namespace", req.Namespace)
 	instance := &saasv1alpha1.Zync{}
@@ -60,7 +69,34 @@ func (r *ZyncReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.
 		return result.Values()
 	}
 
+	// The helpers below only modify the in-memory status,
+	// which is written once at the end of the reconcile
+	defer newStatusWriter(r.Client, instance).write(ctx, &res, &err)
+
+	// Report the errors that the (opt-in) validation webhook would have rejected
+	reportInvalidSpec(ctx, r.Recorder, instance)
+
+	// Stop the workloads in maintenance. Their replicas are
+	// set in the in-memory spec before generating the resources.
+	result = reconcileMaintenance(ctx, r.Reconciler, instance, instance.Spec.Maintenance,
+		zync.MaintenanceTargets(&instance.Spec, instance.Status.BlueGreen), &instance.Status.Maintenance)
+	if result.ShouldReturn() {
+		return result.Values()
+	}
+
 	gen := zync.NewGenerator(instance.GetName(), instance.GetNamespace(), instance.Spec)
+
+	// Evaluate the blue/green delivery of workloads. The state is set
+	// in the in-memory spec before generating the resources.
+	targets, err := gen.BlueGreenTargets()
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	blueGreen, err := newBlueGreenDelivery(ctx, r.Client, instance.GetNamespace(), targets, instance.Status.BlueGreen)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
 	resources, err := gen.Resources()
 	if err != nil {
 		return ctrl.Result{}, err
@@ -71,7 +107,22 @@ func (r *ZyncReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.
 		return result.Values()
 	}
 
-	return ctrl.Result{}, nil
+	result = blueGreen.reconcile(&instance.Status.BlueGreen)
+	if result.ShouldReturn() {
+		return result.Values()
+	}
+
+	result = reconcileWorkloadStatus(ctx, r.Reconciler, instance, &instance.Status.WorkloadStatus, nil)
+	if result.ShouldReturn() {
+		return result.Values()
+	}
+
+	result = reconcileResourceRecommendations(ctx, r.Reconciler, instance, &instance.Status.Recommendations)
+	if result.ShouldReturn() {
+		return result.Values()
+	}
+
+	return ctrl.Result{RequeueAfter: minRequeue(blueGreen.requeue, result.RequeueAfter)}, nil
 }
 
 // SetupWithManager sets up the controller with the Manager.
@@ -79,6 +130,8 @@ func (r *ZyncReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return reconciler.SetupWithDynamicTypeWatches(r,
 		ctrl.NewControllerManagedBy(mgr).
 			For(&saasv1alpha1.Zync{}).
+			Owns(&appsv1.Deployment{}).
+			Owns(&corev1.Service{}).
 			Watches(&corev1.Secret{}, r.FilteredEventHandler(&saasv1alpha1.ZyncList{}, nil, r.Log)),
 	)
 }
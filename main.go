@@ -33,21 +33,27 @@ import (
 	operatorutils "github.com/3scale-ops/saas-operator/pkg/util"
 	externalsecretsv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
 	grafanav1beta1 "github.com/grafana/grafana-operator/v5/api/v1beta1"
+	kedav1alpha1 "github.com/kedacore/keda/v2/apis/keda/v1alpha1"
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	pipelinev1beta1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
 	apimachineryruntime "k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	vpav1 "k8s.io/autoscaler/vertical-pod-autoscaler/pkg/apis/autoscaling.k8s.io/v1"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
 
 	saasv1alpha1 "github.com/3scale-ops/saas-operator/api/v1alpha1"
 	"github.com/3scale-ops/saas-operator/controllers"
 	"github.com/3scale-ops/saas-operator/pkg/reconcilers/threads"
 	redis "github.com/3scale-ops/saas-operator/pkg/redis/server"
+	"github.com/3scale-ops/saas-operator/pkg/render"
 	"github.com/3scale-ops/saas-operator/pkg/version"
+	"github.com/3scale-ops/saas-operator/pkg/webhooks"
 	// +kubebuilder:scaffold:imports
 )
 
@@ -73,10 +79,22 @@ func init() {
 	utilruntime.Must(externalsecretsv1beta1.AddToScheme(scheme))
 	utilruntime.Must(marin3rv1alpha1.AddToScheme(scheme))
 	utilruntime.Must(pipelinev1beta1.AddToScheme(scheme))
+	utilruntime.Must(gatewayv1.AddToScheme(scheme))
+	utilruntime.Must(gatewayv1alpha2.AddToScheme(scheme))
+	utilruntime.Must(kedav1alpha1.AddToScheme(scheme))
+	utilruntime.Must(vpav1.AddToScheme(scheme))
 	// +kubebuilder:scaffold:scheme
 }
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == render.CommandName {
+		if err := render.Run(os.Args[2:], os.Stdin, os.Stdout); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+
 	var metricsAddr string
 	var enableLeaderElection bool
 	var probeAddr string
@@ -157,6 +175,7 @@ func main() {
 			WithLogger(ctrl.Log.WithName("controllers").WithName("TwemproxyConfig")),
 		SentinelEvents: threads.NewManager(),
 		Pool:           redisPool,
+		Recorder:       mgr.GetEventRecorderFor("twemproxyconfig-controller"),
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "TwemproxyConfig")
 		os.Exit(1)
@@ -175,6 +194,7 @@ func main() {
 	if err = (&controllers.ApicastReconciler{
 		Reconciler: reconciler.NewFromManager(mgr).
 			WithLogger(ctrl.Log.WithName("controllers").WithName("Apicast")),
+		Recorder: mgr.GetEventRecorderFor("apicast-controller"),
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "Apicast")
 		os.Exit(1)
@@ -183,6 +203,7 @@ func main() {
 	if err = (&controllers.ZyncReconciler{
 		Reconciler: reconciler.NewFromManager(mgr).
 			WithLogger(ctrl.Log.WithName("controllers").WithName("Zync")),
+		Recorder: mgr.GetEventRecorderFor("zync-controller"),
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "Zync")
 		os.Exit(1)
@@ -191,6 +212,7 @@ func main() {
 	if err = (&controllers.MappingServiceReconciler{
 		Reconciler: reconciler.NewFromManager(mgr).
 			WithLogger(ctrl.Log.WithName("controllers").WithName("MappingService")),
+		Recorder: mgr.GetEventRecorderFor("mappingservice-controller"),
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "MappingService")
 		os.Exit(1)
@@ -199,6 +221,7 @@ func main() {
 	if err = (&controllers.CORSProxyReconciler{
 		Reconciler: reconciler.NewFromManager(mgr).
 			WithLogger(ctrl.Log.WithName("controllers").WithName("CORSProxy")),
+		Recorder: mgr.GetEventRecorderFor("corsproxy-controller"),
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "CORSProxy")
 		os.Exit(1)
@@ -207,6 +230,7 @@ func main() {
 	if err = (&controllers.AutoSSLReconciler{
 		Reconciler: reconciler.NewFromManager(mgr).
 			WithLogger(ctrl.Log.WithName("controllers").WithName("AutoSSL")),
+		Recorder: mgr.GetEventRecorderFor("autossl-controller"),
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "AutoSSL")
 		os.Exit(1)
@@ -215,6 +239,7 @@ func main() {
 	if err = (&controllers.EchoAPIReconciler{
 		Reconciler: reconciler.NewFromManager(mgr).
 			WithLogger(ctrl.Log.WithName("controllers").WithName("EchoAPI")),
+		Recorder: mgr.GetEventRecorderFor("echoapi-controller"),
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "EchoAPI")
 		os.Exit(1)
@@ -223,6 +248,7 @@ func main() {
 	if err = (&controllers.BackendReconciler{
 		Reconciler: reconciler.NewFromManager(mgr).
 			WithLogger(ctrl.Log.WithName("controllers").WithName("Backend")),
+		Recorder: mgr.GetEventRecorderFor("backend-controller"),
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "Backend")
 		os.Exit(1)
@@ -231,11 +257,22 @@ func main() {
 	if err = (&controllers.SystemReconciler{
 		Reconciler: reconciler.NewFromManager(mgr).
 			WithLogger(ctrl.Log.WithName("controllers").WithName("System")),
+		Recorder: mgr.GetEventRecorderFor("system-controller"),
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "System")
 		os.Exit(1)
 	}
 
+	// the webhooks are opt-in as they require a serving certificate, see
+	// the [WEBHOOK] and [CERTMANAGER] sections in config/default. Without
+	// them, the controllers report the same errors with warning Events.
+	if os.Getenv("ENABLE_WEBHOOKS") == "true" {
+		if err = webhooks.SetupWebhooks(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhooks")
+			os.Exit(1)
+		}
+	}
+
 	// +kubebuilder:scaffold:builder
 
 	if err := mgr.AddHealthzCheck("health", healthz.Ping); err != nil {
@@ -246,7 +246,7 @@ func dashboardsSystemJsonGtpl() (*asset, error) {
 	return a, nil
 }
 
-var _dashboardsTwemproxyJsonGtpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x9d\x7b\x73\xdb\xb6\xb2\xc0\xff\xcf\xa7\xc0\x65\x73\x4e\xec\x73\xe5\x58\x92\xf5\xb0\x3d\xe3\xb9\x93\x47\x73\xda\x99\x24\x4d\x13\xa7\x77\x7a\x13\x8f\x0e\x44\xc2\x32\x6b\x92\x60\x01\xd0\xb6\xea\x71\x3f\xfb\x1d\x82\x2f\x90\xa0\x64\x89\xd6\x83\x92\xb6\x7f\x34\x16\xc0\x07\xb8\x8b\xc5\xfe\xb0\x58\x12\xf7\xcf\x10\x32\xb0\xe7\x51\x81\x85\x4d\x3d\x6e\x9c\xa2\xb0\x08\x21\xc3\xb1\xb9\x30\x4e\xd1\x37\xf9\x0b\xc5\xa5\xb2\x66\x18\xd8\x8e\xf8\xd9\x33\x4e\x51\xab\x91\x95\x5a\x58\x60\x4e\x03\x66\x12\xe3\x14\x19\x07\x07\xe8\xdf\x0c\x5f\x62\x0f\xa3\x83\x03\x43\x39\x8c\x78\x78\xe8\x84\x87\x08\x16\x10\xa5\xfc\xca\xb6\x4a\x4a\x6d\x93\x7a\x6f\xa8\x43\x59\x78\x4d\x36\x1a\xe2\xbd\x66\x03\xb5\x5b\xad\x06\x6a\x77\xbb\x0d\xd4\xda\x57\x2f\xed\x61\x57\xde\xfb\x55\xf6\x38\xe8\x9f\xe8\x95\x43\x98\xe0\xea\x71\x62\xec\xcb\xe3\x2c\xcc\xaf\x86\x14\x33\xcb\x88\xeb\x1e\xe4\xbf\x17\xcf\x10\x7a\x08\x0f\x37\x88\x65\x8b\x42\x6b\x8d\x91\x47\xc4\xcf\x96\x71\x8a\xbc\xc0\x71\xa2\x12\x86\xfd\xab\x73\x4a\x1d\x61\xfb\xc6\x29\x6a\xca\x42\xdb\x4a\xc4\x63\x38\xb6\x77\x1d\xca\xf5\xdb\x85\xfc\xe9\x63\x8f\x38\x3c\x95\x6c\x22\x57\xc3\xa4\x8e\x83\x7d\x4e\xc2\x13\x2f\xb1\xc3\x53\x31\xe4\x25\x9b\xdc\x56\xd6\x8c\x98\x6d\x7d\xa2\x99\xd2\x22\x49\x16\x14\x73\x6b\x9c\xa2\x76\x47\x29\xb8\x4b\x5a\x19\xff\x1e\x87\xbf\x13\x11\xa4\xd7\x96\x4f\xd0\x4e\x8f\x53\xda\x7d\x91\x96\x31\xe2\x13\x2c\xa4\x2c\x89\xef\xd0\xb1\x4b\x3c\x91\x4a\xda\xe0\x26\xf5\x89\xf5\x1b\x66\x85\x06\x2a\xc7\x9e\xa2\xfb\x07\xed\xce\xc2\x16\x52\xe6\xc6\xf9\x2d\x71\x7d\x46\xef\xc6\xe8\x79\xd9\xf5\x13\x3d\x32\x7a\x1b\x69\x30\xbe\xc4\x7d\xa9\xe0\x8c\xe7\xca\xcf\xf4\x1a\x97\x36\x71\xac\x37\xd4\xbb\xb4\x47\xc5\x46\x5e\xe2\xc0\x11\xf9\xa6\x47\x6a\x92\xbd\x51\x2d\x44\xc8\x70\xa9\xec\xbe\x86\xb8\x62\x84\x5f\x51\xc7\xe2\x86\x72\xc0\x43\x43\xbd\x84\x8b\x7d\xdf\xf6\x46\x79\x59\x46\x4f\x94\x9d\x3d\xf1\x0e\x78\xc8\xa9\x13\x08\x62\x34\xf2\xf5\x5c\x10\x9f\x2b\x06\x9b\xfc\x77\x5f\xf8\xad\x3c\x83\x31\x62\x84\x78\x85\x0b\xc9\x23\x6e\xb0\x13\x24\xbd\xad\x50\xfb\x50\x3c\x7c\xda\x0d\x18\xb1\xa6\x5d\xfe\xb8\x59\xbc\x78\xee\xf7\xc5\xb3\xb2\x1a\xa5\x01\x06\xbd\x21\x8c\xd9\x16\x89\x64\xa9\xf5\xa4\x89\xf6\x71\x5c\xb0\x8f\x56\xfb\x11\xfb\x68\x95\xdb\x47\xa7\x9f\xfe\xce\x9b\x79\xd4\x3a\x3f\x3f\xa6\x66\xb2\xf9\x10\x2b\x33\x12\x84\x72\x2f\x39\x98\x24\xb5\x98\x11\xac\x56\xfe\x11\x70\x61\x5f\x8e\x93\x6a\x93\x78\x82\x30\xf5\x00\xca\x6c\xe2\x45\x43\x9f\x3c\x3f\x10\x54\xad\x66\xc4\x0a\x4c\xf2\x4b\x49\xab\xc2\x76\x61\xc7\xd4\xfb\x8f\xe1\x60\x2e\x3e\x52\xf1\x31\x70\x1c\xb5\x47\xe7\xfb\xad\xb4\xa2\xf0\x64\x23\xa7\xed\xe8\xf1\x78\x32\xa0\x95\x6a\x50\x90\xbb\x68\x18\x28\x94\xe5\x25\xa4\x49\xdf\x77\x82\x91\xed\xfd\x46\x18\x8f\x9f\xb5\xff\xb2\xfb\xb2\xd5\x5d\xc0\xe0\x83\xd9\x88\x88\xbc\x20\x72\x72\x22\x77\xc4\xf5\x1d\xcc\x34\x67\x25\xeb\x7c\xd9\xed\x4d\x1a\x78\x62\x4f\x24\xc3\xd7\x80\xdc\xf9\x94\x09\xc2\x06\x81\x7f\x1f\x7a\x29\xee\x63\x93\x9c\x7d\x37\x8e\xb8\x89\x1d\x72\xc0\x31\xe6\xdf\x8d\x86\x4f\xad\xb3\xbf\xbf\x1b\xdf\xbe\x65\x6d\xbc\xb8\x38\xf8\x86\x0f\xfe\x6a\x1e\x9c\x5c\xfc\x77\xf6\xd7\x77\xe3\x01\x9d\x9d\xa1\xd6\x3e\xa2\x0c\x51\x6f\x6f\x1f\xdd\x10\x53\x50\xb6\xd7\xdc\xcf\xcb\xff\x92\x32\x37\x1a\x9f\x85\xed\x92\x01\x27\xcc\x26\x3c\x7f\x88\x1d\xf6\xa1\x1b\xec\x84\x07\xb5\xdc\xf2\xba\x77\x38\xbc\x7a\xe8\x0e\x72\xd5\x0e\x19\x11\xcf\x7a\x97\xde\x23\x7f\x32\x23\x97\xd2\x4f\x1a\xaf\xb2\x7e\x93\x88\xfb\x42\x19\xeb\x5d\xf2\x8e\x51\xb7\xe8\xd9\xc2\xf2\x2f\x57\xf6\xa5\xd0\x2b\x62\xe7\xf0\xf5\x93\xee\x09\xb8\xc0\x02\x5c\x01\xb8\x82\x85\xba\x82\x5c\xc1\x14\x5f\xd0\xeb\x81\x2f\x00\x5f\xa0\xd4\xad\xd2\x17\x34\x37\xcf\x17\x34\xaa\xcb\x34\x7f\xf5\x78\xea\x98\x9b\x34\x69\xcf\x63\x54\x69\xee\xeb\xe5\xb8\xae\xb7\xbf\xfc\xef\xc7\x79\x9c\x17\x76\x6c\xcc\xe5\x34\x98\xe7\xcc\xc3\x18\x46\x5d\xba\x38\x5d\xe4\x57\xef\x89\x37\x12\x72\x22\xd8\xcc\x95\x93\xb2\xc3\x17\xe3\x19\xe7\x18\x8b\x2f\x6d\xc7\x51\x27\xa9\xb2\xe0\xdf\x0c\x5b\x76\x64\x8a\xcd\x25\x00\xfc\x89\xd6\x8a\x2b\xdb\xb2\x88\xf7\x25\xb2\x83\xa2\x54\xe4\x88\x7e\x74\x92\x8d\xe8\xb2\xc3\xe4\xdb\x81\x6f\x46\x7a\xb7\x33\xcc\x80\xb1\xe8\x31\x8a\x35\x2e\xbe\x2b\x2b\xb5\xbd\x92\x52\x7e\x45\x6f\xf5\x70\x88\xa0\x42\xf6\xe6\xe2\xd1\xa5\x63\xeb\x83\xea\x8e\x64\xad\x7a\x35\x59\x78\x6b\x5b\x22\x17\x2e\x28\xf3\x5c\x61\x1f\xfe\x44\x6d\x2f\x1d\x86\xc3\x02\x63\xba\x63\xc3\x0e\x61\xe2\x3c\x41\x98\xf8\xd6\xfa\xc0\x4d\x58\xe8\xb7\xf0\x48\x33\xdf\xc7\xc7\x74\x3f\x6c\x51\xd8\x65\x02\xae\x0e\x46\x51\xb9\xae\x4f\x46\x3c\x8b\x30\x22\x47\x8f\x4b\x87\x2e\x20\x4a\x11\x0d\xa0\xbf\xa8\x3d\xbd\x7c\x40\x93\xd6\x2b\xc7\x5d\xa9\xbd\x82\xb3\x94\x96\xd0\xc9\x0f\x4e\x8a\x66\x9a\xb9\x9a\x31\xbe\xb3\xe5\xe3\x4e\x19\x96\xa4\x3f\x29\xb3\x7f\x2e\xb0\x79\xad\x09\x26\x64\x45\x9f\x58\xef\x6d\x4f\x57\xc2\x42\xfc\x1f\x0f\xdc\x3d\x86\x05\x29\x73\x81\x9c\xb0\x1b\xc2\x06\x8c\xfc\x19\x10\x2e\xf8\x40\x4a\x28\xe7\x15\x9f\xa7\x3f\xe6\x73\x8a\x0d\x9f\x52\xe7\x2c\x3c\x36\xfc\xe3\xe2\xe2\xbb\xf1\xf0\xad\xe5\x5e\xec\xef\xa3\xe1\x18\xed\x45\xf7\x7d\x9a\x83\x7c\x92\x7b\xbc\xbf\xff\xcf\xfd\x7d\xd4\x8a\x87\x87\xff\x3c\x3c\x2c\xd7\x5d\xd6\x4c\x05\x4f\xf6\xde\x73\x0a\xbb\xc4\xee\x4a\x3d\xfc\x04\x09\x4f\x6c\x60\x7a\x91\x37\x53\x31\x41\x9d\xc8\x7d\x9b\x0d\x1f\x3e\x93\x51\x3c\xa6\x16\x4e\x98\xce\x15\x5f\xa4\x2e\xd1\xe7\x58\x97\xe8\x3c\x7c\x70\x84\x12\xdd\x1e\x72\x62\x52\xcf\x42\x7b\xc3\x31\xe2\x57\x98\x59\xfb\x0a\x84\xa4\xf1\xeb\x7b\xd5\x09\x61\x46\x2c\xdd\x0d\x71\xca\x44\x5e\xea\x91\x07\x1a\x24\x28\x63\x7b\x96\x7d\x63\x5b\x01\x76\x74\x5a\x4f\x8e\x91\xb3\x9b\xac\x01\x77\xf1\xd0\x96\x5b\x67\x30\xaf\xa3\xe1\x47\x7d\x5c\x75\x86\x6d\xbb\xa4\x64\x01\xa0\x70\x74\xb9\x2b\x4d\x5d\x66\x09\x9f\x8c\xf1\xdd\xb4\x01\xdd\x22\xa6\xed\x62\x19\x0b\x6f\x4e\x18\x41\x18\xf9\xd3\x2f\x8c\x1d\x0e\x1e\x12\x47\x6b\x5d\x58\x41\x47\xaf\x31\x27\xf9\xa8\x7d\x8a\x0b\xda\xe1\x11\x2f\x18\xcd\xfc\xd5\x95\x87\x7c\xb4\x43\x6f\x4c\xfb\x35\x5b\x1a\xeb\x9d\x04\x3b\xf6\xa8\x0c\xa0\x64\xf9\x7b\x72\x93\xb6\xf9\x99\x7a\x51\x20\xec\xa7\x13\xb6\x16\x17\xa9\x82\xd8\xbd\x2e\x20\x36\x20\x36\x20\xf6\xd6\x21\xb6\x4f\xad\x75\xf3\xb5\x4f\x2d\x80\xeb\xba\xc0\xf5\x86\x70\x71\xae\xdb\x02\x15\x03\x15\xd7\xaf\xfd\x40\xc5\x75\xa6\x62\x2d\x71\xa4\x5f\x81\x8a\x4f\x5a\x40\xc5\xdb\x4f\xc5\x40\xb7\x8b\x03\x2c\xee\x53\x8f\x13\x88\x20\xa7\xbd\x6e\x0d\x90\xbb\x42\x1d\xd4\x95\x72\x27\x88\x78\x03\x43\xc8\xb1\x32\x21\x86\x8c\x80\x96\x37\xb9\xfd\x40\xcb\x75\xa6\x65\x3d\xb7\xae\x12\x2e\xb7\x01\x97\x01\x97\xb3\x67\x02\x5c\xae\x11\xaa\x41\x34\x18\x40\x79\xc7\x41\x19\x82\xca\x80\xc9\x35\x6f\x3f\x60\x72\x9d\x31\xb9\x18\x54\x6e\x77\xab\x50\xf2\x11\x50\x32\x50\x72\xf6\x4c\x40\xc9\x33\xae\xda\x0f\xc7\x02\x22\xcb\x28\xed\x7a\xeb\x4b\x9f\x58\x99\x22\x80\x9a\x97\x4d\xcd\xb9\x4c\x0c\xa9\x57\x08\x29\x2f\x9f\x95\x2d\x62\x4a\x59\xaf\x1d\x37\xab\xe3\x72\x6d\x1e\x01\x88\xb9\xce\xc4\xac\x05\x96\xab\x21\x73\x07\x90\x19\x90\x39\x7b\x26\x40\xe6\xba\x91\x1a\x44\x97\xd7\xae\x02\x80\xe5\x35\xc1\x32\x84\x95\x01\x95\x37\xe2\x11\x00\x95\xeb\x8c\xca\xc5\xe0\xf2\xd1\x51\x15\x52\x86\xf7\xf8\x80\x94\x95\x67\x02\x52\x9e\x35\x09\x00\xa2\xcb\xca\xd1\x6b\x4d\xc7\x00\x62\xde\x1a\x62\xce\x27\x65\x40\x7c\x39\xdf\x60\x80\xe6\x0d\x78\x04\x80\xe6\x3a\x43\xb3\x16\x5f\xae\x46\xcd\x3d\xa0\x66\xa0\xe6\xec\x99\x80\x9a\x6b\xc7\x6a\x10\x60\x5e\xbf\x0e\x80\x97\xd7\xc5\xcb\x10\x62\x06\x5a\xde\x88\x47\x00\x5a\xae\x33\x2d\x17\x43\xcc\x1d\xfd\x13\xfa\x33\x7c\x2a\xae\x0f\xb0\xbc\xfd\xb0\x0c\x9f\x8a\x5b\x2a\x64\x97\x70\x5d\x6c\x22\x03\x93\x7a\x1e\x31\x65\xf7\x59\x08\xce\x3d\xec\x0c\x31\xaf\x52\xa8\xb5\x85\xe1\x75\x72\xec\x9b\x48\xda\xe8\x4d\x26\x6d\xc0\xd7\x65\xe3\x2b\xbf\x0a\x45\xb4\x6e\xf0\xab\xce\xae\xf5\x68\x3f\x80\x6b\x9d\xc1\x55\x0b\xf3\x56\x22\xd7\x63\xd8\x47\x04\xc8\x15\xc8\x75\xf1\xe4\xea\xd8\x05\xc6\x1a\x60\x53\xd8\x37\x64\x59\xe1\xc8\x9d\x26\xda\x55\x0b\x1b\x48\xb7\x8c\x74\xa5\x16\x72\xa0\xfb\x4a\x6a\x01\x78\x17\x78\x77\x13\xda\x0f\xbc\x5b\x67\xde\xd5\x02\xb5\x95\xf6\xf4\x38\x06\xdc\x05\xdc\x05\xdc\x7d\x72\x36\xc4\xe4\x45\xf8\x15\x52\xd8\xae\xa6\x0d\xd7\x42\xf8\x80\xc0\x3a\x02\xc7\x49\x0b\x13\x10\x18\x32\x7c\x01\x82\x6b\xdf\x7e\x80\xe0\x3a\x43\xb0\x1e\xf4\xad\x42\xc1\x27\x4d\xa0\x60\xa0\x60\xa0\xe0\x2d\xa2\xe0\x9d\x4a\x03\xae\x85\xd8\x81\x7f\xe7\xe5\x5f\x08\x01\x03\xfd\xd6\xbc\xfd\x40\xbf\x75\xa6\xdf\x62\x08\xb8\x5b\x65\x47\x8e\x1e\x64\x3c\x00\xfc\x02\xfc\x3e\x0d\x7e\x27\xbd\x88\x15\xaf\xc4\x13\xc6\x56\xf0\xf2\xd5\xce\xf0\x6e\x2d\xa4\x0d\xc0\x3b\x39\xe7\xe1\x47\xc6\x28\x4b\x5e\x51\x8b\x3f\x73\x07\xbb\x6c\xe4\x1b\xbc\x34\xf2\xad\xc7\x2e\x15\xd5\xc9\xb7\x1e\xed\x07\xf2\xad\x33\xf9\x6a\x71\xdf\x4a\xe8\xdb\x87\xb8\x2f\xa0\x2f\xa0\xef\x72\xd1\x97\x5e\x02\xfa\xae\x0e\x7d\x57\x22\x6d\x40\xdf\x29\xe8\x4b\x2f\x81\x7b\x81\x7b\x37\xb6\xfd\xc0\xbd\x75\xe6\xde\x62\xc4\xb7\x57\x65\xab\x8c\x7e\x0b\xb0\x17\xb0\x17\xb0\x77\xd9\x9f\x40\x5b\x51\x1c\x72\x57\xb3\x7e\xeb\x21\x7d\x40\x61\x1d\x85\xe3\xb4\x07\x19\x05\xce\xc3\x70\x6e\xaf\x65\xc8\xff\x05\x1e\xae\x7d\xfb\x81\x87\xeb\xcc\xc3\x5a\x1c\xb8\x1a\x10\xb7\x01\x88\x01\x88\x01\x88\xb7\x09\x88\x77\x2a\x01\xb8\x1e\x72\x07\x14\xae\x8c\xc2\x10\x18\x06\x10\xae\x79\xfb\x01\x84\xeb\x0c\xc2\xc5\xc0\x70\xbf\xca\x1e\x17\xfd\x23\xe0\x60\xe0\x60\xe0\xe0\xa5\x73\xf0\x6a\x56\xe9\x21\x30\xbc\x4e\xe9\x03\x0d\x4f\xa6\x61\xfa\x0e\xc2\xc2\x40\xc3\x9b\xdc\x7e\xa0\xe1\x3a\xd3\xb0\x16\x16\xae\x86\xc3\x1d\xc0\x61\xc0\x61\xc0\xe1\x6d\xc2\x61\x08\x0b\x03\x08\xc7\xc2\xad\x3d\x08\x43\x50\x18\x30\xb8\xe6\xed\x07\x0c\xae\x33\x06\x17\x83\xc2\xc7\x55\x76\xc4\xe8\x77\x81\x82\x81\x82\x81\x82\x97\x4d\xc1\xa1\xab\xa4\x81\x58\xdd\x4e\xc9\x10\x19\x5e\xa7\x0a\x80\x8a\x27\x52\xf1\x79\xac\x06\x88\x11\x03\x1c\x6f\x72\xfb\x01\x8e\xeb\x0c\xc7\x5a\x8c\xb8\x1a\x1d\xf7\x80\x8e\x81\x8e\x81\x8e\xb7\x8e\x8e\x21\x50\x0c\x5c\xbc\x79\x5c\x0c\x21\x63\xa0\xe2\x9a\xb7\x1f\xa8\xb8\xce\x54\xac\x85\x8c\xab\xec\xa7\xd1\xef\x03\x14\x03\x14\x03\x14\x2f\xe5\xbb\x6a\x43\x6c\x5e\x13\xcf\x4a\x17\xf1\xff\x48\xf6\x77\x80\xaf\xac\x2d\x9d\x87\xd7\x28\x7b\x00\xe3\x12\x30\x7e\x1d\xe9\x03\x25\xe9\x14\x89\x3e\x80\x85\x61\x7b\x8d\x4d\x68\x3f\xb0\x70\x9d\x59\x58\x8f\x10\x57\x82\x61\xd8\x62\x19\x60\x18\x60\x78\x39\x30\x7c\x49\xd9\x2d\x66\xd6\x80\xc8\x0d\x07\x00\x81\x97\x8f\xc0\x2b\x97\x38\x80\x6f\x09\xf8\xbe\x8b\xb4\x90\xfb\xae\x04\x30\x2f\x30\xef\x06\xb4\x1f\x98\xb7\xce\xcc\x5b\x8c\xff\x9e\x54\xda\x57\x03\xb6\x94\x03\xe4\x05\xe4\x5d\xca\x7e\xca\xb6\x67\x52\xd7\xf6\x46\x83\x3f\x03\x12\x2c\x79\x13\xe5\x1d\xcc\x10\x5e\x9f\xc4\x01\x73\x75\xcc\xfd\x39\x16\x3d\x92\xa2\x87\x4c\x60\xc8\x79\xd8\xcc\xf6\x03\xf3\xd6\x99\x79\xb5\x38\x6f\x25\xe8\x3d\x86\xcd\xe4\x00\x7a\x01\x7a\x37\x1e\x7a\x77\x2a\xf1\x17\x70\x77\xc3\x70\x17\x02\xbc\x00\xbb\x35\x6f\x3f\xc0\x6e\x9d\x61\xb7\x18\xe0\x6d\x35\xab\xec\x98\x71\x0c\x5b\xc8\x01\xec\x02\xec\x2e\x05\x76\x69\x20\x46\x14\x22\xbc\x2b\x44\xde\x15\x49\x1c\x90\x57\x47\xde\x5f\x62\xd1\x43\x84\x17\xa0\x77\x93\xdb\x0f\xd0\x5b\x67\xe8\xd5\x22\xbc\x15\xa9\x17\xf6\x89\x03\xea\x05\xea\xdd\x78\xea\xdd\xf5\x10\x2f\xf0\x2e\xaa\x31\xef\x42\x88\x17\x68\xb7\xe6\xed\xdf\x1d\xda\xb5\x08\x37\x99\x2d\x91\x26\x37\x3e\x6d\x24\x06\x6b\xb1\xdf\x56\x95\x6d\x31\x8e\x61\x97\x38\xa0\x60\xa0\xe0\x15\x24\x3a\x0c\x86\x63\x41\x38\x44\x80\x57\x9d\xf4\xb0\x64\xb9\x03\x17\xeb\x5c\x5c\x48\x7d\x90\x0a\x80\xe8\xef\xf2\x79\xd8\x22\xa6\x94\xf5\xda\x91\xb2\x3a\x12\xd7\xe6\x11\x80\x8a\x37\x92\x8a\xf5\xe0\x70\x35\x2c\x86\xdd\xe2\x00\x8b\x01\x8b\xb7\x04\x8b\x77\x3d\x44\x0c\x40\x5c\x57\x20\x86\xf0\x30\xe0\xf0\x46\x3c\x02\xe0\xf0\x46\xe2\xb0\x16\x24\x6e\x57\xd9\x17\xe3\x18\x76\x8d\x03\x1a\x06\x1a\x5e\x41\xaa\x04\x04\x89\xd7\x93\x36\x01\x4c\x1c\xf7\xf4\xb5\x25\x4f\x40\x90\x38\xdf\x60\xa0\xe2\x0d\x78\x04\xa0\xe2\x8d\xa4\x62\x3d\x48\x5c\x0d\x8b\x61\xbb\x38\xc0\x62\xc0\xe2\x2d\xc1\xe2\x5d\x0f\x12\x03\x10\xd7\x15\x88\x21\x48\x0c\x38\xbc\x11\x8f\xb0\x3a\x1c\x36\xa9\xe3\x60\x9f\xcb\x2e\x3f\x85\x65\x73\x86\x36\x91\x17\x5b\x05\x5e\x6c\x77\x0a\xbc\xa8\x47\x51\xf5\xbd\x23\x24\x11\xb6\xb2\x6c\x5a\x1f\x7b\xc4\x29\x8c\x01\x8c\xf8\x24\x51\x59\x4a\x0f\x4f\x26\x8d\x74\x00\xf9\x44\x2d\x8e\xf6\x9e\x67\xc7\xab\xa3\x46\x6c\xcb\x8c\xde\x1a\xe5\x12\xc5\xe6\x15\x89\x37\xa5\x2c\x4a\xce\x0c\xe7\x1d\xaf\xb1\x79\x3d\x62\x34\xf0\x8a\xc3\x4c\x54\xfd\x5b\x68\xa5\x9a\x36\xcc\x64\xc6\x92\x19\xa8\xf1\xc3\xbb\x76\xe7\xa4\xfb\x46\x1d\x0e\xd8\x68\x88\xf7\xda\x47\xfd\x46\x28\xda\x06\xea\x34\x1b\xa8\xf9\xf2\xf8\x44\x75\x13\xc6\x0f\xed\x93\x13\xb3\xd3\x33\xb4\x4e\x36\xd3\xec\x45\x37\xa2\xc5\xcf\x5c\x52\x83\xf4\xa8\xa7\xdc\x7c\x84\x03\x49\xb0\xca\x1d\x5c\x7c\x97\xc8\xab\xd5\x6c\xe6\x69\x3f\xa9\x68\xea\x03\x63\xd1\x66\x52\xe7\xf4\x3e\xb4\x75\x8d\x6a\xd5\x23\x3e\x60\x76\x4d\x18\x9f\x84\xda\x13\x6d\xe3\xa8\x60\x1b\xbd\xc7\x4c\xe3\xa8\xa9\x5d\x3c\x74\xef\x61\xcf\x4a\x60\xb8\xd8\x7f\xa4\xe9\x1c\x65\x8a\x29\xf5\xfa\x65\x53\x0f\x17\xfb\xbe\xed\x8d\xce\xa3\xbe\xdd\x2a\x2b\x9f\xe2\x1c\x62\x1f\x14\xf9\x17\x24\x28\x12\xe4\xae\xf0\x45\xf5\x9b\x44\x49\x8f\x0e\xd3\xc9\xc5\x18\xf6\x46\x8f\x5c\x6c\x1a\x9d\xbb\xf8\xee\x2d\x16\xf8\x53\x32\x4b\x51\x7a\x87\x3e\xd1\x32\xa9\xe7\x11\x53\x10\xcb\xc8\x1d\x73\x1e\xde\xb9\x60\xc1\xda\x7c\xa9\xf7\xb2\xfd\xb2\xa3\x4e\x97\xb8\xb8\xb4\xef\xf2\x12\x8f\x0b\xdf\x51\x4f\x7c\xb1\xff\x92\xb7\xec\x36\xff\xa1\xd4\x33\xa2\x9f\x23\xcb\x26\x9e\x22\xc5\xf3\x01\xfb\x53\xd4\x72\x19\x51\x55\x7e\x1a\x29\x6b\x44\xf4\x60\xc6\xc7\xc3\x57\x85\x0a\x9a\x9e\x30\x45\xb6\x95\x67\x72\x3e\x66\xd7\x4e\x34\x01\x52\x4e\x0b\xe7\x66\x32\x20\x23\xd5\x1e\x0e\x60\x47\xad\x06\x6a\xb5\x8e\x1b\xa8\x75\x7c\x12\x0e\x60\xad\xe3\xdc\x00\x76\x19\x38\x65\x93\xf3\xf0\xca\xea\x75\xa2\xcb\xb4\x9b\x0d\xd4\x3a\x39\xca\x5d\x40\x1d\x05\x74\x2f\x80\x87\x4e\x78\x9d\xc0\x2d\x04\x68\x66\x98\xa5\x65\xf3\x82\xeb\x60\x48\x06\x99\x40\x06\x5c\x60\x11\xf0\x01\x23\xbe\x63\x9b\x98\x0f\xf0\x0d\xb6\x9d\xf0\x4e\xca\xcc\xe0\x45\x36\x31\x78\xd1\xc8\xce\x3d\xfb\xfb\x85\xe2\x8a\x5e\x14\x99\x7f\x8e\xc9\x55\xca\xf8\xad\xa9\x8c\x3f\xc3\xac\x49\xeb\x13\x39\xbc\x37\x5a\x8d\xb6\x51\x46\xf8\xc6\x51\x93\x1b\xa5\x24\x5f\xac\x89\x3d\xf1\xe7\xc0\xf3\x42\x92\xf7\xa9\xc5\x75\x1f\xcc\x6d\x6f\xe4\x90\x50\xb4\x59\x9d\x1c\x1c\x54\xb3\x39\x56\xcd\x46\xd6\x4e\x37\x1b\x1a\xce\x08\x8c\xb3\x72\x8b\x69\x96\x8f\x44\x8f\x9a\x8c\x3c\xf0\x63\x3c\xb4\xe1\x9b\xd1\x72\xd0\xe1\x53\x32\x8e\x94\xb0\xc3\x1c\x58\x11\xf3\xc1\xbc\x58\x11\xd3\x08\x60\x45\xd6\x6b\xd6\x8f\x15\xbd\x85\x61\x45\xbb\x0c\x2b\x72\x5d\x14\xc0\xe2\x29\x60\x01\xe0\x00\xe0\x30\x03\x38\x04\x5e\x9d\xd0\x61\xad\x70\xf0\x35\x13\x05\x00\x02\xc4\x16\x62\xed\x00\x04\x68\x10\xa0\x2f\xd3\x56\xa6\x80\x3e\x04\x17\x20\xb8\x00\x8c\xb0\x3e\x46\x30\x69\xe0\x89\xbd\xe4\xff\x9e\xc0\xb6\x47\xd8\xc0\x25\x2e\x65\xe3\xc1\x2d\x65\xd7\xb6\x37\x1a\x70\x22\xb4\x75\xc7\x1c\x22\xc8\x55\x47\x95\x0d\xca\x56\x1c\x5f\xc4\x0b\xba\x1e\xb5\x48\x71\xc3\xd1\xad\x8e\x3a\xc8\xf8\xbf\x65\x73\xc1\xec\x61\x20\x88\x85\xa8\x87\xae\x28\x17\x40\x18\x8b\x24\x8c\x8a\x61\x06\xab\xd3\xc1\x47\x18\x08\xa3\x5e\x84\x71\xbc\x30\xc2\xe8\x41\x9c\x61\xa9\x8c\x01\x0c\xb1\xf3\x0c\xe1\xe2\xbb\x3d\x1e\xb8\x7b\x16\x71\x04\x8e\x22\x0e\x3e\xb5\x06\x19\x4e\xa4\x41\x07\x2e\x30\x13\xfa\x7e\xe6\x55\x58\xe2\x5b\xd7\xbd\xd8\xcf\x32\xc4\x76\x89\x27\x3e\xe0\x3b\x19\xa0\x40\x89\x40\xd1\x9e\x83\xb9\x40\x5d\xe4\xda\x5e\x20\x08\x2f\xc9\x2f\xd8\x36\xb0\x58\x77\xde\x75\x0d\xd3\xab\xfb\x05\xa7\xfa\x78\xba\xcc\x51\x95\x4f\x70\xb4\x16\xbb\x2b\x49\xe8\xc5\x7f\x74\x7d\x31\xd6\x13\xcc\xc2\xaa\xff\x23\x8c\xea\x35\xdb\x94\x92\x8d\x30\x47\x7f\x85\x4f\x99\x1e\x56\x8b\xd4\xec\x6e\xbe\x7c\x4d\xa9\xd9\xeb\xca\x8f\x8e\x1c\xdb\x23\xc1\xf3\xf9\x03\xe6\x4f\x77\x52\x33\x64\x2b\x67\x37\x94\x49\xcb\x07\xb2\xab\x1f\xe4\x22\xda\xca\xc9\xef\x6d\xef\xba\x2c\xbd\x50\xf1\x70\xb9\xf2\x50\xb0\x52\x01\x8f\x92\xeb\x8c\x52\x7c\x4a\xee\xc2\xb2\x9d\x7e\xa9\x3c\x65\x7b\x4b\xe4\x59\x9a\xb8\xfc\x44\xd9\x3c\x69\x79\x66\x2d\xd2\x89\x5b\x3c\x4d\x3e\x6f\x66\x97\xcf\xd6\x86\xa7\x02\x4e\xac\x83\x7c\x10\x28\x2f\xa4\xb7\x35\xc9\x7e\xff\x44\x2d\x24\xe5\x8f\xf6\xe4\x40\xd2\x40\x52\xbf\x0d\x14\x78\xe1\xbf\xfb\x08\x7b\x56\x44\xa3\xf2\x69\xb2\x38\x57\xe8\x65\xb2\xcb\x41\x72\xfc\x22\x93\xe3\xf9\x55\x28\xa2\x7c\x07\x5b\x68\x5a\x79\xfe\xc6\xf3\xe4\xc5\xaf\xb0\x91\x5a\x71\xc9\x3c\x77\xa7\x5e\x06\xad\xe1\xa4\xa4\x37\xf7\xa4\xa4\x53\x1a\xe9\x7b\x64\x52\x72\x02\x73\x12\x78\x4d\xb4\x6c\x2e\x12\xbd\xda\x41\xac\xd7\xe3\xcf\x7a\xb0\x7b\x7b\x66\x2a\xeb\x0e\xbf\xad\x04\x35\xe7\x78\x0d\x73\xcd\xc4\x34\x5b\x68\xae\x02\x13\x35\x77\x94\x89\x6a\x40\x15\x9b\x42\x3f\x3b\xfa\xea\x5f\xa7\xa7\xf5\x29\x49\x06\xd9\x07\x83\xd7\xf0\xe6\xdf\x9b\x4f\x5f\xd1\x57\x8e\x47\xa4\xf2\xeb\x7f\x80\x94\x0b\x88\x73\x77\xfa\x15\x90\xb2\x97\xf5\x9c\x09\xb9\x69\xf0\x6d\x11\x08\x64\x6f\x0e\x1e\x7a\xd4\x22\x83\x14\xf4\xf2\x88\x78\x9a\xc1\xa2\xe9\x07\x83\x20\x1c\xb2\x06\xd1\x6e\x69\x31\x2e\x9e\xf2\xc0\x1d\xd8\x0c\x0b\x35\x0e\xf9\x77\xf5\x28\xdb\x62\xa0\xb1\xf2\xb7\x3b\x16\x16\xfb\x5e\x27\x68\xa6\xde\x05\xe2\x6c\x35\x67\x4a\x08\xa7\x29\xe5\x9b\x42\x94\xdd\x4e\x39\x51\x76\xd7\x4d\x94\xbf\x06\x54\xe0\x15\x11\xa5\x29\x13\x83\x0a\xcf\xb8\x89\x98\xa9\xa4\xb9\xb4\x9a\x6a\x9e\xcb\x02\x39\xb3\xab\xef\x77\x1d\xa5\x4c\x00\x33\xce\xcc\x8c\x3e\x1e\x91\x58\x51\xf9\x44\xc3\x29\x40\xb8\x76\xde\x33\x19\x95\xfd\x2d\x27\x99\xe9\x14\x78\x45\x6f\x7f\x22\xd8\x22\xc5\x4f\x82\x25\x5e\x59\xb9\xbd\x49\x9d\xc2\x00\x66\x11\x6e\x4e\x54\xd3\x02\x01\x93\x8b\xb1\x33\xd3\x37\xf2\xce\xf3\x2e\x5c\x71\x01\x06\x0e\x04\xcd\x57\x59\x58\x90\x8c\xd2\x7e\xff\xfd\xf7\xdf\x0f\x3e\x7c\x38\x78\xfb\x16\xfd\xf4\xd3\xa9\xeb\x9e\xf2\x02\xf5\xf9\x58\x08\xc2\xbc\xf2\xdb\x24\xc3\x5d\x34\x9f\x7b\x7c\x61\x39\x6d\xb1\x0e\x4f\x8f\x35\x5b\x66\x9d\xc7\x9d\x57\xf3\x95\x59\x4a\xfa\xc5\x53\x9e\x55\x59\xfb\x2b\xb0\x6d\x84\xaa\xfa\xa7\xd8\xc2\x8a\xf3\x94\xfa\x8c\xb7\xcc\x76\x1c\x64\xd1\x5b\xcf\xd0\x0e\xfb\xca\x4a\x3e\xd8\xa6\x48\x57\xe6\x88\xa3\x1f\x8a\x39\xb2\xe5\x40\x9b\x93\xbe\x17\xb8\x43\xc2\xf2\xe7\x05\x9e\xad\x70\xcb\x7c\x8a\xf9\x1c\xef\xd8\x0c\xba\x29\xe8\xe6\x75\x7d\x74\x83\xfe\x01\xda\x29\x68\xe7\xcd\x62\xb5\x13\xbb\x3c\xf9\x73\x3e\x1d\xbd\xb7\x5d\x1b\xac\x47\xd3\xcf\xdb\xf5\x5b\x4f\xa4\x19\xb0\x1d\x4d\x37\x3f\xd6\xc1\x76\x3e\x51\x6b\xa3\x14\xa3\x7d\x4b\x76\x7e\xbd\x1c\x5a\x87\xbd\xee\x71\x8b\x74\x7a\xa4\x43\xba\x66\x7f\x88\x3b\x4d\xdc\xec\xf7\x3a\xbd\xa3\x93\x2e\xb9\xec\x0f\xbb\xdd\xc3\xeb\x60\x48\x98\x47\x04\xe1\x07\x26\x75\xfd\x40\x90\x03\x46\xa2\x29\x1c\x3f\xf0\xa9\xf5\x3f\x37\x98\x1d\x64\x61\xc1\x2c\x28\xf8\xcf\xb0\xc2\xa7\xd6\xd9\xf3\xc1\xc0\x24\xc5\xd7\x72\x94\x4e\xe0\x17\x05\xbf\x6a\xdb\xdc\x0c\xb5\x2b\x12\x3b\x7c\xf9\xaf\xc3\xf9\x45\xc6\x05\xb3\xbd\xd1\x6c\x22\x8b\xff\x52\x22\x92\x5b\x1a\x6e\xc6\x43\x87\x14\x03\xcd\x5c\x60\x39\xdb\xd3\x2c\x6c\xce\x18\xf4\xc4\x5c\x85\x5c\xf9\xfc\x49\xd5\xe9\xb7\x5d\xf2\xa9\x1e\x89\x59\x0e\x58\x4c\x69\xf7\xa1\x76\xcf\x5e\x98\x94\x91\x17\x8d\xad\x14\xe5\xeb\x45\x88\xb2\x7e\xbd\x15\x1d\x22\xd0\x71\x96\x31\xbe\x64\x73\x71\x24\x96\x6d\xbf\x20\xdf\xee\xac\xb1\xec\x8a\x86\x7f\x5c\xcd\x92\xe5\xe3\x2b\x93\x72\x95\x62\x2d\xd9\x6e\x0c\x7b\x3c\x54\x82\xae\x82\x94\x83\x64\xf1\x01\x75\x2c\x58\xb9\xdc\xbd\x95\xcb\x67\xa8\x7e\x8b\x8d\xbd\xb6\xd6\x09\xa2\x2c\xa4\xf4\xe7\x1a\x16\x1b\x3f\xc8\xf7\xac\x20\x83\x6d\xfd\x19\x6c\xbd\x2a\x6f\x6a\x67\x69\xf3\xb0\xec\x08\xa9\x6a\x1b\x94\xaa\x36\xd7\x9b\x9e\x79\x76\x43\xb3\xc1\x5b\x03\xa5\xf7\xf8\xaf\xb3\x17\x90\x94\xb6\xb8\xa4\x34\xd5\x67\x40\x5e\xda\xd3\xe8\x6e\xe9\xbb\x07\x6d\x23\xdd\xa9\x62\xde\xca\xbc\xb4\xbe\xfe\x82\xa4\x74\xf7\xfd\x1a\xa0\x22\xa4\xa6\xd5\x34\x35\xad\xaf\x6f\xa4\x1a\x7d\x1f\x0f\x18\x11\x52\xd3\x96\x90\x9a\x56\x4a\xca\x90\x99\x36\xcb\x52\x64\x29\x41\x3d\xd6\xf2\x3a\xac\x46\x6f\x60\x72\x5a\xba\x45\xe3\xdc\xea\x81\x14\xb5\x09\x1a\x5a\x70\x8a\xda\xd3\x35\x04\xc9\x36\xf5\x4c\x54\x8b\xd5\x04\xb9\x6a\xab\xc8\x55\xab\x6e\x46\x90\xb1\x36\x41\x43\x90\xb1\x06\x19\x6b\x0b\xd4\x3f\x64\xac\x95\x8a\x6c\x75\x19\x6b\x4f\x88\x3a\x2f\x2d\xe8\xbc\x03\xa9\x69\xa1\x64\xb7\x34\x17\x63\x21\xa9\x69\x6b\xec\x96\xf3\xe7\xa0\x6d\xb1\x32\x57\x9b\x83\xb6\xc5\x82\x5c\x48\x0e\x5a\xbd\xad\x62\x57\x54\x59\x8b\x64\x33\x75\xe9\x01\xf2\xcd\x20\xdf\xac\x76\x2b\x92\xcf\x50\x0d\x17\x11\x8f\xb5\x4e\x10\x7d\xaa\x60\xad\x5f\xb7\xf8\x48\x44\x38\x8e\x43\xc6\xd9\xfa\x3f\xc3\xdb\x3f\xd1\xda\x31\xc3\xde\x20\xd9\x1a\x34\x2c\x27\x6e\xeb\x27\x75\xb7\x2a\xd5\x4c\xbe\x88\xa0\xd0\xa4\x17\x8d\x40\x03\x46\x4c\x62\xdf\x90\x08\x25\xb5\x2f\xe6\x56\x42\xb8\x85\x7f\x32\xb7\x72\xa2\x59\xed\x3e\x99\xfb\x39\x92\x36\x7a\x8d\x3d\x2b\x32\xb1\x27\x71\xdc\xae\x66\x8e\x29\x51\x38\x0d\x51\xb2\x0e\xf6\xda\x5f\x6a\x4e\x59\x71\xe3\xaf\xed\x60\x38\x55\x05\xb0\x79\x00\xaa\x2b\xb5\x1c\xeb\x5f\xe0\x9a\x81\x5a\x8e\x81\x5a\x80\x5a\xb6\x81\x5a\x64\x28\xc2\xb5\x05\x60\xcb\x4a\xb0\xe5\x3c\x16\x37\x70\xcb\xa2\xe2\x4b\xc0\x26\xf5\x60\x93\x67\xf1\x65\x43\x9b\x0b\xcd\x29\xca\x4d\x8e\x74\x63\x70\xf3\x8a\xb8\x38\x1b\xb4\xdb\xfd\xa8\x58\x8c\x23\xb3\xb0\x30\xbb\x8e\x8e\x14\x78\x94\xa9\xdd\x38\xe2\x26\x4e\x83\xa3\x06\x23\x96\x9d\x28\xdb\x10\xb7\xc4\xf5\x19\xbd\x1b\x1b\xe9\x9d\x05\x71\x7d\x07\x0b\xdb\xcb\x70\xc4\x70\x6c\x2e\x94\x6e\x94\x1b\xc6\xb9\xc9\x6c\xe9\xa3\xf4\x0e\x4b\x18\x93\x23\x4f\xa1\xf8\x2a\xda\x34\x5c\x85\x08\xdb\x33\x9d\xc0\x22\xaf\xca\x37\x5c\x2e\x55\xb2\xe1\x06\x8e\xb0\x4b\x0e\x4f\x76\xee\x2e\x81\xad\x9c\x3f\x55\x17\xbe\x8d\x3f\x03\xc2\xc6\x32\xbf\x80\x51\x97\x88\x2b\x12\xa8\x06\xa1\xa8\xa3\x95\x2b\x1d\x91\xbb\x42\x98\xde\xe0\xd7\xb6\xff\x95\x39\x5f\xc6\x9e\x59\xd2\xb8\x64\xd0\x50\x1a\x57\xb4\xda\x5c\x2f\x72\x92\x0d\xe4\x0b\x0f\x9f\x61\x50\xce\x58\x38\x71\xa2\x0d\xc4\xcb\x52\x71\x92\x1d\x82\xef\xef\xd1\xcb\x8f\x89\x3f\x42\xc5\xd1\x3e\xdd\x30\x58\x3b\xac\xcc\x54\x57\xda\x01\x8c\xd4\x8b\x1a\x73\xf4\x83\xd2\x93\x94\x6e\xa0\x3c\xbc\x2a\xcb\xbc\x34\x8b\x0b\x39\xb3\x8a\x73\x36\x81\x66\x83\x8a\x32\xac\xa8\xbd\x72\xca\x3d\x66\xec\x70\x66\xc0\x05\x75\xab\x75\xb6\xc7\x67\x31\xd1\x74\xc5\xf6\xec\xb8\x1f\x44\x2a\x1b\x44\xbe\x69\x2f\x1d\x65\x06\xe4\xce\xa7\x4c\x10\x36\x08\xfc\x49\x1b\x21\x3d\xc8\x77\x09\xf7\x8d\x25\xf7\xb1\x82\x17\x4d\xbb\x58\x49\x7c\x7d\x96\xb1\xa6\xf4\xac\x47\xc6\x9a\x9c\xe5\xa6\xaa\x5e\xa4\xe4\x54\x78\xfb\x22\xb0\x67\x61\x16\xa2\xb8\x8d\x87\x0e\xf9\x55\xde\xb0\xd4\xa6\x1f\x19\xed\x0e\xf7\x5e\xfe\x6b\xbf\x0c\x5a\x0f\x67\xef\x96\x31\x79\xa9\xd7\x17\x78\x24\xfb\x1f\xff\x35\x11\x85\x91\xaf\xd5\x04\x19\x96\x95\x1f\x1c\x77\xfa\x48\xa8\x4a\x45\xc0\xc9\x79\x74\xa1\xd2\xa9\x5c\x15\x6b\xb8\xcf\x7e\x3f\x54\xb7\x07\xd3\x09\xa7\xe7\x03\xc2\xd8\xd4\x6d\xc2\x1e\x1a\x3e\xa5\xce\xd2\x8d\x63\x41\x1e\x38\x6c\xeb\xd2\xed\xa1\xb2\xe4\x96\x63\x1c\x73\x9b\x40\xb3\x1e\x26\x20\xff\x0d\x67\x10\x0f\x11\x05\xda\x52\x85\x31\xff\x5d\x46\xf3\x37\xc3\xa3\xb7\x07\xad\xab\x14\x1e\x69\x5c\x66\xe4\x4e\xf3\x6d\xf3\x5a\x46\x02\xee\x9f\xa9\x02\x1b\x24\x13\x52\xd5\xe9\xa6\x70\x2b\x7f\x1c\xa9\x3f\x5a\x6e\xf6\x77\x57\xf9\xbb\xa5\xfe\x38\x6a\xaa\x35\xca\x2c\xac\xad\xfc\xdd\xb2\x22\x4d\x5e\x24\xed\x0e\xa7\xcc\x3a\x00\x4c\xbe\x8b\x7a\xe1\x9e\x7a\x61\xf5\x2e\xed\x8e\xfa\x43\x49\x38\xe8\x5b\x6a\x7b\x93\xb6\xe4\x44\xf6\x17\x95\x91\x09\x23\xa6\xf7\x64\x9e\x1b\x81\x3b\xfa\x1c\x12\x3b\x3a\x4f\xba\x3e\x4a\x60\x40\x22\xc4\xc3\xb3\xff\x0f\x00\x00\xff\xff\x94\x2b\x1d\xa9\x3b\xff\x01\x00")
+var _dashboardsTwemproxyJsonGtpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x9d\xef\x6f\x1a\x39\x1a\xc7\xdf\xe7\xaf\xb0\xac\xea\x94\xe8\xc8\x16\x48\x13\xda\x48\x7d\xd1\xf6\xb6\xab\x95\x7a\x6d\xb5\xed\xed\xbd\x48\x51\xd6\x30\x06\x66\x6b\xc6\xb3\xb6\x27\x4d\x0e\x71\x7f\xfb\xca\xf3\xfb\x17\x6d\x32\x10\x18\x92\x6f\x89\x54\xc6\x36\x1e\xdb\x8f\xfd\x3c\x9f\xf9\xc6\xc1\x8b\x03\x42\x28\xf3\x3c\x69\x98\x71\xa5\xa7\xe9\x39\xb1\x49\x84\x50\xe1\x6a\x43\xcf\xc9\x45\x78\x45\xe2\x54\xfb\x43\x47\x81\x2b\xcc\xaf\x1e\x3d\x27\xbd\x4e\x96\xea\x30\xc3\xb4\x0c\xd4\x98\xd3\x73\x42\x8f\x8f\xc9\x2f\x8a\x4d\x98\xc7\xc8\xf1\x31\xcd\x15\xe3\x1e\x1b\x09\x5b\xc4\xa8\x80\xe7\xd2\x67\xae\x53\x93\xea\x8e\xa5\xf7\x46\x0a\xa9\x6c\x9d\x6a\x3a\x62\x87\xdd\x0e\xe9\xf7\x7a\x1d\xd2\x3f\x3d\xed\x90\xde\x51\xbe\x6a\x8f\xcd\x6d\x15\xf4\x55\xd6\x1d\xf2\x0f\xf2\x4a\x70\x65\x74\xbe\x9c\xb9\xf1\xc3\x72\x0e\xd3\xb3\x91\x64\xca\xa1\x71\xde\x32\xfc\x7f\x78\x40\xc8\xd2\x16\xa7\xdc\x71\x4d\xa9\xb5\x74\xea\x71\xf3\xab\x43\xcf\x89\x17\x08\x11\x96\x9a\x2a\xe6\xcf\x3e\x4b\x29\x8c\xeb\xd3\x73\xd2\x0d\x13\x5d\x27\x19\x1e\x2a\x5c\xef\xab\x1d\xd7\x8b\x61\x98\xe3\x33\x8f\x0b\x9d\x8e\x6c\x32\xae\x74\x2c\x85\x60\xbe\xe6\xf6\x83\x13\x26\x74\x3a\x0c\xc5\x91\x4d\x6e\x6b\x5f\x74\xaa\x5c\xe7\xa3\xcc\x8c\x66\x5f\x74\x56\x32\xcc\x37\x7a\x4e\xfa\xcf\x72\x09\xd7\x49\x2b\xe3\xeb\x1b\x7b\x1d\x5f\x2e\x93\xf4\xa8\x07\xfd\xb4\x5c\xae\xdd\xc3\x34\x4d\x71\x9f\x33\x3b\x4b\xa8\xc3\x7d\x21\x6f\xe6\xdc\x33\xe9\x48\x53\x3d\x96\x3e\x77\x7e\x67\xaa\xd4\xc0\x5c\xd9\x73\xb2\x58\x56\xee\x6c\x5c\x13\x8e\x39\xfd\xfc\x8d\xcf\x7d\x25\xaf\x6f\xc8\x93\xba\xfa\x13\x3b\x2a\xf9\x8d\x1e\xe4\xaa\x58\xd4\x0e\x1c\x7d\x92\xbb\x4c\xeb\x98\xb8\x5c\x38\x6f\xa4\x37\x71\xa7\xe5\x46\x4e\x58\x20\x4c\xb1\xe9\x91\x99\xa4\x2a\x25\x12\x42\xe7\x32\x9c\xbe\xd4\xcc\x14\xd7\x33\x29\x1c\x9d\x4c\xaa\x42\xdf\xec\x0f\x9d\x33\xdf\x77\xbd\x69\x71\x2c\xed\x2b\xff\xe9\x95\x77\x60\x23\x2d\x45\x60\xb2\x3e\xc4\xf9\xda\x70\x3f\x9b\x56\xd9\xbf\x62\x35\x85\x3e\xd0\xa9\xe2\xdc\x2b\x55\x64\x7f\xe8\x15\x13\x41\x32\xdb\x4a\xb9\xcb\xce\x1d\x6e\xa0\xb8\xf3\xbd\xea\x9f\x77\x0f\x0a\x19\x24\x99\x0c\xd1\x6b\x78\x50\x97\x93\x6b\x00\x95\x57\x5c\x29\xd7\xe1\x61\xc7\x87\x07\xa5\x02\xab\xd7\xc7\xf3\x5c\x1d\x76\x7d\xf4\xfa\x3f\x58\x1f\xbd\x4a\xdd\xe1\xfa\x78\x36\x48\xaf\x8b\xcb\xdc\xbe\xa8\xf4\x8b\x3e\x35\x1b\x9b\x7f\xc7\xc6\x8c\x06\x22\xf9\x40\xe2\x4c\x92\x5c\xa6\x38\xcb\x67\xfe\x19\x68\xe3\x4e\x6e\x92\xec\x31\xf7\x0c\x57\xf9\x02\x52\xb9\xdc\x8b\x3c\xb9\x2d\xc0\x02\x23\xf3\xd9\x8a\x3b\xc1\x98\x7f\xa8\x69\x95\x6d\x17\x13\xe3\xea\xfc\xa1\x82\x69\xf3\x5e\x9a\xf7\x81\x10\xf9\x19\x5d\x9c\xb7\xe1\x2a\xb2\x1f\xa6\xb9\xdb\x25\x76\xd6\x89\x43\x4b\x73\xd2\x41\xb4\x53\x9e\x5f\x5b\x07\xb2\x28\xa7\x25\x9d\x8c\x46\xe8\xa0\xf4\x41\xea\x8b\x60\xea\x7a\xbf\x73\xa5\xe3\xbe\x0e\x7e\x3a\xfd\xa9\x77\xba\x01\xe7\xc3\xd4\x94\x9b\xe2\x40\x14\xc6\x89\x5f\xf3\xb9\x2f\x98\xaa\x04\xab\x30\xcf\xb7\xe9\x74\x2c\x03\xcf\x1c\x9a\xc4\x7d\x5d\xf2\x6b\x5f\x2a\xc3\xd5\x65\xe0\x2f\x6c\x94\xd2\x3e\x1b\xf3\x97\x5f\xe8\x89\x1e\x33\xc1\x8f\x35\x63\xfa\x0b\xed\xf8\xd2\x79\xf9\xff\x2f\xf4\xe2\x22\x6b\xe3\x70\x78\x7c\xc1\x8e\xff\xd7\x3d\x7e\x31\xfc\x67\xf6\xee\x0b\x5d\x92\x97\x2f\x49\xef\x88\x48\x45\xa4\x77\x78\x44\xae\xf8\xd8\x48\x75\xd8\xcd\xc7\x43\xeb\xdd\xa4\x9a\x47\xfe\xd9\xb8\x73\x7e\xa9\xb9\x72\x79\x3e\x14\xda\x69\x6c\xe7\xd0\x15\x13\xb6\x50\x6f\x5e\x9f\xf7\x96\xd9\xda\x6d\x38\x28\x64\x0b\x3e\xe5\x9e\xf3\x36\xbd\x47\xf1\xc3\x8a\x4f\xc2\x38\x49\x5f\xd1\x83\xf2\x22\xce\x96\x88\x6d\xd7\x5b\x25\xe7\xe5\xc8\x66\xd3\x3f\xcd\xdc\x89\xa9\x66\xc4\xc1\xe1\x3f\x1f\xab\x91\x40\x1b\x66\x10\x0a\x10\x0a\x36\x1a\x0a\x7a\xfd\xdb\xc5\x82\xb3\x33\xc4\x02\xc4\x82\x1d\xc5\x82\xee\xfe\xc5\x82\x4e\xf3\x31\x2d\xd6\x1e\x3f\x3a\x16\x1e\x9a\x2a\xfd\xa1\x4d\x9a\xfb\x3a\xd7\xdc\x83\xd2\x64\x5f\x23\x74\xfd\xeb\xc3\x7f\xdf\xdf\x25\x78\x31\xe1\x32\x1d\x3e\x06\xeb\xc2\xf2\xa0\xa3\xe8\xd9\xaa\xfc\xb8\xa8\x67\xef\xb8\x37\x35\xd6\xbb\xf5\xb2\x07\x38\x9b\xce\xeb\x8a\x6f\x26\x32\xde\xc1\x17\x4f\x5c\x21\xf2\x0f\xa9\x61\xc2\x2f\x8a\x39\x96\x5e\xf3\xf0\xbd\x41\x80\x7f\x51\x69\xc5\xcc\x75\x1c\xee\x7d\x8a\x98\xa8\x3c\x2a\x21\xdd\x9f\xbc\x48\xaf\xa3\x09\x53\x6c\x07\xbb\x9a\x96\x3f\x67\x7d\x67\xa0\x54\xd4\x8d\x72\xce\x9c\x5d\xd7\xa5\xba\x5e\x4d\xaa\x9e\xc9\x6f\x95\x15\x40\x8d\x34\x4c\xd4\x94\xae\xf5\xad\x59\x4f\x85\xeb\x71\x5d\xaa\xcd\xc6\x28\xfe\xcd\x75\x4c\x41\x2e\xa8\x8b\x5c\x76\x72\x7f\x94\xae\x97\xba\x61\x9b\x90\x4d\x8e\xda\xc0\xc6\xac\xe8\xf2\x39\x41\x98\xf8\xd6\x95\x76\xf9\x5c\xd9\x67\x18\x36\xad\x2c\xdf\x1f\xfb\x74\xdf\xb6\xc8\x4e\x99\x40\xe7\x9d\x51\x94\x5e\xb5\xa7\xe2\x9e\xc3\x15\x0f\xbd\xc7\x44\xc8\x0d\xa8\x14\x91\x03\xfd\x90\x9f\xe9\xf5\x0e\x2d\x5c\xbd\xf6\xbe\x4f\x49\x68\xbf\x27\x4f\xd3\x9b\xe7\x57\x43\x4e\xa1\x29\x59\x27\x37\x95\xed\x64\x66\xd7\xae\x6d\x6a\x3f\x4d\xac\xba\xa6\xf0\xf9\xa2\xce\x07\x68\xc3\xc6\x5f\x2b\x83\x63\xa5\x03\x9f\x3b\xef\x5c\xaf\x6a\x88\x8d\xc4\x40\x1d\xcc\x0f\x15\x33\xbc\x2e\x0c\x6a\xae\xae\xb8\xba\x54\xfc\xaf\x80\x6b\xa3\x2f\xc3\x31\x2a\x44\xc6\x27\xe9\xc5\xdd\x02\x63\xc7\x97\x52\x44\x85\xed\xbb\xe1\xf0\x0b\x5d\x5e\xf4\xe6\xc3\xa3\x23\x32\xba\x21\x87\x36\xad\x43\xa2\xdb\xaf\x17\x2b\xd7\x8a\x94\x8b\xc5\x1f\x8b\x85\x6d\xcb\x72\xf9\x74\xb1\x88\x9a\xb3\x5c\xfe\xb1\x5c\xae\x88\x49\x1b\x0a\xa1\xad\x35\xc9\xd1\xda\xc1\xbd\xb9\x01\xa2\x05\xba\x7a\xec\xf3\x3c\xb0\x62\xec\x57\xb6\x37\xad\xe4\x4d\xae\x92\xca\xca\x2d\x3c\xf6\x5d\xdc\x0e\x36\x7e\xe3\xd3\xd8\x03\x5f\x0c\xef\x40\x21\x9f\xc2\xa9\x46\x7e\x8b\xad\x4c\x3e\xdb\xbe\x13\x92\x58\xfd\xa9\xe6\x63\xe9\x39\xe4\x70\x74\x43\xf4\x8c\x29\x27\x33\x0c\x35\xa9\xda\x9d\xf5\x9d\xda\x42\x3c\xf1\xf5\x49\x51\x9b\x2e\x95\x29\x1a\x21\x7a\x16\xb8\x4c\xc0\xc7\xf5\x1c\xf7\xca\x75\x02\x96\x3e\x55\x2c\x2b\x70\x14\x8a\xec\x59\x03\xae\x63\x27\x98\xbb\xfd\x28\x18\x7f\x8d\x1c\x55\xbe\xbb\xf9\xe7\x71\x77\xce\x6b\x7e\x5d\x50\x2a\x5d\x1f\x78\xd3\x00\x5b\x43\x33\x37\xec\xfa\x7b\xee\xdf\xe1\x63\x77\xce\x84\xae\x38\xf2\xcc\xc9\x28\xfe\x97\x5f\x72\x2f\x82\x8d\xb8\xa8\xb4\xce\x66\xc8\xe9\x6b\xa6\x79\x3e\x68\xe7\xe0\xa2\x52\x3c\xa2\x0b\xda\x2d\xd6\x9e\xeb\x64\x9a\xbc\xec\xec\x79\xfb\xe3\x77\xd9\x12\x48\x22\x65\xd6\x1d\xca\x84\x3b\xad\xc3\xad\x30\xfd\x1d\xbf\x4a\xdb\x1c\x67\x2d\xc1\xe3\x1b\xe2\xf1\x5e\x7f\x03\x40\x7e\x76\x0a\x20\x07\x90\x03\xc8\x1f\x32\x90\xfb\x32\x47\x1a\x3b\xa6\x71\x5f\x3a\x40\xf1\xf6\xa3\x78\x65\xb9\xb5\x93\xa2\x7d\x09\x86\x06\x43\x83\xa1\xc1\xd0\x0d\x19\xba\x5b\x42\xe8\xde\xa0\xd2\x8c\x1f\x8b\xda\x2f\x7a\x60\xe8\x87\xcf\xd0\x60\xe1\xcd\xb2\xb0\xf6\xa5\xa7\x39\xd4\xe9\x36\xa9\xd3\x3b\xb1\xc9\xbe\x30\xf1\x8a\xc1\x5f\xd9\xde\xf6\xca\xd3\xb1\x99\xa1\x4f\x43\x9f\x86\x3e\x0d\x7d\x7a\x5b\xfa\x74\x33\xb8\x4e\x6b\x89\xfd\x38\xe0\x1a\x70\x0d\xb8\x6e\x27\x5c\x43\x69\xae\x55\x9a\x77\x62\x0d\x60\x75\x0b\xb1\xda\x97\x80\x6a\x40\x35\xa0\x1a\x50\xdd\x10\xaa\xbb\x25\xa6\xee\x9f\x56\x9a\x71\x0b\xc1\xfa\x04\x4c\x0d\xa6\x06\x53\xdf\x8d\xa9\xe3\xfd\x02\xa3\x1b\x03\xd5\xba\x55\xaa\xf5\xee\x0c\x03\xc6\xde\x36\x63\x17\xf6\x84\x84\x4b\x11\x72\xf5\xfd\xcb\xd5\x0e\x1f\x87\x63\xbd\x73\x38\xed\xec\x7f\x17\xe2\x77\xe0\xeb\x56\xf2\x75\xaf\xbf\x11\xc0\x4e\x61\x29\xf6\xe0\x00\x6c\x00\x36\x00\xbb\xc5\x80\x0d\xe5\xba\x56\xb9\x06\x5a\x3f\x7a\xb4\xf6\x25\xc0\x1a\x60\x0d\xb0\x06\x58\xaf\x05\xd6\xdd\x12\x57\x9f\x9c\x54\x9a\x71\x0b\xae\x3e\x05\x57\x83\xab\xc1\xd5\x77\xe3\xea\x64\xfb\x01\x94\xeb\xb6\x29\xd7\x3b\xb4\x0c\xf8\x7a\xdb\x7c\x1d\x1b\x1b\xda\x35\xb4\x6b\x68\xd7\xd0\xae\xb7\xa0\x5d\x37\x63\xec\x33\x30\x36\x18\x1b\x8c\xbd\x47\x8c\x0d\xf1\xba\x56\xbc\xde\xa1\x4d\x40\xd7\x6d\xa1\x6b\x5f\x82\xad\xc1\xd6\x60\x6b\xb0\xf5\x5a\x6c\xdd\x2d\xa1\xf5\xb3\xea\x91\x05\x3f\x96\xaf\xcf\x06\x40\xeb\x87\x8f\xd6\x5b\xfb\xb2\xbd\xc8\x7a\x49\x05\x8f\x05\xc7\x6b\x88\x2f\x5e\x22\x97\x63\xe9\x79\x7c\x1c\x4e\x9f\x8d\x80\xde\x92\xae\x08\x1a\x5b\x83\xea\x6d\xb1\xf4\x36\x07\x75\xeb\x6c\x5c\xb3\x4e\x6a\x61\xb8\x32\xf5\xb7\xc8\xb1\x6f\xa2\xd1\x26\x6f\xb2\xd1\x06\xbe\xde\x37\xbe\xea\x99\x25\xfc\x5d\x83\x5f\x67\xcf\xdb\x1f\xbf\x03\xb8\xb6\x12\x5c\x7b\xfd\x4d\x90\xeb\x73\x9c\xdb\x82\x73\x5b\x70\x6e\xcb\xfa\xe7\xb6\xd4\x81\x96\x70\x4b\x9c\x75\xc9\xc6\xc6\xbd\xe2\xf7\x26\x56\xd2\x15\xf1\xe4\xe1\x6a\xc5\xad\x18\xf6\xfd\x93\x87\x2b\xcb\x69\x9b\x44\x2c\xdc\x32\x10\xbf\x0a\x97\x05\xb8\x18\x5c\x0c\x2e\x06\x17\xaf\xc7\xc5\xdd\x32\x16\x37\x3a\x3d\x25\xbd\x4d\xec\x71\x81\xc5\xc0\x62\x60\xf1\xdd\xb1\x78\x05\xa3\xc5\xbf\xca\xdf\x09\xa3\x3d\xee\xad\xcb\x2d\x33\x06\x80\xf9\xc7\xc0\x1c\x6f\x85\x58\x01\xcc\xd8\x65\x7c\xaf\xbb\x8c\x21\x25\x43\x4a\x7e\x7c\x52\x72\x13\x66\x7e\xd1\x05\x33\x83\x99\xc1\xcc\x0f\x93\x99\x0b\xdb\x2d\x77\x0c\xcc\x3b\x95\x97\x41\xcb\xfb\x4c\xcb\xbe\x04\x2b\x83\x95\xc1\xca\x60\xe5\x86\xac\xdc\x2d\xa1\xf2\x69\x93\xb3\x4f\xce\xb0\xeb\x02\xbb\x2e\xb0\xeb\x62\xfd\x5d\x17\xab\xfe\x54\x2c\xde\x03\xc0\x95\xda\xc6\x9f\x87\xd1\x15\xf1\xe4\xf1\xfd\x89\xde\x76\xc7\x1d\xfb\x2e\x1a\xec\xbb\xf8\x59\x29\xa9\x92\x3f\xa7\x8b\xbf\x08\x10\x27\x99\xe0\x24\x13\x9c\x64\x82\x93\x4c\x36\x72\x92\x49\xaf\xbf\x09\x44\x1e\x74\x81\xc8\x40\x64\x20\xf2\xbd\x23\xb2\x9c\x00\x91\x77\x81\xc8\x72\x02\x44\x6e\x2b\x22\xcb\x09\xf8\x18\x7c\x0c\x3e\x06\x1f\xdf\x03\x1f\x77\x4b\x78\x7c\xd6\xe4\x20\x92\x41\x0f\x78\x0c\x3c\x06\x1e\xaf\x8d\xc7\xdf\x43\xb5\xf8\x57\xfd\x5b\x57\x33\xf1\xe5\xca\xdf\xf9\x72\x65\x68\xcb\x2d\xd4\x96\xe3\x4d\x17\xa1\xb6\x5c\x44\xe7\xc2\x29\xd9\xd8\xab\x7c\xaf\x7b\x95\xa1\x2e\x43\x5d\x7e\x74\xea\x72\x33\x7c\x4e\x6b\x89\xbd\x2f\xf0\x19\xf8\x0c\x7c\x7e\x28\xf8\x5c\xd0\xe8\x76\xcc\xce\xbb\x57\x9c\x01\xce\x7b\x0f\xce\xbe\x04\x36\x03\x9b\x81\xcd\xc0\xe6\x86\xd8\xdc\x2d\x51\xf3\xa0\xc9\x09\x22\x83\x13\x50\x33\xa8\x19\xd4\xbc\x15\x6a\x96\x13\x88\xce\xed\x11\x9d\xe5\x04\xec\xdc\x56\x76\x96\x6f\x21\x39\x43\x72\x86\xe4\x0c\xc9\x79\x4b\x92\x73\x33\x78\x4e\x81\x26\xf6\xbd\x80\x67\xc0\x33\xe0\xf9\xa1\xc0\x33\x24\xe7\x3a\xc9\x19\xd8\xbc\xa7\xd8\xec\x4b\x40\x33\xa0\x19\xd0\x0c\x68\x6e\x08\xcd\xdd\x12\x33\x3f\x6f\x72\x3a\xc9\xe0\x14\xcc\x0c\x66\x06\x33\x6f\x83\x99\x2d\x38\xc8\xc0\x68\xa8\xce\xad\x51\x9d\x77\x63\x12\x30\xf4\xad\x19\xfa\x73\x6c\x20\xe8\xcf\xd0\x9f\xa1\x3f\x43\x7f\xde\x92\xfe\xdc\x8c\xa5\xcf\xc0\xd2\x60\x69\xb0\xf4\x43\x66\x69\x88\xd0\x35\x22\x34\x28\x7a\xff\x29\xda\x97\x60\x68\x30\x34\x18\x1a\x0c\xdd\x90\xa1\xbb\x65\x84\x6e\x72\xc2\xc9\x60\x00\x84\x06\x42\x03\xa1\xd7\x46\xe8\x55\xc4\x36\x62\xe3\xaf\xdc\x73\x12\x72\xe3\x7f\x26\x27\x6c\x6c\x01\xdd\xe8\x8a\xe8\xf2\xf8\xb8\x79\x97\x56\x00\x40\xdf\x05\xa0\x5f\x47\x96\x22\x31\x48\xff\x9c\x58\x0a\x47\x9d\xe0\xa8\x13\x1c\x75\x82\xa3\x4e\xd6\x3b\xea\xa4\xd7\xdf\x08\x34\xa7\xf7\x89\x3d\x2e\xa0\x19\xd0\xbc\x87\xd0\xfc\x37\x7b\xe7\xfe\xdb\xb6\x91\xc4\xf1\xdf\xfd\x57\xf0\xd8\xf6\x6c\x17\xb4\x2d\xea\xad\x00\xc1\xa1\xb1\x1b\xe4\x80\x38\xe7\x24\xce\x01\xb9\xa2\x10\xd6\xe4\x5a\x26\x4c\x72\xd9\xe5\x32\xb6\x1b\x38\x7f\xfb\x61\xf9\x5c\x3e\x24\x4a\x14\x25\x52\xf2\xa0\x40\xeb\x92\xe2\x6b\x66\x67\xe7\xb3\xb3\x5f\x72\x5b\x0b\xcd\xb7\x84\x3e\x20\xaa\xf3\xef\x3b\x10\x0a\xa8\xbc\x55\x54\xde\xbe\xed\x01\x90\x57\x01\xe4\xb7\x81\x7f\x52\xdf\xd7\x00\x36\x06\x36\x06\x36\x06\x36\x5e\x8b\x8d\x3b\x19\x34\x9e\x54\x5a\xe3\x64\x02\x2b\x66\xc3\x8a\xd9\xb0\x62\xf6\xa6\x56\xcc\x36\x6c\x8d\x58\x86\x3d\x9b\xfe\xe5\x61\x6f\x3b\xcb\x64\xbf\x64\x35\x73\x1b\x3c\x00\x50\x5c\x0e\xc5\xff\x0e\x9d\x22\xf9\x4e\x01\xd5\x32\xa8\x96\x41\xb5\x0c\xaa\xe5\x8d\xab\x96\x2b\x21\xf2\xb8\x03\x88\x0c\x88\x0c\x88\xbc\x47\x88\x9c\xaa\xbd\x35\xcc\xc7\xdb\xab\x20\x03\x1c\xef\x0d\x1c\x3b\x04\xd0\x18\xd0\x18\xd0\x18\xd0\xb8\x22\x1a\x77\x32\x64\xac\x76\xaa\xac\x61\x32\x56\x01\x8d\x01\x8d\x01\x8d\x37\x85\xc6\xc4\x63\x33\x02\xd5\xe3\x06\xab\xc7\x5b\xf7\x00\x00\x72\x39\x20\xff\x27\x74\x0a\x54\x8f\xa1\x7a\x0c\xd5\x63\xa8\x1e\x6f\xa9\x7a\x5c\x91\x91\xe3\xd3\x84\x5d\x2f\x30\x32\x30\x32\x30\xf2\x2e\x33\x32\x94\x8f\xc3\xf2\x31\xd0\xf1\x6e\xd2\xb1\x43\x80\x8d\x81\x8d\x81\x8d\x5b\xc1\xc6\x3a\x76\x35\x6a\xf8\x9f\x2c\x48\x75\x57\xfb\x51\x57\x56\xab\x2c\x54\x32\xee\x01\x33\x03\x33\x03\x33\x6f\x8a\x99\xd3\xd3\xfe\xd3\x9b\x27\x86\x5d\xa8\x2e\x6f\xbf\xba\xdc\x90\x1f\x80\xa2\xcb\x29\x3a\x23\xc2\xf0\x43\x04\x2a\xcb\x9b\xaf\x2c\xeb\x58\xf3\x6d\xdd\x38\x80\x2a\xbb\xff\x08\xe1\x5f\xc0\xd0\xbb\xc5\xd0\x6a\xb7\x1e\x88\x8e\xc1\x26\xec\x8f\x01\xa2\x01\xa2\x01\xa2\x77\x1f\xa2\xa1\xfc\x1c\x96\x9f\x01\x9f\x77\x0f\x9f\x1d\x02\xf0\x0c\xf0\x0c\xf0\x0c\xf0\xbc\x19\x78\xee\x64\xd9\xb9\x5b\x65\xa5\x92\xf1\x00\xd8\x19\xd8\x19\xd8\x79\x53\xec\x9c\x16\x0e\x40\x01\xba\xa9\x02\x74\x43\x7e\x00\x82\x2e\x27\xe8\x8c\x8c\x03\x0a\xd0\x50\x80\x86\x02\x34\x14\xa0\x9b\x29\x40\x57\x83\xe8\x21\x40\x34\x40\x34\x40\xf4\xde\x41\x34\x14\xa0\xc3\x02\x34\xe0\xf3\xee\xe1\xb3\x43\x00\x9e\x01\x9e\x01\x9e\x05\x78\xd6\x88\x69\x22\xc7\xc5\x7a\xf6\x4c\x69\xf2\x15\x1f\x66\x3e\x5d\x0a\x66\xf0\xe9\xb2\xdb\x2f\xad\xd0\xe6\xd7\xf4\xf0\xf9\x51\x4d\x54\xc0\x0e\xb2\xb1\x99\xe9\x03\x28\x76\x70\xe4\xb2\xa8\x3f\x5d\x9f\x4b\xe2\x0e\xe4\x8a\xe8\xae\x74\xf4\x73\xf2\xfb\x63\x39\x17\xcb\x94\x3c\xc8\xc5\x16\x45\xda\x1d\x0e\x17\x15\xcd\x5a\x4e\xe3\xaf\x61\xf2\x65\x93\x66\x94\x78\x76\xb6\x9b\x09\x76\xff\x97\x47\x69\xce\x1b\x5a\x34\xbe\x49\x02\x54\xfe\xe9\x6d\xb7\x3f\x19\x9c\xc7\xf7\xc6\x4b\x19\xb3\x1b\x74\xd4\xed\x8d\x14\x49\xed\x4e\x14\xa9\xdf\x51\xa4\xce\xe9\x78\x92\xdc\x3f\x3f\xac\x3b\x99\x68\xfd\xa1\x9c\x6b\x64\x4b\x8d\x75\xf2\x41\x54\xff\x38\x27\x0e\x48\x9b\xd8\xc2\xc5\x67\xc8\x9b\xe1\xf4\x15\x2c\xf4\x18\xd9\x4b\xed\x88\xad\xcb\x32\xec\x68\x87\xb8\x39\x8c\xb6\x94\x6d\xc5\xe4\xf4\x9e\x77\x57\xee\xa2\x5f\x5c\x22\x7a\x8f\x69\x34\x26\xc8\xdd\xfb\xdc\xd8\xe8\x65\x62\x63\x58\x16\x1a\xbd\x4e\xee\xe4\x3c\xdb\xf3\x96\x15\xa1\x73\xb6\xfd\xf8\xa1\xd3\x8b\xcf\x54\x0c\x01\x45\x03\x15\x0b\x39\x8e\x61\xcf\xae\x9f\x9c\x54\x6f\x26\x6e\x5f\x90\x1c\xc2\x1c\x14\xe4\x17\x89\x11\x89\xe1\xc7\x24\x1c\x93\xd4\xc3\xe3\x3a\xde\xf8\xac\x2c\x3e\x19\x45\xf6\xac\xe4\x64\x8b\x38\xde\x42\x8f\x17\x88\xa1\xab\x68\x4c\x23\xb4\x8e\xfc\xb0\x4c\x23\xb6\x8d\x35\x86\xf5\xf8\x3a\xfe\x6f\xae\xf1\x63\x2e\x82\x73\xa3\xab\xe1\x69\xf7\xb4\x9f\x1c\xe7\x10\x97\xdd\x1a\x8f\x69\x8b\x87\x1b\xdf\x12\x9b\x7d\x36\xfe\xe6\x26\x96\x07\x9d\x5f\x84\xfd\x14\xe7\x8f\xa1\x78\xd1\x21\xbe\x79\x2e\x91\xb3\xc0\x2d\xb7\x41\x51\x32\x3d\xe8\xe4\xff\xc8\xbe\x7f\x5e\x49\xf2\x87\xb3\xdf\x32\x3b\x48\x7c\xc0\x02\xdb\x56\x1e\xf7\x39\x88\xde\xf3\xe1\x59\xfa\x30\x5e\xd6\xf0\xcb\x37\xfc\xda\x7e\x07\xd6\x53\x15\x49\x55\xc7\x8a\xa4\x8e\x27\xbc\x03\x53\xc7\xa9\x0e\xec\x96\x3f\x50\x3e\x44\xf9\x99\xc5\xf3\x04\xa7\xe9\x76\x14\x49\x9d\xf4\x8e\xe5\x39\xbd\x40\xee\x2e\x19\xba\x31\xf1\x39\x31\x3d\x2b\x53\xce\x59\x62\x3c\x97\x8c\x18\xee\xbd\x1b\x3c\x4d\x0c\x32\x75\x19\x62\x9e\x3b\xa5\xd8\x31\x0d\x0d\xb9\x53\xf4\x0d\x19\x26\xbf\x92\x30\x66\x38\x4c\x86\x0c\x87\x4a\x72\xec\xeb\x1f\x87\x42\x2a\x3a\x7c\x3e\x96\xe7\xe0\x4b\xe9\xf8\x2b\x46\x7e\x75\x21\xf2\xcf\xc1\x7b\x71\x3c\x95\x6b\x13\x71\xff\xc8\xed\x23\xab\x4a\x57\x2e\x22\x7c\xb9\xd7\x71\xe5\x42\x92\xcf\xee\x09\x33\xf1\x27\xcf\xb6\x39\xc9\x3b\x44\x77\xf3\x39\xd8\x35\xec\x99\x89\xb9\x69\x93\x7d\x7e\x27\x24\x86\xcd\x58\x0c\x1b\x7f\xef\xe2\xb0\x21\x7c\x44\x20\xbf\x2e\x8e\x98\x4e\x71\x4f\x54\x1a\x32\xfe\x75\x3f\x84\x5d\x1b\xaf\x7e\x6d\x04\x1d\xae\xa2\x7e\x24\x15\x1a\x2b\x63\x45\xc8\x07\xab\x62\x45\x48\x23\xb9\x67\x07\xac\x68\x10\x2b\x86\xb5\x61\x45\xb7\x08\x2b\x52\x4d\x14\xc0\x62\x1d\xb0\x00\x70\x00\x70\x58\x02\x1c\x3c\xbb\x4d\xe8\xd0\x28\x1c\x7c\x49\x4c\x01\x80\xb0\x14\x20\x44\xb9\x6b\x09\x08\x80\xda\xc2\xfe\xd4\x16\xd4\x6e\x6d\x14\x30\x52\x0e\x32\x3d\x03\x14\x17\xa0\xb8\x00\xc5\x85\xad\x15\x17\x34\xe2\xd9\xec\x28\xfa\xb7\xcd\x90\x61\x63\x3a\xb5\xb0\x45\xe8\xd3\xf4\x81\xd0\x7b\x3e\x2b\xec\x62\x96\x9b\x91\x4c\x21\x82\x3f\x1f\x29\xb2\x41\x32\x03\x99\xfc\x75\x18\xce\x3b\xda\x44\xc7\xc7\xb5\xa3\x43\x8b\xab\x0e\x7e\xfd\x5f\x37\x5c\x46\x8d\x1b\x8f\x61\x5d\x22\xb6\x74\x47\x5c\x06\x84\x51\x27\x61\x54\x2c\x33\xe8\xfd\x3e\xea\x21\x39\xf7\x7c\x40\x18\x4d\x12\xc6\xb8\x36\xc2\x18\x16\x11\x06\xd4\x19\xa0\xce\x00\x75\x86\xba\xea\x0c\x16\x7a\x3c\xe2\x93\x14\x3a\x36\x19\x0a\x2a\x0e\x0e\xd1\xa7\x09\x4e\xc4\x45\x07\x97\x21\xca\xf2\x2b\xcb\x57\x61\x89\x3f\x06\xc2\xfa\xf1\xfa\x8b\xe2\x89\x4b\xf4\xe8\x17\x28\xa4\xc8\xa0\xd2\x91\x89\x5c\x26\x0d\x24\xcb\xb0\x3d\x86\xdd\xe3\xfd\x07\x8b\xa6\x55\xda\x2d\x14\x63\x8f\x32\x49\xb5\x5c\x2e\xd3\xab\xf2\x31\x10\x55\xad\x55\x8b\xcd\xb3\xf8\xef\x96\xc3\x9e\x32\xe9\x3b\xdc\xf5\x3f\x4c\x49\x7e\xcf\x3e\x09\xb8\x25\xe4\x4a\x7f\xf3\xa7\x8c\x7f\xd6\x0a\x21\xf7\xa0\x15\x42\xee\xa6\x94\xd4\x41\x62\x2b\x29\x9e\xcf\xcb\x60\xc9\x11\xe9\x44\x76\xf8\xbc\x7e\x92\xea\x2e\x4c\x52\xbe\xba\x36\xb9\xa0\xff\x36\xe0\x89\xdf\xd4\x4f\x52\x15\x6d\xe1\xe0\xf7\x86\x7d\x9f\x81\xba\x6c\x86\x4b\x6d\x77\x19\xe6\x33\xda\x6a\xc4\xe3\x82\x03\xab\x59\x71\x1d\xed\xc2\xfa\xf6\x54\x0b\x4c\x52\x62\x4f\xff\x7e\x0b\xec\x59\x28\x5c\x5e\xd3\x36\x6b\x4d\xcf\x34\x62\x1d\xcf\x2e\xb5\xcf\xf9\xf2\xf6\xd9\xdb\xf2\x94\xe7\x62\xfd\x24\x5d\x04\x4a\x1b\xe9\x42\x30\x52\xf8\xd7\x1c\xb0\xfc\xe3\xcf\x22\xaa\xac\x4b\xfd\x7e\x45\x74\xc9\xb7\xbf\x74\xe4\x77\x24\x8a\xe4\xfb\x57\x91\x3c\x9b\xff\xf7\x58\x42\xb6\x1e\xd0\xa8\xff\x34\x49\x9d\x8b\x67\x19\x10\xc7\x6f\x46\x1c\xef\xde\xf1\xf7\x07\x36\x28\x2b\x4f\x5f\x58\x78\xc4\x78\xf3\xb3\x52\x7c\xf7\x5b\xbc\xc9\xdc\xe6\x82\x71\xee\x56\xd5\xef\x30\x28\xc9\x0d\x4a\x86\x2b\x0f\x4a\xfa\x9d\x0a\x83\x92\x09\x8c\x49\xea\x1c\x93\xb4\x6c\x2c\xd2\xad\x3e\x16\x09\x5e\xed\xc0\xfa\x9b\xa7\x4f\xe4\x21\x77\xdc\xde\x8c\x54\x9a\x2e\xbf\xc9\x4a\x71\x0e\xa8\x15\x35\x57\x78\x41\x33\xfc\xab\x29\x62\x5a\xae\x34\x57\x81\x89\x3a\x2f\x94\x89\x5a\x40\x15\xbb\x42\x3f\xe9\xfb\x0c\xff\xda\x02\xfc\x34\xfc\xea\x5f\x7f\x78\x90\x71\x4d\x40\x06\xfd\x26\xdf\xfc\x3b\xbf\xfa\x22\x7d\x71\xd1\x0c\x57\x7e\xfd\x0f\xea\xdc\x35\xd4\xb9\xfb\xa3\xdc\x7d\x94\x23\xe5\x30\x69\x39\x51\xb2\x4a\xcf\x8f\xd5\x41\x9a\x50\xc8\x86\x42\xf6\x16\x0a\xd9\x1c\x0f\x6d\xa2\xe3\x69\x0c\x7a\x69\x44\x7c\x95\xc0\xa2\xe6\x78\x53\x8f\x77\x59\xd3\x60\x0d\xe4\x10\x17\x5f\xb9\x9e\x35\x35\x28\x62\x62\x1d\xf2\x47\xf5\x2a\x5b\x3d\xd0\xd8\xad\x0a\x8d\xb5\xd5\xbe\xc3\xbf\x1a\x01\xcd\x38\xbb\xac\xc7\x94\x5d\x60\xca\x4d\x33\xa5\x90\x91\xd2\x4f\x12\x6f\x7e\x56\x1a\xbf\xc9\xdc\xe6\xf0\x3e\xc5\x04\xf2\x92\x88\x72\xd0\x3f\xc8\xf8\x26\x00\x83\x41\xd3\x44\xf9\xd1\x23\x0c\x6d\x89\x28\x35\x5f\x18\x94\x79\xc6\x5d\xc4\x4c\x41\xe6\xa2\x76\x44\x9d\x4b\x8d\x9c\x39\xc8\xaf\xea\x6d\xe8\xa9\xdb\x00\x66\x2c\x63\x46\x07\xcd\x70\xe8\xa8\x54\x60\x2f\x02\xc2\xc6\x79\x4f\xa3\xc4\x34\xb3\x96\x59\x4c\x81\x77\xe4\xe1\x1d\x46\x3a\xce\x7e\x2c\x2c\xca\xca\xc2\xe5\x35\x92\x6a\xcb\x7e\x54\xb8\x5a\xf4\x5c\xf9\xbb\xa9\x0f\x30\x5d\xf6\x64\x2e\xf5\x45\xbd\xeb\x74\x0a\x17\x52\x80\x8c\x3c\x96\xb8\x37\xea\x0f\x70\x42\x69\x5f\xbf\x7e\xfd\x7a\x72\x79\x79\x72\x71\x21\xbd\x7b\xf7\xca\xb2\x5e\xb9\x19\xea\x73\x10\x63\x98\xda\xc5\x97\x89\xba\xbb\x60\x3c\x17\x61\x8a\x60\x8e\x79\x77\x9c\x87\xa7\xb2\xdb\xf6\x05\xe9\x61\xe3\x15\x9b\x66\xb2\x33\xed\xe3\x2a\xcf\x2a\xcc\xfd\x65\xd8\xd6\xb0\x73\xae\x8b\x77\x5c\xc7\xd4\x27\x5f\x50\xc3\x34\x25\x9d\x3c\xd8\x72\xee\x67\x5f\x68\x7a\x04\x9b\xb5\xae\x2f\x1e\x97\x7e\xca\x40\xef\x1c\xa0\x4d\x59\xdf\xf6\xac\x1b\x4c\xd3\xc7\x79\xb6\x21\x70\xcb\x6a\x8e\xf9\x14\xae\x34\x0d\xbe\xc9\xf8\xe6\x4d\x7b\x7c\x23\xfd\x02\xde\xc9\x78\xe7\xbc\x5e\xef\x84\x29\xcf\x77\xd6\x6a\x3e\x7a\x6f\x58\x06\x44\x4f\x2e\x7a\x2e\xea\xf5\x4f\x95\xe8\x09\x3c\x03\xb1\x93\x8b\x9d\xdf\xeb\xf5\x4d\xb5\xd8\xb9\x22\xfa\x4e\x39\x46\x04\xc7\x8a\x7e\x39\xd3\xcf\x86\x83\xb1\x8a\xfb\x43\xdc\xc7\x03\x6d\x74\x83\xfa\x1d\xd4\x19\x0d\xfb\xc3\xde\x64\x80\x6f\x47\x37\x83\xc1\x19\x9f\x53\xa6\x36\x66\xd8\x3d\xd1\x88\xe5\x78\x0c\x9f\x50\x1c\x0c\xe1\x5c\x2e\x84\xfc\xd7\x37\x44\x4f\x92\xb2\x60\x52\x14\xfc\x27\xdf\xc1\x67\x94\x7f\x9e\x4e\x35\x6c\x9a\x73\x1b\x81\x43\xf4\x7a\xfd\xbf\x6a\x6c\xee\x86\xdb\x05\x8b\x9d\x9d\xfe\x7a\xb6\xba\xc9\xb8\x36\xcf\x9e\x2d\x67\xb2\xf0\xaf\x3f\xf7\xbe\xdc\xcc\xb5\xc7\xe9\x9d\x86\xed\x32\x64\xb3\xa2\x08\x5b\xb1\x06\x2d\x57\x2d\x2c\x2b\xe5\x26\x2e\x90\x7a\x44\x61\x39\xa5\x21\xa5\x7d\xe7\xde\x7d\x7d\xa8\x11\x8a\x0f\x95\xbd\x34\xe5\x9b\x3a\x4c\xd9\xbe\xd6\x2a\x9d\x49\xe0\xe3\xc8\xc7\xe7\x75\xf8\x78\x91\x29\x4d\x1f\xcb\xf6\xdf\x90\x17\x75\x18\x72\x27\x83\xe5\xa5\x78\xf8\xf7\x52\x0f\xe7\xf3\x7a\x85\x29\xcb\xf2\x99\x49\x7f\x96\x62\xbd\x99\xc9\x4e\xb5\x99\x49\x8a\x6c\x97\x3b\x21\xef\x82\x98\x83\xfc\xcd\x27\x5c\x7f\x00\x33\x97\x2f\x6e\xe6\xb2\x8d\x93\x8d\xc3\xee\x41\xc6\x9c\xa1\x0a\xa9\xc9\xc9\xc6\x4b\xff\x33\x40\xa0\x60\x6b\x5e\xc1\x36\xac\xf2\xa6\x76\x9c\x3f\xc2\xdc\x01\xd3\x8e\xf3\xa7\x1d\x41\xaa\xd6\x22\xa9\xda\x4a\x6f\x7a\xa6\xd9\x4d\x5a\x0e\xde\x14\x29\xbe\xc6\x3f\x5e\x1f\x82\x28\xad\x3e\x51\x9a\x98\x33\xd6\xa3\xbf\x6e\x35\xfa\xdb\x23\x5d\xda\xc6\x57\x0f\x12\xf2\x4c\xfa\x49\xe2\xcd\xcf\x4a\xd9\x4d\xb6\x8d\xee\xf6\x5e\x97\x36\xea\x1c\x64\x7c\x13\xa4\xfb\x51\x0b\x50\x11\xa4\x69\x2d\x95\xa6\x8d\xd4\xdc\x8d\x18\xba\xf8\x25\xbe\x30\xfb\x00\x23\xce\x67\x44\x90\xa6\x2d\x2f\x4d\x2b\x24\x65\x50\xa6\x2d\xa3\x4c\x2b\x24\xa8\x56\x4f\x4b\x6e\x51\x26\x90\x81\xdf\x39\x60\xbb\xf4\x34\x71\xbc\x44\xe3\xca\xee\x01\x89\xda\x76\x24\x6a\xeb\x7b\x08\x84\x6a\xed\x14\xaa\x85\x6e\x02\xad\xda\x36\xb4\x6a\xd5\xc3\x08\x14\x6b\xa0\x58\x03\xc5\x1a\x28\xd6\xf6\x5e\xb1\xb6\x46\xd5\x79\x63\x45\xe7\x06\x04\x04\xe5\xe5\x65\xa5\xdc\x96\x2b\xc8\x96\x78\x91\x13\xa4\x69\xf3\xa5\x69\x0d\x36\xcb\xd5\x35\x68\x7b\xec\xcc\xed\x6a\xd0\xf6\xd8\x90\xb5\x68\xd0\xda\x1d\x15\x2f\xc5\x95\xad\x10\x9b\x85\xe3\x14\xd0\x9b\x81\xde\x0c\xf4\x66\xcb\xea\xcd\x46\xe3\x83\x8c\x39\xc3\x4f\x15\x0c\x9a\x9c\x45\xfc\x80\xd9\x03\xa1\xf7\xa0\x38\xab\x5b\x71\xb6\xfa\x67\x78\x47\x93\xdc\x7d\x94\x2b\xce\xd4\x11\x4c\x27\xce\x99\x4e\x6c\x99\xd4\xac\xdb\xc0\x54\x64\x1b\xa5\x66\xfe\x3b\x66\xc2\x18\xcb\x0e\x7a\xa0\x29\xc5\x1a\x36\xbe\xe1\x00\x25\x73\x5f\xcc\xfd\xd1\x8a\x4f\xe6\x56\x16\x9a\xb5\xee\x93\xb9\x9f\x02\x6b\x4b\x6f\x90\xad\x07\x21\xb6\x16\xc7\x75\xab\x71\xdc\xce\x2b\xc7\x84\x2a\x5c\xfa\x52\xa9\x06\xf6\xc6\x71\x37\x09\x47\xd9\x85\xbf\x84\xa7\x8c\x37\x3f\x2b\xc5\x0f\xd0\x5e\x86\xdb\xbc\xaa\x0c\xa8\xa5\x06\x6a\x19\xe7\xbf\xc0\xb5\x04\xb5\x8c\x81\x5a\x80\x5a\xf6\x81\x5a\xfc\x52\x84\x65\x30\xc0\x96\xad\x60\xcb\x75\x68\x6e\xe0\x96\xf5\xb8\x05\xd8\xa4\x6d\x6c\x72\x10\x9e\x96\xc7\x1c\xef\xdd\xb9\xed\xd4\x70\x31\x5a\xd9\xd5\xee\xb0\x85\x92\x4e\xbb\xeb\x8f\xfa\x03\x49\x26\xff\xa1\x8e\xe8\x7d\xf0\x4b\x86\x66\x89\xdb\xe5\x9e\xab\xa1\xb8\x38\x2a\x53\xac\x1b\x91\xb3\x65\xf6\x80\x2d\x87\x92\xc7\x27\x39\xbe\x32\xc3\x96\x63\x22\xc6\x27\x7f\xa3\x27\x92\x4d\xc3\x65\x42\x33\x12\x9e\x53\xc7\xae\x46\x0d\xff\x25\xae\x9c\x01\x65\x4c\x29\xa1\xf9\xcd\x7c\x2d\x9f\x0c\x44\x18\xb6\x66\x7a\x3a\xfe\xad\x78\xc1\xe5\x42\x27\xcb\x96\x67\x32\xa3\xe0\xe7\x61\xf0\x88\xec\x25\xec\x4d\xf2\x69\xd2\xe5\x48\x92\xfc\x97\x87\x29\x27\x19\xd9\xa1\xc4\xc2\xec\x0e\x7b\x62\x40\x08\xee\x10\x9a\x93\x4c\xf1\x0c\xa7\x97\xd3\xe6\x6d\xe6\xde\x70\xbe\x50\xf3\xf3\x93\x1d\x7f\x2a\x52\xd8\x1b\x75\x1a\xc2\xcd\x1d\x64\xda\x7f\xaa\x15\x99\xd1\x02\xf2\x99\x87\x4f\x30\x28\xf9\x39\xbf\x38\x36\x83\x05\xc4\x73\x57\x16\x56\x08\xfe\xfe\x5d\x3a\xfd\x10\xe5\x23\xe9\xf9\x79\xce\x82\xc1\xb9\x9f\x15\x85\xea\x56\x1b\x80\x1c\x67\x51\x79\x85\x76\x50\x78\x90\xd0\x0c\xe2\x8d\xa2\xe9\xb3\xd6\x4c\x77\xba\xcb\x9b\x73\x39\x83\x26\x9d\x4a\x18\x85\xd9\x56\xb9\xe0\x1a\x4b\x36\x38\xcd\x73\x19\xb1\x72\x59\x6c\xa9\xc6\x56\x3e\x8a\xe1\x3f\xc2\xb7\x86\x6d\x84\xed\x20\x70\xd9\xd4\x6f\x4b\xee\x51\xdc\xcb\x4c\xf1\xa3\x43\x28\xc3\x74\xea\x39\x02\x1f\xa5\xf0\xe8\x59\x91\x32\xbc\xb3\x91\x36\x96\xc9\xa2\x71\x13\x13\xb8\x74\x85\x36\x56\x7c\x54\x49\x5f\x23\xb6\xb6\xc4\xd5\x75\x5a\x4e\x84\xb7\xcf\x0c\xd9\x3a\xa2\xbc\x80\x68\xf0\x59\xb1\x8f\xfe\x05\x0b\x63\xba\xa4\xb7\x3b\x3b\x3a\xfd\xf5\xb8\x08\x5a\xcf\x96\x6f\x96\x21\x79\x89\xe7\x67\x68\xe6\xb7\x3f\xf7\x63\x64\x0a\xf1\x74\x51\x4a\x13\x0d\xc9\xb7\x15\xff\x38\x6c\xf4\x81\x51\x85\x1d\x9e\x8b\xaf\xd1\x6c\xee\x50\xae\x4a\x34\x7c\x4f\xfe\xff\xb9\x2c\x1e\xfe\x2f\xdc\xb1\x9a\x9c\x03\x9a\x54\x88\x4f\x2d\x2a\xc2\xe8\x3d\x20\xc7\x71\xad\x4e\x41\x7e\x7e\xce\x40\x65\x0e\x34\x53\x60\x99\x02\x4d\x35\x2c\x4f\x80\x5c\x4a\xf3\xdc\x40\x76\xb8\xd1\x28\x6b\xc4\x69\xd8\x2b\x66\xa4\x26\xe6\x94\x64\xa8\x68\xea\x69\x91\x9e\x21\x0c\x06\x36\x43\x90\xd4\xc2\x40\x8d\x77\x44\x8d\x88\xe8\x6d\x80\xa0\x12\xa8\x51\x87\x24\x12\x8b\xb5\xb5\x81\xa6\x47\x25\x3e\x3e\x11\x4d\x1b\x17\x7a\x55\x09\xa9\x32\x41\x03\x60\xe0\xfc\x0b\xe9\x44\xc1\x5c\xab\x94\x06\xe9\x72\x2a\xe5\xe5\x97\xeb\x1a\xc2\xba\x67\x4a\x25\xf9\x50\x31\x25\x14\x6d\x05\x99\xc9\xd9\xa9\x45\x08\xcd\xd0\x58\x8e\x87\xf5\xa1\x91\xdb\x09\xf0\xf6\x38\x98\x63\x8c\xcc\x31\xcc\x45\xb0\x4d\x91\xd8\x86\xc8\x1c\x63\x03\x64\x19\xa4\x8e\xa3\x11\x12\xdb\x30\x45\x89\x0b\x29\xb0\xc0\x7e\x8b\x47\xca\x40\x04\x6d\x41\x36\xd8\x0c\xd9\x60\x64\x5b\x8c\x4c\x90\x39\x48\x6b\x24\xcc\x91\xd8\xc6\x06\x29\x4a\x58\x42\xba\x2a\x1f\x3c\x05\xa4\x04\xed\x70\xc0\xba\xe6\x90\xbe\x86\x42\x10\xa8\x93\xa1\x10\x02\xcb\xaf\x0a\xb0\xf6\x8b\x42\x6d\xad\x12\x57\x2d\x17\x60\x00\xd7\xa0\xf0\x00\x06\x05\x02\x00")
 
 func dashboardsTwemproxyJsonGtplBytes() ([]byte, error) {
 	return bindataRead(
@@ -261,7 +261,7 @@ func dashboardsTwemproxyJsonGtpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "dashboards/twemproxy.json.gtpl", size: 130875, mode: os.FileMode(420), modTime: time.Unix(1718206072, 0)}
+	info := bindataFileInfo{name: "dashboards/twemproxy.json.gtpl", size: 132358, mode: os.FileMode(420), modTime: time.Unix(1792400358, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -0,0 +1,256 @@
+package bluegreen
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	saasv1alpha1 "github.com/3scale-ops/saas-operator/api/v1alpha1"
+	deployment_workload "github.com/3scale-ops/saas-operator/pkg/workloads/deployment"
+	"github.com/samber/lo"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// pollInterval is the time to wait before checking again the
+	// readiness of the standby colour or the smoke test results
+	pollInterval time.Duration = 10 * time.Second
+)
+
+// Target is a workload delivered using blue/green
+type Target struct {
+	// Name of the workload, which is also the name of the blue Deployment
+	Name string
+	// Spec is the blue/green spec of the workload. Its State
+	// is set by Apply so the resources can be generated.
+	Spec *saasv1alpha1.BlueGreenSpec
+	// Revision identifies the desired version of the workload
+	Revision string
+}
+
+// Switcher drives the blue/green delivery of workloads
+type Switcher struct {
+	Client client.Client
+	Now    func() time.Time
+}
+
+// NewSwitcher returns a Switcher that uses the given client
+// to check the state of the Deployments and smoke test Jobs
+func NewSwitcher(cl client.Client) *Switcher {
+	return &Switcher{Client: cl, Now: time.Now}
+}
+
+// Progress evaluates the blue/green delivery of the targets and returns their updated statuses,
+// together with the time after which the delivery needs to be evaluated again (zero if no target
+// requires it). Statuses of workloads that no longer use blue/green are dropped.
+func (s *Switcher) Progress(ctx context.Context, namespace string, targets []Target,
+	current saasv1alpha1.BlueGreenStatuses) (saasv1alpha1.BlueGreenStatuses, time.Duration, error) {
+
+	var statuses saasv1alpha1.BlueGreenStatuses
+	var requeue time.Duration
+
+	for _, t := range targets {
+		if t.Spec == nil {
+			continue
+		}
+
+		var status *saasv1alpha1.BlueGreenStatus
+		if st := current.Get(t.Name); st != nil {
+			status = st.DeepCopy()
+		} else {
+			// The workload starts in the blue colour, which
+			// is the Deployment that already exists
+			status = &saasv1alpha1.BlueGreenStatus{
+				Name:           t.Name,
+				Phase:          saasv1alpha1.BlueGreenPhaseStable,
+				Active:         saasv1alpha1.BlueGreenColourBlue,
+				ActiveRevision: t.Revision,
+				Message:        fmt.Sprintf("revision %s active in %s", t.Revision, saasv1alpha1.BlueGreenColourBlue),
+			}
+		}
+
+		after, err := s.progress(ctx, namespace, t, status)
+		if err != nil {
+			return nil, 0, err
+		}
+		if after > 0 && (requeue == 0 || after < requeue) {
+			requeue = after
+		}
+		statuses = append(statuses, *status)
+	}
+
+	return statuses, requeue, nil
+}
+
+// progress evaluates the blue/green delivery of a single workload
+func (s *Switcher) progress(ctx context.Context, namespace string, t Target,
+	status *saasv1alpha1.BlueGreenStatus) (time.Duration, error) {
+
+	switch {
+
+	case t.Revision == status.ActiveRevision:
+		if status.Phase != saasv1alpha1.BlueGreenPhaseStable {
+			// The spec was reverted while the new revision
+			// was being deployed, drop the standby colour
+			status.Phase = saasv1alpha1.BlueGreenPhaseStable
+			status.StandbyRevision = ""
+			status.Message = fmt.Sprintf("deployment aborted, revision %s active in %s", status.ActiveRevision, status.Active)
+			return 0, nil
+		}
+		if status.StandbyRevision == "" {
+			return 0, nil
+		}
+		remaining := time.Duration(0)
+		if status.SwitchTime != nil {
+			remaining = t.Spec.GetRollbackWindow().Duration - s.Now().Sub(status.SwitchTime.Time)
+		}
+		if remaining > 0 {
+			return remaining, nil
+		}
+		status.StandbyRevision = ""
+		status.Message = fmt.Sprintf("revision %s active in %s, rollback window expired", status.ActiveRevision, status.Active)
+		return 0, nil
+
+	case t.Revision == status.StandbyRevision && status.Phase == saasv1alpha1.BlueGreenPhaseStable:
+		// The spec was reverted to the previous revision within
+		// the rollback window, switch traffic back to it
+		s.switchColour(status)
+		status.Message = fmt.Sprintf("rolled back to revision %s in %s", status.ActiveRevision, status.Active)
+		return t.Spec.GetRollbackWindow().Duration, nil
+
+	case t.Revision != status.StandbyRevision || status.Phase == saasv1alpha1.BlueGreenPhaseStable:
+		// New revision, (re)start its deployment in the standby colour
+		status.Phase = saasv1alpha1.BlueGreenPhaseDeploying
+		status.StandbyRevision = t.Revision
+		status.Message = fmt.Sprintf("deploying revision %s to %s", t.Revision, status.Active.Other())
+		return pollInterval, nil
+	}
+
+	switch status.Phase {
+
+	case saasv1alpha1.BlueGreenPhaseDeploying:
+		ready, err := s.isReady(ctx, types.NamespacedName{Name: Deployment(t.Name, status.Active.Other()), Namespace: namespace})
+		if err != nil {
+			return 0, err
+		}
+		if !ready {
+			return pollInterval, nil
+		}
+		if t.Spec.SmokeTest != nil {
+			status.Phase = saasv1alpha1.BlueGreenPhaseTesting
+			status.Message = fmt.Sprintf("running smoke test against revision %s in %s", t.Revision, status.Active.Other())
+			return pollInterval, nil
+		}
+
+	case saasv1alpha1.BlueGreenPhaseTesting:
+		finished, passed, err := s.smokeTestResult(ctx,
+			types.NamespacedName{Name: deployment_workload.SmokeTestJobName(t.Name, t.Revision), Namespace: namespace})
+		if err != nil {
+			return 0, err
+		}
+		if !finished {
+			return pollInterval, nil
+		}
+		if !passed {
+			status.Phase = saasv1alpha1.BlueGreenPhaseFailed
+			status.Message = fmt.Sprintf("smoke test of revision %s failed, traffic kept in %s", t.Revision, status.Active)
+			return 0, nil
+		}
+
+	default:
+		// Failed: wait for a new revision
+		return 0, nil
+	}
+
+	s.switchColour(status)
+	status.Phase = saasv1alpha1.BlueGreenPhaseStable
+	status.Message = fmt.Sprintf("revision %s active in %s", status.ActiveRevision, status.Active)
+	return t.Spec.GetRollbackWindow().Duration, nil
+}
+
+// switchColour sends traffic to the standby colour and
+// keeps the previous one during the rollback window
+func (s *Switcher) switchColour(status *saasv1alpha1.BlueGreenStatus) {
+	status.Active = status.Active.Other()
+	status.ActiveRevision, status.StandbyRevision = status.StandbyRevision, status.ActiveRevision
+	status.SwitchTime = &metav1.Time{Time: s.Now()}
+}
+
+// isReady returns true if all the replicas of the Deployment
+// run its current template and are available
+func (s *Switcher) isReady(ctx context.Context, key types.NamespacedName) (bool, error) {
+	dep := &appsv1.Deployment{}
+	if err := s.Client.Get(ctx, key, dep); err != nil {
+		if errors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	replicas := lo.FromPtrOr(dep.Spec.Replicas, 1)
+	return dep.Status.ObservedGeneration >= dep.GetGeneration() &&
+		dep.Status.Replicas == replicas &&
+		dep.Status.UpdatedReplicas == replicas &&
+		dep.Status.AvailableReplicas == replicas, nil
+}
+
+// smokeTestResult returns whether the smoke test Job has finished and if it passed
+func (s *Switcher) smokeTestResult(ctx context.Context, key types.NamespacedName) (bool, bool, error) {
+	job := &batchv1.Job{}
+	if err := s.Client.Get(ctx, key, job); err != nil {
+		if errors.IsNotFound(err) {
+			return false, false, nil
+		}
+		return false, false, err
+	}
+
+	for _, cond := range job.Status.Conditions {
+		if cond.Status != corev1.ConditionTrue {
+			continue
+		}
+		switch cond.Type {
+		case batchv1.JobComplete:
+			return true, true, nil
+		case batchv1.JobFailed:
+			return true, false, nil
+		}
+	}
+	return false, false, nil
+}
+
+// Apply sets the state of the blue/green delivery in the spec of the targets. The
+// changes are meant to be kept in memory only, to generate the resources, and never
+// persisted in the API server.
+func Apply(targets []Target, statuses saasv1alpha1.BlueGreenStatuses) {
+	for _, t := range targets {
+		if t.Spec == nil {
+			continue
+		}
+		if status := statuses.Get(t.Name); status != nil {
+			t.Spec.State = status.DeepCopy()
+		}
+	}
+}
+
+// ActiveDeployment returns the name of the Deployment of the active colour of
+// the given workload, which is the workload name if it doesn't use blue/green
+func ActiveDeployment(name string, statuses saasv1alpha1.BlueGreenStatuses) string {
+	if status := statuses.Get(name); status != nil {
+		return Deployment(name, status.Active)
+	}
+	return name
+}
+
+// Deployment returns the name of the Deployment of the given colour
+func Deployment(name string, colour saasv1alpha1.BlueGreenColour) string {
+	if colour == saasv1alpha1.BlueGreenColourGreen {
+		return name + deployment_workload.BlueGreenGreenSuffix
+	}
+	return name
+}
@@ -0,0 +1,266 @@
+package bluegreen
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/3scale-ops/basereconciler/util"
+	saasv1alpha1 "github.com/3scale-ops/saas-operator/api/v1alpha1"
+	"github.com/google/go-cmp/cmp"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var testNow = time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+func testSwitcher(objects ...client.Object) *Switcher {
+	return &Switcher{
+		Client: fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(objects...).Build(),
+		Now:    func() time.Time { return testNow },
+	}
+}
+
+func testTarget(revision string, smokeTest bool) Target {
+	t := Target{
+		Name:     "system-app",
+		Spec:     &saasv1alpha1.BlueGreenSpec{RollbackWindow: &metav1.Duration{Duration: 30 * time.Minute}},
+		Revision: revision,
+	}
+	if smokeTest {
+		t.Spec.SmokeTest = &saasv1alpha1.BlueGreenSmokeTest{Command: []string{"test"}}
+	}
+	return t
+}
+
+func testDeployment(name string, ready bool) *appsv1.Deployment {
+	dep := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "ns", Generation: 2},
+		Spec:       appsv1.DeploymentSpec{Replicas: util.Pointer(int32(3))},
+		Status: appsv1.DeploymentStatus{
+			ObservedGeneration: 2, Replicas: 3, UpdatedReplicas: 3, AvailableReplicas: 3,
+		},
+	}
+	if !ready {
+		dep.Status.AvailableReplicas = 1
+	}
+	return dep
+}
+
+func testJob(name string, condition batchv1.JobConditionType) *batchv1.Job {
+	job := &batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "ns"}}
+	if condition != "" {
+		job.Status.Conditions = []batchv1.JobCondition{{Type: condition, Status: corev1.ConditionTrue}}
+	}
+	return job
+}
+
+func TestSwitcher_Progress(t *testing.T) {
+	tests := []struct {
+		name        string
+		objects     []client.Object
+		target      Target
+		current     saasv1alpha1.BlueGreenStatuses
+		want        saasv1alpha1.BlueGreenStatuses
+		wantRequeue time.Duration
+	}{
+		{
+			name:   "Starts in blue with the current revision",
+			target: testTarget("aaa", false),
+			want: saasv1alpha1.BlueGreenStatuses{{
+				Name: "system-app", Phase: saasv1alpha1.BlueGreenPhaseStable, Active: saasv1alpha1.BlueGreenColourBlue,
+				ActiveRevision: "aaa", Message: "revision aaa active in blue",
+			}},
+		},
+		{
+			name:   "Deploys a new revision to the standby colour",
+			target: testTarget("bbb", false),
+			current: saasv1alpha1.BlueGreenStatuses{{
+				Name: "system-app", Phase: saasv1alpha1.BlueGreenPhaseStable, Active: saasv1alpha1.BlueGreenColourBlue,
+				ActiveRevision: "aaa",
+			}},
+			want: saasv1alpha1.BlueGreenStatuses{{
+				Name: "system-app", Phase: saasv1alpha1.BlueGreenPhaseDeploying, Active: saasv1alpha1.BlueGreenColourBlue,
+				ActiveRevision: "aaa", StandbyRevision: "bbb", Message: "deploying revision bbb to green",
+			}},
+			wantRequeue: pollInterval,
+		},
+		{
+			name:    "Waits until the standby colour is ready",
+			objects: []client.Object{testDeployment("system-app-green", false)},
+			target:  testTarget("bbb", false),
+			current: saasv1alpha1.BlueGreenStatuses{{
+				Name: "system-app", Phase: saasv1alpha1.BlueGreenPhaseDeploying, Active: saasv1alpha1.BlueGreenColourBlue,
+				ActiveRevision: "aaa", StandbyRevision: "bbb",
+			}},
+			want: saasv1alpha1.BlueGreenStatuses{{
+				Name: "system-app", Phase: saasv1alpha1.BlueGreenPhaseDeploying, Active: saasv1alpha1.BlueGreenColourBlue,
+				ActiveRevision: "aaa", StandbyRevision: "bbb",
+			}},
+			wantRequeue: pollInterval,
+		},
+		{
+			name:    "Switches traffic once the standby colour is ready",
+			objects: []client.Object{testDeployment("system-app-green", true)},
+			target:  testTarget("bbb", false),
+			current: saasv1alpha1.BlueGreenStatuses{{
+				Name: "system-app", Phase: saasv1alpha1.BlueGreenPhaseDeploying, Active: saasv1alpha1.BlueGreenColourBlue,
+				ActiveRevision: "aaa", StandbyRevision: "bbb",
+			}},
+			want: saasv1alpha1.BlueGreenStatuses{{
+				Name: "system-app", Phase: saasv1alpha1.BlueGreenPhaseStable, Active: saasv1alpha1.BlueGreenColourGreen,
+				ActiveRevision: "bbb", StandbyRevision: "aaa", SwitchTime: &metav1.Time{Time: testNow},
+				Message: "revision bbb active in green",
+			}},
+			wantRequeue: 30 * time.Minute,
+		},
+		{
+			name:    "Runs the smoke test once the standby colour is ready",
+			objects: []client.Object{testDeployment("system-app", true)},
+			target:  testTarget("ccc", true),
+			current: saasv1alpha1.BlueGreenStatuses{{
+				Name: "system-app", Phase: saasv1alpha1.BlueGreenPhaseDeploying, Active: saasv1alpha1.BlueGreenColourGreen,
+				ActiveRevision: "bbb", StandbyRevision: "ccc",
+			}},
+			want: saasv1alpha1.BlueGreenStatuses{{
+				Name: "system-app", Phase: saasv1alpha1.BlueGreenPhaseTesting, Active: saasv1alpha1.BlueGreenColourGreen,
+				ActiveRevision: "bbb", StandbyRevision: "ccc", Message: "running smoke test against revision ccc in blue",
+			}},
+			wantRequeue: pollInterval,
+		},
+		{
+			name:    "Switches traffic once the smoke test passes",
+			objects: []client.Object{testJob("system-app-smoke-test-bbb", batchv1.JobComplete)},
+			target:  testTarget("bbb", true),
+			current: saasv1alpha1.BlueGreenStatuses{{
+				Name: "system-app", Phase: saasv1alpha1.BlueGreenPhaseTesting, Active: saasv1alpha1.BlueGreenColourBlue,
+				ActiveRevision: "aaa", StandbyRevision: "bbb",
+			}},
+			want: saasv1alpha1.BlueGreenStatuses{{
+				Name: "system-app", Phase: saasv1alpha1.BlueGreenPhaseStable, Active: saasv1alpha1.BlueGreenColourGreen,
+				ActiveRevision: "bbb", StandbyRevision: "aaa", SwitchTime: &metav1.Time{Time: testNow},
+				Message: "revision bbb active in green",
+			}},
+			wantRequeue: 30 * time.Minute,
+		},
+		{
+			name:    "Waits for the smoke test to finish",
+			objects: []client.Object{testJob("system-app-smoke-test-bbb", "")},
+			target:  testTarget("bbb", true),
+			current: saasv1alpha1.BlueGreenStatuses{{
+				Name: "system-app", Phase: saasv1alpha1.BlueGreenPhaseTesting, Active: saasv1alpha1.BlueGreenColourBlue,
+				ActiveRevision: "aaa", StandbyRevision: "bbb",
+			}},
+			want: saasv1alpha1.BlueGreenStatuses{{
+				Name: "system-app", Phase: saasv1alpha1.BlueGreenPhaseTesting, Active: saasv1alpha1.BlueGreenColourBlue,
+				ActiveRevision: "aaa", StandbyRevision: "bbb",
+			}},
+			wantRequeue: pollInterval,
+		},
+		{
+			name:    "Keeps traffic in the active colour if the smoke test fails",
+			objects: []client.Object{testJob("system-app-smoke-test-bbb", batchv1.JobFailed)},
+			target:  testTarget("bbb", true),
+			current: saasv1alpha1.BlueGreenStatuses{{
+				Name: "system-app", Phase: saasv1alpha1.BlueGreenPhaseTesting, Active: saasv1alpha1.BlueGreenColourBlue,
+				ActiveRevision: "aaa", StandbyRevision: "bbb",
+			}},
+			want: saasv1alpha1.BlueGreenStatuses{{
+				Name: "system-app", Phase: saasv1alpha1.BlueGreenPhaseFailed, Active: saasv1alpha1.BlueGreenColourBlue,
+				ActiveRevision: "aaa", StandbyRevision: "bbb", Message: "smoke test of revision bbb failed, traffic kept in blue",
+			}},
+		},
+		{
+			name:   "Aborts the deployment if the spec is reverted",
+			target: testTarget("aaa", true),
+			current: saasv1alpha1.BlueGreenStatuses{{
+				Name: "system-app", Phase: saasv1alpha1.BlueGreenPhaseFailed, Active: saasv1alpha1.BlueGreenColourBlue,
+				ActiveRevision: "aaa", StandbyRevision: "bbb",
+			}},
+			want: saasv1alpha1.BlueGreenStatuses{{
+				Name: "system-app", Phase: saasv1alpha1.BlueGreenPhaseStable, Active: saasv1alpha1.BlueGreenColourBlue,
+				ActiveRevision: "aaa", Message: "deployment aborted, revision aaa active in blue",
+			}},
+		},
+		{
+			name:   "Rolls back instantly within the rollback window",
+			target: testTarget("aaa", true),
+			current: saasv1alpha1.BlueGreenStatuses{{
+				Name: "system-app", Phase: saasv1alpha1.BlueGreenPhaseStable, Active: saasv1alpha1.BlueGreenColourGreen,
+				ActiveRevision: "bbb", StandbyRevision: "aaa", SwitchTime: &metav1.Time{Time: testNow.Add(-10 * time.Minute)},
+			}},
+			want: saasv1alpha1.BlueGreenStatuses{{
+				Name: "system-app", Phase: saasv1alpha1.BlueGreenPhaseStable, Active: saasv1alpha1.BlueGreenColourBlue,
+				ActiveRevision: "aaa", StandbyRevision: "bbb", SwitchTime: &metav1.Time{Time: testNow},
+				Message: "rolled back to revision aaa in blue",
+			}},
+			wantRequeue: 30 * time.Minute,
+		},
+		{
+			name:   "Keeps the previous colour during the rollback window",
+			target: testTarget("bbb", false),
+			current: saasv1alpha1.BlueGreenStatuses{{
+				Name: "system-app", Phase: saasv1alpha1.BlueGreenPhaseStable, Active: saasv1alpha1.BlueGreenColourGreen,
+				ActiveRevision: "bbb", StandbyRevision: "aaa", SwitchTime: &metav1.Time{Time: testNow.Add(-10 * time.Minute)},
+			}},
+			want: saasv1alpha1.BlueGreenStatuses{{
+				Name: "system-app", Phase: saasv1alpha1.BlueGreenPhaseStable, Active: saasv1alpha1.BlueGreenColourGreen,
+				ActiveRevision: "bbb", StandbyRevision: "aaa", SwitchTime: &metav1.Time{Time: testNow.Add(-10 * time.Minute)},
+			}},
+			wantRequeue: 20 * time.Minute,
+		},
+		{
+			name:   "Drops the previous colour when the rollback window expires",
+			target: testTarget("bbb", false),
+			current: saasv1alpha1.BlueGreenStatuses{{
+				Name: "system-app", Phase: saasv1alpha1.BlueGreenPhaseStable, Active: saasv1alpha1.BlueGreenColourGreen,
+				ActiveRevision: "bbb", StandbyRevision: "aaa", SwitchTime: &metav1.Time{Time: testNow.Add(-31 * time.Minute)},
+			}},
+			want: saasv1alpha1.BlueGreenStatuses{{
+				Name: "system-app", Phase: saasv1alpha1.BlueGreenPhaseStable, Active: saasv1alpha1.BlueGreenColourGreen,
+				ActiveRevision: "bbb", SwitchTime: &metav1.Time{Time: testNow.Add(-31 * time.Minute)},
+				Message: "revision bbb active in green, rollback window expired",
+			}},
+		},
+		{
+			name:   "Drops statuses of workloads without blue/green",
+			target: Target{Name: "system-app", Revision: "aaa"},
+			current: saasv1alpha1.BlueGreenStatuses{{
+				Name: "system-app", Phase: saasv1alpha1.BlueGreenPhaseStable, Active: saasv1alpha1.BlueGreenColourBlue,
+				ActiveRevision: "aaa",
+			}},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotRequeue, err := testSwitcher(tt.objects...).Progress(context.TODO(), "ns", []Target{tt.target}, tt.current)
+			if err != nil {
+				t.Fatalf("Switcher.Progress() error = %v", err)
+			}
+			if diff := cmp.Diff(got, tt.want); len(diff) > 0 {
+				t.Errorf("Switcher.Progress() got diff %v", diff)
+			}
+			if gotRequeue != tt.wantRequeue {
+				t.Errorf("Switcher.Progress() requeue = %v, want %v", gotRequeue, tt.wantRequeue)
+			}
+		})
+	}
+}
+
+func TestApply(t *testing.T) {
+	target := testTarget("bbb", false)
+	statuses := saasv1alpha1.BlueGreenStatuses{{
+		Name: "system-app", Phase: saasv1alpha1.BlueGreenPhaseDeploying, Active: saasv1alpha1.BlueGreenColourBlue,
+		ActiveRevision: "aaa", StandbyRevision: "bbb",
+	}}
+	Apply([]Target{target}, statuses)
+	if diff := cmp.Diff(target.Spec.State, &statuses[0]); len(diff) > 0 {
+		t.Errorf("Apply() got diff %v", diff)
+	}
+}
@@ -0,0 +1,355 @@
+package canary
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/3scale-ops/basereconciler/util"
+	saasv1alpha1 "github.com/3scale-ops/saas-operator/api/v1alpha1"
+	"github.com/samber/lo"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// analysisRetryInterval is the time to wait before retrying
+	// an analysis that couldn't be completed due to errors
+	analysisRetryInterval time.Duration = 30 * time.Second
+	// analysisTimeout bounds the time spent evaluating the metric
+	// checks of all the canaries in a single reconcile
+	analysisTimeout time.Duration = 15 * time.Second
+)
+
+// Target is a canary Deployment, together with the parts
+// of the main workload spec that progressive delivery acts upon
+type Target struct {
+	// Name of the canary Deployment
+	Name string
+	// Canary spec of the workload
+	Canary *saasv1alpha1.Canary
+	// Image of the main Deployment, updated when the canary is promoted
+	Image *saasv1alpha1.ImageSpec
+	// Replicas of the main Deployment, used to scale the canary
+	// when the Replicas traffic mode is used
+	Replicas *int32
+	// PublishingStrategies of the main Deployment, used to set route and
+	// marin3r sidecar weights when the Route traffic mode is used
+	PublishingStrategies *saasv1alpha1.PublishingStrategies
+	// RemoveCanary removes the canary from the workload spec
+	RemoveCanary func()
+}
+
+func (t Target) progressive() *saasv1alpha1.ProgressiveDeliverySpec {
+	if t.Canary == nil {
+		return nil
+	}
+	return t.Canary.Progressive
+}
+
+// routable returns true if traffic can be shifted to the canary using the Route traffic
+// mode. This requires an endpoint published with an HTTPRoute or a TLSRoute, or a marin3r
+// sidecar with typed routes to the workload in the same Pod.
+func (t Target) routable() bool {
+	if t.PublishingStrategies == nil {
+		return false
+	}
+	for _, ep := range t.PublishingStrategies.Endpoints {
+		switch ep.Strategy {
+		case saasv1alpha1.RouteStrategy:
+			if ep.Route != nil && lo.FromPtrOr(ep.Route.Kind, saasv1alpha1.RouteKindHTTPRoute) != saasv1alpha1.RouteKindIngress {
+				return true
+			}
+		case saasv1alpha1.Marin3rSidecarStrategy:
+			if ep.Marin3rSidecar != nil && len(ep.Marin3rSidecar.CanaryClusters()) > 0 {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Analyzer drives the progressive delivery of canaries
+type Analyzer struct {
+	NewMetricsClient func(address string) (MetricsClient, error)
+	Now              func() time.Time
+	// Timeout bounds the total time spent in the analyses of a call
+	// to Progress. The analyses that don't complete in time are retried
+	// later. No timeout is applied if zero.
+	Timeout time.Duration
+}
+
+// NewAnalyzer returns an Analyzer that evaluates metric checks using Prometheus
+func NewAnalyzer() *Analyzer {
+	return &Analyzer{NewMetricsClient: NewPrometheusClient, Now: time.Now, Timeout: analysisTimeout}
+}
+
+// Progress evaluates the progressive delivery of the targets and returns their updated
+// statuses, together with the time after which the progression needs to be evaluated
+// again (zero if no target requires it). Statuses of canaries that no longer exist or
+// no longer use progressive delivery are dropped.
+func (a *Analyzer) Progress(ctx context.Context, targets []Target,
+	current saasv1alpha1.CanaryStatuses) (saasv1alpha1.CanaryStatuses, time.Duration) {
+
+	var statuses saasv1alpha1.CanaryStatuses
+	var requeue time.Duration
+
+	// the analyses run within the reconcile, so the time spent
+	// querying the metrics of all the canaries is bounded
+	if a.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, a.Timeout)
+		defer cancel()
+	}
+
+	for _, t := range targets {
+		spec := t.progressive()
+		if spec == nil {
+			continue
+		}
+
+		var status *saasv1alpha1.CanaryStatus
+		if st := current.Get(t.Name); st != nil && st.Revision == t.Canary.Revision() &&
+			st.Phase != saasv1alpha1.CanaryPhaseRejected {
+			status = st.DeepCopy()
+		} else {
+			// New canary revision, (re)start the progressive delivery
+			status = &saasv1alpha1.CanaryStatus{
+				Name:     t.Name,
+				Revision: t.Canary.Revision(),
+			}
+			a.setStep(status, spec, 0)
+		}
+
+		// Without an endpoint that supports weights the canary would get no
+		// traffic, so it could neither be analyzed nor promoted
+		if spec.GetTrafficMode() == saasv1alpha1.ProgressiveTrafficModeRoute && !t.routable() {
+			status.Phase = saasv1alpha1.CanaryPhaseRejected
+			status.Weight = 0
+			status.StepStartTime = nil
+			status.Message = "the Route traffic mode requires an endpoint published with an HTTPRoute/TLSRoute " +
+				"or a marin3r sidecar with typed routes to the workload"
+			statuses = append(statuses, *status)
+			continue
+		}
+
+		after := a.progress(ctx, status, spec)
+		if after > 0 && (requeue == 0 || after < requeue) {
+			requeue = after
+		}
+		statuses = append(statuses, *status)
+	}
+
+	return statuses, requeue
+}
+
+// progress evaluates the current step of a single canary
+func (a *Analyzer) progress(ctx context.Context, status *saasv1alpha1.CanaryStatus,
+	spec *saasv1alpha1.ProgressiveDeliverySpec) time.Duration {
+
+	if status.Phase != saasv1alpha1.CanaryPhaseProgressing {
+		return 0
+	}
+
+	// Steps might have been removed from the spec
+	if int(status.CurrentStep) >= len(spec.Steps) {
+		a.setStep(status, spec, int32(len(spec.Steps)-1))
+	}
+
+	step := spec.Steps[status.CurrentStep]
+	elapsed := a.Now().Sub(status.StepStartTime.Time)
+	if pause := step.GetPause().Duration; elapsed < pause {
+		return pause - elapsed
+	}
+
+	if spec.Analysis != nil {
+		verdicts, err := a.analyze(ctx, spec.Analysis, status.CurrentStep)
+		if err != nil {
+			status.Message = fmt.Sprintf("unable to complete analysis of step %d: %s", status.CurrentStep+1, err.Error())
+			return analysisRetryInterval
+		}
+		status.Verdicts = append(status.Verdicts, verdicts...)
+
+		for _, v := range verdicts {
+			if !v.Passed {
+				status.Phase = saasv1alpha1.CanaryPhaseRolledBack
+				status.Weight = 0
+				status.Message = fmt.Sprintf("metric check '%s' failed in step %d with value %s",
+					v.Metric, status.CurrentStep+1, v.Value)
+				return 0
+			}
+		}
+	}
+
+	if int(status.CurrentStep)+1 < len(spec.Steps) {
+		a.setStep(status, spec, status.CurrentStep+1)
+		return spec.Steps[status.CurrentStep].GetPause().Duration
+	}
+
+	status.Phase = saasv1alpha1.CanaryPhaseSucceeded
+	status.Message = "all steps succeeded"
+	return 0
+}
+
+func (a *Analyzer) setStep(status *saasv1alpha1.CanaryStatus, spec *saasv1alpha1.ProgressiveDeliverySpec, step int32) {
+	status.Phase = saasv1alpha1.CanaryPhaseProgressing
+	status.CurrentStep = step
+	status.Weight = spec.Steps[step].Weight
+	status.StepStartTime = &metav1.Time{Time: a.Now()}
+	status.Message = fmt.Sprintf("step %d/%d: sending %d%% of traffic to the canary", step+1, len(spec.Steps), status.Weight)
+}
+
+// analyze evaluates the metric checks of a step
+func (a *Analyzer) analyze(ctx context.Context, spec *saasv1alpha1.CanaryAnalysisSpec, step int32) ([]saasv1alpha1.CanaryVerdict, error) {
+	cl, err := a.NewMetricsClient(spec.PrometheusAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	verdicts := make([]saasv1alpha1.CanaryVerdict, 0, len(spec.Metrics))
+	for _, metric := range spec.Metrics {
+		if err := ctx.Err(); err != nil {
+			return nil, fmt.Errorf("analysis timed out: %w", err)
+		}
+		value, err := cl.Query(ctx, metric.Query)
+		if err != nil {
+			return nil, fmt.Errorf("metric check '%s': %w", metric.Name, err)
+		}
+		passed, err := evaluate(value, metric)
+		if err != nil {
+			return nil, fmt.Errorf("metric check '%s': %w", metric.Name, err)
+		}
+		verdicts = append(verdicts, saasv1alpha1.CanaryVerdict{
+			Step:   step,
+			Metric: metric.Name,
+			Value:  strconv.FormatFloat(value, 'f', -1, 64),
+			Passed: passed,
+			Time:   metav1.Time{Time: a.Now()},
+		})
+	}
+
+	return verdicts, nil
+}
+
+// evaluate returns whether the value is within the thresholds of the metric check.
+// NaN values (eg a ratio with no requests) never pass.
+func evaluate(value float64, metric saasv1alpha1.CanaryMetric) (bool, error) {
+	if math.IsNaN(value) {
+		return false, nil
+	}
+	if metric.Min != nil {
+		min, err := strconv.ParseFloat(*metric.Min, 64)
+		if err != nil {
+			return false, err
+		}
+		if value < min {
+			return false, nil
+		}
+	}
+	if metric.Max != nil {
+		max, err := strconv.ParseFloat(*metric.Max, 64)
+		if err != nil {
+			return false, err
+		}
+		if value > max {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// Apply sets the traffic weights of the targets in their specs, according to their
+// progressive delivery statuses. The changes are meant to be kept in memory only, to
+// generate the resources, and never persisted in the API server.
+func Apply(targets []Target, statuses saasv1alpha1.CanaryStatuses) {
+	for _, t := range targets {
+		spec := t.progressive()
+		if spec == nil {
+			continue
+		}
+		status := statuses.Get(t.Name)
+		if status == nil {
+			continue
+		}
+
+		mainReplicas := int32(1)
+		if t.Replicas != nil {
+			mainReplicas = *t.Replicas
+		}
+
+		switch spec.GetTrafficMode() {
+
+		case saasv1alpha1.ProgressiveTrafficModeRoute:
+			t.Canary.SendTraffic = false
+			if status.IsFinished() {
+				t.Canary.Replicas = util.Pointer(int32(0))
+				continue
+			}
+			if t.Canary.Replicas == nil {
+				t.Canary.Replicas = util.Pointer(mainReplicas)
+			}
+			if t.PublishingStrategies != nil {
+				for i := range t.PublishingStrategies.Endpoints {
+					if route := t.PublishingStrategies.Endpoints[i].Route; route != nil {
+						route.CanaryWeight = util.Pointer(status.Weight)
+					}
+					if sidecar := t.PublishingStrategies.Endpoints[i].Marin3rSidecar; sidecar != nil {
+						sidecar.CanaryWeight = util.Pointer(status.Weight)
+					}
+				}
+			}
+
+		default:
+			if status.IsFinished() {
+				t.Canary.SendTraffic = false
+				t.Canary.Replicas = util.Pointer(int32(0))
+				continue
+			}
+			t.Canary.SendTraffic = status.Weight > 0
+			t.Canary.Replicas = util.Pointer(Replicas(mainReplicas, status.Weight))
+		}
+	}
+}
+
+// Replicas returns the number of canary replicas required for the canary
+// to receive the given percentage of traffic, given the number of replicas
+// of the main Deployment. At least one canary replica is always returned so
+// the canary can be analyzed.
+func Replicas(main int32, weight int32) int32 {
+	if weight >= 100 {
+		return max(main, 1)
+	}
+	replicas := int32(math.Ceil(float64(main) * float64(weight) / float64(100-weight)))
+	return max(replicas, 1)
+}
+
+// Promote updates the image of the main Deployment of the targets whose canary
+// succeeded and have automatic promotion enabled. It returns true if any
+// target has been promoted.
+func Promote(targets []Target, statuses saasv1alpha1.CanaryStatuses) bool {
+	promoted := false
+	for _, t := range targets {
+		spec := t.progressive()
+		if spec == nil || !spec.IsAutoPromoteEnabled() {
+			continue
+		}
+		status := statuses.Get(t.Name)
+		if status == nil || status.Phase != saasv1alpha1.CanaryPhaseSucceeded {
+			continue
+		}
+
+		if t.Canary.ImageName != nil {
+			t.Image.Name = util.Pointer(*t.Canary.ImageName)
+		}
+		if t.Canary.ImageTag != nil {
+			t.Image.Tag = util.Pointer(*t.Canary.ImageTag)
+		}
+		status.Phase = saasv1alpha1.CanaryPhasePromoted
+		status.Weight = 0
+		status.Message = fmt.Sprintf("image '%s:%s' promoted to the main Deployment",
+			lo.FromPtr(t.Image.Name), lo.FromPtr(t.Image.Tag))
+		promoted = true
+	}
+	return promoted
+}
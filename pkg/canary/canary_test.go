@@ -0,0 +1,468 @@
+package canary
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/3scale-ops/basereconciler/util"
+	saasv1alpha1 "github.com/3scale-ops/saas-operator/api/v1alpha1"
+	"github.com/google/go-cmp/cmp"
+	"github.com/prometheus/common/model"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type testMetricsClient map[string]float64
+
+func (c testMetricsClient) Query(ctx context.Context, query string) (float64, error) {
+	if v, ok := c[query]; ok {
+		return v, nil
+	}
+	return 0, fmt.Errorf("unknown query")
+}
+
+var testNow = time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+func testAnalyzer(values map[string]float64) *Analyzer {
+	return &Analyzer{
+		NewMetricsClient: func(string) (MetricsClient, error) { return testMetricsClient(values), nil },
+		Now:              func() time.Time { return testNow },
+	}
+}
+
+func testTarget() Target {
+	return Target{
+		Name: "backend-listener-canary",
+		Canary: &saasv1alpha1.Canary{
+			ImageTag: util.Pointer("v2"),
+			Progressive: &saasv1alpha1.ProgressiveDeliverySpec{
+				Steps: []saasv1alpha1.CanaryStep{
+					{Weight: 10, Pause: &metav1.Duration{Duration: 5 * time.Minute}},
+					{Weight: 50, Pause: &metav1.Duration{Duration: 10 * time.Minute}},
+				},
+				Analysis: &saasv1alpha1.CanaryAnalysisSpec{
+					PrometheusAddress: "http://prometheus:9090",
+					Metrics: []saasv1alpha1.CanaryMetric{
+						{Name: "error-rate", Query: "errors", Max: util.Pointer("0.05")},
+					},
+				},
+			},
+		},
+		Image:    &saasv1alpha1.ImageSpec{Name: util.Pointer("quay.io/3scale/backend"), Tag: util.Pointer("v1")},
+		Replicas: util.Pointer(int32(9)),
+	}
+}
+
+func TestAnalyzer_Progress(t *testing.T) {
+	revision := testTarget().Canary.Revision()
+	tests := []struct {
+		name        string
+		values      map[string]float64
+		current     saasv1alpha1.CanaryStatuses
+		want        saasv1alpha1.CanaryStatuses
+		wantRequeue time.Duration
+	}{
+		{
+			name:    "Starts the progressive delivery",
+			current: nil,
+			want: saasv1alpha1.CanaryStatuses{{
+				Name:          "backend-listener-canary",
+				Revision:      revision,
+				Phase:         saasv1alpha1.CanaryPhaseProgressing,
+				CurrentStep:   0,
+				Weight:        10,
+				StepStartTime: &metav1.Time{Time: testNow},
+				Message:       "step 1/2: sending 10% of traffic to the canary",
+			}},
+			wantRequeue: 5 * time.Minute,
+		},
+		{
+			name: "Waits until the pause elapses",
+			current: saasv1alpha1.CanaryStatuses{{
+				Name:          "backend-listener-canary",
+				Revision:      revision,
+				Phase:         saasv1alpha1.CanaryPhaseProgressing,
+				CurrentStep:   0,
+				Weight:        10,
+				StepStartTime: &metav1.Time{Time: testNow.Add(-3 * time.Minute)},
+			}},
+			want: saasv1alpha1.CanaryStatuses{{
+				Name:          "backend-listener-canary",
+				Revision:      revision,
+				Phase:         saasv1alpha1.CanaryPhaseProgressing,
+				CurrentStep:   0,
+				Weight:        10,
+				StepStartTime: &metav1.Time{Time: testNow.Add(-3 * time.Minute)},
+			}},
+			wantRequeue: 2 * time.Minute,
+		},
+		{
+			name:   "Progresses to the next step if the analysis passes",
+			values: map[string]float64{"errors": 0.01},
+			current: saasv1alpha1.CanaryStatuses{{
+				Name:          "backend-listener-canary",
+				Revision:      revision,
+				Phase:         saasv1alpha1.CanaryPhaseProgressing,
+				CurrentStep:   0,
+				Weight:        10,
+				StepStartTime: &metav1.Time{Time: testNow.Add(-6 * time.Minute)},
+			}},
+			want: saasv1alpha1.CanaryStatuses{{
+				Name:          "backend-listener-canary",
+				Revision:      revision,
+				Phase:         saasv1alpha1.CanaryPhaseProgressing,
+				CurrentStep:   1,
+				Weight:        50,
+				StepStartTime: &metav1.Time{Time: testNow},
+				Verdicts: []saasv1alpha1.CanaryVerdict{
+					{Step: 0, Metric: "error-rate", Value: "0.01", Passed: true, Time: metav1.Time{Time: testNow}},
+				},
+				Message: "step 2/2: sending 50% of traffic to the canary",
+			}},
+			wantRequeue: 10 * time.Minute,
+		},
+		{
+			name:   "Succeeds after the last step",
+			values: map[string]float64{"errors": 0.02},
+			current: saasv1alpha1.CanaryStatuses{{
+				Name:          "backend-listener-canary",
+				Revision:      revision,
+				Phase:         saasv1alpha1.CanaryPhaseProgressing,
+				CurrentStep:   1,
+				Weight:        50,
+				StepStartTime: &metav1.Time{Time: testNow.Add(-10 * time.Minute)},
+			}},
+			want: saasv1alpha1.CanaryStatuses{{
+				Name:          "backend-listener-canary",
+				Revision:      revision,
+				Phase:         saasv1alpha1.CanaryPhaseSucceeded,
+				CurrentStep:   1,
+				Weight:        50,
+				StepStartTime: &metav1.Time{Time: testNow.Add(-10 * time.Minute)},
+				Verdicts: []saasv1alpha1.CanaryVerdict{
+					{Step: 1, Metric: "error-rate", Value: "0.02", Passed: true, Time: metav1.Time{Time: testNow}},
+				},
+				Message: "all steps succeeded",
+			}},
+		},
+		{
+			name:   "Rolls back if the analysis fails",
+			values: map[string]float64{"errors": 0.1},
+			current: saasv1alpha1.CanaryStatuses{{
+				Name:          "backend-listener-canary",
+				Revision:      revision,
+				Phase:         saasv1alpha1.CanaryPhaseProgressing,
+				CurrentStep:   0,
+				Weight:        10,
+				StepStartTime: &metav1.Time{Time: testNow.Add(-5 * time.Minute)},
+			}},
+			want: saasv1alpha1.CanaryStatuses{{
+				Name:          "backend-listener-canary",
+				Revision:      revision,
+				Phase:         saasv1alpha1.CanaryPhaseRolledBack,
+				CurrentStep:   0,
+				Weight:        0,
+				StepStartTime: &metav1.Time{Time: testNow.Add(-5 * time.Minute)},
+				Verdicts: []saasv1alpha1.CanaryVerdict{
+					{Step: 0, Metric: "error-rate", Value: "0.1", Passed: false, Time: metav1.Time{Time: testNow}},
+				},
+				Message: "metric check 'error-rate' failed in step 1 with value 0.1",
+			}},
+		},
+		{
+			name:   "Retries the analysis on errors",
+			values: map[string]float64{},
+			current: saasv1alpha1.CanaryStatuses{{
+				Name:          "backend-listener-canary",
+				Revision:      revision,
+				Phase:         saasv1alpha1.CanaryPhaseProgressing,
+				CurrentStep:   0,
+				Weight:        10,
+				StepStartTime: &metav1.Time{Time: testNow.Add(-5 * time.Minute)},
+			}},
+			want: saasv1alpha1.CanaryStatuses{{
+				Name:          "backend-listener-canary",
+				Revision:      revision,
+				Phase:         saasv1alpha1.CanaryPhaseProgressing,
+				CurrentStep:   0,
+				Weight:        10,
+				StepStartTime: &metav1.Time{Time: testNow.Add(-5 * time.Minute)},
+				Message:       "unable to complete analysis of step 1: metric check 'error-rate': unknown query",
+			}},
+			wantRequeue: analysisRetryInterval,
+		},
+		{
+			name: "Restarts if the canary revision changes",
+			current: saasv1alpha1.CanaryStatuses{{
+				Name:     "backend-listener-canary",
+				Revision: "old",
+				Phase:    saasv1alpha1.CanaryPhaseRolledBack,
+			}},
+			want: saasv1alpha1.CanaryStatuses{{
+				Name:          "backend-listener-canary",
+				Revision:      revision,
+				Phase:         saasv1alpha1.CanaryPhaseProgressing,
+				CurrentStep:   0,
+				Weight:        10,
+				StepStartTime: &metav1.Time{Time: testNow},
+				Message:       "step 1/2: sending 10% of traffic to the canary",
+			}},
+			wantRequeue: 5 * time.Minute,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, requeue := testAnalyzer(tt.values).Progress(context.TODO(), []Target{testTarget()}, tt.current)
+			if diff := cmp.Diff(got, tt.want); len(diff) > 0 {
+				t.Errorf("Analyzer.Progress() diff = %v", diff)
+			}
+			if requeue != tt.wantRequeue {
+				t.Errorf("Analyzer.Progress() requeue = %v, want %v", requeue, tt.wantRequeue)
+			}
+		})
+	}
+}
+
+func TestAnalyzer_Progress_dropsStatuses(t *testing.T) {
+	target := testTarget()
+	target.Canary.Progressive = nil
+	got, _ := testAnalyzer(nil).Progress(context.TODO(), []Target{target},
+		saasv1alpha1.CanaryStatuses{{Name: "backend-listener-canary"}})
+	if got != nil {
+		t.Errorf("Analyzer.Progress() = %v, want nil", got)
+	}
+}
+
+func TestAnalyzer_Progress_routeMode(t *testing.T) {
+	tests := []struct {
+		name      string
+		endpoints []saasv1alpha1.PublishingStrategy
+		want      saasv1alpha1.CanaryPhase
+	}{
+		{
+			name: "Progresses with an HTTPRoute endpoint",
+			endpoints: []saasv1alpha1.PublishingStrategy{
+				{Strategy: saasv1alpha1.RouteStrategy, EndpointName: "HTTP", Route: &saasv1alpha1.RouteStrategySpec{}},
+			},
+			want: saasv1alpha1.CanaryPhaseProgressing,
+		},
+		{
+			name: "Rejects the canary with an Ingress endpoint",
+			endpoints: []saasv1alpha1.PublishingStrategy{
+				{Strategy: saasv1alpha1.RouteStrategy, EndpointName: "HTTP",
+					Route: &saasv1alpha1.RouteStrategySpec{Kind: util.Pointer(saasv1alpha1.RouteKindIngress)}},
+			},
+			want: saasv1alpha1.CanaryPhaseRejected,
+		},
+		{
+			name: "Rejects the canary without weighted endpoints",
+			endpoints: []saasv1alpha1.PublishingStrategy{
+				{Strategy: saasv1alpha1.SimpleStrategy, EndpointName: "HTTP"},
+			},
+			want: saasv1alpha1.CanaryPhaseRejected,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := testTarget()
+			target.Canary.Progressive.TrafficMode = util.Pointer(saasv1alpha1.ProgressiveTrafficModeRoute)
+			target.PublishingStrategies = &saasv1alpha1.PublishingStrategies{Endpoints: tt.endpoints}
+			got, _ := testAnalyzer(nil).Progress(context.TODO(), []Target{target}, nil)
+			if len(got) != 1 || got[0].Phase != tt.want {
+				t.Errorf("Analyzer.Progress() = %v, want phase %v", got, tt.want)
+			}
+			if tt.want == saasv1alpha1.CanaryPhaseRejected && got[0].Weight != 0 {
+				t.Errorf("Analyzer.Progress() weight = %v, want 0", got[0].Weight)
+			}
+		})
+	}
+}
+
+type blockingMetricsClient struct{}
+
+func (c blockingMetricsClient) Query(ctx context.Context, query string) (float64, error) {
+	<-ctx.Done()
+	return 0, ctx.Err()
+}
+
+func TestAnalyzer_Progress_timeout(t *testing.T) {
+	a := &Analyzer{
+		NewMetricsClient: func(string) (MetricsClient, error) { return blockingMetricsClient{}, nil },
+		Now:              func() time.Time { return testNow },
+		Timeout:          50 * time.Millisecond,
+	}
+	targets := []Target{testTarget(), testTarget()}
+	targets[1].Name = "backend-worker-canary"
+	current := saasv1alpha1.CanaryStatuses{}
+	for _, target := range targets {
+		current = append(current, saasv1alpha1.CanaryStatus{
+			Name:          target.Name,
+			Revision:      target.Canary.Revision(),
+			Phase:         saasv1alpha1.CanaryPhaseProgressing,
+			Weight:        10,
+			StepStartTime: &metav1.Time{Time: testNow.Add(-6 * time.Minute)},
+		})
+	}
+
+	start := time.Now()
+	got, requeue := a.Progress(context.TODO(), targets, current)
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("Analyzer.Progress() took %v, want the analyses bounded by the timeout", elapsed)
+	}
+	if requeue != analysisRetryInterval {
+		t.Errorf("Analyzer.Progress() requeue = %v, want %v", requeue, analysisRetryInterval)
+	}
+	for _, status := range got {
+		if status.Phase != saasv1alpha1.CanaryPhaseProgressing || status.CurrentStep != 0 ||
+			!strings.HasPrefix(status.Message, "unable to complete analysis of step 1") {
+			t.Errorf("Analyzer.Progress() status = %v, want the analysis to be retried", status)
+		}
+	}
+}
+
+func Test_evaluate(t *testing.T) {
+	tests := []struct {
+		name   string
+		value  float64
+		metric saasv1alpha1.CanaryMetric
+		want   bool
+	}{
+		{"Within range", 0.5, saasv1alpha1.CanaryMetric{Min: util.Pointer("0"), Max: util.Pointer("1")}, true},
+		{"Below min", -1, saasv1alpha1.CanaryMetric{Min: util.Pointer("0")}, false},
+		{"Above max", 300, saasv1alpha1.CanaryMetric{Max: util.Pointer("250")}, false},
+		{"NaN", math.NaN(), saasv1alpha1.CanaryMetric{}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := evaluate(tt.value, tt.metric)
+			if err != nil {
+				t.Fatalf("evaluate() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("evaluate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestApply(t *testing.T) {
+	t.Run("Scales the canary in Replicas mode", func(t *testing.T) {
+		target := testTarget()
+		Apply([]Target{target}, saasv1alpha1.CanaryStatuses{
+			{Name: "backend-listener-canary", Phase: saasv1alpha1.CanaryPhaseProgressing, Weight: 25},
+		})
+		if !target.Canary.SendTraffic || *target.Canary.Replicas != 3 {
+			t.Errorf("Apply() = sendTraffic %v, replicas %v", target.Canary.SendTraffic, *target.Canary.Replicas)
+		}
+	})
+
+	t.Run("Scales down the canary when rolled back", func(t *testing.T) {
+		target := testTarget()
+		target.Canary.SendTraffic = true
+		Apply([]Target{target}, saasv1alpha1.CanaryStatuses{
+			{Name: "backend-listener-canary", Phase: saasv1alpha1.CanaryPhaseRolledBack},
+		})
+		if target.Canary.SendTraffic || *target.Canary.Replicas != 0 {
+			t.Errorf("Apply() = sendTraffic %v, replicas %v", target.Canary.SendTraffic, *target.Canary.Replicas)
+		}
+	})
+
+	t.Run("Sets route weights in Route mode", func(t *testing.T) {
+		target := testTarget()
+		target.Canary.Progressive.TrafficMode = util.Pointer(saasv1alpha1.ProgressiveTrafficModeRoute)
+		target.PublishingStrategies = &saasv1alpha1.PublishingStrategies{
+			Endpoints: []saasv1alpha1.PublishingStrategy{
+				{Strategy: saasv1alpha1.SimpleStrategy, EndpointName: "Internal"},
+				{Strategy: saasv1alpha1.RouteStrategy, EndpointName: "HTTP", Route: &saasv1alpha1.RouteStrategySpec{}},
+				{Strategy: saasv1alpha1.Marin3rSidecarStrategy, EndpointName: "Envoy", Marin3rSidecar: &saasv1alpha1.Marin3rSidecarSpec{}},
+			},
+		}
+		Apply([]Target{target}, saasv1alpha1.CanaryStatuses{
+			{Name: "backend-listener-canary", Phase: saasv1alpha1.CanaryPhaseProgressing, Weight: 25},
+		})
+		if target.Canary.SendTraffic || *target.Canary.Replicas != 9 {
+			t.Errorf("Apply() = sendTraffic %v, replicas %v", target.Canary.SendTraffic, *target.Canary.Replicas)
+		}
+		if w := target.PublishingStrategies.Endpoints[1].Route.CanaryWeight; w == nil || *w != 25 {
+			t.Errorf("Apply() = canaryWeight %v", w)
+		}
+		if w := target.PublishingStrategies.Endpoints[2].Marin3rSidecar.CanaryWeight; w == nil || *w != 25 {
+			t.Errorf("Apply() = sidecar canaryWeight %v", w)
+		}
+	})
+}
+
+func TestReplicas(t *testing.T) {
+	tests := []struct {
+		main   int32
+		weight int32
+		want   int32
+	}{
+		{main: 9, weight: 10, want: 1},
+		{main: 9, weight: 50, want: 9},
+		{main: 3, weight: 25, want: 1},
+		{main: 10, weight: 75, want: 30},
+		{main: 4, weight: 100, want: 4},
+		{main: 4, weight: 0, want: 1},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%d/%d%%", tt.main, tt.weight), func(t *testing.T) {
+			if got := Replicas(tt.main, tt.weight); got != tt.want {
+				t.Errorf("Replicas() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPromote(t *testing.T) {
+	target := testTarget()
+	statuses := saasv1alpha1.CanaryStatuses{
+		{Name: "backend-listener-canary", Phase: saasv1alpha1.CanaryPhaseSucceeded, Weight: 50},
+	}
+	if !Promote([]Target{target}, statuses) {
+		t.Fatalf("Promote() = false")
+	}
+	if *target.Image.Tag != "v2" || *target.Image.Name != "quay.io/3scale/backend" {
+		t.Errorf("Promote() image = %s:%s", *target.Image.Name, *target.Image.Tag)
+	}
+	if statuses[0].Phase != saasv1alpha1.CanaryPhasePromoted || statuses[0].Weight != 0 {
+		t.Errorf("Promote() status = %v", statuses[0])
+	}
+
+	target = testTarget()
+	target.Canary.Progressive.AutoPromote = util.Pointer(false)
+	if Promote([]Target{target}, saasv1alpha1.CanaryStatuses{
+		{Name: "backend-listener-canary", Phase: saasv1alpha1.CanaryPhaseSucceeded},
+	}) {
+		t.Errorf("Promote() = true with autoPromote disabled")
+	}
+}
+
+func Test_valueOf(t *testing.T) {
+	tests := []struct {
+		name    string
+		result  model.Value
+		want    float64
+		wantErr bool
+	}{
+		{"Scalar", &model.Scalar{Value: 1.5}, 1.5, false},
+		{"Single sample vector", model.Vector{{Value: 0.2}}, 0.2, false},
+		{"Empty vector", model.Vector{}, 0, true},
+		{"Matrix", model.Matrix{}, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := valueOf(tt.result)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("valueOf() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("valueOf() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
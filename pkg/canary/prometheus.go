@@ -0,0 +1,59 @@
+package canary
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/prometheus/client_golang/api"
+	prometheusv1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	"github.com/prometheus/common/model"
+)
+
+// MetricsClient evaluates the queries of the metric checks
+type MetricsClient interface {
+	Query(ctx context.Context, query string) (float64, error)
+}
+
+// PrometheusClient is a MetricsClient that evaluates PromQL queries
+type PrometheusClient struct {
+	api prometheusv1.API
+}
+
+var _ MetricsClient = &PrometheusClient{}
+
+// NewPrometheusClient returns a client for the Prometheus API at the given address
+func NewPrometheusClient(address string) (MetricsClient, error) {
+	cl, err := api.NewClient(api.Config{Address: address})
+	if err != nil {
+		return nil, err
+	}
+	return &PrometheusClient{api: prometheusv1.NewAPI(cl)}, nil
+}
+
+// Query evaluates an instant query and returns its value. The query must
+// return either a scalar or a vector with a single sample.
+func (c *PrometheusClient) Query(ctx context.Context, query string) (float64, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	result, _, err := c.api.Query(ctx, query, time.Now())
+	if err != nil {
+		return 0, err
+	}
+	return valueOf(result)
+}
+
+func valueOf(result model.Value) (float64, error) {
+	switch v := result.(type) {
+	case *model.Scalar:
+		return float64(v.Value), nil
+	case model.Vector:
+		if len(v) != 1 {
+			return 0, fmt.Errorf("query returned %d samples, expected 1", len(v))
+		}
+		return float64(v[0].Value), nil
+	default:
+		return 0, fmt.Errorf("unsupported query result type '%s'", result.Type())
+	}
+}
@@ -0,0 +1,89 @@
+package canary
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	saasv1alpha1 "github.com/3scale-ops/saas-operator/api/v1alpha1"
+	jsonpatch "github.com/evanphx/json-patch"
+	"github.com/samber/lo"
+)
+
+// PromoteSpec merges the effective spec of the named canary (the main spec with the canary
+// patches and image applied) into the main spec and removes the canary from it. Canary
+// patches are written against the defaulted spec, so the changes are computed on the
+// defaulted spec and then applied to 'spec', which is expected not to be defaulted, to
+// avoid persisting the defaults. It returns the JSON merge patch applied to the spec.
+func PromoteSpec[T any](spec *T, defaulted *T, targets func(*T) []Target, name string) ([]byte, error) {
+
+	target, ok := lo.Find(targets(defaulted), func(t Target) bool { return t.Name == name })
+	if !ok || target.Canary == nil {
+		return nil, fmt.Errorf("canary '%s' not found", name)
+	}
+
+	promoted := new(T)
+	if err := target.Canary.PatchSpec(defaulted, promoted); err != nil {
+		return nil, err
+	}
+
+	t, _ := lo.Find(targets(promoted), func(t Target) bool { return t.Name == name })
+	if target.Canary.ImageName != nil {
+		t.Image.Name = lo.ToPtr(*target.Canary.ImageName)
+	}
+	if target.Canary.ImageTag != nil {
+		t.Image.Tag = lo.ToPtr(*target.Canary.ImageTag)
+	}
+	t.RemoveCanary()
+
+	original, err := json.Marshal(defaulted)
+	if err != nil {
+		return nil, err
+	}
+	modified, err := json.Marshal(promoted)
+	if err != nil {
+		return nil, err
+	}
+	patch, err := jsonpatch.CreateMergePatch(original, modified)
+	if err != nil {
+		return nil, err
+	}
+
+	doc, err := json.Marshal(spec)
+	if err != nil {
+		return nil, err
+	}
+	doc, err = jsonpatch.MergePatch(doc, patch)
+	if err != nil {
+		return nil, err
+	}
+	result := new(T)
+	if err := json.Unmarshal(doc, result); err != nil {
+		return nil, err
+	}
+	*spec = *result
+
+	return patch, nil
+}
+
+// CanariesToPromote parses the value of the promotion annotation, a comma separated list
+// of canary Deployment names or "all", and returns the names of the canaries to promote
+func CanariesToPromote(value string, targets []Target) []string {
+	if strings.TrimSpace(value) == saasv1alpha1.CanaryPromoteAll {
+		names := []string{}
+		for _, t := range targets {
+			if t.Canary != nil {
+				names = append(names, t.Name)
+			}
+		}
+		return names
+	}
+
+	names := []string{}
+	for _, name := range strings.Split(value, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			names = append(names, name)
+		}
+	}
+	return lo.Uniq(names)
+}
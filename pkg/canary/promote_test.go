@@ -0,0 +1,123 @@
+package canary
+
+import (
+	"testing"
+
+	"github.com/3scale-ops/basereconciler/util"
+	saasv1alpha1 "github.com/3scale-ops/saas-operator/api/v1alpha1"
+	"github.com/google/go-cmp/cmp"
+)
+
+type testConfig struct {
+	LogLevel *string `json:"logLevel,omitempty"`
+	Workers  *int32  `json:"workers,omitempty"`
+}
+
+type testSpec struct {
+	Image    *saasv1alpha1.ImageSpec `json:"image,omitempty"`
+	Replicas *int32                  `json:"replicas,omitempty"`
+	Config   *testConfig             `json:"config,omitempty"`
+	Canary   *saasv1alpha1.Canary    `json:"canary,omitempty"`
+}
+
+func (spec *testSpec) defaulted() *testSpec {
+	d := &testSpec{
+		Image:    &saasv1alpha1.ImageSpec{Name: util.Pointer("quay.io/3scale/test"), Tag: util.Pointer("v1")},
+		Replicas: util.Pointer(int32(2)),
+		Config:   &testConfig{LogLevel: util.Pointer("info"), Workers: util.Pointer(int32(4))},
+		Canary:   spec.Canary,
+	}
+	if spec.Image != nil && spec.Image.Tag != nil {
+		d.Image.Tag = spec.Image.Tag
+	}
+	if spec.Replicas != nil {
+		d.Replicas = spec.Replicas
+	}
+	return d
+}
+
+func testSpecTargets(spec *testSpec) []Target {
+	return []Target{{
+		Name:         "test-canary",
+		Canary:       spec.Canary,
+		RemoveCanary: func() { spec.Canary = nil },
+		Image:        spec.Image,
+		Replicas:     spec.Replicas,
+	}}
+}
+
+func TestPromoteSpec(t *testing.T) {
+	tests := []struct {
+		name      string
+		spec      *testSpec
+		canary    string
+		want      *testSpec
+		wantPatch string
+		wantErr   bool
+	}{
+		{
+			name: "Merges the canary image and patches into the main spec",
+			spec: &testSpec{
+				Replicas: util.Pointer(int32(5)),
+				Canary: &saasv1alpha1.Canary{
+					ImageTag: util.Pointer("v2"),
+					Replicas: util.Pointer(int32(1)),
+					Patches:  []string{`[{"op":"replace","path":"/config/logLevel","value":"debug"}]`},
+				},
+			},
+			canary: "test-canary",
+			want: &testSpec{
+				Image:    &saasv1alpha1.ImageSpec{Tag: util.Pointer("v2")},
+				Replicas: util.Pointer(int32(5)),
+				Config:   &testConfig{LogLevel: util.Pointer("debug")},
+			},
+			wantPatch: `{"canary":null,"config":{"logLevel":"debug"},"image":{"tag":"v2"}}`,
+		},
+		{
+			name:    "Fails if the canary does not exist",
+			spec:    &testSpec{},
+			canary:  "test-canary",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			patch, err := PromoteSpec(tt.spec, tt.spec.defaulted(), testSpecTargets, tt.canary)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("PromoteSpec() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if diff := cmp.Diff(tt.spec, tt.want); len(diff) > 0 {
+				t.Errorf("PromoteSpec() diff = %v", diff)
+			}
+			if string(patch) != tt.wantPatch {
+				t.Errorf("PromoteSpec() patch = %s, want %s", patch, tt.wantPatch)
+			}
+		})
+	}
+}
+
+func TestCanariesToPromote(t *testing.T) {
+	targets := []Target{
+		{Name: "a-canary", Canary: &saasv1alpha1.Canary{}},
+		{Name: "b-canary"},
+		{Name: "c-canary", Canary: &saasv1alpha1.Canary{}},
+	}
+	tests := []struct {
+		value string
+		want  []string
+	}{
+		{value: "all", want: []string{"a-canary", "c-canary"}},
+		{value: "a-canary, b-canary,,a-canary", want: []string{"a-canary", "b-canary"}},
+		{value: "", want: []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			if diff := cmp.Diff(CanariesToPromote(tt.value, targets), tt.want); len(diff) > 0 {
+				t.Errorf("CanariesToPromote() diff = %v", diff)
+			}
+		})
+	}
+}
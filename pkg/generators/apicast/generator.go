@@ -6,12 +6,14 @@ import (
 	mutators "github.com/3scale-ops/basereconciler/mutators"
 	"github.com/3scale-ops/basereconciler/resource"
 	saasv1alpha1 "github.com/3scale-ops/saas-operator/api/v1alpha1"
+	"github.com/3scale-ops/saas-operator/pkg/canary"
 	"github.com/3scale-ops/saas-operator/pkg/generators"
 	"github.com/3scale-ops/saas-operator/pkg/generators/apicast/config"
 	"github.com/3scale-ops/saas-operator/pkg/resource_builders/grafanadashboard"
 	"github.com/3scale-ops/saas-operator/pkg/resource_builders/pod"
 	"github.com/3scale-ops/saas-operator/pkg/resource_builders/podmonitor"
 	"github.com/3scale-ops/saas-operator/pkg/resource_builders/service"
+	"github.com/3scale-ops/saas-operator/pkg/scaling"
 	operatorutil "github.com/3scale-ops/saas-operator/pkg/util"
 	deployment_workload "github.com/3scale-ops/saas-operator/pkg/workloads/deployment"
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
@@ -188,9 +190,15 @@ func (gen *EnvGenerator) Deployment() *resource.Template[*appsv1.Deployment] {
 func (gen *EnvGenerator) HPASpec() *saasv1alpha1.HorizontalPodAutoscalerSpec {
 	return gen.Spec.HPA
 }
+func (gen *EnvGenerator) VPASpec() *saasv1alpha1.VerticalPodAutoscalerSpec {
+	return gen.Spec.VPA
+}
 func (gen *EnvGenerator) PDBSpec() *saasv1alpha1.PodDisruptionBudgetSpec {
 	return gen.Spec.PDB
 }
+func (gen *EnvGenerator) PodTemplateOverrides() *saasv1alpha1.PodTemplateOverrides {
+	return &gen.Spec.PodTemplateOverrides
+}
 func (gen *EnvGenerator) MonitoredEndpoints() []monitoringv1.PodMetricsEndpoint {
 	return []monitoringv1.PodMetricsEndpoint{
 		podmonitor.PodMetricsEndpoint("/metrics", "metrics", 30),
@@ -214,3 +222,45 @@ func (gen *EnvGenerator) PublishingStrategies() ([]service.ServiceDescriptor, er
 		return pss, nil
 	}
 }
+
+// CanaryTargets returns the canaries of the Apicast environments, used to
+// drive their progressive delivery. The spec is expected to be defaulted.
+func CanaryTargets(spec *saasv1alpha1.ApicastSpec) []canary.Target {
+	return []canary.Target{
+		{
+			Name:                 apicastCanaryStaging,
+			Canary:               spec.Staging.Canary,
+			RemoveCanary:         func() { spec.Staging.Canary = nil },
+			Image:                spec.Staging.Image,
+			Replicas:             spec.Staging.Replicas,
+			PublishingStrategies: spec.Staging.PublishingStrategies,
+		},
+		{
+			Name:                 apicastCanaryProduction,
+			Canary:               spec.Production.Canary,
+			RemoveCanary:         func() { spec.Production.Canary = nil },
+			Image:                spec.Production.Image,
+			Replicas:             spec.Production.Replicas,
+			PublishingStrategies: spec.Production.PublishingStrategies,
+		},
+	}
+}
+
+// ScalingTargets returns the scaling schedules of the Apicast environments,
+// used to override their replicas. The spec is expected to be defaulted.
+func ScalingTargets(spec *saasv1alpha1.ApicastSpec) []scaling.Target {
+	return []scaling.Target{
+		{
+			Name:     apicastStaging,
+			Windows:  spec.Staging.ScalingSchedule,
+			Replicas: &spec.Staging.Replicas,
+			HPA:      spec.Staging.HPA,
+		},
+		{
+			Name:     apicastProduction,
+			Windows:  spec.Production.ScalingSchedule,
+			Replicas: &spec.Production.Replicas,
+			HPA:      spec.Production.HPA,
+		},
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/3scale-ops/basereconciler/mutators"
 	"github.com/3scale-ops/basereconciler/resource"
 	saasv1alpha1 "github.com/3scale-ops/saas-operator/api/v1alpha1"
+	"github.com/3scale-ops/saas-operator/pkg/canary"
 	"github.com/3scale-ops/saas-operator/pkg/generators"
 	"github.com/3scale-ops/saas-operator/pkg/generators/autossl/config"
 	"github.com/3scale-ops/saas-operator/pkg/resource_builders/grafanadashboard"
@@ -116,10 +117,16 @@ func (gen *Generator) Deployment() *resource.Template[*appsv1.Deployment] {
 func (gen *Generator) HPASpec() *saasv1alpha1.HorizontalPodAutoscalerSpec {
 	return gen.Spec.HPA
 }
+func (gen *Generator) VPASpec() *saasv1alpha1.VerticalPodAutoscalerSpec {
+	return gen.Spec.VPA
+}
 
 func (gen *Generator) PDBSpec() *saasv1alpha1.PodDisruptionBudgetSpec {
 	return gen.Spec.PDB
 }
+func (gen *Generator) PodTemplateOverrides() *saasv1alpha1.PodTemplateOverrides {
+	return &gen.Spec.PodTemplateOverrides
+}
 
 func (gen *Generator) MonitoredEndpoints() []monitoringv1.PodMetricsEndpoint {
 	return []monitoringv1.PodMetricsEndpoint{
@@ -134,3 +141,16 @@ func (gen *Generator) PublishingStrategies() ([]service.ServiceDescriptor, error
 		return pss, nil
 	}
 }
+
+// CanaryTargets returns the canary of AutoSSL, used to drive
+// its progressive delivery. The spec is expected to be defaulted.
+func CanaryTargets(spec *saasv1alpha1.AutoSSLSpec) []canary.Target {
+	return []canary.Target{{
+		Name:                 strings.Join([]string{component, "canary"}, "-"),
+		Canary:               spec.Canary,
+		RemoveCanary:         func() { spec.Canary = nil },
+		Image:                spec.Image,
+		Replicas:             spec.Replicas,
+		PublishingStrategies: spec.PublishingStrategies,
+	}}
+}
@@ -7,12 +7,16 @@ import (
 	"github.com/3scale-ops/basereconciler/mutators"
 	"github.com/3scale-ops/basereconciler/resource"
 	saasv1alpha1 "github.com/3scale-ops/saas-operator/api/v1alpha1"
+	"github.com/3scale-ops/saas-operator/pkg/canary"
 	"github.com/3scale-ops/saas-operator/pkg/generators"
 	"github.com/3scale-ops/saas-operator/pkg/generators/backend/config"
+	"github.com/3scale-ops/saas-operator/pkg/maintenance"
 	"github.com/3scale-ops/saas-operator/pkg/resource_builders/grafanadashboard"
 	"github.com/3scale-ops/saas-operator/pkg/resource_builders/pod"
 	"github.com/3scale-ops/saas-operator/pkg/resource_builders/podmonitor"
 	"github.com/3scale-ops/saas-operator/pkg/resource_builders/service"
+	"github.com/3scale-ops/saas-operator/pkg/resource_builders/twemproxy"
+	"github.com/3scale-ops/saas-operator/pkg/scaling"
 	operatorutil "github.com/3scale-ops/saas-operator/pkg/util"
 	deployment_workload "github.com/3scale-ops/saas-operator/pkg/workloads/deployment"
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
@@ -188,6 +192,19 @@ func (gen *Generator) Resources() ([]resource.TemplateInterface, error) {
 	return operatorutil.ConcatSlices(listener_resources, worker_resources, cron_resources, externalsecrets, misc), nil
 }
 
+// TwemproxySpecs returns the specs of the twemproxy sidecars of the Backend workloads,
+// so the controller can resolve the source of their config before generating the resources
+func (gen *Generator) TwemproxySpecs() []*saasv1alpha1.TwemproxySpec {
+	specs := []*saasv1alpha1.TwemproxySpec{gen.Listener.TwemproxySpec, gen.Worker.TwemproxySpec}
+	if gen.CanaryListener != nil {
+		specs = append(specs, gen.CanaryListener.TwemproxySpec)
+	}
+	if gen.CanaryWorker != nil {
+		specs = append(specs, gen.CanaryWorker.TwemproxySpec)
+	}
+	return specs
+}
+
 // ListenerGenerator has methods to generate resources for a
 // Backend environment
 type ListenerGenerator struct {
@@ -205,6 +222,9 @@ var _ deployment_workload.DeploymentWorkload = &ListenerGenerator{}
 // Validate that ListenerGenerator implements deployment_workload.WithPublishingStrategies interface
 var _ deployment_workload.WithPublishingStrategies = &ListenerGenerator{}
 
+// Validate that ListenerGenerator implements deployment_workload.WithSidecarVerticalPodAutoscaler interface
+var _ deployment_workload.WithSidecarVerticalPodAutoscaler = &ListenerGenerator{}
+
 func (gen *ListenerGenerator) Labels() map[string]string {
 	return gen.GetLabels()
 }
@@ -217,9 +237,18 @@ func (gen *ListenerGenerator) Deployment() *resource.Template[*appsv1.Deployment
 func (gen *ListenerGenerator) HPASpec() *saasv1alpha1.HorizontalPodAutoscalerSpec {
 	return gen.ListenerSpec.HPA
 }
+func (gen *ListenerGenerator) VPASpec() *saasv1alpha1.VerticalPodAutoscalerSpec {
+	return gen.ListenerSpec.VPA
+}
+func (gen *ListenerGenerator) SidecarVPASpecs() map[string]saasv1alpha1.VerticalPodAutoscalerResourcePolicy {
+	return twemproxy.VPASpecs(gen.TwemproxySpec)
+}
 func (gen *ListenerGenerator) PDBSpec() *saasv1alpha1.PodDisruptionBudgetSpec {
 	return gen.ListenerSpec.PDB
 }
+func (gen *ListenerGenerator) PodTemplateOverrides() *saasv1alpha1.PodTemplateOverrides {
+	return &gen.ListenerSpec.PodTemplateOverrides
+}
 func (gen *ListenerGenerator) MonitoredEndpoints() []monitoringv1.PodMetricsEndpoint {
 	pmes := []monitoringv1.PodMetricsEndpoint{
 		podmonitor.PodMetricsEndpoint("/metrics", "metrics", 30),
@@ -260,6 +289,9 @@ type WorkerGenerator struct {
 // Validate that WorkerGenerator implements deployment_workload.DeploymentWorkload interface
 var _ deployment_workload.DeploymentWorkload = &WorkerGenerator{}
 
+// Validate that WorkerGenerator implements deployment_workload.WithSidecarVerticalPodAutoscaler interface
+var _ deployment_workload.WithSidecarVerticalPodAutoscaler = &WorkerGenerator{}
+
 func (gen *WorkerGenerator) Deployment() *resource.Template[*appsv1.Deployment] {
 	return resource.NewTemplateFromObjectFunction(gen.deployment).
 		WithMutation(mutators.SetDeploymentReplicas(gen.WorkerSpec.HPA.IsDeactivated())).
@@ -268,9 +300,18 @@ func (gen *WorkerGenerator) Deployment() *resource.Template[*appsv1.Deployment]
 func (gen *WorkerGenerator) HPASpec() *saasv1alpha1.HorizontalPodAutoscalerSpec {
 	return gen.WorkerSpec.HPA
 }
+func (gen *WorkerGenerator) VPASpec() *saasv1alpha1.VerticalPodAutoscalerSpec {
+	return gen.WorkerSpec.VPA
+}
+func (gen *WorkerGenerator) SidecarVPASpecs() map[string]saasv1alpha1.VerticalPodAutoscalerResourcePolicy {
+	return twemproxy.VPASpecs(gen.TwemproxySpec)
+}
 func (gen *WorkerGenerator) PDBSpec() *saasv1alpha1.PodDisruptionBudgetSpec {
 	return gen.WorkerSpec.PDB
 }
+func (gen *WorkerGenerator) PodTemplateOverrides() *saasv1alpha1.PodTemplateOverrides {
+	return &gen.WorkerSpec.PodTemplateOverrides
+}
 func (gen *WorkerGenerator) MonitoredEndpoints() []monitoringv1.PodMetricsEndpoint {
 	pmes := []monitoringv1.PodMetricsEndpoint{
 		podmonitor.PodMetricsEndpoint("/metrics", "metrics", 30),
@@ -281,6 +322,8 @@ func (gen *WorkerGenerator) MonitoredEndpoints() []monitoringv1.PodMetricsEndpoi
 	return pmes
 }
 
+// twemproxyVPASpecs returns the resource policy of the twemproxy
+// sidecar in the VerticalPodAutoscaler of the workload, if any
 // CronGenerator has methods to generate resources for a
 // Backend environment
 type CronGenerator struct {
@@ -300,7 +343,79 @@ func (gen *CronGenerator) Deployment() *resource.Template[*appsv1.Deployment] {
 func (gen *CronGenerator) HPASpec() *saasv1alpha1.HorizontalPodAutoscalerSpec {
 	return &saasv1alpha1.HorizontalPodAutoscalerSpec{}
 }
+func (gen *CronGenerator) VPASpec() *saasv1alpha1.VerticalPodAutoscalerSpec {
+	return gen.CronSpec.VPA
+}
 func (gen *CronGenerator) PDBSpec() *saasv1alpha1.PodDisruptionBudgetSpec {
 	return &saasv1alpha1.PodDisruptionBudgetSpec{}
 }
+func (gen *CronGenerator) PodTemplateOverrides() *saasv1alpha1.PodTemplateOverrides {
+	return &gen.CronSpec.PodTemplateOverrides
+}
 func (gen *CronGenerator) MonitoredEndpoints() []monitoringv1.PodMetricsEndpoint { return nil }
+
+// CanaryTargets returns the canaries of the Backend workloads, used to
+// drive their progressive delivery. The spec is expected to be defaulted.
+func CanaryTargets(spec *saasv1alpha1.BackendSpec) []canary.Target {
+	return []canary.Target{
+		{
+			Name:                 strings.Join([]string{component, listener, "canary"}, "-"),
+			Canary:               spec.Listener.Canary,
+			RemoveCanary:         func() { spec.Listener.Canary = nil },
+			Image:                spec.Image,
+			Replicas:             spec.Listener.Replicas,
+			PublishingStrategies: spec.Listener.PublishingStrategies,
+		},
+		{
+			Name:         strings.Join([]string{component, worker, "canary"}, "-"),
+			Canary:       spec.Worker.Canary,
+			RemoveCanary: func() { spec.Worker.Canary = nil },
+			Image:        spec.Image,
+			Replicas:     spec.Worker.Replicas,
+		},
+	}
+}
+
+// ScalingTargets returns the scaling schedules of the Backend workloads,
+// used to override their replicas. The spec is expected to be defaulted.
+func ScalingTargets(spec *saasv1alpha1.BackendSpec) []scaling.Target {
+	return []scaling.Target{
+		{
+			Name:     strings.Join([]string{component, listener}, "-"),
+			Windows:  spec.Listener.ScalingSchedule,
+			Replicas: &spec.Listener.Replicas,
+			HPA:      spec.Listener.HPA,
+		},
+		{
+			Name:     strings.Join([]string{component, worker}, "-"),
+			Windows:  spec.Worker.ScalingSchedule,
+			Replicas: &spec.Worker.Replicas,
+			HPA:      spec.Worker.HPA,
+		},
+	}
+}
+
+// MaintenanceTargets returns the Backend workloads that can be stopped
+// during a maintenance. The spec is expected to be defaulted.
+func MaintenanceTargets(spec *saasv1alpha1.BackendSpec) []maintenance.Target {
+	return []maintenance.Target{
+		{
+			Name:     strings.Join([]string{component, listener}, "-"),
+			Replicas: &spec.Listener.Replicas,
+			HPA:      spec.Listener.HPA,
+			Canary:   spec.Listener.Canary,
+		},
+		{
+			Name:     strings.Join([]string{component, worker}, "-"),
+			Worker:   true,
+			Replicas: &spec.Worker.Replicas,
+			HPA:      spec.Worker.HPA,
+			Canary:   spec.Worker.Canary,
+		},
+		{
+			Name:     strings.Join([]string{component, cron}, "-"),
+			Worker:   true,
+			Replicas: &spec.Cron.Replicas,
+		},
+	}
+}
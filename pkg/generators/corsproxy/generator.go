@@ -82,10 +82,16 @@ func (gen *Generator) Deployment() *resource.Template[*appsv1.Deployment] {
 func (gen *Generator) HPASpec() *saasv1alpha1.HorizontalPodAutoscalerSpec {
 	return gen.Spec.HPA
 }
+func (gen *Generator) VPASpec() *saasv1alpha1.VerticalPodAutoscalerSpec {
+	return gen.Spec.VPA
+}
 
 func (gen *Generator) PDBSpec() *saasv1alpha1.PodDisruptionBudgetSpec {
 	return gen.Spec.PDB
 }
+func (gen *Generator) PodTemplateOverrides() *saasv1alpha1.PodTemplateOverrides {
+	return &gen.Spec.PodTemplateOverrides
+}
 
 func (gen *Generator) MonitoredEndpoints() []monitoringv1.PodMetricsEndpoint {
 	return []monitoringv1.PodMetricsEndpoint{
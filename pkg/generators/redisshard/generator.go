@@ -19,6 +19,9 @@ type Generator struct {
 	MasterIndex int32
 	Replicas    int32
 	Command     string
+	// AnnounceHostnames configures the redis servers to
+	// announce themselves using their stable Pod hostname
+	AnnounceHostnames bool
 }
 
 // Override the GetSelector function as it needs to be different in this case
@@ -39,10 +42,11 @@ func NewGenerator(instance, namespace string, spec saasv1alpha1.RedisShardSpec)
 				"part-of": "3scale-saas-testing",
 			},
 		},
-		Image:       *spec.Image,
-		MasterIndex: *spec.MasterIndex,
-		Replicas:    *spec.SlaveCount + 1,
-		Command:     *spec.Command,
+		Image:             *spec.Image,
+		MasterIndex:       *spec.MasterIndex,
+		Replicas:          *spec.SlaveCount + 1,
+		Command:           *spec.Command,
+		AnnounceHostnames: *spec.AnnounceHostnames,
 	}
 }
 
@@ -60,3 +64,13 @@ func (gen *Generator) Resources() []resource.TemplateInterface {
 func (gen *Generator) ServiceName() string {
 	return fmt.Sprintf("%s-%s", gen.GetComponent(), gen.GetInstanceName())
 }
+
+// PodHostname returns the stable DNS name that the StatefulSet
+// headless Service provides for the Pod with the given index
+func (gen *Generator) PodHostname(index int) string {
+	return gen.podHostname(fmt.Sprintf("%s-%d", gen.ServiceName(), index))
+}
+
+func (gen *Generator) podHostname(podName string) string {
+	return fmt.Sprintf("%s.%s.%s.svc.cluster.local", podName, gen.ServiceName(), gen.GetNamespace())
+}
@@ -18,6 +18,9 @@ func (gen *Generator) service() *corev1.Service {
 			ClusterIPs: []string{corev1.ClusterIPNone},
 			Ports:      []corev1.ServicePort{},
 			Selector:   gen.GetSelector(),
+			// the Pod hostnames need to resolve before the Pods are ready, as
+			// readiness depends on replication being already configured
+			PublishNotReadyAddresses: gen.AnnounceHostnames,
 		},
 	}
 }
@@ -39,8 +39,28 @@ func (gen *Generator) statefulSet() *appsv1.StatefulSet {
 					Containers: []corev1.Container{
 						{
 							Command: strings.Split(gen.Command, " "),
-							Image:   fmt.Sprintf("%s:%s", *gen.Image.Name, *gen.Image.Tag),
-							Name:    "redis-server",
+							Args: func() []string {
+								if gen.AnnounceHostnames {
+									return []string{"--replica-announce-ip", gen.podHostname("$(POD_NAME)")}
+								}
+								return nil
+							}(),
+							Env: func() []corev1.EnvVar {
+								if gen.AnnounceHostnames {
+									return []corev1.EnvVar{{
+										Name: "POD_NAME",
+										ValueFrom: &corev1.EnvVarSource{
+											FieldRef: &corev1.ObjectFieldSelector{
+												FieldPath:  "metadata.name",
+												APIVersion: corev1.SchemeGroupVersion.Version,
+											},
+										},
+									}}
+								}
+								return nil
+							}(),
+							Image: fmt.Sprintf("%s:%s", *gen.Image.Name, *gen.Image.Tag),
+							Name:  "redis-server",
 							Ports: pod.ContainerPorts(
 								pod.ContainerPortTCP("redis-server", 6379),
 							),
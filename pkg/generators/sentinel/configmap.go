@@ -7,6 +7,30 @@ import (
 )
 
 func (gen *Generator) configMap() *corev1.ConfigMap {
+	script := heredoc.Doc(`
+		if [ ! -f $1 ]; then
+			echo "dir /redis" >> $1
+			echo "port 26379" >> $1
+			echo "daemonize no" >> $1
+			echo "logfile /dev/stdout" >> $1
+			echo "sentinel deny-scripts-reconfig yes" >> $1
+			echo "protected-mode no" >> $1
+			echo "sentinel announce-ip ${POD_IP}" >> $1
+			echo "sentinel announce-port 26379" >> $1
+		else
+			sed -i "s/^sentinel announce-ip.*/sentinel announce-ip ${POD_IP}/g" $1
+		fi
+	`)
+
+	if gen.Spec.Config.ResolveHostnames != nil && *gen.Spec.Config.ResolveHostnames {
+		// resolve-hostnames/announce-hostnames are global options that can be
+		// safely appended to an already existing config file
+		script += heredoc.Doc(`
+			grep -q "^sentinel resolve-hostnames" $1 || echo "sentinel resolve-hostnames yes" >> $1
+			grep -q "^sentinel announce-hostnames" $1 || echo "sentinel announce-hostnames yes" >> $1
+		`)
+	}
+
 	return &corev1.ConfigMap{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      gen.GetComponent() + "-gen-config",
@@ -14,20 +38,7 @@ func (gen *Generator) configMap() *corev1.ConfigMap {
 			Labels:    gen.GetLabels(),
 		},
 		Data: map[string]string{
-			"generate-config.sh": heredoc.Doc(`
-					if [ ! -f $1 ]; then
-						echo "dir /redis" >> $1
-						echo "port 26379" >> $1
-						echo "daemonize no" >> $1
-						echo "logfile /dev/stdout" >> $1
-						echo "sentinel deny-scripts-reconfig yes" >> $1
-						echo "protected-mode no" >> $1
-						echo "sentinel announce-ip ${POD_IP}" >> $1
-						echo "sentinel announce-port 26379" >> $1
-					else
-						sed -i "s/^sentinel announce-ip.*/sentinel announce-ip ${POD_IP}/g" $1
-					fi
-				`),
+			"generate-config.sh": script,
 		},
 	}
 }
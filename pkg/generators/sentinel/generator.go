@@ -77,18 +77,7 @@ func (gen *Generator) ClusterTopology(ctx context.Context) (map[string]map[strin
 		for shard, serversdef := range gen.Spec.Config.ClusterTopology {
 			shardmap := map[string]string{}
 			for alias, server := range serversdef {
-				// the redis servers must be defined using IP
-				// addresses, so this tries to resolve a hostname
-				// if present in the connection string.
-				u, err := url.Parse(server)
-				if err != nil {
-					return nil, err
-				}
-				ip, err := operatorutils.LookupIPv4(ctx, u.Hostname())
-				if err != nil {
-					return nil, err
-				}
-				u.Host = net.JoinHostPort(ip, u.Port())
+				u, err := gen.serverURL(ctx, server)
 				if err != nil {
 					return nil, err
 				}
@@ -101,19 +90,12 @@ func (gen *Generator) ClusterTopology(ctx context.Context) (map[string]map[strin
 		for shard, servers := range gen.Spec.Config.MonitoredShards {
 			shardmap := map[string]string{}
 			for _, server := range servers {
-				// the redis servers must be defined using IP
-				// addresses, so this tries to resolve a hostname
-				// if present in the connection string.
 				u, err := url.Parse(server)
 				if err != nil {
 					return nil, err
 				}
 				alias := u.Host
-				ip, err := operatorutils.LookupIPv4(ctx, u.Hostname())
-				if err != nil {
-					return nil, err
-				}
-				u.Host = net.JoinHostPort(ip, u.Port())
+				u, err = gen.serverURL(ctx, server)
 				if err != nil {
 					return nil, err
 				}
@@ -138,3 +120,23 @@ func (gen *Generator) ClusterTopology(ctx context.Context) (map[string]map[strin
 
 	return clustermap, nil
 }
+
+// serverURL parses the connection string of a redis server. Unless sentinel
+// is configured to resolve hostnames, the redis servers must be defined using
+// IP addresses, so this tries to resolve a hostname if present in the
+// connection string.
+func (gen *Generator) serverURL(ctx context.Context, server string) (*url.URL, error) {
+	u, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+	if gen.Spec.Config.ResolveHostnames != nil && *gen.Spec.Config.ResolveHostnames {
+		return u, nil
+	}
+	ip, err := operatorutils.LookupIPv4(ctx, u.Hostname())
+	if err != nil {
+		return nil, err
+	}
+	u.Host = net.JoinHostPort(ip, u.Port())
+	return u, nil
+}
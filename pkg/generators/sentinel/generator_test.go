@@ -102,6 +102,35 @@ func TestGenerator_ClusterTopology(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "Keeps hostnames if 'spec.config.resolveHostnames' is set",
+			key:  types.NamespacedName{Name: "test", Namespace: "test"},
+			spec: saasv1alpha1.SentinelSpec{
+				Replicas: util.Pointer(int32(3)),
+				Config: &saasv1alpha1.SentinelConfig{
+					ResolveHostnames: util.Pointer(true),
+					ClusterTopology: map[string]map[string]string{
+						"shard01": {
+							"redis-shard-rs0-0": "redis://redis-shard-rs0-0.redis-shard-rs0.test.svc.cluster.local:6379",
+							"redis-shard-rs0-1": "redis://redis-shard-rs0-1.redis-shard-rs0.test.svc.cluster.local:6379",
+						}}},
+			},
+			args: args{
+				ctx: context.TODO(),
+			},
+			want: map[string]map[string]string{
+				"shard01": {
+					"redis-shard-rs0-0": "redis://redis-shard-rs0-0.redis-shard-rs0.test.svc.cluster.local:6379",
+					"redis-shard-rs0-1": "redis://redis-shard-rs0-1.redis-shard-rs0.test.svc.cluster.local:6379",
+				},
+				"sentinel": {
+					"redis-sentinel-0": "redis://redis-sentinel-0.test.svc.cluster.local:26379",
+					"redis-sentinel-1": "redis://redis-sentinel-1.test.svc.cluster.local:26379",
+					"redis-sentinel-2": "redis://redis-sentinel-2.test.svc.cluster.local:26379",
+				},
+			},
+			wantErr: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -7,12 +7,16 @@ import (
 	"github.com/3scale-ops/basereconciler/mutators"
 	"github.com/3scale-ops/basereconciler/resource"
 	saasv1alpha1 "github.com/3scale-ops/saas-operator/api/v1alpha1"
+	"github.com/3scale-ops/saas-operator/pkg/bluegreen"
+	"github.com/3scale-ops/saas-operator/pkg/canary"
 	"github.com/3scale-ops/saas-operator/pkg/generators"
 	"github.com/3scale-ops/saas-operator/pkg/generators/system/config"
+	"github.com/3scale-ops/saas-operator/pkg/maintenance"
 	"github.com/3scale-ops/saas-operator/pkg/resource_builders/grafanadashboard"
 	"github.com/3scale-ops/saas-operator/pkg/resource_builders/pod"
 	"github.com/3scale-ops/saas-operator/pkg/resource_builders/podmonitor"
 	"github.com/3scale-ops/saas-operator/pkg/resource_builders/service"
+	"github.com/3scale-ops/saas-operator/pkg/resource_builders/twemproxy"
 	operatorutil "github.com/3scale-ops/saas-operator/pkg/util"
 	deployment_workload "github.com/3scale-ops/saas-operator/pkg/workloads/deployment"
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
@@ -37,6 +41,7 @@ type Generator struct {
 	generators.BaseOptionsV2
 	App                  AppGenerator
 	CanaryApp            *AppGenerator
+	GreenApp             *AppGenerator
 	SidekiqDefault       SidekiqGenerator
 	CanarySidekiqDefault *SidekiqGenerator
 	SidekiqBilling       SidekiqGenerator
@@ -205,6 +210,15 @@ func NewGenerator(instance, namespace string, spec saasv1alpha1.SystemSpec) (Gen
 		generator.CanaryApp.Spec.PDB = &saasv1alpha1.PodDisruptionBudgetSpec{}
 	}
 
+	if spec.App.BlueGreen != nil {
+		if spec.App.Canary != nil {
+			return Generator{}, fmt.Errorf("canary and blueGreen can't be used at the same time in system-app")
+		}
+		green := generator.App
+		green.BaseOptionsV2.Component = strings.Join([]string{component, app}, "-") + deployment_workload.BlueGreenGreenSuffix
+		generator.GreenApp = &green
+	}
+
 	if spec.SidekiqDefault.Canary != nil {
 		canarySpec, err := spec.ResolveCanarySpec(spec.SidekiqDefault.Canary)
 		if err != nil {
@@ -313,7 +327,14 @@ func NewGenerator(instance, namespace string, spec saasv1alpha1.SystemSpec) (Gen
 
 // Resources returns the list of resource templates
 func (gen *Generator) Resources() ([]resource.TemplateInterface, error) {
-	app_resources, err := deployment_workload.New(&gen.App, gen.CanaryApp)
+	var app_resources []resource.TemplateInterface
+	var err error
+	if gen.GreenApp != nil {
+		app_resources, err = deployment_workload.NewBlueGreen(&gen.App, gen.GreenApp, gen.App.Spec.BlueGreen,
+			fmt.Sprintf("%s:%s", *gen.App.Image.Name, *gen.App.Image.Tag))
+	} else {
+		app_resources, err = deployment_workload.New(&gen.App, gen.CanaryApp)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -363,6 +384,32 @@ func (gen *Generator) Resources() ([]resource.TemplateInterface, error) {
 		nil
 }
 
+// TwemproxySpecs returns the specs of the twemproxy sidecars of the System workloads,
+// so the controller can resolve the source of their config before generating the resources
+func (gen *Generator) TwemproxySpecs() []*saasv1alpha1.TwemproxySpec {
+	specs := []*saasv1alpha1.TwemproxySpec{
+		gen.App.TwemproxySpec,
+		gen.SidekiqDefault.TwemproxySpec,
+		gen.SidekiqBilling.TwemproxySpec,
+		gen.SidekiqLow.TwemproxySpec,
+		gen.Console.TwemproxySpec,
+	}
+	for _, app := range []*AppGenerator{gen.CanaryApp, gen.GreenApp} {
+		if app != nil {
+			specs = append(specs, app.TwemproxySpec)
+		}
+	}
+	for _, sidekiq := range []*SidekiqGenerator{gen.CanarySidekiqDefault, gen.CanarySidekiqBilling, gen.CanarySidekiqLow} {
+		if sidekiq != nil {
+			specs = append(specs, sidekiq.TwemproxySpec)
+		}
+	}
+	for _, tr := range gen.Tekton {
+		specs = append(specs, tr.TwemproxySpec)
+	}
+	return specs
+}
+
 // AppGenerator has methods to generate resources for system-app
 type AppGenerator struct {
 	generators.BaseOptionsV2
@@ -380,6 +427,9 @@ var _ deployment_workload.DeploymentWorkload = &AppGenerator{}
 // Validate that AppGenerator implements deployment_workload.WithPublishingStrategies interface
 var _ deployment_workload.WithPublishingStrategies = &AppGenerator{}
 
+// Validate that AppGenerator implements deployment_workload.WithSidecarVerticalPodAutoscaler interface
+var _ deployment_workload.WithSidecarVerticalPodAutoscaler = &AppGenerator{}
+
 func (gen *AppGenerator) Deployment() *resource.Template[*appsv1.Deployment] {
 	return resource.NewTemplateFromObjectFunction(gen.deployment).
 		WithMutations(gen.Options.GenerateRolloutTriggers(gen.ConfigFilesSecret)).
@@ -389,10 +439,19 @@ func (gen *AppGenerator) Deployment() *resource.Template[*appsv1.Deployment] {
 func (gen *AppGenerator) HPASpec() *saasv1alpha1.HorizontalPodAutoscalerSpec {
 	return gen.Spec.HPA
 }
+func (gen *AppGenerator) VPASpec() *saasv1alpha1.VerticalPodAutoscalerSpec {
+	return gen.Spec.VPA
+}
+func (gen *AppGenerator) SidecarVPASpecs() map[string]saasv1alpha1.VerticalPodAutoscalerResourcePolicy {
+	return twemproxy.VPASpecs(gen.TwemproxySpec)
+}
 
 func (gen *AppGenerator) PDBSpec() *saasv1alpha1.PodDisruptionBudgetSpec {
 	return gen.Spec.PDB
 }
+func (gen *AppGenerator) PodTemplateOverrides() *saasv1alpha1.PodTemplateOverrides {
+	return &gen.Spec.PodTemplateOverrides
+}
 
 func (gen *AppGenerator) MonitoredEndpoints() []monitoringv1.PodMetricsEndpoint {
 	pmes := []monitoringv1.PodMetricsEndpoint{
@@ -421,6 +480,9 @@ func (gen *AppGenerator) PublishingStrategies() ([]service.ServiceDescriptor, er
 // Validate that SidekiqGenerator implements deployment_workload.DeploymentWorkloadWithTraffic interface
 var _ deployment_workload.DeploymentWorkload = &SidekiqGenerator{}
 
+// Validate that SidekiqGenerator implements deployment_workload.WithSidecarVerticalPodAutoscaler interface
+var _ deployment_workload.WithSidecarVerticalPodAutoscaler = &SidekiqGenerator{}
+
 // SidekiqGenerator has methods to generate resources for system-sidekiq
 type SidekiqGenerator struct {
 	generators.BaseOptionsV2
@@ -440,10 +502,19 @@ func (gen *SidekiqGenerator) Deployment() *resource.Template[*appsv1.Deployment]
 func (gen *SidekiqGenerator) HPASpec() *saasv1alpha1.HorizontalPodAutoscalerSpec {
 	return gen.Spec.HPA
 }
+func (gen *SidekiqGenerator) VPASpec() *saasv1alpha1.VerticalPodAutoscalerSpec {
+	return gen.Spec.VPA
+}
+func (gen *SidekiqGenerator) SidecarVPASpecs() map[string]saasv1alpha1.VerticalPodAutoscalerResourcePolicy {
+	return twemproxy.VPASpecs(gen.TwemproxySpec)
+}
 
 func (gen *SidekiqGenerator) PDBSpec() *saasv1alpha1.PodDisruptionBudgetSpec {
 	return gen.Spec.PDB
 }
+func (gen *SidekiqGenerator) PodTemplateOverrides() *saasv1alpha1.PodTemplateOverrides {
+	return &gen.Spec.PodTemplateOverrides
+}
 
 func (gen *SidekiqGenerator) MonitoredEndpoints() []monitoringv1.PodMetricsEndpoint {
 	pmes := []monitoringv1.PodMetricsEndpoint{
@@ -503,3 +574,92 @@ type SystemTektonGenerator struct {
 	TwemproxySpec     *saasv1alpha1.TwemproxySpec
 	Enabled           bool
 }
+
+// CanaryTargets returns the canaries of the System workloads, used to
+// drive their progressive delivery. The spec is expected to be defaulted.
+func CanaryTargets(spec *saasv1alpha1.SystemSpec) []canary.Target {
+	return []canary.Target{
+		{
+			Name:                 strings.Join([]string{component, app, "canary"}, "-"),
+			Canary:               spec.App.Canary,
+			RemoveCanary:         func() { spec.App.Canary = nil },
+			Image:                spec.Image,
+			Replicas:             spec.App.Replicas,
+			PublishingStrategies: spec.App.PublishingStrategies,
+		},
+		{
+			Name:         strings.Join([]string{component, sidekiqDefault, "canary"}, "-"),
+			Canary:       spec.SidekiqDefault.Canary,
+			RemoveCanary: func() { spec.SidekiqDefault.Canary = nil },
+			Image:        spec.Image,
+			Replicas:     spec.SidekiqDefault.Replicas,
+		},
+		{
+			Name:         strings.Join([]string{component, sidekiqBilling, "canary"}, "-"),
+			Canary:       spec.SidekiqBilling.Canary,
+			RemoveCanary: func() { spec.SidekiqBilling.Canary = nil },
+			Image:        spec.Image,
+			Replicas:     spec.SidekiqBilling.Replicas,
+		},
+		{
+			Name:         strings.Join([]string{component, sidekiqLow, "canary"}, "-"),
+			Canary:       spec.SidekiqLow.Canary,
+			RemoveCanary: func() { spec.SidekiqLow.Canary = nil },
+			Image:        spec.Image,
+			Replicas:     spec.SidekiqLow.Replicas,
+		},
+	}
+}
+
+// BlueGreenTargets returns the workloads of System delivered using blue/green
+func (gen *Generator) BlueGreenTargets() ([]bluegreen.Target, error) {
+	if gen.GreenApp == nil {
+		return nil, nil
+	}
+	revision, err := deployment_workload.BlueGreenRevision(&gen.App)
+	if err != nil {
+		return nil, err
+	}
+	return []bluegreen.Target{{
+		Name:     gen.App.GetComponent(),
+		Spec:     gen.App.Spec.BlueGreen,
+		Revision: revision,
+	}}, nil
+}
+
+// MaintenanceTargets returns the System workloads that can be stopped during a maintenance.
+// The searchd and console StatefulSets are not stopped, as the console is required to run
+// database migrations. The spec is expected to be defaulted. The live Deployment of the
+// app is the one of the active colour in the given blue/green statuses.
+func MaintenanceTargets(spec *saasv1alpha1.SystemSpec, blueGreen saasv1alpha1.BlueGreenStatuses) []maintenance.Target {
+	return []maintenance.Target{
+		{
+			Name:       strings.Join([]string{component, app}, "-"),
+			Deployment: bluegreen.ActiveDeployment(strings.Join([]string{component, app}, "-"), blueGreen),
+			Replicas:   &spec.App.Replicas,
+			HPA:        spec.App.HPA,
+			Canary:     spec.App.Canary,
+		},
+		{
+			Name:     strings.Join([]string{component, sidekiqDefault}, "-"),
+			Worker:   true,
+			Replicas: &spec.SidekiqDefault.Replicas,
+			HPA:      spec.SidekiqDefault.HPA,
+			Canary:   spec.SidekiqDefault.Canary,
+		},
+		{
+			Name:     strings.Join([]string{component, sidekiqBilling}, "-"),
+			Worker:   true,
+			Replicas: &spec.SidekiqBilling.Replicas,
+			HPA:      spec.SidekiqBilling.HPA,
+			Canary:   spec.SidekiqBilling.Canary,
+		},
+		{
+			Name:     strings.Join([]string{component, sidekiqLow}, "-"),
+			Worker:   true,
+			Replicas: &spec.SidekiqLow.Replicas,
+			HPA:      spec.SidekiqLow.HPA,
+			Canary:   spec.SidekiqLow.Canary,
+		},
+	}
+}
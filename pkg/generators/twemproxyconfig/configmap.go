@@ -15,15 +15,13 @@ const (
 	HealthBindAddress string = "127.0.0.1:22333"
 )
 
-// configMap returns a ConfigMap that holds the twemproxy config file.
+// configMap returns a ConfigMap that holds the twemproxy config file. The
+// redis passwords are never stored in the ConfigMap, they are added to the
+// config in the Secret that the twemproxy sidecars mount.
 func (gen *Generator) configMap(toYAML bool) *corev1.ConfigMap {
 	config := make(map[string]twemproxy.ServerPoolConfig, len(gen.Spec.ServerPools)+1)
 	for _, pool := range gen.Spec.ServerPools {
-		if *pool.Target == saasv1alpha1.Masters {
-			config[pool.Name] = twemproxy.GenerateServerPool(pool, gen.masterTargets)
-		} else {
-			config[pool.Name] = twemproxy.GenerateServerPool(pool, gen.slaverwTargets)
-		}
+		config[pool.Name] = twemproxy.GenerateServerPool(pool, gen.getPoolTargets(pool))
 	}
 
 	config[HealthPoolName] = twemproxy.ServerPoolConfig{
@@ -49,11 +47,19 @@ func (gen *Generator) configMap(toYAML bool) *corev1.ConfigMap {
 		panic(err)
 	}
 
+	topology, err := json.Marshal(gen.Topology())
+	if err != nil {
+		panic(err)
+	}
+
 	return &corev1.ConfigMap{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      gen.GetInstanceName(),
 			Namespace: gen.GetNamespace(),
 			Labels:    gen.GetLabels(),
+			Annotations: map[string]string{
+				saasv1alpha1.TwemproxyTopologyAnnotationKey: string(topology),
+			},
 		},
 		Data: map[string]string{
 			"nutcracker.yml": string(b),
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"net/url"
+	"slices"
 	"strings"
 
 	"github.com/3scale-ops/basereconciler/resource"
@@ -36,19 +37,32 @@ var (
 		},
 		[]string{"twemproxy_config", "shard"},
 	)
+	serverPoolSlaveRwConfigured = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name:      "server_pool_slave_rw_configured",
+			Namespace: "saas_twemproxyconfig",
+			Help:      "1 if the TwemproxyConfig server pool points to a RW slave, 0 otherwise",
+		},
+		[]string{"twemproxy_config", "pool", "shard"},
+	)
 )
 
 func init() {
 	// Register custom metrics with the global prometheus registry
-	metrics.Registry.MustRegister(slaveRwConfigured)
+	metrics.Registry.MustRegister(slaveRwConfigured, serverPoolSlaveRwConfigured)
 }
 
 // Generator configures the generators for Sentinel
 type Generator struct {
 	generators.BaseOptionsV2
-	Spec           saasv1alpha1.TwemproxyConfigSpec
-	masterTargets  map[string]twemproxy.Server
-	slaverwTargets map[string]twemproxy.Server
+	Spec            saasv1alpha1.TwemproxyConfigSpec
+	masterTargets   map[string]twemproxy.Server
+	slaverwTargets  map[string]twemproxy.Server
+	leastLagTargets map[string]twemproxy.Server
+	redisAuth       map[string]string
+	// serverShards indexes the discovered servers
+	// by address, to tell the shard they belong to
+	serverShards map[string]string
 }
 
 // NewGenerator returns a new Options struct
@@ -68,7 +82,16 @@ func NewGenerator(ctx context.Context, instance *saasv1alpha1.TwemproxyConfig, c
 		Spec: instance.Spec,
 	}
 
+	if err := validateServerPools(gen.Spec.ServerPools); err != nil {
+		return Generator{}, err
+	}
+
 	var err error
+	gen.redisAuth, err = getRedisAuth(ctx, cl, instance.GetNamespace(), gen.Spec.ServerPools)
+	if err != nil {
+		return Generator{}, err
+	}
+
 	if gen.Spec.SentinelURIs == nil {
 		gen.Spec.SentinelURIs, err = discoverSentinels(ctx, cl, instance.GetNamespace())
 		if err != nil {
@@ -95,7 +118,7 @@ func NewGenerator(ctx context.Context, instance *saasv1alpha1.TwemproxyConfig, c
 	// Check if there are pools in the config that require slave discovery
 	discoverSlavesRW := false
 	for _, pool := range gen.Spec.ServerPools {
-		if *pool.Target == saasv1alpha1.SlavesRW {
+		if pool.TargetsSlaves() {
 			discoverSlavesRW = true
 		}
 	}
@@ -113,7 +136,12 @@ func NewGenerator(ctx context.Context, instance *saasv1alpha1.TwemproxyConfig, c
 		}
 
 	case true:
-		merr := shardedCluster.SentinelDiscover(ctx, sharded.SlaveReadOnlyDiscoveryOpt)
+		opts := []sharded.DiscoveryOption{sharded.SlaveReadOnlyDiscoveryOpt}
+		if gen.targetInUse(saasv1alpha1.SlavesRWLeastLag) {
+			// the offsets reported by the master are required to calculate the replication lag
+			opts = append(opts, sharded.MasterReplicationOffsetDiscoveryOpt)
+		}
+		merr := shardedCluster.SentinelDiscover(ctx, opts...)
 		if merr != nil {
 			log.Error(merr, "DiscoveryError")
 			// Only sentinel/master discovery errors should return.
@@ -135,24 +163,123 @@ func NewGenerator(ctx context.Context, instance *saasv1alpha1.TwemproxyConfig, c
 		if err != nil {
 			return Generator{}, err
 		}
+		if gen.targetInUse(saasv1alpha1.SlavesRWLeastLag) {
+			gen.leastLagTargets, err = gen.getLeastLagReadWriteSlavesWithFallbackToMasters(
+				ctx, shardedCluster, currentLeastLagTargets(instance), log.WithName("leastLagTargets"),
+			)
+			if err != nil {
+				return Generator{}, err
+			}
+		}
+		gen.publishSlaveRwMetrics()
+	}
+
+	gen.serverShards = map[string]string{}
+	for _, shard := range shardedCluster.Shards {
+		for _, srv := range shard.Servers {
+			gen.serverShards[srv.ID()] = shard.Name
+		}
 	}
 
 	return gen, nil
 }
 
+// GetTargets returns the servers targeted by the given server pool,
+// indexed by physical shard name
 func (gen *Generator) GetTargets(poolName string) map[string]twemproxy.Server {
+	pool := gen.Spec.GetServerPool(poolName)
+	if pool == nil {
+		return nil
+	}
+	return gen.getPoolTargets(*pool)
+}
+
+func (gen *Generator) getPoolTargets(pool saasv1alpha1.TwemproxyServerPool) map[string]twemproxy.Server {
+	targets := make(map[string]twemproxy.Server, len(gen.masterTargets))
+	for shard := range gen.masterTargets {
+		switch pool.GetTarget(shard) {
+		case saasv1alpha1.SlavesRW:
+			targets[shard] = gen.slaverwTargets[shard]
+		case saasv1alpha1.SlavesRWLeastLag:
+			targets[shard] = gen.leastLagTargets[shard]
+		default:
+			targets[shard] = gen.masterTargets[shard]
+		}
+	}
+	return targets
+}
+
+// targetInUse returns true if any of the server pools
+// targets the given servers for at least one shard
+func (gen *Generator) targetInUse(target saasv1alpha1.TargetRedisServers) bool {
 	for _, pool := range gen.Spec.ServerPools {
-		if pool.Name == poolName {
-			if *pool.Target == saasv1alpha1.Masters {
-				return gen.masterTargets
-			} else {
-				return gen.slaverwTargets
+		if *pool.Target == target {
+			return true
+		}
+		for _, t := range pool.ShardTargets {
+			if t == target {
+				return true
 			}
 		}
 	}
+	return false
+}
+
+// validateServerPools checks that the server pools can be
+// served by the same twemproxy instance without conflicts
+func validateServerPools(pools []saasv1alpha1.TwemproxyServerPool) error {
+	names := map[string]bool{HealthPoolName: true}
+	addresses := map[string]bool{HealthBindAddress: true}
+	for _, pool := range pools {
+		if names[pool.Name] {
+			return fmt.Errorf("duplicate or reserved server pool name '%s'", pool.Name)
+		}
+		if addresses[pool.BindAddress] {
+			return fmt.Errorf("duplicate or reserved bind address '%s' in server pool '%s'", pool.BindAddress, pool.Name)
+		}
+		if err := twemproxy.ValidateServerPool(pool); err != nil {
+			return err
+		}
+		for shard := range pool.ShardTargets {
+			if !slices.ContainsFunc(pool.Topology, func(t saasv1alpha1.ShardedRedisTopology) bool { return t.PhysicalShard == shard }) {
+				return fmt.Errorf("unknown physical shard '%s' in the shard targets of server pool '%s'", shard, pool.Name)
+			}
+		}
+		names[pool.Name] = true
+		addresses[pool.BindAddress] = true
+	}
 	return nil
 }
 
+// getRedisAuth retrieves the redis passwords of the server pools
+// that have redis_auth configured, indexed by pool name
+func getRedisAuth(ctx context.Context, cl client.Client, namespace string,
+	pools []saasv1alpha1.TwemproxyServerPool) (map[string]string, error) {
+	var auth map[string]string
+
+	for _, pool := range pools {
+		if pool.RedisAuth == nil {
+			continue
+		}
+		secret := &corev1.Secret{}
+		key := types.NamespacedName{Name: pool.RedisAuth.Name, Namespace: namespace}
+		if err := cl.Get(ctx, key, secret); err != nil {
+			return nil, err
+		}
+		password, ok := secret.Data[pool.RedisAuth.Key]
+		if !ok {
+			return nil, fmt.Errorf("key '%s' not found in secret '%s' for server pool '%s'",
+				pool.RedisAuth.Key, key, pool.Name)
+		}
+		if auth == nil {
+			auth = map[string]string{}
+		}
+		auth[pool.Name] = string(password)
+	}
+
+	return auth, nil
+}
+
 func discoverSentinels(ctx context.Context, cl client.Client, namespace string) ([]string, error) {
 	sl := &saasv1alpha1.SentinelList{}
 	if err := cl.List(ctx, sl, client.InNamespace(namespace)); err != nil {
@@ -180,11 +307,7 @@ func (gen *Generator) getMonitoredMasters(ctx context.Context,
 		if err != nil {
 			return nil, err
 		}
-		m[shard.Name] = twemproxy.Server{
-			Address:  master.ID(),
-			Priority: 1,
-		}
-		m[shard.Name] = twemproxy.NewServer(master.ID(), master.GetAlias())
+		m[shard.Name] = twemproxy.NewServer(master.ID(), master.GetAlias()).WithReason("master")
 	}
 
 	return m, nil
@@ -197,8 +320,8 @@ func (gen *Generator) getMonitoredReadWriteSlavesWithFallbackToMasters(ctx conte
 	for _, shard := range cluster.Shards {
 
 		if slavesRW := shard.GetSlavesRW(); len(slavesRW) > 0 {
-			m[shard.Name] = twemproxy.NewServer(slavesRW[0].ID(), slavesRW[0].GetAlias())
-			slaveRwConfigured.With(prometheus.Labels{"twemproxy_config": gen.InstanceName, "shard": shard.Name}).Set(1)
+			m[shard.Name] = twemproxy.NewServer(slavesRW[0].ID(), slavesRW[0].GetAlias()).
+				WithReason("rw slave")
 
 		} else {
 			// Fall back to the master if there are no
@@ -207,14 +330,79 @@ func (gen *Generator) getMonitoredReadWriteSlavesWithFallbackToMasters(ctx conte
 			if err != nil {
 				return nil, err
 			}
-			m[shard.Name] = twemproxy.NewServer(master.ID(), master.GetAlias())
-			slaveRwConfigured.With(prometheus.Labels{"twemproxy_config": gen.InstanceName, "shard": shard.Name}).Set(0)
+			m[shard.Name] = twemproxy.NewServer(master.ID(), master.GetAlias()).
+				WithReason("no rw slaves available, fallback to master")
 		}
 	}
 
 	return m, nil
 }
 
+func (gen *Generator) getLeastLagReadWriteSlavesWithFallbackToMasters(ctx context.Context,
+	cluster *sharded.Cluster, current map[string]string, log logr.Logger) (map[string]twemproxy.Server, error) {
+
+	m := make(map[string]twemproxy.Server, len(cluster.Shards))
+	for _, shard := range cluster.Shards {
+
+		if slave := shard.GetSlaveRWWithLeastLag(current[shard.Name], *gen.Spec.MaxReplicationLag); slave != nil {
+			m[shard.Name] = twemproxy.NewServer(slave.ID(), slave.GetAlias()).
+				WithReason("in sync rw slave")
+
+		} else {
+			// Fall back to the master if there are no
+			// healthy RW slaves for this shard
+			master, err := shard.GetMaster()
+			if err != nil {
+				return nil, err
+			}
+			log.V(1).Info("no healthy rw slaves available, fallback to master", "shard", shard.Name)
+			m[shard.Name] = twemproxy.NewServer(master.ID(), master.GetAlias()).
+				WithReason("no healthy rw slaves available, fallback to master")
+		}
+	}
+
+	return m, nil
+}
+
+// currentLeastLagTargets returns the addresses of the servers currently targeted
+// by the shards that use the "slaves-rw-least-lag" target, as reported in the
+// status of the TwemproxyConfig, indexed by physical shard name
+func currentLeastLagTargets(instance *saasv1alpha1.TwemproxyConfig) map[string]string {
+	current := map[string]string{}
+	for _, pool := range instance.Spec.ServerPools {
+		for shard, target := range instance.Status.SelectedTargets[pool.Name] {
+			if pool.GetTarget(shard) == saasv1alpha1.SlavesRWLeastLag {
+				current[shard] = target.ServerAddress
+			}
+		}
+	}
+	return current
+}
+
+// publishSlaveRwMetrics sets, for each of the server pools targeting
+// RW slaves, whether each shard points to a RW slave or has fallen back
+// to the master. The metric without the pool label is kept for backwards
+// compatibility and reports the first server pool that targets RW slaves.
+func (gen *Generator) publishSlaveRwMetrics() {
+	legacy := true
+	for _, pool := range gen.Spec.ServerPools {
+		if !pool.TargetsSlaves() {
+			continue
+		}
+		for shard, srv := range gen.getPoolTargets(pool) {
+			var value float64 = 1
+			if master, ok := gen.masterTargets[shard]; ok && master.Address == srv.Address {
+				value = 0
+			}
+			serverPoolSlaveRwConfigured.With(prometheus.Labels{"twemproxy_config": gen.InstanceName, "pool": pool.Name, "shard": shard}).Set(value)
+			if legacy {
+				slaveRwConfigured.With(prometheus.Labels{"twemproxy_config": gen.InstanceName, "shard": shard}).Set(value)
+			}
+		}
+		legacy = false
+	}
+}
+
 // Returns the twemproxy config ConfigMap
 func (gen *Generator) ConfigMap() *resource.Template[*corev1.ConfigMap] {
 	return resource.NewTemplateFromObjectFunction(func() *corev1.ConfigMap { return gen.configMap(true) })
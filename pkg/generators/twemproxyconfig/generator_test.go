@@ -683,6 +683,65 @@ func TestNewGenerator(t *testing.T) {
 			want:    Generator{},
 			wantErr: true,
 		},
+		{
+			name: "Returns error if a shard target is not part of the topology",
+			args: args{
+				ctx: context.TODO(),
+				instance: &saasv1alpha1.TwemproxyConfig{
+					ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "test"},
+					Spec: saasv1alpha1.TwemproxyConfigSpec{
+						SentinelURIs: []string{"redis://127.0.0.1:26379"},
+						ServerPools: []saasv1alpha1.TwemproxyServerPool{
+							{
+								Name:         "masters",
+								Target:       util.Pointer(saasv1alpha1.Masters),
+								Topology:     []saasv1alpha1.ShardedRedisTopology{{ShardName: "l-shard00", PhysicalShard: "shard0"}},
+								ShardTargets: map[string]saasv1alpha1.TargetRedisServers{"shard1": saasv1alpha1.SlavesRW},
+								BindAddress:  "0.0.0.0:22121",
+							},
+						},
+						ReconcileServerPools: util.Pointer(true),
+					},
+				},
+				cl:   nil,
+				pool: server.NewServerPool(),
+				log:  logr.Discard(),
+			},
+			want:    Generator{},
+			wantErr: true,
+		},
+		{
+			name: "Returns error if two server pools share the same bind address",
+			args: args{
+				ctx: context.TODO(),
+				instance: &saasv1alpha1.TwemproxyConfig{
+					ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "test"},
+					Spec: saasv1alpha1.TwemproxyConfigSpec{
+						SentinelURIs: []string{"redis://127.0.0.1:26379"},
+						ServerPools: []saasv1alpha1.TwemproxyServerPool{
+							{
+								Name:        "masters",
+								Target:      util.Pointer(saasv1alpha1.Masters),
+								Topology:    []saasv1alpha1.ShardedRedisTopology{{ShardName: "l-shard00", PhysicalShard: "shard0"}},
+								BindAddress: "0.0.0.0:22121",
+							},
+							{
+								Name:        "slaves-rw",
+								Target:      util.Pointer(saasv1alpha1.SlavesRW),
+								Topology:    []saasv1alpha1.ShardedRedisTopology{{ShardName: "l-shard00", PhysicalShard: "shard0"}},
+								BindAddress: "0.0.0.0:22121",
+							},
+						},
+						ReconcileServerPools: util.Pointer(true),
+					},
+				},
+				cl:   nil,
+				pool: server.NewServerPool(),
+				log:  logr.Discard(),
+			},
+			want:    Generator{},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -692,9 +751,72 @@ func TestNewGenerator(t *testing.T) {
 				return
 			}
 			deep.CompareUnexportedFields = true
-			if diff := cmp.Diff(got, tt.want, cmp.AllowUnexported(Generator{}), cmpopts.IgnoreUnexported(twemproxy.Server{})); len(diff) != 0 {
+			if diff := cmp.Diff(got, tt.want, cmp.AllowUnexported(Generator{}),
+				cmpopts.IgnoreUnexported(twemproxy.Server{}), cmpopts.IgnoreFields(Generator{}, "serverShards")); len(diff) != 0 {
 				t.Errorf("NewGenerator() = diff %v", diff)
 			}
 		})
 	}
 }
+
+func TestGenerator_GetTargets(t *testing.T) {
+	gen := Generator{
+		Spec: saasv1alpha1.TwemproxyConfigSpec{
+			ServerPools: []saasv1alpha1.TwemproxyServerPool{
+				{
+					Name:   "masters",
+					Target: util.Pointer(saasv1alpha1.Masters),
+				},
+				{
+					Name:         "slaves",
+					Target:       util.Pointer(saasv1alpha1.SlavesRWLeastLag),
+					ShardTargets: map[string]saasv1alpha1.TargetRedisServers{"shard1": saasv1alpha1.Masters},
+				},
+			},
+		},
+		masterTargets: map[string]twemproxy.Server{
+			"shard0": {Address: "127.0.0.1:1000", Priority: 1},
+			"shard1": {Address: "127.0.0.1:4000", Priority: 1},
+		},
+		leastLagTargets: map[string]twemproxy.Server{
+			"shard0": {Address: "127.0.0.1:2000", Priority: 1},
+			"shard1": {Address: "127.0.0.1:5000", Priority: 1},
+		},
+	}
+
+	tests := []struct {
+		name string
+		pool string
+		want map[string]twemproxy.Server
+	}{
+		{
+			name: "Returns masters",
+			pool: "masters",
+			want: map[string]twemproxy.Server{
+				"shard0": {Address: "127.0.0.1:1000", Priority: 1},
+				"shard1": {Address: "127.0.0.1:4000", Priority: 1},
+			},
+		},
+		{
+			name: "Applies per shard target overrides",
+			pool: "slaves",
+			want: map[string]twemproxy.Server{
+				"shard0": {Address: "127.0.0.1:2000", Priority: 1},
+				"shard1": {Address: "127.0.0.1:4000", Priority: 1},
+			},
+		},
+		{
+			name: "Returns nil for unknown pools",
+			pool: "unknown",
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := gen.GetTargets(tt.pool)
+			if diff := cmp.Diff(got, tt.want, cmpopts.IgnoreUnexported(twemproxy.Server{})); len(diff) != 0 {
+				t.Errorf("Generator.GetTargets() = diff %v", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package twemproxyconfig
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/3scale-ops/basereconciler/util"
+	saasv1alpha1 "github.com/3scale-ops/saas-operator/api/v1alpha1"
+	"github.com/3scale-ops/saas-operator/pkg/resource_builders/twemproxy"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/yaml"
+)
+
+// appliedConfig returns the config of the server pools stored
+// in a ConfigMap generated by a TwemproxyConfig
+func appliedConfig(cm *corev1.ConfigMap) (map[string]twemproxy.ServerPoolConfig, error) {
+	config := map[string]twemproxy.ServerPoolConfig{}
+	if err := yaml.Unmarshal([]byte(cm.Data["nutcracker.yml"]), &config); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// KeyDistributionChanges returns the list of changes in the key distribution of the
+// server pools present in both the applied ConfigMap and the spec. Applying any of
+// these changes would make the keys already stored in the pool unreachable.
+func (gen *Generator) KeyDistributionChanges(applied *corev1.ConfigMap) ([]string, error) {
+	config, err := appliedConfig(applied)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := []string{}
+	for _, pool := range gen.Spec.ServerPools {
+		current, ok := config[pool.Name]
+		if !ok {
+			continue
+		}
+		desired := twemproxy.GenerateServerPool(pool, nil)
+		if current.KeyDistribution() != desired.KeyDistribution() {
+			changes = append(changes, fmt.Sprintf("%s: %s -> %s",
+				pool.Name, current.KeyDistribution(), desired.KeyDistribution()))
+		}
+	}
+
+	sort.Strings(changes)
+	return changes, nil
+}
+
+// KeepKeyDistribution replaces the parameters that determine the key distribution
+// of the server pools with the ones in the applied ConfigMap, so the config is
+// generated without applying any change in the key distribution. Pools not present
+// in the applied ConfigMap keep the parameters from the spec.
+func (gen *Generator) KeepKeyDistribution(applied *corev1.ConfigMap) error {
+	config, err := appliedConfig(applied)
+	if err != nil {
+		return err
+	}
+
+	pools := make([]saasv1alpha1.TwemproxyServerPool, 0, len(gen.Spec.ServerPools))
+	for _, pool := range gen.Spec.ServerPools {
+		if current, ok := config[pool.Name]; ok {
+			pool.Hash = util.Pointer(saasv1alpha1.TwemproxyHash(current.Hash))
+			pool.HashTag = util.Pointer(current.HashTag)
+			pool.Distribution = util.Pointer(saasv1alpha1.TwemproxyDistribution(current.Distribution))
+			pool.AutoEjectHosts = util.Pointer(current.AutoEjectHosts)
+		}
+		pools = append(pools, pool)
+	}
+	gen.Spec.ServerPools = pools
+
+	return nil
+}
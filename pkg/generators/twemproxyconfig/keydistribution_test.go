@@ -0,0 +1,99 @@
+package twemproxyconfig
+
+import (
+	"testing"
+
+	"github.com/3scale-ops/basereconciler/util"
+	saasv1alpha1 "github.com/3scale-ops/saas-operator/api/v1alpha1"
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestGenerator_KeyDistributionChanges(t *testing.T) {
+	cm := func(config string) *corev1.ConfigMap {
+		return &corev1.ConfigMap{Data: map[string]string{"nutcracker.yml": config}}
+	}
+	pool := func(name string, hash saasv1alpha1.TwemproxyHash, autoEject bool) saasv1alpha1.TwemproxyServerPool {
+		return saasv1alpha1.TwemproxyServerPool{
+			Name:           name,
+			Hash:           util.Pointer(hash),
+			HashTag:        util.Pointer("{}"),
+			Distribution:   util.Pointer(saasv1alpha1.TwemproxyDistribution("ketama")),
+			AutoEjectHosts: util.Pointer(autoEject),
+		}
+	}
+	tests := []struct {
+		name    string
+		pools   []saasv1alpha1.TwemproxyServerPool
+		applied *corev1.ConfigMap
+		want    []string
+		wantErr bool
+	}{
+		{
+			name:    "No changes if only the servers of a pool change",
+			pools:   []saasv1alpha1.TwemproxyServerPool{pool("pool", "fnv1a_64", false)},
+			applied: cm(`{"pool":{"listen":"0.0.0.0:22121","hash":"fnv1a_64","hash_tag":"{}","distribution":"ketama","servers":["127.0.0.1:6379:1 lshard01"]}}`),
+			want:    []string{},
+		},
+		{
+			name:    "No changes when adding new pools",
+			pools:   []saasv1alpha1.TwemproxyServerPool{pool("pool", "fnv1a_64", false), pool("other", "md5", false)},
+			applied: cm(`{"pool":{"listen":"0.0.0.0:22121","hash":"fnv1a_64","hash_tag":"{}","distribution":"ketama","servers":[]}}`),
+			want:    []string{},
+		},
+		{
+			name:    "Reports a change in the hash of a pool",
+			pools:   []saasv1alpha1.TwemproxyServerPool{pool("pool", "murmur", false)},
+			applied: cm(`{"pool":{"listen":"0.0.0.0:22121","hash":"fnv1a_64","hash_tag":"{}","distribution":"ketama","servers":[]}}`),
+			want: []string{
+				"pool: hash=fnv1a_64, hash_tag={}, distribution=ketama, auto_eject_hosts=false -> hash=murmur, hash_tag={}, distribution=ketama, auto_eject_hosts=false",
+			},
+		},
+		{
+			name:    "Reports enabling auto_eject_hosts in a pool",
+			pools:   []saasv1alpha1.TwemproxyServerPool{pool("pool", "fnv1a_64", true)},
+			applied: cm(`{"pool":{"listen":"0.0.0.0:22121","hash":"fnv1a_64","hash_tag":"{}","distribution":"ketama","auto_eject_hosts":false,"servers":[]}}`),
+			want: []string{
+				"pool: hash=fnv1a_64, hash_tag={}, distribution=ketama, auto_eject_hosts=false -> hash=fnv1a_64, hash_tag={}, distribution=ketama, auto_eject_hosts=true",
+			},
+		},
+		{
+			name:    "Fails with an invalid config",
+			pools:   []saasv1alpha1.TwemproxyServerPool{pool("pool", "fnv1a_64", false)},
+			applied: cm(`{"pool":`),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gen := &Generator{Spec: saasv1alpha1.TwemproxyConfigSpec{ServerPools: tt.pools}}
+			got, err := gen.KeyDistributionChanges(tt.applied)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Generator.KeyDistributionChanges() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if diff := cmp.Diff(got, tt.want); len(diff) > 0 {
+				t.Errorf("Generator.KeyDistributionChanges() got diff %v", diff)
+			}
+		})
+	}
+}
+
+func TestGenerator_KeepKeyDistribution(t *testing.T) {
+	gen := &Generator{Spec: saasv1alpha1.TwemproxyConfigSpec{ServerPools: []saasv1alpha1.TwemproxyServerPool{
+		{Name: "pool", Hash: util.Pointer(saasv1alpha1.TwemproxyHash("murmur")), AutoEjectHosts: util.Pointer(true)},
+		{Name: "other", Hash: util.Pointer(saasv1alpha1.TwemproxyHash("md5"))},
+	}}}
+	applied := &corev1.ConfigMap{Data: map[string]string{"nutcracker.yml": `{"pool":{"listen":"0.0.0.0:22121","hash":"fnv1a_64","hash_tag":"{}","distribution":"ketama","servers":[]}}`}}
+
+	if err := gen.KeepKeyDistribution(applied); err != nil {
+		t.Fatalf("Generator.KeepKeyDistribution() error = %v", err)
+	}
+	changes, _ := gen.KeyDistributionChanges(applied)
+	if len(changes) != 0 {
+		t.Errorf("Generator.KeepKeyDistribution() changes not reverted: %v", changes)
+	}
+	if got := *gen.Spec.ServerPools[1].Hash; got != "md5" {
+		t.Errorf("Generator.KeepKeyDistribution() changed the hash of a pool not applied: %v", got)
+	}
+}
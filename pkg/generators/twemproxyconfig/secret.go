@@ -0,0 +1,47 @@
+package twemproxyconfig
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+)
+
+// UsesRedisAuth returns true if any of the server pools uses redis authentication
+func (gen *Generator) UsesRedisAuth() bool {
+	return len(gen.redisAuth) > 0
+}
+
+// Secret returns a Secret that holds the twemproxy config file mounted
+// by the twemproxy sidecars. The config is the one applied in the given
+// ConfigMap with the redis passwords of the server pools added to it.
+func (gen *Generator) Secret(applied *corev1.ConfigMap) (*corev1.Secret, error) {
+	data := []byte(applied.Data["nutcracker.yml"])
+
+	if len(gen.redisAuth) > 0 {
+		config, err := appliedConfig(applied)
+		if err != nil {
+			return nil, err
+		}
+		for name, password := range gen.redisAuth {
+			if cfg, ok := config[name]; ok {
+				cfg.RedisAuth = password
+				config[name] = cfg
+			}
+		}
+		if data, err = yaml.Marshal(config); err != nil {
+			return nil, err
+		}
+	}
+
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      gen.GetInstanceName(),
+			Namespace: gen.GetNamespace(),
+			Labels:    gen.GetLabels(),
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: map[string][]byte{
+			"nutcracker.yml": data,
+		},
+	}, nil
+}
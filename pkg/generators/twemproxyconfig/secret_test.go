@@ -0,0 +1,74 @@
+package twemproxyconfig
+
+import (
+	"testing"
+
+	saasv1alpha1 "github.com/3scale-ops/saas-operator/api/v1alpha1"
+	"github.com/3scale-ops/saas-operator/pkg/generators"
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestGenerator_Secret(t *testing.T) {
+	config := `{"pool1":{"listen":"0.0.0.0:22121","hash":"fnv1a_64","preconnect":false,"redis":true,"auto_eject_hosts":false,"servers":["127.0.0.1:6379:1 lshard01"]},` +
+		`"pool2":{"listen":"0.0.0.0:22122","hash":"fnv1a_64","preconnect":false,"redis":true,"auto_eject_hosts":false,"servers":["127.0.0.1:6379:1 lshard01"]}}`
+	tests := []struct {
+		name      string
+		redisAuth map[string]string
+		want      string
+	}{
+		{
+			name: "Copies the config when there are no passwords",
+			want: config,
+		},
+		{
+			name:      "Adds the passwords to the config",
+			redisAuth: map[string]string{"pool2": "secret"},
+			want: `pool1:
+  auto_eject_hosts: false
+  hash: fnv1a_64
+  listen: 0.0.0.0:22121
+  preconnect: false
+  redis: true
+  servers:
+  - 127.0.0.1:6379:1 lshard01
+pool2:
+  auto_eject_hosts: false
+  hash: fnv1a_64
+  listen: 0.0.0.0:22122
+  preconnect: false
+  redis: true
+  redis_auth: secret
+  servers:
+  - 127.0.0.1:6379:1 lshard01
+`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gen := &Generator{
+				BaseOptionsV2: generators.BaseOptionsV2{
+					Component:    "twemproxy",
+					InstanceName: "test",
+					Namespace:    "ns",
+					Labels:       map[string]string{},
+				},
+				Spec:      saasv1alpha1.TwemproxyConfigSpec{},
+				redisAuth: tt.redisAuth,
+			}
+			got, err := gen.Secret(&corev1.ConfigMap{Data: map[string]string{"nutcracker.yml": config}})
+			if err != nil {
+				t.Fatalf("Generator.Secret() error = %v", err)
+			}
+			want := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "ns", Labels: map[string]string{}},
+				Type:       corev1.SecretTypeOpaque,
+				Data:       map[string][]byte{"nutcracker.yml": []byte(tt.want)},
+			}
+			if diff := cmp.Diff(got, want); len(diff) > 0 {
+				t.Errorf("Generator.Secret() got diff %v", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,141 @@
+package twemproxyconfig
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+
+	saasv1alpha1 "github.com/3scale-ops/saas-operator/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// Topology is the logical to physical shard mapping of
+// each of the server pools, indexed by pool name
+type Topology map[string][]saasv1alpha1.ShardedRedisTopology
+
+// Topology returns the shard mapping of the server pools in the spec
+func (gen *Generator) Topology() Topology {
+	topology := make(Topology, len(gen.Spec.ServerPools))
+	for _, pool := range gen.Spec.ServerPools {
+		topology[pool.Name] = pool.Topology
+	}
+	return topology
+}
+
+// KeepTopology replaces the shard mapping of the server pools with the
+// given one, so the config is generated with the current targets but without
+// applying any change in the mapping. Pools not present in the given
+// topology keep the mapping from the spec.
+func (gen *Generator) KeepTopology(applied Topology) {
+	pools := make([]saasv1alpha1.TwemproxyServerPool, 0, len(gen.Spec.ServerPools))
+	for _, pool := range gen.Spec.ServerPools {
+		if topology, ok := applied[pool.Name]; ok {
+			pool.Topology = topology
+		}
+		pools = append(pools, pool)
+	}
+	gen.Spec.ServerPools = pools
+}
+
+// AppliedTopology returns the shard mapping stored in the annotations of a
+// ConfigMap generated by a TwemproxyConfig. Returns nil if the ConfigMap
+// does not have the annotation.
+func AppliedTopology(cm *corev1.ConfigMap) (Topology, error) {
+	value, ok := cm.GetAnnotations()[saasv1alpha1.TwemproxyTopologyAnnotationKey]
+	if !ok {
+		return nil, nil
+	}
+	topology := Topology{}
+	if err := json.Unmarshal([]byte(value), &topology); err != nil {
+		return nil, err
+	}
+	return topology, nil
+}
+
+// SeedTopology returns the shard mapping of the config applied in a ConfigMap that
+// does not have the topology annotation, as generated by previous versions of the
+// operator. The physical shard of each server is looked up by address. Servers that
+// are not known (e.g. they were removed from the shard) are assumed to be mapped
+// as in the spec.
+func (gen *Generator) SeedTopology(cm *corev1.ConfigMap) (Topology, error) {
+	config, err := appliedConfig(cm)
+	if err != nil {
+		return nil, err
+	}
+
+	desired := gen.Topology()
+	topology := make(Topology, len(gen.Spec.ServerPools))
+	for _, pool := range gen.Spec.ServerPools {
+		cfg, ok := config[pool.Name]
+		if !ok {
+			continue
+		}
+		fallback := make(map[string]string, len(desired[pool.Name]))
+		for _, shard := range desired[pool.Name] {
+			fallback[shard.ShardName] = shard.PhysicalShard
+		}
+		shards := make([]saasv1alpha1.ShardedRedisTopology, 0, len(cfg.Servers))
+		for _, srv := range cfg.Servers {
+			pshard, ok := gen.serverShards[srv.Address]
+			if !ok {
+				pshard = fallback[srv.Name]
+			}
+			shards = append(shards, saasv1alpha1.ShardedRedisTopology{ShardName: srv.Name, PhysicalShard: pshard})
+		}
+		topology[pool.Name] = shards
+	}
+
+	return topology, nil
+}
+
+// TopologyChanges returns the list of changes in the shard mapping of the server
+// pools present in both topologies. Master changes due to failovers don't
+// change the mapping so they are not reported here.
+func TopologyChanges(applied, desired Topology) []string {
+	changes := []string{}
+
+	for pool, desiredShards := range desired {
+		appliedShards, ok := applied[pool]
+		if !ok {
+			continue
+		}
+
+		n := len(changes)
+		from := make(map[string]string, len(appliedShards))
+		for _, shard := range appliedShards {
+			from[shard.ShardName] = shard.PhysicalShard
+		}
+		to := make(map[string]string, len(desiredShards))
+		for _, shard := range desiredShards {
+			to[shard.ShardName] = shard.PhysicalShard
+		}
+
+		for lshard, pshard := range to {
+			if from[lshard] != pshard {
+				changes = append(changes, fmt.Sprintf("%s/%s: %s -> %s", pool, lshard, shardName(from[lshard]), pshard))
+			}
+		}
+		for lshard, pshard := range from {
+			if _, ok := to[lshard]; !ok {
+				changes = append(changes, fmt.Sprintf("%s/%s: %s -> %s", pool, lshard, pshard, shardName("")))
+			}
+		}
+
+		// the position of the servers in the list also
+		// matters for some of the distribution modes
+		if len(changes) == n && !slices.Equal(appliedShards, desiredShards) {
+			changes = append(changes, fmt.Sprintf("%s: order of logical shards changed", pool))
+		}
+	}
+
+	sort.Strings(changes)
+	return changes
+}
+
+func shardName(name string) string {
+	if name == "" {
+		return "<none>"
+	}
+	return name
+}
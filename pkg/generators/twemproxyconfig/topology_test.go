@@ -0,0 +1,170 @@
+package twemproxyconfig
+
+import (
+	"testing"
+
+	saasv1alpha1 "github.com/3scale-ops/saas-operator/api/v1alpha1"
+	"github.com/go-test/deep"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestTopologyChanges(t *testing.T) {
+	type args struct {
+		applied Topology
+		desired Topology
+	}
+	tests := []struct {
+		name string
+		args args
+		want []string
+	}{
+		{
+			name: "No changes",
+			args: args{
+				applied: Topology{"pool": {{ShardName: "l-shard00", PhysicalShard: "shard0"}, {ShardName: "l-shard01", PhysicalShard: "shard1"}}},
+				desired: Topology{"pool": {{ShardName: "l-shard00", PhysicalShard: "shard0"}, {ShardName: "l-shard01", PhysicalShard: "shard1"}}},
+			},
+			want: []string{},
+		},
+		{
+			name: "Detects a logical shard moved to another physical shard",
+			args: args{
+				applied: Topology{"pool": {{ShardName: "l-shard00", PhysicalShard: "shard0"}, {ShardName: "l-shard01", PhysicalShard: "shard1"}}},
+				desired: Topology{"pool": {{ShardName: "l-shard00", PhysicalShard: "shard0"}, {ShardName: "l-shard01", PhysicalShard: "shard0"}}},
+			},
+			want: []string{"pool/l-shard01: shard1 -> shard0"},
+		},
+		{
+			name: "Detects added and removed logical shards",
+			args: args{
+				applied: Topology{"pool": {{ShardName: "l-shard00", PhysicalShard: "shard0"}, {ShardName: "l-shard01", PhysicalShard: "shard1"}}},
+				desired: Topology{"pool": {{ShardName: "l-shard00", PhysicalShard: "shard0"}, {ShardName: "l-shard02", PhysicalShard: "shard1"}}},
+			},
+			want: []string{"pool/l-shard01: shard1 -> <none>", "pool/l-shard02: <none> -> shard1"},
+		},
+		{
+			name: "Detects changes in the order of the logical shards",
+			args: args{
+				applied: Topology{"pool": {{ShardName: "l-shard00", PhysicalShard: "shard0"}, {ShardName: "l-shard01", PhysicalShard: "shard1"}}},
+				desired: Topology{"pool": {{ShardName: "l-shard01", PhysicalShard: "shard1"}, {ShardName: "l-shard00", PhysicalShard: "shard0"}}},
+			},
+			want: []string{"pool: order of logical shards changed"},
+		},
+		{
+			name: "Ignores new pools",
+			args: args{
+				applied: Topology{"pool": {{ShardName: "l-shard00", PhysicalShard: "shard0"}}},
+				desired: Topology{
+					"pool":  {{ShardName: "l-shard00", PhysicalShard: "shard0"}},
+					"other": {{ShardName: "l-shard00", PhysicalShard: "shard1"}},
+				},
+			},
+			want: []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := TopologyChanges(tt.args.applied, tt.args.desired)
+			if diff := deep.Equal(got, tt.want); len(diff) > 0 {
+				t.Errorf("TopologyChanges() = diff %v", diff)
+			}
+		})
+	}
+}
+
+func TestGenerator_KeepTopology(t *testing.T) {
+	spec := saasv1alpha1.TwemproxyConfigSpec{
+		ServerPools: []saasv1alpha1.TwemproxyServerPool{
+			{Name: "pool", Topology: []saasv1alpha1.ShardedRedisTopology{{ShardName: "l-shard00", PhysicalShard: "shard1"}}},
+			{Name: "other", Topology: []saasv1alpha1.ShardedRedisTopology{{ShardName: "l-shard00", PhysicalShard: "shard1"}}},
+		},
+	}
+	gen := &Generator{Spec: spec}
+	gen.KeepTopology(Topology{"pool": {{ShardName: "l-shard00", PhysicalShard: "shard0"}}})
+
+	want := Topology{
+		"pool":  {{ShardName: "l-shard00", PhysicalShard: "shard0"}},
+		"other": {{ShardName: "l-shard00", PhysicalShard: "shard1"}},
+	}
+	if diff := deep.Equal(gen.Topology(), want); len(diff) > 0 {
+		t.Errorf("Generator.KeepTopology() = diff %v", diff)
+	}
+	// the original spec must not be modified
+	if spec.ServerPools[0].Topology[0].PhysicalShard != "shard1" {
+		t.Errorf("Generator.KeepTopology() modified the original spec")
+	}
+}
+
+func TestAppliedTopology(t *testing.T) {
+	tests := []struct {
+		name    string
+		cm      *corev1.ConfigMap
+		want    Topology
+		wantErr bool
+	}{
+		{
+			name:    "Returns nil if the annotation is not present",
+			cm:      &corev1.ConfigMap{},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "Returns the topology stored in the annotation",
+			cm: &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{
+				saasv1alpha1.TwemproxyTopologyAnnotationKey: `{"pool":[{"shardName":"l-shard00","physicalShard":"shard0"}]}`,
+			}}},
+			want:    Topology{"pool": {{ShardName: "l-shard00", PhysicalShard: "shard0"}}},
+			wantErr: false,
+		},
+		{
+			name: "Returns error if the annotation is not valid",
+			cm: &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{
+				saasv1alpha1.TwemproxyTopologyAnnotationKey: `{"pool":`,
+			}}},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := AppliedTopology(tt.cm)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("AppliedTopology() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if diff := deep.Equal(got, tt.want); len(diff) > 0 {
+				t.Errorf("AppliedTopology() = diff %v", diff)
+			}
+		})
+	}
+}
+
+func TestGenerator_SeedTopology(t *testing.T) {
+	gen := &Generator{
+		Spec: saasv1alpha1.TwemproxyConfigSpec{ServerPools: []saasv1alpha1.TwemproxyServerPool{
+			{Name: "pool", Topology: []saasv1alpha1.ShardedRedisTopology{
+				{ShardName: "l-shard00", PhysicalShard: "shard1"},
+				{ShardName: "l-shard01", PhysicalShard: "shard1"},
+			}},
+			{Name: "new", Topology: []saasv1alpha1.ShardedRedisTopology{
+				{ShardName: "l-shard00", PhysicalShard: "shard0"},
+			}},
+		}},
+		serverShards: map[string]string{"127.0.0.1:6379": "shard0", "127.0.0.2:6379": "shard1"},
+	}
+	cm := &corev1.ConfigMap{Data: map[string]string{"nutcracker.yml": `{"pool":{"listen":"0.0.0.0:22121",` +
+		`"servers":["127.0.0.1:6379:1 l-shard00","127.0.0.3:6379:1 l-shard01"]}}`}}
+
+	got, err := gen.SeedTopology(cm)
+	if err != nil {
+		t.Fatalf("Generator.SeedTopology() error = %v", err)
+	}
+	want := Topology{"pool": {
+		{ShardName: "l-shard00", PhysicalShard: "shard0"},
+		{ShardName: "l-shard01", PhysicalShard: "shard1"},
+	}}
+	if diff := deep.Equal(got, want); len(diff) > 0 {
+		t.Errorf("Generator.SeedTopology() = diff %v", diff)
+	}
+}
@@ -7,8 +7,10 @@ import (
 	"github.com/3scale-ops/basereconciler/mutators"
 	"github.com/3scale-ops/basereconciler/resource"
 	saasv1alpha1 "github.com/3scale-ops/saas-operator/api/v1alpha1"
+	"github.com/3scale-ops/saas-operator/pkg/bluegreen"
 	"github.com/3scale-ops/saas-operator/pkg/generators"
 	"github.com/3scale-ops/saas-operator/pkg/generators/zync/config"
+	"github.com/3scale-ops/saas-operator/pkg/maintenance"
 	"github.com/3scale-ops/saas-operator/pkg/resource_builders/grafanadashboard"
 	"github.com/3scale-ops/saas-operator/pkg/resource_builders/pod"
 	"github.com/3scale-ops/saas-operator/pkg/resource_builders/podmonitor"
@@ -31,6 +33,7 @@ const (
 type Generator struct {
 	generators.BaseOptionsV2
 	API                  APIGenerator
+	GreenAPI             *APIGenerator
 	Que                  QueGenerator
 	Console              ConsoleGenerator
 	GrafanaDashboardSpec saasv1alpha1.GrafanaDashboardSpec
@@ -39,7 +42,7 @@ type Generator struct {
 
 // NewGenerator returns a new Options struct
 func NewGenerator(instance, namespace string, spec saasv1alpha1.ZyncSpec) Generator {
-	return Generator{
+	generator := Generator{
 		BaseOptionsV2: generators.BaseOptionsV2{
 			Component:    component,
 			InstanceName: instance,
@@ -98,11 +101,26 @@ func NewGenerator(instance, namespace string, spec saasv1alpha1.ZyncSpec) Genera
 		GrafanaDashboardSpec: *spec.GrafanaDashboard,
 		Config:               spec.Config,
 	}
+
+	if spec.API.BlueGreen != nil {
+		green := generator.API
+		green.BaseOptionsV2.Component = api + deployment_workload.BlueGreenGreenSuffix
+		generator.GreenAPI = &green
+	}
+
+	return generator
 }
 
 // Resources returns the list of templates
 func (gen *Generator) Resources() ([]resource.TemplateInterface, error) {
-	app_resources, err := deployment_workload.New(&gen.API, nil)
+	var app_resources []resource.TemplateInterface
+	var err error
+	if gen.GreenAPI != nil {
+		app_resources, err = deployment_workload.NewBlueGreen(&gen.API, gen.GreenAPI, gen.API.APISpec.BlueGreen,
+			fmt.Sprintf("%s:%s", *gen.API.Image.Name, *gen.API.Image.Tag))
+	} else {
+		app_resources, err = deployment_workload.New(&gen.API, nil)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -159,9 +177,15 @@ func (gen *APIGenerator) Deployment() *resource.Template[*appsv1.Deployment] {
 func (gen *APIGenerator) HPASpec() *saasv1alpha1.HorizontalPodAutoscalerSpec {
 	return gen.APISpec.HPA
 }
+func (gen *APIGenerator) VPASpec() *saasv1alpha1.VerticalPodAutoscalerSpec {
+	return gen.APISpec.VPA
+}
 func (gen *APIGenerator) PDBSpec() *saasv1alpha1.PodDisruptionBudgetSpec {
 	return gen.APISpec.PDB
 }
+func (gen *APIGenerator) PodTemplateOverrides() *saasv1alpha1.PodTemplateOverrides {
+	return &gen.APISpec.PodTemplateOverrides
+}
 func (gen *APIGenerator) MonitoredEndpoints() []monitoringv1.PodMetricsEndpoint {
 	return []monitoringv1.PodMetricsEndpoint{
 		podmonitor.PodMetricsEndpoint("/metrics", "metrics", 30),
@@ -203,9 +227,15 @@ func (gen *QueGenerator) Deployment() *resource.Template[*appsv1.Deployment] {
 func (gen *QueGenerator) HPASpec() *saasv1alpha1.HorizontalPodAutoscalerSpec {
 	return gen.QueSpec.HPA
 }
+func (gen *QueGenerator) VPASpec() *saasv1alpha1.VerticalPodAutoscalerSpec {
+	return gen.QueSpec.VPA
+}
 func (gen *QueGenerator) PDBSpec() *saasv1alpha1.PodDisruptionBudgetSpec {
 	return gen.QueSpec.PDB
 }
+func (gen *QueGenerator) PodTemplateOverrides() *saasv1alpha1.PodTemplateOverrides {
+	return &gen.QueSpec.PodTemplateOverrides
+}
 func (gen *QueGenerator) MonitoredEndpoints() []monitoringv1.PodMetricsEndpoint {
 	return []monitoringv1.PodMetricsEndpoint{
 		podmonitor.PodMetricsEndpoint("/metrics", "metrics", 30),
@@ -228,3 +258,39 @@ func (gen *ConsoleGenerator) StatefulSet() []resource.TemplateInterface {
 			WithMutations(gen.Options.GenerateRolloutTriggers()),
 	}
 }
+
+// BlueGreenTargets returns the workloads of Zync delivered using blue/green
+func (gen *Generator) BlueGreenTargets() ([]bluegreen.Target, error) {
+	if gen.GreenAPI == nil {
+		return nil, nil
+	}
+	revision, err := deployment_workload.BlueGreenRevision(&gen.API)
+	if err != nil {
+		return nil, err
+	}
+	return []bluegreen.Target{{
+		Name:     gen.API.GetComponent(),
+		Spec:     gen.API.APISpec.BlueGreen,
+		Revision: revision,
+	}}, nil
+}
+
+// MaintenanceTargets returns the Zync workloads that can be stopped during a maintenance.
+// The spec is expected to be defaulted. The live Deployment of the api is the one of the
+// active colour in the given blue/green statuses.
+func MaintenanceTargets(spec *saasv1alpha1.ZyncSpec, blueGreen saasv1alpha1.BlueGreenStatuses) []maintenance.Target {
+	return []maintenance.Target{
+		{
+			Name:       api,
+			Deployment: bluegreen.ActiveDeployment(api, blueGreen),
+			Replicas:   &spec.API.Replicas,
+			HPA:        spec.API.HPA,
+		},
+		{
+			Name:     strings.Join([]string{component, que}, "-"),
+			Worker:   true,
+			Replicas: &spec.Que.Replicas,
+			HPA:      spec.Que.HPA,
+		},
+	}
+}
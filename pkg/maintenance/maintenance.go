@@ -0,0 +1,158 @@
+package maintenance
+
+import (
+	"context"
+	"time"
+
+	"github.com/3scale-ops/basereconciler/util"
+	saasv1alpha1 "github.com/3scale-ops/saas-operator/api/v1alpha1"
+	"github.com/go-logr/logr"
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Target is a workload that can be stopped during a maintenance
+type Target struct {
+	// Name of the workload, used to store its replicas in the status
+	Name string
+	// Deployment is the name of the live Deployment of the workload, which
+	// is the Deployment of the active colour for blue/green workloads.
+	// Defaults to the name of the workload.
+	Deployment string
+	// Worker is true for background processing workloads, which
+	// are also stopped in the "drain-workers" maintenance mode
+	Worker bool
+	// Replicas points to the replicas field of the workload
+	// spec, which is set to zero by Apply
+	Replicas **int32
+	// HPA is the hpa spec of the workload, which is deactivated by Apply
+	HPA *saasv1alpha1.HorizontalPodAutoscalerSpec
+	// Canary is the canary of the workload, if any, which is also scaled to zero by Apply
+	Canary *saasv1alpha1.Canary
+}
+
+// deployment returns the name of the live Deployment of the workload
+func (t Target) deployment() string {
+	if t.Deployment != "" {
+		return t.Deployment
+	}
+	return t.Name
+}
+
+// IsStopped returns true if the workload is stopped in the given maintenance mode
+func (t Target) IsStopped(spec *saasv1alpha1.MaintenanceSpec) bool {
+	return spec != nil && (spec.Mode == saasv1alpha1.MaintenanceModeFull || t.Worker)
+}
+
+// Manager drives the maintenance of workloads
+type Manager struct {
+	Client client.Client
+	Now    func() time.Time
+}
+
+// NewManager returns a Manager that uses the given client
+// to read and restore the replicas of the Deployments
+func NewManager(cl client.Client) *Manager {
+	return &Manager{Client: cl, Now: time.Now}
+}
+
+// Progress returns the maintenance status for the given spec. The live replicas of the Deployments
+// that are going to be stopped are stored in the status, and the replicas of the Deployments that
+// are no longer stopped are restored from it. The status is nil when there is no maintenance.
+func (m *Manager) Progress(ctx context.Context, namespace string, spec *saasv1alpha1.MaintenanceSpec,
+	targets []Target, current *saasv1alpha1.MaintenanceStatus) (*saasv1alpha1.MaintenanceStatus, error) {
+	logger := logr.FromContextOrDiscard(ctx)
+
+	if spec == nil && current == nil {
+		return nil, nil
+	}
+
+	status := &saasv1alpha1.MaintenanceStatus{Since: metav1.NewTime(m.Now())}
+	if current != nil {
+		status.Since = current.Since
+	}
+
+	previous := []saasv1alpha1.MaintenanceReplicas{}
+	for _, t := range targets {
+		stored := current.GetPreviousReplicas(t.Name)
+
+		if t.IsStopped(spec) {
+			if stored != nil {
+				previous = append(previous, *stored)
+				continue
+			}
+			dep, err := m.getDeployment(ctx, types.NamespacedName{Name: t.deployment(), Namespace: namespace})
+			if err != nil {
+				return nil, err
+			}
+			if dep != nil {
+				replicas := util.Pointer[int32](1)
+				if dep.Spec.Replicas != nil {
+					replicas = dep.Spec.Replicas
+				}
+				logger.Info("stopping workload for maintenance", "workload", t.Name, "replicas", *replicas)
+				previous = append(previous, saasv1alpha1.MaintenanceReplicas{Name: t.Name, Replicas: *replicas})
+			}
+			continue
+		}
+
+		if stored != nil {
+			if err := m.restore(ctx, types.NamespacedName{Name: t.deployment(), Namespace: namespace}, stored.Replicas); err != nil {
+				return nil, err
+			}
+			logger.Info("workload restored after maintenance", "workload", t.Name, "replicas", stored.Replicas)
+		}
+	}
+
+	if spec == nil {
+		return nil, nil
+	}
+
+	status.Mode = spec.Mode
+	status.PreviousReplicas = previous
+	return status, nil
+}
+
+// Apply scales to zero, in the in-memory copy of the spec, the workloads that
+// are stopped in the given maintenance mode. Autoscaling is also deactivated.
+func Apply(spec *saasv1alpha1.MaintenanceSpec, targets []Target) {
+	for _, t := range targets {
+		if !t.IsStopped(spec) {
+			continue
+		}
+		*t.Replicas = util.Pointer[int32](0)
+		if t.HPA != nil {
+			*t.HPA = saasv1alpha1.HorizontalPodAutoscalerSpec{}
+		}
+		if t.Canary != nil {
+			t.Canary.Replicas = util.Pointer[int32](0)
+		}
+	}
+}
+
+func (m *Manager) getDeployment(ctx context.Context, key types.NamespacedName) (*appsv1.Deployment, error) {
+	dep := &appsv1.Deployment{}
+	if err := m.Client.Get(ctx, key, dep); err != nil {
+		if errors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return dep, nil
+}
+
+// restore sets the replicas of a Deployment back to the ones it had before the maintenance. This is
+// required for Deployments managed by an HorizontalPodAutoscaler, as autoscaling is disabled when a
+// Deployment has zero replicas.
+func (m *Manager) restore(ctx context.Context, key types.NamespacedName, replicas int32) error {
+	dep, err := m.getDeployment(ctx, key)
+	if err != nil || dep == nil {
+		return err
+	}
+	patch := client.MergeFrom(dep.DeepCopy())
+	dep.Spec.Replicas = util.Pointer(replicas)
+	return m.Client.Patch(ctx, dep, patch)
+}
@@ -0,0 +1,190 @@
+package maintenance
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/3scale-ops/basereconciler/util"
+	saasv1alpha1 "github.com/3scale-ops/saas-operator/api/v1alpha1"
+	"github.com/google/go-cmp/cmp"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var (
+	testNow   = time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	testSince = metav1.NewTime(testNow.Add(-time.Hour))
+)
+
+func testManager(objects ...client.Object) *Manager {
+	return &Manager{
+		Client: fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(objects...).Build(),
+		Now:    func() time.Time { return testNow },
+	}
+}
+
+func testDeployment(name string, replicas int32) *appsv1.Deployment {
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "ns"},
+		Spec:       appsv1.DeploymentSpec{Replicas: util.Pointer(replicas)},
+	}
+}
+
+var testTargets = []Target{
+	{Name: "backend-listener"},
+	{Name: "backend-worker", Worker: true},
+	{Name: "backend-cron", Worker: true},
+}
+
+func TestManager_Progress(t *testing.T) {
+	tests := []struct {
+		name         string
+		targets      []Target
+		spec         *saasv1alpha1.MaintenanceSpec
+		current      *saasv1alpha1.MaintenanceStatus
+		objects      []client.Object
+		want         *saasv1alpha1.MaintenanceStatus
+		wantReplicas map[string]int32
+	}{
+		{
+			name: "No maintenance",
+			want: nil,
+		},
+		{
+			name:    "Drain workers stores the replicas of the workers",
+			spec:    &saasv1alpha1.MaintenanceSpec{Mode: saasv1alpha1.MaintenanceModeDrainWorkers},
+			objects: []client.Object{testDeployment("backend-listener", 6), testDeployment("backend-worker", 4)},
+			want: &saasv1alpha1.MaintenanceStatus{
+				Mode:             saasv1alpha1.MaintenanceModeDrainWorkers,
+				Since:            metav1.NewTime(testNow),
+				PreviousReplicas: []saasv1alpha1.MaintenanceReplicas{{Name: "backend-worker", Replicas: 4}},
+			},
+		},
+		{
+			name: "Full maintenance keeps the replicas already stored",
+			spec: &saasv1alpha1.MaintenanceSpec{Mode: saasv1alpha1.MaintenanceModeFull},
+			current: &saasv1alpha1.MaintenanceStatus{
+				Mode:             saasv1alpha1.MaintenanceModeDrainWorkers,
+				Since:            testSince,
+				PreviousReplicas: []saasv1alpha1.MaintenanceReplicas{{Name: "backend-worker", Replicas: 4}},
+			},
+			objects: []client.Object{testDeployment("backend-listener", 6), testDeployment("backend-worker", 0), testDeployment("backend-cron", 1)},
+			want: &saasv1alpha1.MaintenanceStatus{
+				Mode:  saasv1alpha1.MaintenanceModeFull,
+				Since: testSince,
+				PreviousReplicas: []saasv1alpha1.MaintenanceReplicas{
+					{Name: "backend-listener", Replicas: 6},
+					{Name: "backend-worker", Replicas: 4},
+					{Name: "backend-cron", Replicas: 1},
+				},
+			},
+		},
+		{
+			name: "Restores the workloads that are no longer stopped",
+			spec: &saasv1alpha1.MaintenanceSpec{Mode: saasv1alpha1.MaintenanceModeDrainWorkers},
+			current: &saasv1alpha1.MaintenanceStatus{
+				Mode:  saasv1alpha1.MaintenanceModeFull,
+				Since: testSince,
+				PreviousReplicas: []saasv1alpha1.MaintenanceReplicas{
+					{Name: "backend-listener", Replicas: 6},
+					{Name: "backend-worker", Replicas: 4},
+				},
+			},
+			objects: []client.Object{testDeployment("backend-listener", 0), testDeployment("backend-worker", 0)},
+			want: &saasv1alpha1.MaintenanceStatus{
+				Mode:             saasv1alpha1.MaintenanceModeDrainWorkers,
+				Since:            testSince,
+				PreviousReplicas: []saasv1alpha1.MaintenanceReplicas{{Name: "backend-worker", Replicas: 4}},
+			},
+			wantReplicas: map[string]int32{"backend-listener": 6, "backend-worker": 0},
+		},
+		{
+			name: "Restores all the workloads when the maintenance ends",
+			current: &saasv1alpha1.MaintenanceStatus{
+				Mode:  saasv1alpha1.MaintenanceModeFull,
+				Since: testSince,
+				PreviousReplicas: []saasv1alpha1.MaintenanceReplicas{
+					{Name: "backend-listener", Replicas: 6},
+					{Name: "backend-worker", Replicas: 4},
+				},
+			},
+			objects:      []client.Object{testDeployment("backend-listener", 0), testDeployment("backend-worker", 0)},
+			want:         nil,
+			wantReplicas: map[string]int32{"backend-listener": 6, "backend-worker": 4},
+		},
+		{
+			name:    "Stores the replicas of the live Deployment of the workload",
+			targets: []Target{{Name: "system-app", Deployment: "system-app-green"}},
+			spec:    &saasv1alpha1.MaintenanceSpec{Mode: saasv1alpha1.MaintenanceModeFull},
+			objects: []client.Object{testDeployment("system-app", 1), testDeployment("system-app-green", 8)},
+			want: &saasv1alpha1.MaintenanceStatus{
+				Mode:             saasv1alpha1.MaintenanceModeFull,
+				Since:            metav1.NewTime(testNow),
+				PreviousReplicas: []saasv1alpha1.MaintenanceReplicas{{Name: "system-app", Replicas: 8}},
+			},
+		},
+		{
+			name:    "Restores the live Deployment of the workload",
+			targets: []Target{{Name: "system-app", Deployment: "system-app-green"}},
+			current: &saasv1alpha1.MaintenanceStatus{
+				Mode:             saasv1alpha1.MaintenanceModeFull,
+				Since:            testSince,
+				PreviousReplicas: []saasv1alpha1.MaintenanceReplicas{{Name: "system-app", Replicas: 8}},
+			},
+			objects:      []client.Object{testDeployment("system-app", 0), testDeployment("system-app-green", 0)},
+			want:         nil,
+			wantReplicas: map[string]int32{"system-app": 0, "system-app-green": 8},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			targets := testTargets
+			if tt.targets != nil {
+				targets = tt.targets
+			}
+			m := testManager(tt.objects...)
+			got, err := m.Progress(context.TODO(), "ns", tt.spec, targets, tt.current)
+			if err != nil {
+				t.Errorf("Progress() error = %v", err)
+				return
+			}
+			if diff := cmp.Diff(got, tt.want); len(diff) > 0 {
+				t.Errorf("Progress() diff = %v", diff)
+			}
+			for name, replicas := range tt.wantReplicas {
+				dep := &appsv1.Deployment{}
+				if err := m.Client.Get(context.TODO(), types.NamespacedName{Name: name, Namespace: "ns"}, dep); err != nil {
+					t.Errorf("Progress() error = %v", err)
+					return
+				}
+				if *dep.Spec.Replicas != replicas {
+					t.Errorf("Progress() replicas of %s = %d, want %d", name, *dep.Spec.Replicas, replicas)
+				}
+			}
+		})
+	}
+}
+
+func TestApply(t *testing.T) {
+	listenerReplicas, workerReplicas := util.Pointer[int32](6), util.Pointer[int32](4)
+	listenerHPA := &saasv1alpha1.HorizontalPodAutoscalerSpec{MinReplicas: util.Pointer[int32](2)}
+	workerHPA := &saasv1alpha1.HorizontalPodAutoscalerSpec{MinReplicas: util.Pointer[int32](2)}
+	workerCanary := &saasv1alpha1.Canary{Replicas: util.Pointer[int32](1)}
+
+	Apply(&saasv1alpha1.MaintenanceSpec{Mode: saasv1alpha1.MaintenanceModeDrainWorkers}, []Target{
+		{Name: "backend-listener", Replicas: &listenerReplicas, HPA: listenerHPA},
+		{Name: "backend-worker", Worker: true, Replicas: &workerReplicas, HPA: workerHPA, Canary: workerCanary},
+	})
+
+	if *listenerReplicas != 6 || listenerHPA.IsDeactivated() {
+		t.Errorf("Apply() the listener must not be stopped")
+	}
+	if *workerReplicas != 0 || !workerHPA.IsDeactivated() || *workerCanary.Replicas != 0 {
+		t.Errorf("Apply() the worker must be stopped")
+	}
+}
@@ -10,19 +10,26 @@ import (
 	"time"
 
 	"github.com/3scale-ops/saas-operator/pkg/redis/client"
+	"github.com/3scale-ops/saas-operator/pkg/util"
 	"github.com/go-redis/redis/v8"
 )
 
 // Server is a host that talks the redis protocol
 // Contains methods to use a subset of redis commands
 type Server struct {
-	alias  string
-	client client.TestableInterface
-	host   string
-	port   string
-	mu     sync.Mutex
+	alias      string
+	client     client.TestableInterface
+	host       string
+	port       string
+	ip         string
+	resolvedAt time.Time
+	mu         sync.Mutex
 }
 
+// ipCacheTTL is how long the resolved IP of a server is
+// kept before resolving its host again
+const ipCacheTTL = 5 * time.Minute
+
 // NewServer returns a new client for this redis server from the given connection
 // string. It can optionally be passed an alias to identify the server.
 func NewServer(connectionString string, alias *string) (*Server, error) {
@@ -96,6 +103,24 @@ func (srv *Server) SetAlias(alias string) {
 	srv.mu.Unlock()
 }
 
+// ResolveIP returns the IPv4 address of the server host. The address is
+// cached for some time so the host is not resolved on every call.
+func (srv *Server) ResolveIP(ctx context.Context) (string, error) {
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+
+	if srv.ip != "" && time.Since(srv.resolvedAt) < ipCacheTTL {
+		return srv.ip, nil
+	}
+
+	ip, err := util.LookupIPv4(ctx, srv.host)
+	if err != nil {
+		return "", err
+	}
+	srv.ip, srv.resolvedAt = ip, time.Now()
+	return ip, nil
+}
+
 // ID returns the ID of the server, which takes the form "host:port"
 func (srv *Server) ID() string {
 	return net.JoinHostPort(srv.host, srv.port)
@@ -3,6 +3,9 @@ package sharded
 import (
 	"context"
 	"fmt"
+	"net"
+	"strconv"
+	"strings"
 
 	"github.com/3scale-ops/saas-operator/pkg/redis/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
@@ -18,6 +21,8 @@ const (
 	OnlyMasterDiscoveryOpt
 	SlavePriorityDiscoveryOpt
 	ReplicationInfoDiscoveryOpt
+	ResolveIPDiscoveryOpt
+	MasterReplicationOffsetDiscoveryOpt
 )
 
 func (set DiscoveryOptionSet) Has(opt DiscoveryOption) bool {
@@ -99,9 +104,63 @@ func (srv *RedisServer) Discover(ctx context.Context, opts ...DiscoveryOption) e
 		srv.Info["replication"] = fmt.Sprintf("master-link: %s, sync-in-progress: %s", repinfo["master_link_status"], syncInProgress)
 	}
 
+	if DiscoveryOptionSet(opts).Has(MasterReplicationOffsetDiscoveryOpt) && role == client.Master {
+		repinfo, err := srv.RedisInfo(ctx, "replication")
+		if err != nil {
+			logger.Error(err, fmt.Sprintf("unable to get %s|%s|%s replication info", srv.GetAlias(), srv.Role, srv.ID()))
+			return err
+		}
+		offset, err := strconv.Atoi(repinfo["master_repl_offset"])
+		if err != nil {
+			logger.Error(err, fmt.Sprintf("unexpected value '%s' for 'master_repl_offset' %s|%s|%s", repinfo["master_repl_offset"], srv.GetAlias(), srv.Role, srv.ID()))
+			return err
+		}
+		srv.Replication = &ReplicationStatus{MasterLinkUp: true, Offset: offset, SlaveOffsets: slaveOffsets(repinfo)}
+	}
+
+	// The IP is only informative, so a failure to
+	// resolve it does not fail the discovery
+	if DiscoveryOptionSet(opts).Has(ResolveIPDiscoveryOpt) {
+		if ip, err := srv.ResolveIP(ctx); err != nil {
+			logger.Error(err, fmt.Sprintf("unable to resolve %s|%s|%s IP", srv.GetAlias(), srv.Role, srv.ID()))
+		} else {
+			srv.IP = ip
+		}
+	}
+
 	return nil
 }
 
+// slaveOffsets parses the 'slaveN' lines of the replication info of a master, like
+// "ip=10.0.0.1,port=6379,state=online,offset=1234,lag=0", and returns the offsets of
+// the online slaves keyed by ip:port. Lines that can't be parsed are ignored.
+func slaveOffsets(repinfo map[string]string) map[string]int {
+	offsets := map[string]int{}
+	for key, value := range repinfo {
+		if !strings.HasPrefix(key, "slave") {
+			continue
+		}
+		if _, err := strconv.Atoi(strings.TrimPrefix(key, "slave")); err != nil {
+			continue
+		}
+		fields := map[string]string{}
+		for _, field := range strings.Split(value, ",") {
+			if kv := strings.SplitN(field, "=", 2); len(kv) == 2 {
+				fields[kv[0]] = kv[1]
+			}
+		}
+		if fields["state"] != "online" {
+			continue
+		}
+		offset, err := strconv.Atoi(fields["offset"])
+		if err != nil {
+			continue
+		}
+		offsets[net.JoinHostPort(fields["ip"], fields["port"])] = offset
+	}
+	return offsets
+}
+
 // Discovery errors
 type DiscoveryError_Sentinel_Failure struct{ error }
 type DiscoveryError_Master_SingleServerFailure struct{ error }
@@ -53,12 +53,14 @@ func TestRedisServer_Discover(t *testing.T) {
 		opts []DiscoveryOption
 	}
 	tests := []struct {
-		name       string
-		fields     fields
-		args       args
-		wantRole   client.Role
-		wantConfig map[string]string
-		wantErr    bool
+		name            string
+		fields          fields
+		args            args
+		wantRole        client.Role
+		wantConfig      map[string]string
+		wantIP          string
+		wantReplication *ReplicationStatus
+		wantErr         bool
 	}{
 		{
 			name: "Discovers a master",
@@ -146,6 +148,69 @@ func TestRedisServer_Discover(t *testing.T) {
 			wantConfig: map[string]string{"slave-read-only": "no"},
 			wantErr:    false,
 		},
+		{
+			name: "Discovers the IP of a server addressed by hostname",
+			fields: fields{
+				Server: redis.NewFakeServerWithFakeClient("localhost", "1000",
+					client.FakeResponse{
+						InjectResponse: func() interface{} {
+							return []interface{}{"master", ""}
+						},
+						InjectError: func() error { return nil },
+					},
+				)},
+			args:       args{ctx: context.TODO(), opts: DiscoveryOptionSet{ResolveIPDiscoveryOpt}},
+			wantRole:   client.Master,
+			wantConfig: map[string]string{},
+			wantIP:     "127.0.0.1",
+			wantErr:    false,
+		},
+		{
+			name: "A failure to resolve the IP does not fail the discovery",
+			fields: fields{
+				Server: redis.NewFakeServerWithFakeClient("redis.invalid", "1000",
+					client.FakeResponse{
+						InjectResponse: func() interface{} {
+							return []interface{}{"master", ""}
+						},
+						InjectError: func() error { return nil },
+					},
+				)},
+			args:       args{ctx: context.TODO(), opts: DiscoveryOptionSet{ResolveIPDiscoveryOpt}},
+			wantRole:   client.Master,
+			wantConfig: map[string]string{},
+			wantIP:     "",
+			wantErr:    false,
+		},
+		{
+			name: "Discovers the replication offsets of a master",
+			fields: fields{
+				Server: redis.NewFakeServerWithFakeClient("127.0.0.1", "1000",
+					client.FakeResponse{
+						InjectResponse: func() interface{} {
+							return []interface{}{"master", ""}
+						},
+						InjectError: func() error { return nil },
+					},
+					client.FakeResponse{
+						// cmd: RedisInfo("replication")
+						InjectResponse: func() interface{} {
+							return "# Replication\nrole:master\nconnected_slaves:3\n" +
+								"slave0:ip=127.0.0.1,port=2000,state=online,offset=900,lag=0\n" +
+								"slave1:ip=127.0.0.1,port=3000,state=online,offset=1000,lag=0\n" +
+								"slave2:ip=127.0.0.1,port=4000,state=wait_bgsave,offset=0,lag=0\n" +
+								"master_repl_offset:1000\n"
+						},
+						InjectError: func() error { return nil },
+					},
+				)},
+			args:       args{ctx: context.TODO(), opts: DiscoveryOptionSet{MasterReplicationOffsetDiscoveryOpt}},
+			wantRole:   client.Master,
+			wantConfig: map[string]string{},
+			wantReplication: &ReplicationStatus{MasterLinkUp: true, Offset: 1000,
+				SlaveOffsets: map[string]int{"127.0.0.1:2000": 900, "127.0.0.1:3000": 1000}},
+			wantErr: false,
+		},
 		{
 			name: "'role' command fails, returns an error",
 			fields: fields{
@@ -180,6 +245,12 @@ func TestRedisServer_Discover(t *testing.T) {
 			if diff := deep.Equal(srv.Config, tt.wantConfig); len(diff) > 0 {
 				t.Errorf("RedisServer.Discover() got diff: %v", diff)
 			}
+			if tt.wantIP != srv.IP {
+				t.Errorf("RedisServer.Discover() got IP = %v, want %v", srv.IP, tt.wantIP)
+			}
+			if diff := deep.Equal(srv.Replication, tt.wantReplication); len(diff) > 0 {
+				t.Errorf("RedisServer.Discover() got replication diff: %v", diff)
+			}
 		})
 	}
 }
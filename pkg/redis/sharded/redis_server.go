@@ -14,6 +14,29 @@ type RedisServer struct {
 	Role   client.Role
 	Config map[string]string
 	Info   map[string]string
+	// IP is the resolved address of the server. Servers are tracked by
+	// their host (which can be a stable hostname), so this is
+	// only informative and is populated during discovery.
+	IP string
+	// Replication is the replication status of a slave as seen by
+	// sentinel. It is only populated when the shard is discovered
+	// through sentinel. For a master, it holds its replication offset
+	// and the offsets of its slaves when discovered with
+	// MasterReplicationOffsetDiscoveryOpt.
+	Replication *ReplicationStatus
+}
+
+// ReplicationStatus is the status of the replication link
+// between a slave and its master
+type ReplicationStatus struct {
+	// MasterLinkUp is true if the link with the master is up
+	MasterLinkUp bool
+	// Offset is the replication offset of the slave
+	Offset int
+	// SlaveOffsets are the replication offsets of the connected slaves,
+	// keyed by ip:port, as reported by the master in the same
+	// 'INFO replication' call as its own offset. Only set for a master.
+	SlaveOffsets map[string]int
 }
 
 func NewRedisServerFromPool(connectionString string, alias *string, pool *redis.ServerPool) (*RedisServer, error) {
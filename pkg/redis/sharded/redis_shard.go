@@ -153,6 +153,10 @@ func (shard *Shard) Discover(ctx context.Context, sentinel *SentinelServer, opti
 				continue
 
 			} else {
+				srv.Replication = &ReplicationStatus{
+					MasterLinkUp: slave.MasterLinkStatus == "ok",
+					Offset:       slave.SlaveReplOffset,
+				}
 				if err := srv.Discover(ctx, options...); err != nil {
 					srv.Role = client.Role(client.Unknown)
 					logger.Error(err, fmt.Sprintf("unable to discover redis server %s", srv.GetAlias()))
@@ -207,6 +211,57 @@ func (shard *Shard) GetSlavesRW() []*RedisServer {
 	return servers
 }
 
+// GetSlaveRWWithLeastLag returns the read-write slave to target amongst those in sync
+// with the master: the link to the master is up and, if the replication offsets have
+// been discovered in the master, they are at most maxLag bytes behind it. Both offsets
+// are taken from the same 'INFO replication' output of the master, as the offsets
+// cached by sentinel can be several seconds old. The current slave, given as host:port,
+// is kept while it is in sync so the target does not change with every discovery.
+// Otherwise the slave with the highest replication offset is returned. Returns nil if
+// there are no slaves in sync.
+func (shard *Shard) GetSlaveRWWithLeastLag(current string, maxLag int) *RedisServer {
+	var masterReplication *ReplicationStatus
+	if master, err := shard.GetMaster(); err == nil && master.Replication != nil && master.Replication.SlaveOffsets != nil {
+		masterReplication = master.Replication
+	}
+
+	var selected *RedisServer
+	var selectedOffset int
+	for _, srv := range shard.GetSlavesRW() {
+		if srv.Replication == nil || !srv.Replication.MasterLinkUp {
+			continue
+		}
+		offset := srv.Replication.Offset
+		if masterReplication != nil {
+			var ok bool
+			if offset, ok = masterReplication.slaveOffset(srv); !ok || masterReplication.Offset-offset > maxLag {
+				continue
+			}
+		}
+		if srv.ID() == current {
+			return srv
+		}
+		if selected == nil || offset > selectedOffset {
+			selected, selectedOffset = srv, offset
+		}
+	}
+	return selected
+}
+
+// slaveOffset returns the replication offset of the given slave as reported by the master.
+// Slaves are reported by IP, so the resolved IP of the slave is also looked up.
+func (status *ReplicationStatus) slaveOffset(srv *RedisServer) (int, bool) {
+	if offset, ok := status.SlaveOffsets[srv.ID()]; ok {
+		return offset, true
+	}
+	if srv.IP != "" {
+		_, port, _ := net.SplitHostPort(srv.ID())
+		offset, ok := status.SlaveOffsets[net.JoinHostPort(srv.IP, port)]
+		return offset, ok
+	}
+	return 0, false
+}
+
 func (shard *Shard) GetSlavesRO() []*RedisServer {
 	servers := []*RedisServer{}
 	for _, srv := range shard.Servers {
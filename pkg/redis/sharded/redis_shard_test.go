@@ -1102,6 +1102,125 @@ func TestShard_GetMasterAddr(t *testing.T) {
 	}
 }
 
+func TestShard_GetSlaveRWWithLeastLag(t *testing.T) {
+	master := func(replication *ReplicationStatus) *RedisServer {
+		srv := NewRedisServerFromParams(redis.MustNewServer("redis://127.0.0.1:1000", nil), client.Master, map[string]string{})
+		srv.Replication = replication
+		return srv
+	}
+	slave := func(connectionString string, readOnly string, replication *ReplicationStatus) *RedisServer {
+		srv := NewRedisServerFromParams(redis.MustNewServer(connectionString, nil), client.Slave,
+			map[string]string{"slave-read-only": readOnly})
+		srv.Replication = replication
+		return srv
+	}
+	type args struct {
+		current string
+		maxLag  int
+	}
+	tests := []struct {
+		name    string
+		servers []*RedisServer
+		args    args
+		want    string
+	}{
+		{
+			name: "Returns the rw slave with the highest offset",
+			servers: []*RedisServer{
+				master(nil),
+				slave("redis://127.0.0.1:2000", "no", &ReplicationStatus{MasterLinkUp: true, Offset: 100}),
+				slave("redis://127.0.0.1:3000", "no", &ReplicationStatus{MasterLinkUp: true, Offset: 200}),
+				slave("redis://127.0.0.1:4000", "yes", &ReplicationStatus{MasterLinkUp: true, Offset: 300}),
+			},
+			args: args{current: "", maxLag: 1000},
+			want: "127.0.0.1:3000",
+		},
+		{
+			name: "Ignores rw slaves with the link to the master down",
+			servers: []*RedisServer{
+				master(nil),
+				slave("redis://127.0.0.1:2000", "no", &ReplicationStatus{MasterLinkUp: true, Offset: 100}),
+				slave("redis://127.0.0.1:3000", "no", &ReplicationStatus{MasterLinkUp: false, Offset: 200}),
+			},
+			args: args{current: "", maxLag: 1000},
+			want: "127.0.0.1:2000",
+		},
+		{
+			name: "Returns nil if there are no healthy rw slaves",
+			servers: []*RedisServer{
+				master(nil),
+				slave("redis://127.0.0.1:2000", "no", &ReplicationStatus{MasterLinkUp: false, Offset: 100}),
+				slave("redis://127.0.0.1:3000", "no", nil),
+			},
+			args: args{current: "", maxLag: 1000},
+			want: "",
+		},
+		{
+			name: "Keeps the current rw slave while in sync",
+			servers: []*RedisServer{
+				master(&ReplicationStatus{MasterLinkUp: true, Offset: 1000,
+					SlaveOffsets: map[string]int{"127.0.0.1:2000": 900, "127.0.0.1:3000": 1000}}),
+				slave("redis://127.0.0.1:2000", "no", &ReplicationStatus{MasterLinkUp: true, Offset: 900}),
+				slave("redis://127.0.0.1:3000", "no", &ReplicationStatus{MasterLinkUp: true, Offset: 1000}),
+			},
+			args: args{current: "127.0.0.1:2000", maxLag: 100},
+			want: "127.0.0.1:2000",
+		},
+		{
+			name: "Replaces the current rw slave when it lags behind",
+			servers: []*RedisServer{
+				master(&ReplicationStatus{MasterLinkUp: true, Offset: 1000,
+					SlaveOffsets: map[string]int{"127.0.0.1:2000": 800, "127.0.0.1:3000": 950}}),
+				slave("redis://127.0.0.1:2000", "no", &ReplicationStatus{MasterLinkUp: true, Offset: 800}),
+				slave("redis://127.0.0.1:3000", "no", &ReplicationStatus{MasterLinkUp: true, Offset: 950}),
+			},
+			args: args{current: "127.0.0.1:2000", maxLag: 100},
+			want: "127.0.0.1:3000",
+		},
+		{
+			name: "Returns nil if all rw slaves lag behind",
+			servers: []*RedisServer{
+				master(&ReplicationStatus{MasterLinkUp: true, Offset: 1000,
+					SlaveOffsets: map[string]int{"127.0.0.1:2000": 800}}),
+				slave("redis://127.0.0.1:2000", "no", &ReplicationStatus{MasterLinkUp: true, Offset: 800}),
+			},
+			args: args{current: "", maxLag: 100},
+			want: "",
+		},
+		{
+			name: "Uses the slave offsets reported by the master instead of the ones cached by sentinel",
+			servers: []*RedisServer{
+				master(&ReplicationStatus{MasterLinkUp: true, Offset: 5000,
+					SlaveOffsets: map[string]int{"127.0.0.1:2000": 4950, "127.0.0.1:3000": 4800}}),
+				slave("redis://127.0.0.1:2000", "no", &ReplicationStatus{MasterLinkUp: true, Offset: 1000}),
+				slave("redis://127.0.0.1:3000", "no", &ReplicationStatus{MasterLinkUp: true, Offset: 4990}),
+			},
+			args: args{current: "127.0.0.1:3000", maxLag: 100},
+			want: "127.0.0.1:2000",
+		},
+		{
+			name: "Ignores rw slaves not reported by the master",
+			servers: []*RedisServer{
+				master(&ReplicationStatus{MasterLinkUp: true, Offset: 1000,
+					SlaveOffsets: map[string]int{"127.0.0.1:3000": 990}}),
+				slave("redis://127.0.0.1:2000", "no", &ReplicationStatus{MasterLinkUp: true, Offset: 1000}),
+				slave("redis://127.0.0.1:3000", "no", &ReplicationStatus{MasterLinkUp: true, Offset: 900}),
+			},
+			args: args{current: "127.0.0.1:2000", maxLag: 100},
+			want: "127.0.0.1:3000",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			shard := &Shard{Name: "test", Servers: tt.servers, pool: redis.NewServerPool()}
+			got := shard.GetSlaveRWWithLeastLag(tt.args.current, tt.args.maxLag)
+			if (got == nil && tt.want != "") || (got != nil && got.ID() != tt.want) {
+				t.Errorf("Shard.GetSlaveRWWithLeastLag() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestShard_GetServerByID(t *testing.T) {
 	type args struct {
 		hostport string
@@ -0,0 +1,243 @@
+package render
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	marin3rv1alpha1 "github.com/3scale-ops/marin3r/apis/marin3r/v1alpha1"
+	saasv1alpha1 "github.com/3scale-ops/saas-operator/api/v1alpha1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utiljson "k8s.io/apimachinery/pkg/util/json"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+)
+
+// CommandName is the name of the subcommand that renders EnvoyConfigs
+const CommandName string = "render"
+
+var scheme = runtime.NewScheme()
+
+func init() {
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(saasv1alpha1.AddToScheme(scheme))
+	utilruntime.Must(marin3rv1alpha1.AddToScheme(scheme))
+	utilruntime.Must(apiextensionsv1.AddToScheme(scheme))
+}
+
+// Run executes the render subcommand. It reads custom resources from a YAML file
+// and writes the EnvoyConfigs generated for them to out. Optionally, a diff against
+// the EnvoyConfigs currently applied in the cluster, or read from another file, is
+// written instead. Nothing is ever written to the cluster.
+func Run(args []string, in io.Reader, out io.Writer) error {
+	fs := flag.NewFlagSet(CommandName, flag.ContinueOnError)
+	fs.SetOutput(out)
+	file := fs.String("f", "", "YAML file with the custom resources to render. Use '-' to read from stdin.")
+	crds := fs.String("crds", "", "Directory with the CRD manifests used to apply the schema defaults to the custom "+
+		"resources (e.g. config/crd/bases). If unset, the CRDs installed in the cluster are used.")
+	diff := fs.Bool("diff", false, "Show a diff against the EnvoyConfigs applied in the cluster instead of rendering them.")
+	applied := fs.String("applied", "", "YAML file with the applied EnvoyConfigs to diff against. Implies -diff.")
+	summary := fs.Bool("summary", false, "Show a summary with the hash of each envoy resource instead of the full EnvoyConfig.")
+	namespace := fs.String("n", "", "Override the namespace of the custom resources.")
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage: %s %s -f <file> [options]\n", os.Args[0], CommandName)
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *file == "" {
+		fs.Usage()
+		return errors.New("flag -f is required")
+	}
+
+	ctx := context.TODO()
+	var cl client.Client
+	if *crds == "" || (*diff && *applied == "") {
+		cfg, err := ctrl.GetConfig()
+		if err != nil {
+			return err
+		}
+		if cl, err = client.New(cfg, client.Options{Scheme: scheme}); err != nil {
+			return err
+		}
+	}
+
+	var sd SchemaDefaulter
+	var err error
+	if *crds != "" {
+		sd, err = SchemaDefaulterFromDir(*crds)
+	} else {
+		sd, err = SchemaDefaulterFromCluster(ctx, cl)
+	}
+	if err != nil {
+		return fmt.Errorf("unable to load CRD schemas: %w", err)
+	}
+
+	r := in
+	if *file != "-" {
+		f, err := os.Open(*file)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		r = f
+	}
+	objs, err := decode(r, sd)
+	if err != nil {
+		return err
+	}
+
+	desired := []*marin3rv1alpha1.EnvoyConfig{}
+	for _, o := range objs {
+		if *namespace != "" {
+			o.SetNamespace(*namespace)
+		}
+		ecs, err := EnvoyConfigs(ctx, cl, o)
+		if err != nil {
+			return fmt.Errorf("%s/%s: %w", o.GetObjectKind().GroupVersionKind().Kind, o.GetName(), err)
+		}
+		desired = append(desired, ecs...)
+	}
+
+	switch {
+	case *applied != "" || *diff:
+		getter, err := appliedGetter(ctx, cl, *applied)
+		if err != nil {
+			return err
+		}
+		for _, ec := range desired {
+			current, err := getter(ec)
+			if err != nil {
+				return err
+			}
+			d, err := Diff(current, ec)
+			if err != nil {
+				return err
+			}
+			switch {
+			case current == nil:
+				fmt.Fprintf(out, "EnvoyConfig %s/%s: not applied\n%s", ec.GetNamespace(), ec.GetName(), d)
+			case d == "":
+				fmt.Fprintf(out, "EnvoyConfig %s/%s: no changes\n", ec.GetNamespace(), ec.GetName())
+			default:
+				fmt.Fprintf(out, "EnvoyConfig %s/%s:\n%s", ec.GetNamespace(), ec.GetName(), d)
+			}
+		}
+
+	case *summary:
+		for _, ec := range desired {
+			list, err := Summary(ec)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(out, "EnvoyConfig %s/%s (nodeID: %s)\n", ec.GetNamespace(), ec.GetName(), ec.Spec.NodeID)
+			for _, rs := range list {
+				fmt.Fprintf(out, "  %-10s %-40s %s\n", rs.Type, rs.Name, rs.Hash)
+			}
+		}
+
+	default:
+		for i, ec := range desired {
+			ec.SetGroupVersionKind(marin3rv1alpha1.GroupVersion.WithKind("EnvoyConfig"))
+			y, err := yaml.Marshal(ec)
+			if err != nil {
+				return err
+			}
+			if i > 0 {
+				fmt.Fprintln(out, "---")
+			}
+			fmt.Fprint(out, string(y))
+		}
+	}
+
+	return nil
+}
+
+// decode reads the objects in a multi document YAML stream, applying
+// the schema defaults of their CRDs if the defaulter is not nil
+func decode(r io.Reader, sd SchemaDefaulter) ([]client.Object, error) {
+	docs, err := readDocuments(r)
+	if err != nil {
+		return nil, err
+	}
+
+	objs := []client.Object{}
+	for _, doc := range docs {
+		j, err := yaml.YAMLToJSON(doc)
+		if err != nil {
+			return nil, err
+		}
+		u := map[string]interface{}{}
+		if err := utiljson.Unmarshal(j, &u); err != nil {
+			return nil, err
+		}
+		if sd != nil {
+			if err := sd.Default(u); err != nil {
+				return nil, err
+			}
+		}
+		gvk := schema.FromAPIVersionAndKind(stringValue(u["apiVersion"]), stringValue(u["kind"]))
+		ro, err := scheme.New(gvk)
+		if err != nil {
+			return nil, err
+		}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u, ro); err != nil {
+			return nil, err
+		}
+		o, ok := ro.(client.Object)
+		if !ok {
+			return nil, fmt.Errorf("unexpected object type %T", ro)
+		}
+		o.GetObjectKind().SetGroupVersionKind(gvk)
+		objs = append(objs, o)
+	}
+	return objs, nil
+}
+
+// appliedGetter returns a function that retrieves the applied version of an EnvoyConfig,
+// either from a file or from the cluster. The function returns nil if not found.
+func appliedGetter(ctx context.Context, cl client.Client, file string) (func(*marin3rv1alpha1.EnvoyConfig) (*marin3rv1alpha1.EnvoyConfig, error), error) {
+
+	if file != "" {
+		f, err := os.Open(file)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		objs, err := decode(f, nil)
+		if err != nil {
+			return nil, err
+		}
+		ecs := map[string]*marin3rv1alpha1.EnvoyConfig{}
+		for _, obj := range objs {
+			if ec, ok := obj.(*marin3rv1alpha1.EnvoyConfig); ok {
+				ecs[client.ObjectKeyFromObject(ec).String()] = ec
+			}
+		}
+		return func(ec *marin3rv1alpha1.EnvoyConfig) (*marin3rv1alpha1.EnvoyConfig, error) {
+			return ecs[client.ObjectKeyFromObject(ec).String()], nil
+		}, nil
+	}
+
+	return func(ec *marin3rv1alpha1.EnvoyConfig) (*marin3rv1alpha1.EnvoyConfig, error) {
+		current := &marin3rv1alpha1.EnvoyConfig{}
+		err := cl.Get(ctx, client.ObjectKeyFromObject(ec), current)
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		return current, nil
+	}, nil
+}
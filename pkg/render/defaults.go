@@ -0,0 +1,153 @@
+package render
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utiljson "k8s.io/apimachinery/pkg/util/json"
+	k8syaml "k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+)
+
+// SchemaDefaulter applies the default values declared in the openapi
+// schemas of CRDs, the same way the API server does when it receives
+// a custom resource. Schemas are indexed by GroupVersionKind.
+type SchemaDefaulter map[schema.GroupVersionKind]*apiextensionsv1.JSONSchemaProps
+
+// Add indexes the schemas of all the versions of a CRD
+func (sd SchemaDefaulter) Add(crd *apiextensionsv1.CustomResourceDefinition) {
+	for _, v := range crd.Spec.Versions {
+		if v.Schema == nil || v.Schema.OpenAPIV3Schema == nil {
+			continue
+		}
+		gvk := schema.GroupVersionKind{Group: crd.Spec.Group, Version: v.Name, Kind: crd.Spec.Names.Kind}
+		sd[gvk] = v.Schema.OpenAPIV3Schema
+	}
+}
+
+// Default applies the schema defaults to an object in unstructured
+// form. Objects without a known schema are not modified.
+func (sd SchemaDefaulter) Default(obj map[string]interface{}) error {
+	gvk := schema.FromAPIVersionAndKind(stringValue(obj["apiVersion"]), stringValue(obj["kind"]))
+	s, ok := sd[gvk]
+	if !ok {
+		return nil
+	}
+	return applyDefaults(obj, s)
+}
+
+func applyDefaults(x interface{}, s *apiextensionsv1.JSONSchemaProps) error {
+	switch v := x.(type) {
+	case map[string]interface{}:
+		for name, prop := range s.Properties {
+			if _, ok := v[name]; !ok && prop.Default != nil {
+				var def interface{}
+				if err := utiljson.Unmarshal(prop.Default.Raw, &def); err != nil {
+					return err
+				}
+				v[name] = def
+			}
+			if value, ok := v[name]; ok {
+				prop := prop
+				if err := applyDefaults(value, &prop); err != nil {
+					return err
+				}
+			}
+		}
+		if s.AdditionalProperties != nil && s.AdditionalProperties.Schema != nil {
+			for name, value := range v {
+				if _, ok := s.Properties[name]; ok {
+					continue
+				}
+				if err := applyDefaults(value, s.AdditionalProperties.Schema); err != nil {
+					return err
+				}
+			}
+		}
+
+	case []interface{}:
+		if s.Items != nil && s.Items.Schema != nil {
+			for _, item := range v {
+				if err := applyDefaults(item, s.Items.Schema); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// SchemaDefaulterFromDir loads the CRD manifests stored in a directory
+func SchemaDefaulterFromDir(dir string) (SchemaDefaulter, error) {
+	sd := SchemaDefaulter{}
+	files, err := filepath.Glob(filepath.Join(dir, "*.yaml"))
+	if err != nil {
+		return nil, err
+	}
+	for _, file := range files {
+		f, err := os.Open(file)
+		if err != nil {
+			return nil, err
+		}
+		docs, err := readDocuments(f)
+		f.Close()
+		if err != nil {
+			return nil, err
+		}
+		for _, doc := range docs {
+			crd := &apiextensionsv1.CustomResourceDefinition{}
+			if err := yaml.Unmarshal(doc, crd); err != nil {
+				return nil, err
+			}
+			if crd.Kind == "CustomResourceDefinition" {
+				sd.Add(crd)
+			}
+		}
+	}
+	return sd, nil
+}
+
+// SchemaDefaulterFromCluster loads the CRDs installed in the cluster
+func SchemaDefaulterFromCluster(ctx context.Context, cl client.Client) (SchemaDefaulter, error) {
+	sd := SchemaDefaulter{}
+	list := &apiextensionsv1.CustomResourceDefinitionList{}
+	if err := cl.List(ctx, list); err != nil {
+		return nil, err
+	}
+	for i := range list.Items {
+		sd.Add(&list.Items[i])
+	}
+	return sd, nil
+}
+
+// readDocuments splits a multi document YAML stream
+func readDocuments(r io.Reader) ([][]byte, error) {
+	reader := k8syaml.NewYAMLReader(bufio.NewReader(r))
+	docs := [][]byte{}
+	for {
+		doc, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(bytes.TrimSpace(doc)) == 0 {
+			continue
+		}
+		docs = append(docs, doc)
+	}
+	return docs, nil
+}
+
+func stringValue(x interface{}) string {
+	s, _ := x.(string)
+	return s
+}
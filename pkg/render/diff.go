@@ -0,0 +1,232 @@
+package render
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"sort"
+	"strings"
+
+	marin3rv1alpha1 "github.com/3scale-ops/marin3r/apis/marin3r/v1alpha1"
+	"github.com/3scale-ops/marin3r/pkg/envoy"
+	"sigs.k8s.io/yaml"
+)
+
+// ResourceSummary identifies one of the envoy resources of an
+// EnvoyConfig and holds a hash of its value
+type ResourceSummary struct {
+	Type  string
+	Name  string
+	Hash  string
+	Value string
+}
+
+// Summary returns the list of envoy resources of an EnvoyConfig, sorted by type and name
+func Summary(ec *marin3rv1alpha1.EnvoyConfig) ([]ResourceSummary, error) {
+	list := []ResourceSummary{}
+	if ec == nil {
+		return list, nil
+	}
+	if ec.Spec.EnvoyResources == nil {
+		return resourcesSummary(list, ec.Spec.Resources)
+	}
+
+	for _, group := range []struct {
+		rtype     string
+		resources []marin3rv1alpha1.EnvoyResource
+	}{
+		{"cluster", ec.Spec.EnvoyResources.Clusters},
+		{"route", ec.Spec.EnvoyResources.Routes},
+		{"listener", ec.Spec.EnvoyResources.Listeners},
+		{"runtime", ec.Spec.EnvoyResources.Runtimes},
+	} {
+		for _, res := range group.resources {
+			name, err := resourceName(res)
+			if err != nil {
+				return nil, err
+			}
+			list = append(list, summary(group.rtype, name, res.Value))
+		}
+	}
+
+	for _, secret := range ec.Spec.EnvoyResources.Secrets {
+		value, err := yaml.Marshal(secret)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, summary("secret", secret.Name, string(value)))
+	}
+
+	sortSummary(list)
+	return list, nil
+}
+
+// resourcesSummary adds the envoy resources of the 'resources' field of an EnvoyConfig to
+// the list. It is used instead of the deprecated 'envoyResources' field when a secret
+// requires a blueprint other than the TLS certificate.
+func resourcesSummary(list []ResourceSummary, resources []marin3rv1alpha1.Resource) ([]ResourceSummary, error) {
+	for _, res := range resources {
+		if res.Type == envoy.Secret {
+			name, err := res.SecretRef()
+			if err != nil {
+				return nil, err
+			}
+			value, err := yaml.Marshal(res)
+			if err != nil {
+				return nil, err
+			}
+			list = append(list, summary(string(res.Type), name, string(value)))
+			continue
+		}
+		if res.Value == nil {
+			continue
+		}
+		value, err := yaml.JSONToYAML(res.Value.Raw)
+		if err != nil {
+			return nil, err
+		}
+		name, err := resourceName(marin3rv1alpha1.EnvoyResource{Value: string(value)})
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, summary(string(res.Type), name, string(value)))
+	}
+
+	sortSummary(list)
+	return list, nil
+}
+
+func sortSummary(list []ResourceSummary) {
+	sort.SliceStable(list, func(i, j int) bool {
+		if list[i].Type != list[j].Type {
+			return list[i].Type < list[j].Type
+		}
+		return list[i].Name < list[j].Name
+	})
+}
+
+func summary(rtype, name, value string) ResourceSummary {
+	return ResourceSummary{
+		Type:  rtype,
+		Name:  name,
+		Hash:  fmt.Sprintf("%x", sha256.Sum256([]byte(value)))[:10],
+		Value: value,
+	}
+}
+
+func resourceName(res marin3rv1alpha1.EnvoyResource) (string, error) {
+	if res.Name != nil {
+		return *res.Name, nil
+	}
+	meta := struct {
+		Name string `json:"name"`
+	}{}
+	if err := yaml.Unmarshal([]byte(res.Value), &meta); err != nil {
+		return "", fmt.Errorf("unable to get the name of envoy resource: %w", err)
+	}
+	return meta.Name, nil
+}
+
+// Diff returns a human readable diff between the envoy resources of the applied
+// and the desired EnvoyConfigs. Resources are matched by type and name. An empty
+// string is returned if there are no differences. A nil applied EnvoyConfig is
+// considered empty.
+func Diff(applied, desired *marin3rv1alpha1.EnvoyConfig) (string, error) {
+	from, err := Summary(applied)
+	if err != nil {
+		return "", err
+	}
+	to, err := Summary(desired)
+	if err != nil {
+		return "", err
+	}
+
+	index := func(list []ResourceSummary) map[string]ResourceSummary {
+		m := make(map[string]ResourceSummary, len(list))
+		for _, rs := range list {
+			m[rs.Type+"/"+rs.Name] = rs
+		}
+		return m
+	}
+	fromIdx, toIdx := index(from), index(to)
+
+	keys := []string{}
+	for k := range fromIdx {
+		keys = append(keys, k)
+	}
+	for k := range toIdx {
+		if _, ok := fromIdx[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	b := &strings.Builder{}
+	if applied != nil && desired != nil && applied.Spec.NodeID != desired.Spec.NodeID {
+		fmt.Fprintf(b, "~ nodeID: %s -> %s\n", applied.Spec.NodeID, desired.Spec.NodeID)
+	}
+	for _, k := range keys {
+		a, inApplied := fromIdx[k]
+		d, inDesired := toIdx[k]
+		switch {
+		case !inApplied:
+			fmt.Fprintf(b, "+ %s (%s)\n", k, d.Hash)
+			writeLines(b, "+ ", d.Value)
+		case !inDesired:
+			fmt.Fprintf(b, "- %s (%s)\n", k, a.Hash)
+			writeLines(b, "- ", a.Value)
+		case a.Hash != d.Hash:
+			fmt.Fprintf(b, "~ %s (%s -> %s)\n", k, a.Hash, d.Hash)
+			b.WriteString(lineDiff(a.Value, d.Value))
+		}
+	}
+
+	return b.String(), nil
+}
+
+func writeLines(b *strings.Builder, prefix, value string) {
+	for _, line := range splitLines(value) {
+		b.WriteString("  " + prefix + line + "\n")
+	}
+}
+
+func splitLines(value string) []string {
+	return strings.Split(strings.TrimSuffix(value, "\n"), "\n")
+}
+
+// lineDiff returns a line by line diff of two texts, computed
+// using the longest common subsequence of lines
+func lineDiff(a, b string) string {
+	x, y := splitLines(a), splitLines(b)
+
+	lcs := make([][]int, len(x)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(y)+1)
+	}
+	for i := len(x) - 1; i >= 0; i-- {
+		for j := len(y) - 1; j >= 0; j-- {
+			if x[i] == y[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	out := &strings.Builder{}
+	i, j := 0, 0
+	for i < len(x) || j < len(y) {
+		switch {
+		case i < len(x) && j < len(y) && x[i] == y[j]:
+			out.WriteString("    " + x[i] + "\n")
+			i++
+			j++
+		case i < len(x) && (j == len(y) || lcs[i+1][j] >= lcs[i][j+1]):
+			out.WriteString("  - " + x[i] + "\n")
+			i++
+		default:
+			out.WriteString("  + " + y[j] + "\n")
+			j++
+		}
+	}
+	return out.String()
+}
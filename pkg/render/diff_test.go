@@ -0,0 +1,111 @@
+package render
+
+import (
+	"testing"
+
+	"github.com/3scale-ops/basereconciler/util"
+	marin3rv1alpha1 "github.com/3scale-ops/marin3r/apis/marin3r/v1alpha1"
+	"github.com/3scale-ops/marin3r/pkg/envoy"
+	"github.com/MakeNowJust/heredoc"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func testEnvoyConfig(nodeID string, clusters ...string) *marin3rv1alpha1.EnvoyConfig {
+	resources := []marin3rv1alpha1.EnvoyResource{}
+	for _, c := range clusters {
+		resources = append(resources, marin3rv1alpha1.EnvoyResource{Value: c})
+	}
+	return &marin3rv1alpha1.EnvoyConfig{
+		Spec: marin3rv1alpha1.EnvoyConfigSpec{
+			NodeID: nodeID,
+			EnvoyResources: &marin3rv1alpha1.EnvoyResources{
+				Clusters: resources,
+				Secrets:  []marin3rv1alpha1.EnvoySecretResource{{Name: "cert"}},
+			},
+		},
+	}
+}
+
+func TestDiff(t *testing.T) {
+	tests := []struct {
+		name    string
+		applied *marin3rv1alpha1.EnvoyConfig
+		desired *marin3rv1alpha1.EnvoyConfig
+		want    string
+		wantErr bool
+	}{
+		{
+			name:    "No changes",
+			applied: testEnvoyConfig("node", "name: a\nport: 80\n"),
+			desired: testEnvoyConfig("node", "name: a\nport: 80\n"),
+			want:    "",
+		},
+		{
+			name:    "Modified, added and removed resources",
+			applied: testEnvoyConfig("node", "name: a\nport: 80\ntype: STATIC\n", "name: b\n"),
+			desired: testEnvoyConfig("other", "name: a\nport: 81\ntype: STATIC\n", "name: c\n"),
+			want: heredoc.Doc(`
+				~ nodeID: node -> other
+				~ cluster/a (7df7431818 -> 209f92ab32)
+				    name: a
+				  - port: 80
+				  + port: 81
+				    type: STATIC
+				- cluster/b (9aa29ea401)
+				  - name: b
+				+ cluster/c (cfef9bbdf4)
+				  + name: c
+			`),
+		},
+		{
+			name:    "Nil applied EnvoyConfig",
+			applied: nil,
+			desired: &marin3rv1alpha1.EnvoyConfig{Spec: marin3rv1alpha1.EnvoyConfigSpec{
+				EnvoyResources: &marin3rv1alpha1.EnvoyResources{
+					Listeners: []marin3rv1alpha1.EnvoyResource{{Name: util.Pointer("l"), Value: "port: 80\n"}},
+				},
+			}},
+			want: heredoc.Doc(`
+				+ listener/l (9a15d11937)
+				  + port: 80
+			`),
+		},
+		{
+			name:    "EnvoyConfig with the resources field",
+			applied: testEnvoyConfig("node", "name: a\n"),
+			desired: &marin3rv1alpha1.EnvoyConfig{Spec: marin3rv1alpha1.EnvoyConfigSpec{
+				NodeID: "node",
+				Resources: []marin3rv1alpha1.Resource{
+					{Type: envoy.Cluster, Value: &runtime.RawExtension{Raw: []byte(`{"name":"a"}`)}},
+					{Type: envoy.Secret, GenerateFromTlsSecret: util.Pointer("ca"), Blueprint: util.Pointer(marin3rv1alpha1.TlsValidationContext)},
+				},
+			}},
+			want: heredoc.Doc(`
+				+ secret/ca (f66288eb91)
+				  + blueprint: validationContext
+				  + generateFromTlsSecret: ca
+				  + type: secret
+				- secret/cert (8d375e8cf8)
+				  - name: cert
+			`),
+		},
+		{
+			name:    "Returns error for invalid resources",
+			applied: nil,
+			desired: testEnvoyConfig("node", "name: [a"),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Diff(tt.applied, tt.desired)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Diff() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("Diff() = \n%v\nwant\n%v", got, tt.want)
+			}
+		})
+	}
+}
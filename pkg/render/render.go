@@ -0,0 +1,141 @@
+package render
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/3scale-ops/basereconciler/resource"
+	marin3rv1alpha1 "github.com/3scale-ops/marin3r/apis/marin3r/v1alpha1"
+	saasv1alpha1 "github.com/3scale-ops/saas-operator/api/v1alpha1"
+	"github.com/3scale-ops/saas-operator/controllers"
+	"github.com/3scale-ops/saas-operator/pkg/generators/apicast"
+	"github.com/3scale-ops/saas-operator/pkg/generators/autossl"
+	"github.com/3scale-ops/saas-operator/pkg/generators/backend"
+	"github.com/3scale-ops/saas-operator/pkg/generators/corsproxy"
+	"github.com/3scale-ops/saas-operator/pkg/generators/echoapi"
+	"github.com/3scale-ops/saas-operator/pkg/generators/mappingservice"
+	"github.com/3scale-ops/saas-operator/pkg/generators/system"
+	"github.com/3scale-ops/saas-operator/pkg/generators/zync"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// EnvoyConfigs returns the EnvoyConfig resources that the operator would generate
+// for the given custom resource. As in the controllers, the resource upgrader of the
+// kind runs first, so the deprecated "marin3r" fields are migrated, and then the
+// custom resource is defaulted. The upgraders look up the Services the operator
+// created before the publishing strategies existed: if cl is nil they are looked up
+// in an empty fake client, as for a custom resource that was never reconciled.
+// Nothing is written to the cluster.
+func EnvoyConfigs(ctx context.Context, cl client.Client, obj client.Object) ([]*marin3rv1alpha1.EnvoyConfig, error) {
+	if cl == nil {
+		cl = fake.NewClientBuilder().WithScheme(scheme).Build()
+	}
+	if err := upgrade(ctx, cl, obj); err != nil {
+		return nil, fmt.Errorf("unable to upgrade resource: %w", err)
+	}
+
+	templates, err := templates(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	ecs := []*marin3rv1alpha1.EnvoyConfig{}
+	for _, t := range templates {
+		ect, ok := t.(*resource.Template[*marin3rv1alpha1.EnvoyConfig])
+		if !ok || !ect.Enabled() {
+			continue
+		}
+		// the EnvoyConfig templates don't have mutations that
+		// require an API client so a nil client can be used
+		o, err := ect.Build(ctx, nil, obj)
+		if err != nil {
+			return nil, err
+		}
+		ec := o.(*marin3rv1alpha1.EnvoyConfig)
+		if ec.GetNamespace() == "" {
+			ec.SetNamespace(obj.GetNamespace())
+		}
+		ecs = append(ecs, ec)
+	}
+
+	sort.Slice(ecs, func(i, j int) bool { return ecs[i].GetName() < ecs[j].GetName() })
+	return ecs, nil
+}
+
+// upgrade runs the same resource upgrader that the controller
+// of the kind runs before defaulting and generating the resources
+func upgrade(ctx context.Context, cl client.Client, obj client.Object) error {
+	switch obj.(type) {
+	case *saasv1alpha1.Apicast:
+		return controllers.ApicastResourceUpgrader(ctx, cl, obj)
+	case *saasv1alpha1.AutoSSL:
+		return controllers.AutosslResourceUpgrader(ctx, cl, obj)
+	case *saasv1alpha1.Backend:
+		return controllers.BackendResourceUpgrader(ctx, cl, obj)
+	case *saasv1alpha1.CORSProxy:
+		return controllers.CorsproxyResourceUpgrader(ctx, cl, obj)
+	case *saasv1alpha1.EchoAPI:
+		return controllers.EchoapiResourceUpgrader(ctx, cl, obj)
+	case *saasv1alpha1.MappingService:
+		return controllers.MappingserviceResourceUpgrader(ctx, cl, obj)
+	case *saasv1alpha1.System:
+		return controllers.SystemResourceUpgrader(ctx, cl, obj)
+	case *saasv1alpha1.Zync:
+		return controllers.ZyncResourceUpgrader(ctx, cl, obj)
+	default:
+		return nil
+	}
+}
+
+func templates(obj client.Object) ([]resource.TemplateInterface, error) {
+	switch o := obj.(type) {
+	case *saasv1alpha1.Apicast:
+		o.Default()
+		gen, err := apicast.NewGenerator(o.GetName(), o.GetNamespace(), o.Spec)
+		if err != nil {
+			return nil, err
+		}
+		return gen.Resources()
+	case *saasv1alpha1.AutoSSL:
+		o.Default()
+		gen, err := autossl.NewGenerator(o.GetName(), o.GetNamespace(), o.Spec)
+		if err != nil {
+			return nil, err
+		}
+		return gen.Resources()
+	case *saasv1alpha1.Backend:
+		o.Default()
+		gen, err := backend.NewGenerator(o.GetName(), o.GetNamespace(), o.Spec)
+		if err != nil {
+			return nil, err
+		}
+		return gen.Resources()
+	case *saasv1alpha1.CORSProxy:
+		o.Default()
+		gen := corsproxy.NewGenerator(o.GetName(), o.GetNamespace(), o.Spec)
+		return gen.Resources()
+	case *saasv1alpha1.EchoAPI:
+		o.Default()
+		gen := echoapi.NewGenerator(o.GetName(), o.GetNamespace(), o.Spec)
+		return gen.Resources()
+	case *saasv1alpha1.MappingService:
+		o.Default()
+		gen := mappingservice.NewGenerator(o.GetName(), o.GetNamespace(), o.Spec)
+		return gen.Resources()
+	case *saasv1alpha1.System:
+		o.Default()
+		gen, err := system.NewGenerator(o.GetName(), o.GetNamespace(), o.Spec)
+		if err != nil {
+			return nil, err
+		}
+		return gen.Resources()
+	case *saasv1alpha1.Zync:
+		o.Default()
+		gen := zync.NewGenerator(o.GetName(), o.GetNamespace(), o.Spec)
+		return gen.Resources()
+	default:
+		return nil, fmt.Errorf("kind %T does not generate EnvoyConfig resources", obj)
+	}
+}
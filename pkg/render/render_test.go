@@ -0,0 +1,186 @@
+package render
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/go-test/deep"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+)
+
+func TestSchemaDefaulter_Default(t *testing.T) {
+	sd := SchemaDefaulter{}
+	sd.Add(&apiextensionsv1.CustomResourceDefinition{
+		Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+			Group: "example.com",
+			Names: apiextensionsv1.CustomResourceDefinitionNames{Kind: "Test"},
+			Versions: []apiextensionsv1.CustomResourceDefinitionVersion{{
+				Name: "v1",
+				Schema: &apiextensionsv1.CustomResourceValidation{OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+					Properties: map[string]apiextensionsv1.JSONSchemaProps{
+						"spec": {Properties: map[string]apiextensionsv1.JSONSchemaProps{
+							"enabled": {Default: &apiextensionsv1.JSON{Raw: []byte(`true`)}},
+							"items": {Items: &apiextensionsv1.JSONSchemaPropsOrArray{Schema: &apiextensionsv1.JSONSchemaProps{
+								Properties: map[string]apiextensionsv1.JSONSchemaProps{
+									"port": {Default: &apiextensionsv1.JSON{Raw: []byte(`80`)}},
+								},
+							}}},
+							"configs": {AdditionalProperties: &apiextensionsv1.JSONSchemaPropsOrBool{Schema: &apiextensionsv1.JSONSchemaProps{
+								Properties: map[string]apiextensionsv1.JSONSchemaProps{
+									"version": {Default: &apiextensionsv1.JSON{Raw: []byte(`"v1"`)}},
+								},
+							}}},
+						}},
+					},
+				}},
+			}},
+		},
+	})
+
+	obj := map[string]interface{}{
+		"apiVersion": "example.com/v1",
+		"kind":       "Test",
+		"spec": map[string]interface{}{
+			"items":   []interface{}{map[string]interface{}{}, map[string]interface{}{"port": int64(8080)}},
+			"configs": map[string]interface{}{"a": map[string]interface{}{}},
+		},
+	}
+	if err := sd.Default(obj); err != nil {
+		t.Fatalf("SchemaDefaulter.Default() error = %v", err)
+	}
+
+	want := map[string]interface{}{
+		"apiVersion": "example.com/v1",
+		"kind":       "Test",
+		"spec": map[string]interface{}{
+			"enabled": true,
+			"items":   []interface{}{map[string]interface{}{"port": int64(80)}, map[string]interface{}{"port": int64(8080)}},
+			"configs": map[string]interface{}{"a": map[string]interface{}{"version": "v1"}},
+		},
+	}
+	if diff := deep.Equal(obj, want); len(diff) > 0 {
+		t.Errorf("SchemaDefaulter.Default() = diff %v", diff)
+	}
+}
+
+func TestEnvoyConfigs(t *testing.T) {
+	sd, err := SchemaDefaulterFromDir("../../config/crd/bases")
+	if err != nil {
+		t.Fatalf("SchemaDefaulterFromDir() error = %v", err)
+	}
+
+	objs, err := decode(strings.NewReader(heredoc.Doc(`
+		apiVersion: saas.3scale.net/v1alpha1
+		kind: EchoAPI
+		metadata:
+		  name: example
+		  namespace: ns
+		spec:
+		  image: {name: echo, tag: latest}
+		  publishingStrategies:
+		    endpoints:
+		      - name: HTTP
+		        strategy: Marin3rSidecar
+		        marin3rSidecar:
+		          ports: [{name: http, port: 8080}]
+		          dynamicConfigs:
+		            http:
+		              generatorVersion: v1
+		              listenerHttp: {port: 8080, routeConfigName: router}
+		            router:
+		              generatorVersion: v1
+		              routeConfiguration:
+		                typedVirtualHosts:
+		                  - name: vh
+		                    domains: ["*"]
+		                    routes: [{match: {prefix: /}, route: {cluster: echo}}]
+		            echo:
+		              generatorVersion: v1
+		              cluster: {host: 127.0.0.1, port: 9000}
+	`)), sd)
+	if err != nil {
+		t.Fatalf("decode() error = %v", err)
+	}
+
+	ecs, err := EnvoyConfigs(context.TODO(), nil, objs[0])
+	if err != nil {
+		t.Fatalf("EnvoyConfigs() error = %v", err)
+	}
+	if len(ecs) != 1 {
+		t.Fatalf("EnvoyConfigs() returned %d EnvoyConfigs, want 1", len(ecs))
+	}
+
+	list, err := Summary(ecs[0])
+	if err != nil {
+		t.Fatalf("Summary() error = %v", err)
+	}
+	got := []string{ecs[0].GetNamespace() + "/" + ecs[0].GetName(), ecs[0].Spec.NodeID}
+	for _, rs := range list {
+		got = append(got, rs.Type+"/"+rs.Name)
+	}
+	want := []string{"ns/echo-api", "echo-api", "cluster/echo", "listener/http", "route/router"}
+	if diff := deep.Equal(got, want); len(diff) > 0 {
+		t.Errorf("EnvoyConfigs() = diff %v", diff)
+	}
+}
+
+func TestEnvoyConfigs_upgrade(t *testing.T) {
+	sd, err := SchemaDefaulterFromDir("../../config/crd/bases")
+	if err != nil {
+		t.Fatalf("SchemaDefaulterFromDir() error = %v", err)
+	}
+
+	// the deprecated 'marin3r' field is migrated to a
+	// publishing strategy by the resource upgrader
+	objs, err := decode(strings.NewReader(heredoc.Doc(`
+		apiVersion: saas.3scale.net/v1alpha1
+		kind: EchoAPI
+		metadata:
+		  name: example
+		  namespace: ns
+		spec:
+		  image: {name: echo, tag: latest}
+		  marin3r:
+		    ports: [{name: http, port: 8080}]
+		    dynamicConfigs:
+		      http:
+		        generatorVersion: v1
+		        listenerHttp: {port: 8080, routeConfigName: router}
+		      router:
+		        generatorVersion: v1
+		        routeConfiguration:
+		          typedVirtualHosts:
+		            - name: vh
+		              domains: ["*"]
+		              routes: [{match: {prefix: /}, route: {cluster: echo}}]
+		      echo:
+		        generatorVersion: v1
+		        cluster: {host: 127.0.0.1, port: 9000}
+	`)), sd)
+	if err != nil {
+		t.Fatalf("decode() error = %v", err)
+	}
+
+	ecs, err := EnvoyConfigs(context.TODO(), nil, objs[0])
+	if err != nil {
+		t.Fatalf("EnvoyConfigs() error = %v", err)
+	}
+	if len(ecs) != 1 {
+		t.Fatalf("EnvoyConfigs() returned %d EnvoyConfigs, want 1", len(ecs))
+	}
+
+	list, err := Summary(ecs[0])
+	if err != nil {
+		t.Fatalf("Summary() error = %v", err)
+	}
+	got := []string{}
+	for _, rs := range list {
+		got = append(got, rs.Type+"/"+rs.Name)
+	}
+	want := []string{"cluster/echo", "listener/http", "route/router"}
+	if diff := deep.Equal(got, want); len(diff) > 0 {
+		t.Errorf("EnvoyConfigs() = diff %v", diff)
+	}
+}
@@ -1,16 +1,22 @@
 package auto
 
 import (
+	"github.com/3scale-ops/basereconciler/util"
 	marin3rv1alpha1 "github.com/3scale-ops/marin3r/apis/marin3r/v1alpha1"
 	"github.com/3scale-ops/marin3r/pkg/envoy"
+	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
 	envoy_config_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
 	envoy_extensions_transport_sockets_tls_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
 	"github.com/samber/lo"
 )
 
-func GenerateSecrets(resources []envoy.Resource) ([]marin3rv1alpha1.EnvoySecretResource, error) {
+// GenerateSecrets returns the envoy secret resources referenced by the given listeners and
+// clusters: the certificates are generated from the 'kubernetes.io/tls' Secrets with the
+// tlsCertificate blueprint and the trusted CAs of the clusters with the validationContext one
+func GenerateSecrets(resources []envoy.Resource) ([]marin3rv1alpha1.Resource, error) {
 
-	refs := []string{}
+	certificates := []string{}
+	validationContexts := []string{}
 
 	for _, res := range resources {
 
@@ -21,14 +27,33 @@ func GenerateSecrets(resources []envoy.Resource) ([]marin3rv1alpha1.EnvoySecretR
 			if err != nil {
 				return nil, err
 			}
-			refs = append(refs, secrets...)
+			certificates = append(certificates, secrets...)
+
+		case *envoy_config_cluster_v3.Cluster:
+			secrets, cas, err := secretRefsFromCluster(o)
+			if err != nil {
+				return nil, err
+			}
+			certificates = append(certificates, secrets...)
+			validationContexts = append(validationContexts, cas...)
 
 		}
 	}
 
-	secrets := []marin3rv1alpha1.EnvoySecretResource{}
-	for _, ref := range lo.Uniq(refs) {
-		secrets = append(secrets, marin3rv1alpha1.EnvoySecretResource{Name: ref})
+	secrets := []marin3rv1alpha1.Resource{}
+	for _, ref := range lo.Uniq(certificates) {
+		secrets = append(secrets, marin3rv1alpha1.Resource{
+			Type:                  envoy.Secret,
+			GenerateFromTlsSecret: util.Pointer(ref),
+			Blueprint:             util.Pointer(marin3rv1alpha1.TlsCertificate),
+		})
+	}
+	for _, ref := range lo.Uniq(validationContexts) {
+		secrets = append(secrets, marin3rv1alpha1.Resource{
+			Type:                  envoy.Secret,
+			GenerateFromTlsSecret: util.Pointer(ref),
+			Blueprint:             util.Pointer(marin3rv1alpha1.TlsValidationContext),
+		})
 	}
 
 	return secrets, nil
@@ -52,3 +77,31 @@ func secretRefsFromListener(listener *envoy_config_listener_v3.Listener) ([]stri
 
 	return lo.Uniq(secrets), nil
 }
+
+// secretRefsFromCluster returns the client certificates and the
+// trusted CAs that the cluster fetches from the discovery service
+func secretRefsFromCluster(cluster *envoy_config_cluster_v3.Cluster) ([]string, []string, error) {
+
+	if cluster.TransportSocket == nil {
+		return nil, nil, nil
+	}
+
+	secrets := []string{}
+	cas := []string{}
+	proto, err := cluster.TransportSocket.GetTypedConfig().UnmarshalNew()
+	if err != nil {
+		return nil, nil, err
+	}
+	tlsContext, ok := proto.(*envoy_extensions_transport_sockets_tls_v3.UpstreamTlsContext)
+	if !ok || tlsContext.CommonTlsContext == nil {
+		return nil, nil, nil
+	}
+	for _, sdsConfig := range tlsContext.CommonTlsContext.TlsCertificateSdsSecretConfigs {
+		secrets = append(secrets, sdsConfig.Name)
+	}
+	if combined := tlsContext.CommonTlsContext.GetCombinedValidationContext(); combined != nil && combined.ValidationContextSdsSecretConfig != nil {
+		cas = append(cas, combined.ValidationContextSdsSecretConfig.Name)
+	}
+
+	return lo.Uniq(secrets), cas, nil
+}
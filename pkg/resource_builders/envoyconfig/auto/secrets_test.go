@@ -9,6 +9,7 @@ import (
 	"github.com/3scale-ops/marin3r/pkg/envoy"
 	saasv1alpha1 "github.com/3scale-ops/saas-operator/api/v1alpha1"
 	"github.com/3scale-ops/saas-operator/pkg/resource_builders/envoyconfig/templates"
+	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
 	envoy_config_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
 )
 
@@ -54,6 +55,89 @@ func Test_secretRefsFromListener(t *testing.T) {
 	}
 }
 
+func Test_secretRefsFromCluster(t *testing.T) {
+	type args struct {
+		cluster *envoy_config_cluster_v3.Cluster
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    []string
+		wantCAs []string
+		wantErr bool
+	}{
+		{
+			name: "returns the client certificate used by the cluster",
+			args: args{
+				cluster: func() *envoy_config_cluster_v3.Cluster {
+					c, _ := templates.Cluster_v2("test", &saasv1alpha1.Cluster{
+						Host:    "upstream",
+						Port:    8443,
+						IsHttp2: util.Pointer(false),
+						UpstreamTLS: &saasv1alpha1.ClusterUpstreamTLS{
+							Validation:                  &saasv1alpha1.UpstreamTLSValidation{},
+							ClientCertificateSecretName: util.Pointer("my_certificate"),
+						},
+					})
+					return c.(*envoy_config_cluster_v3.Cluster)
+				}(),
+			},
+			want:    []string{"my_certificate"},
+			wantCAs: []string{},
+			wantErr: false,
+		},
+		{
+			name: "returns the trusted CA served through the discovery service",
+			args: args{
+				cluster: func() *envoy_config_cluster_v3.Cluster {
+					c, _ := templates.Cluster_v2("test", &saasv1alpha1.Cluster{
+						Host:    "upstream",
+						Port:    8443,
+						IsHttp2: util.Pointer(false),
+						UpstreamTLS: &saasv1alpha1.ClusterUpstreamTLS{
+							Validation: &saasv1alpha1.UpstreamTLSValidation{TrustedCASecretName: util.Pointer("my_ca")},
+						},
+					})
+					return c.(*envoy_config_cluster_v3.Cluster)
+				}(),
+			},
+			want:    []string{},
+			wantCAs: []string{"my_ca"},
+			wantErr: false,
+		},
+		{
+			name: "returns nothing for plaintext clusters",
+			args: args{
+				cluster: func() *envoy_config_cluster_v3.Cluster {
+					c, _ := templates.Cluster_v1("test", &saasv1alpha1.Cluster{
+						Host:    "upstream",
+						Port:    8080,
+						IsHttp2: util.Pointer(false),
+					})
+					return c.(*envoy_config_cluster_v3.Cluster)
+				}(),
+			},
+			want:    nil,
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotCAs, err := secretRefsFromCluster(tt.args.cluster)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("secretRefsFromCluster() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("secretRefsFromCluster() = %v, want %v", got, tt.want)
+			}
+			if !reflect.DeepEqual(gotCAs, tt.wantCAs) {
+				t.Errorf("secretRefsFromCluster() CAs = %v, want %v", gotCAs, tt.wantCAs)
+			}
+		})
+	}
+}
+
 func TestGenerateSecrets(t *testing.T) {
 	type args struct {
 		resources []envoy.Resource
@@ -61,7 +145,7 @@ func TestGenerateSecrets(t *testing.T) {
 	tests := []struct {
 		name    string
 		args    args
-		want    []marin3rv1alpha1.EnvoySecretResource
+		want    []marin3rv1alpha1.Resource
 		wantErr bool
 	}{
 		{
@@ -98,11 +182,25 @@ func TestGenerateSecrets(t *testing.T) {
 						})
 						return l
 					}(),
+					func() envoy.Resource {
+						c, _ := templates.Cluster_v2("upstream", &saasv1alpha1.Cluster{
+							Host:    "upstream",
+							Port:    8443,
+							IsHttp2: util.Pointer(false),
+							UpstreamTLS: &saasv1alpha1.ClusterUpstreamTLS{
+								Validation:                  &saasv1alpha1.UpstreamTLSValidation{TrustedCASecretName: util.Pointer("ca")},
+								ClientCertificateSecretName: util.Pointer("client-cert"),
+							},
+						})
+						return c
+					}(),
 				},
 			},
-			want: []marin3rv1alpha1.EnvoySecretResource{
-				{Name: "cert1"},
-				{Name: "cert2"},
+			want: []marin3rv1alpha1.Resource{
+				{Type: envoy.Secret, GenerateFromTlsSecret: util.Pointer("cert1"), Blueprint: util.Pointer(marin3rv1alpha1.TlsCertificate)},
+				{Type: envoy.Secret, GenerateFromTlsSecret: util.Pointer("cert2"), Blueprint: util.Pointer(marin3rv1alpha1.TlsCertificate)},
+				{Type: envoy.Secret, GenerateFromTlsSecret: util.Pointer("client-cert"), Blueprint: util.Pointer(marin3rv1alpha1.TlsCertificate)},
+				{Type: envoy.Secret, GenerateFromTlsSecret: util.Pointer("ca"), Blueprint: util.Pointer(marin3rv1alpha1.TlsValidationContext)},
 			},
 			wantErr: false,
 		},
@@ -49,7 +49,7 @@ func (factory EnvoyDynamicConfigFactory) NewResource(desc descriptor.EnvoyDynami
 
 	resource, err := class.Function(desc.GetName(), desc.GetOptions())
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("invalid envoy dynamic config '%s': %w", desc.GetName(), err)
 	}
 	return resource, nil
 }
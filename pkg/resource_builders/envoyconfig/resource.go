@@ -15,6 +15,7 @@ import (
 	envoy_config_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
 	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
 	envoy_service_runtime_v3 "github.com/envoyproxy/go-control-plane/envoy/service/runtime/v3"
+	"github.com/samber/lo"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -87,7 +88,7 @@ func newFromProtos(key types.NamespacedName, nodeID string, resources []envoy.Re
 			}
 		}
 
-		return &marin3rv1alpha1.EnvoyConfig{
+		ec := &marin3rv1alpha1.EnvoyConfig{
 			ObjectMeta: metav1.ObjectMeta{
 				Name:      key.Name,
 				Namespace: key.Namespace,
@@ -101,10 +102,30 @@ func newFromProtos(key types.NamespacedName, nodeID string, resources []envoy.Re
 					Routes:    routes,
 					Listeners: listeners,
 					Runtimes:  runtimes,
-					Secrets:   secrets,
+					Secrets:   []marin3rv1alpha1.EnvoySecretResource{},
 				},
 			},
-		}, nil
+		}
+
+		// The deprecated 'envoyResources' field only supports certificates. The 'resources'
+		// field is only used when a validation context is required, so the EnvoyConfigs that
+		// don't need it are left untouched.
+		if lo.EveryBy(secrets, func(s marin3rv1alpha1.Resource) bool { return s.GetBlueprint() == marin3rv1alpha1.TlsCertificate }) {
+			for _, s := range secrets {
+				ec.Spec.EnvoyResources.Secrets = append(ec.Spec.EnvoyResources.Secrets,
+					marin3rv1alpha1.EnvoySecretResource{Name: *s.GenerateFromTlsSecret})
+			}
+			return ec, nil
+		}
+
+		ec.Spec.Resources, err = ec.Spec.EnvoyResources.Resources(envoy_serializer.YAML)
+		if err != nil {
+			return nil, err
+		}
+		ec.Spec.Resources = append(ec.Spec.Resources, secrets...)
+		ec.Spec.EnvoyResources = nil
+
+		return ec, nil
 
 	}
 }
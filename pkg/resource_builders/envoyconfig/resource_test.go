@@ -11,6 +11,7 @@ import (
 	saasv1alpha1 "github.com/3scale-ops/saas-operator/api/v1alpha1"
 	descriptor "github.com/3scale-ops/saas-operator/pkg/resource_builders/envoyconfig/descriptor"
 	"github.com/3scale-ops/saas-operator/pkg/resource_builders/envoyconfig/factory"
+	"github.com/3scale-ops/saas-operator/pkg/resource_builders/envoyconfig/templates"
 	"github.com/MakeNowJust/heredoc"
 	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
 	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
@@ -325,3 +326,35 @@ func Test_newFromProtos(t *testing.T) {
 		})
 	}
 }
+
+func Test_newFromProtos_validationContext(t *testing.T) {
+	cluster, err := templates.Cluster_v2("upstream", &saasv1alpha1.Cluster{
+		Host:    "upstream",
+		Port:    8443,
+		IsHttp2: util.Pointer(false),
+		UpstreamTLS: &saasv1alpha1.ClusterUpstreamTLS{
+			Validation: &saasv1alpha1.UpstreamTLSValidation{TrustedCASecretName: util.Pointer("ca")},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Cluster_v2() error = %v", err)
+	}
+
+	got, err := newFromProtos(types.NamespacedName{Name: "test", Namespace: "ns"}, "test", []envoy.Resource{cluster})()
+	if err != nil {
+		t.Fatalf("newFromProtos() error = %v", err)
+	}
+	if got.Spec.EnvoyResources != nil {
+		t.Errorf("newFromProtos() envoyResources = %v, want nil", got.Spec.EnvoyResources)
+	}
+	if len(got.Spec.Resources) != 2 || got.Spec.Resources[0].Type != envoy.Cluster {
+		t.Fatalf("newFromProtos() resources = %v, want the cluster and the secret", got.Spec.Resources)
+	}
+	if diff := deep.Equal(got.Spec.Resources[1], marin3rv1alpha1.Resource{
+		Type:                  envoy.Secret,
+		GenerateFromTlsSecret: util.Pointer("ca"),
+		Blueprint:             util.Pointer(marin3rv1alpha1.TlsValidationContext),
+	}); len(diff) > 0 {
+		t.Errorf("newFromProtos() secret = diff %v", diff)
+	}
+}
@@ -0,0 +1,59 @@
+package templates
+
+import (
+	"github.com/3scale-ops/basereconciler/util"
+	saasv1alpha1 "github.com/3scale-ops/saas-operator/api/v1alpha1"
+	envoy_config_bootstrap_v3 "github.com/envoyproxy/go-control-plane/envoy/config/bootstrap/v3"
+	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_config_metrics_v3 "github.com/envoyproxy/go-control-plane/envoy/config/metrics/v3"
+	envoy_extensions_stat_sinks_open_telemetry_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/stat_sinks/open_telemetry/v3"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// StatsSinkBootstrap_v1 returns the parts of the envoy bootstrap that configure an
+// OpenTelemetry stats sink. Stats sinks are created when envoy starts, before any
+// dynamic resource is received, so the collector is reached through a static cluster.
+// The result is meant to be merged with the bootstrap that marin3r generates.
+func StatsSinkBootstrap_v1(opts *saasv1alpha1.StatsSinkOptions) (*envoy_config_bootstrap_v3.Bootstrap, error) {
+	cluster, err := Cluster_v1(saasv1alpha1.StatsCollectorClusterName, &saasv1alpha1.Cluster{
+		Host:    opts.CollectorHost,
+		Port:    opts.CollectorPort,
+		IsHttp2: util.Pointer(true),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sink, err := anypb.New(&envoy_extensions_stat_sinks_open_telemetry_v3.SinkConfig{
+		ProtocolSpecifier: &envoy_extensions_stat_sinks_open_telemetry_v3.SinkConfig_GrpcService{
+			GrpcService: &envoy_config_core_v3.GrpcService{
+				TargetSpecifier: &envoy_config_core_v3.GrpcService_EnvoyGrpc_{
+					EnvoyGrpc: &envoy_config_core_v3.GrpcService_EnvoyGrpc{
+						ClusterName: saasv1alpha1.StatsCollectorClusterName,
+					},
+				},
+			},
+		},
+		ReportCountersAsDeltas: opts.ReportCountersAsDeltas != nil && *opts.ReportCountersAsDeltas,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	bootstrap := &envoy_config_bootstrap_v3.Bootstrap{
+		StaticResources: &envoy_config_bootstrap_v3.Bootstrap_StaticResources{
+			Clusters: []*envoy_config_cluster_v3.Cluster{cluster.(*envoy_config_cluster_v3.Cluster)},
+		},
+		StatsSinks: []*envoy_config_metrics_v3.StatsSink{{
+			Name:       "envoy.stat_sinks.open_telemetry",
+			ConfigType: &envoy_config_metrics_v3.StatsSink_TypedConfig{TypedConfig: sink},
+		}},
+	}
+	if opts.FlushInterval != nil {
+		bootstrap.StatsFlushInterval = durationpb.New(opts.FlushInterval.Duration)
+	}
+
+	return bootstrap, nil
+}
@@ -0,0 +1,95 @@
+package templates
+
+import (
+	"testing"
+	"time"
+
+	"github.com/3scale-ops/basereconciler/util"
+	envoy_serializer_v3 "github.com/3scale-ops/marin3r/pkg/envoy/serializer/v3"
+	saasv1alpha1 "github.com/3scale-ops/saas-operator/api/v1alpha1"
+	"github.com/MakeNowJust/heredoc"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+)
+
+func TestStatsSinkBootstrap_v1(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    *saasv1alpha1.StatsSinkOptions
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "Generates an OpenTelemetry stats sink",
+			opts: &saasv1alpha1.StatsSinkOptions{
+				CollectorHost:          "otel-collector",
+				CollectorPort:          4317,
+				FlushInterval:          &metav1.Duration{Duration: 30 * time.Second},
+				ReportCountersAsDeltas: util.Pointer(true),
+			},
+			want: heredoc.Doc(`
+                static_resources:
+                  clusters:
+                  - connect_timeout: 1s
+                    dns_lookup_family: V4_ONLY
+                    load_assignment:
+                      cluster_name: stats_collector
+                      endpoints:
+                      - lb_endpoints:
+                        - endpoint:
+                            address:
+                              socket_address:
+                                address: otel-collector
+                                port_value: 4317
+                    name: stats_collector
+                    type: STRICT_DNS
+                    typed_extension_protocol_options:
+                      envoy.extensions.upstreams.http.v3.HttpProtocolOptions:
+                        '@type': type.googleapis.com/envoy.extensions.upstreams.http.v3.HttpProtocolOptions
+                        explicit_http_config:
+                          http2_protocol_options:
+                            initial_connection_window_size: 1048576
+                            initial_stream_window_size: 65536
+                stats_flush_interval: 30s
+                stats_sinks:
+                - name: envoy.stat_sinks.open_telemetry
+                  typed_config:
+                    '@type': type.googleapis.com/envoy.extensions.stat_sinks.open_telemetry.v3.SinkConfig
+                    grpc_service:
+                      envoy_grpc:
+                        cluster_name: stats_collector
+                    report_counters_as_deltas: true
+			`),
+		},
+		{
+			name:    "Fails without collector host",
+			opts:    &saasv1alpha1.StatsSinkOptions{CollectorPort: 4317},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := StatsSinkBootstrap_v1(tt.opts)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("StatsSinkBootstrap_v1() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if err := got.ValidateAll(); err != nil {
+				t.Fatal(err)
+			}
+			j, err := envoy_serializer_v3.JSON{}.Marshal(got)
+			if err != nil {
+				t.Error(err)
+			}
+			y, err := yaml.JSONToYAML([]byte(j))
+			if err != nil {
+				t.Error(err)
+			}
+			if string(y) != tt.want {
+				t.Errorf("StatsSinkBootstrap_v1():\n# got:\n%v\n# want:\n%v", string(y), tt.want)
+			}
+		})
+	}
+}
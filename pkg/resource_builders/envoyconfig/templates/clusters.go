@@ -1,6 +1,7 @@
 package templates
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/3scale-ops/marin3r/pkg/envoy"
@@ -8,7 +9,10 @@ import (
 	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
 	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoy_config_endpoint_v3 "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
+	envoy_extensions_transport_sockets_tls_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
 	envoy_extensions_upstreams_http_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/upstreams/http/v3"
+	envoy_type_matcher_v3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	envoy_type_v3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
 	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
@@ -16,6 +20,9 @@ import (
 
 func Cluster_v1(name string, opts interface{}) (envoy.Resource, error) {
 	o := opts.(*saasv1alpha1.Cluster)
+	if o.Host == "" || o.Port == 0 {
+		return nil, fmt.Errorf("cluster '%s': 'host' and 'port' are required", name)
+	}
 
 	cluster := &envoy_config_cluster_v3.Cluster{
 		Name:           name,
@@ -44,25 +51,326 @@ func Cluster_v1(name string, opts interface{}) (envoy.Resource, error) {
 	}
 
 	if *o.IsHttp2 {
-		any, err := anypb.New(&envoy_extensions_upstreams_http_v3.HttpProtocolOptions{
-			UpstreamProtocolOptions: &envoy_extensions_upstreams_http_v3.HttpProtocolOptions_ExplicitHttpConfig_{
-				ExplicitHttpConfig: &envoy_extensions_upstreams_http_v3.HttpProtocolOptions_ExplicitHttpConfig{
-					ProtocolConfig: &envoy_extensions_upstreams_http_v3.HttpProtocolOptions_ExplicitHttpConfig_Http2ProtocolOptions{
-						Http2ProtocolOptions: &envoy_config_core_v3.Http2ProtocolOptions{
-							InitialStreamWindowSize:     wrapperspb.UInt32(65536),   // 64 KiB
-							InitialConnectionWindowSize: wrapperspb.UInt32(1048576), // 1 MiB
-						},
-					},
+		cluster.TypedExtensionProtocolOptions = Http2ProtocolOptions_v1()
+	}
+
+	return cluster, nil
+}
+
+func Cluster_v2(name string, opts interface{}) (envoy.Resource, error) {
+	o := opts.(*saasv1alpha1.Cluster)
+
+	endpoints := o.Endpoints
+	if len(endpoints) == 0 {
+		if o.Host == "" || o.Port == 0 {
+			return nil, fmt.Errorf("cluster '%s': either 'host' and 'port' or 'endpoints' must be set", name)
+		}
+		endpoints = []saasv1alpha1.ClusterEndpoint{{Host: o.Host, Port: o.Port}}
+	} else if o.Host != "" {
+		return nil, fmt.Errorf("cluster '%s': 'host' and 'endpoints' are mutually exclusive", name)
+	}
+
+	lbEndpoints := make([]*envoy_config_endpoint_v3.LbEndpoint, 0, len(endpoints))
+	for _, ep := range endpoints {
+		lbEndpoint := &envoy_config_endpoint_v3.LbEndpoint{
+			HostIdentifier: &envoy_config_endpoint_v3.LbEndpoint_Endpoint{
+				Endpoint: &envoy_config_endpoint_v3.Endpoint{
+					Address: Address_v1(ep.Host, ep.Port),
 				},
 			},
-		})
-		if err != nil {
-			panic(err)
 		}
-		cluster.TypedExtensionProtocolOptions = map[string]*anypb.Any{
-			"envoy.extensions.upstreams.http.v3.HttpProtocolOptions": any,
+		if ep.Weight != nil {
+			lbEndpoint.LoadBalancingWeight = wrapperspb.UInt32(*ep.Weight)
 		}
+		lbEndpoints = append(lbEndpoints, lbEndpoint)
+	}
+
+	cluster := &envoy_config_cluster_v3.Cluster{
+		Name: name,
+		ConnectTimeout: func() *durationpb.Duration {
+			if o.ConnectTimeout != nil {
+				return durationpb.New(o.ConnectTimeout.Duration)
+			}
+			return durationpb.New(1 * time.Second)
+		}(),
+		ClusterDiscoveryType: &envoy_config_cluster_v3.Cluster_Type{
+			Type: clusterDiscoveryType(o.DiscoveryType),
+		},
+		DnsLookupFamily: envoy_config_cluster_v3.Cluster_V4_ONLY,
+		LbPolicy:        clusterLbPolicy(o.LbPolicy),
+		LoadAssignment: &envoy_config_endpoint_v3.ClusterLoadAssignment{
+			ClusterName: name,
+			Endpoints: []*envoy_config_endpoint_v3.LocalityLbEndpoints{
+				{LbEndpoints: lbEndpoints},
+			},
+		},
+		HealthChecks:     HealthChecks_v1(name, o.HealthChecks),
+		OutlierDetection: OutlierDetection_v1(o.OutlierDetection),
+		CircuitBreakers:  CircuitBreakers_v1(o.CircuitBreakers),
+	}
+
+	if o.IsHttp2 != nil && *o.IsHttp2 {
+		cluster.TypedExtensionProtocolOptions = Http2ProtocolOptions_v1()
+	}
+
+	if o.UpstreamTLS != nil {
+		cluster.TransportSocket = UpstreamTransportSocket_v1(o.UpstreamTLS, o.IsHttp2 != nil && *o.IsHttp2)
+	}
+
+	if err := cluster.ValidateAll(); err != nil {
+		return nil, err
 	}
 
 	return cluster, nil
 }
+
+func clusterDiscoveryType(t *saasv1alpha1.ClusterDiscoveryType) envoy_config_cluster_v3.Cluster_DiscoveryType {
+	if t == nil {
+		return envoy_config_cluster_v3.Cluster_STRICT_DNS
+	}
+	switch *t {
+	case saasv1alpha1.ClusterDiscoveryTypeLogicalDNS:
+		return envoy_config_cluster_v3.Cluster_LOGICAL_DNS
+	case saasv1alpha1.ClusterDiscoveryTypeStatic:
+		return envoy_config_cluster_v3.Cluster_STATIC
+	default:
+		return envoy_config_cluster_v3.Cluster_STRICT_DNS
+	}
+}
+
+func clusterLbPolicy(p *saasv1alpha1.ClusterLbPolicy) envoy_config_cluster_v3.Cluster_LbPolicy {
+	if p == nil {
+		return envoy_config_cluster_v3.Cluster_ROUND_ROBIN
+	}
+	switch *p {
+	case saasv1alpha1.ClusterLbPolicyLeastRequest:
+		return envoy_config_cluster_v3.Cluster_LEAST_REQUEST
+	case saasv1alpha1.ClusterLbPolicyRingHash:
+		return envoy_config_cluster_v3.Cluster_RING_HASH
+	case saasv1alpha1.ClusterLbPolicyMaglev:
+		return envoy_config_cluster_v3.Cluster_MAGLEV
+	case saasv1alpha1.ClusterLbPolicyRandom:
+		return envoy_config_cluster_v3.Cluster_RANDOM
+	default:
+		return envoy_config_cluster_v3.Cluster_ROUND_ROBIN
+	}
+}
+
+func Http2ProtocolOptions_v1() map[string]*anypb.Any {
+	any, err := anypb.New(&envoy_extensions_upstreams_http_v3.HttpProtocolOptions{
+		UpstreamProtocolOptions: &envoy_extensions_upstreams_http_v3.HttpProtocolOptions_ExplicitHttpConfig_{
+			ExplicitHttpConfig: &envoy_extensions_upstreams_http_v3.HttpProtocolOptions_ExplicitHttpConfig{
+				ProtocolConfig: &envoy_extensions_upstreams_http_v3.HttpProtocolOptions_ExplicitHttpConfig_Http2ProtocolOptions{
+					Http2ProtocolOptions: &envoy_config_core_v3.Http2ProtocolOptions{
+						InitialStreamWindowSize:     wrapperspb.UInt32(65536),   // 64 KiB
+						InitialConnectionWindowSize: wrapperspb.UInt32(1048576), // 1 MiB
+					},
+				},
+			},
+		},
+	})
+	if err != nil {
+		panic(err)
+	}
+	return map[string]*anypb.Any{
+		"envoy.extensions.upstreams.http.v3.HttpProtocolOptions": any,
+	}
+}
+
+func HealthChecks_v1(name string, opts []saasv1alpha1.ClusterHealthCheck) []*envoy_config_core_v3.HealthCheck {
+	if len(opts) == 0 {
+		return nil
+	}
+
+	hcs := make([]*envoy_config_core_v3.HealthCheck, 0, len(opts))
+	for _, opt := range opts {
+		hc := &envoy_config_core_v3.HealthCheck{
+			Timeout:            durationpb.New(opt.Timeout.Duration),
+			Interval:           durationpb.New(opt.Interval.Duration),
+			UnhealthyThreshold: wrapperspb.UInt32(opt.UnhealthyThreshold),
+			HealthyThreshold:   wrapperspb.UInt32(opt.HealthyThreshold),
+		}
+
+		if opt.Http != nil {
+			host := name
+			if opt.Http.Host != nil {
+				host = *opt.Http.Host
+			}
+			hc.HealthChecker = &envoy_config_core_v3.HealthCheck_HttpHealthCheck_{
+				HttpHealthCheck: &envoy_config_core_v3.HealthCheck_HttpHealthCheck{
+					Host: host,
+					Path: opt.Http.Path,
+				},
+			}
+		} else if opt.Tcp != nil {
+			tcp := &envoy_config_core_v3.HealthCheck_TcpHealthCheck{}
+			if opt.Tcp.Send != nil {
+				tcp.Send = &envoy_config_core_v3.HealthCheck_Payload{
+					Payload: &envoy_config_core_v3.HealthCheck_Payload_Text{Text: *opt.Tcp.Send},
+				}
+			}
+			for _, r := range opt.Tcp.Receive {
+				tcp.Receive = append(tcp.Receive, &envoy_config_core_v3.HealthCheck_Payload{
+					Payload: &envoy_config_core_v3.HealthCheck_Payload_Text{Text: r},
+				})
+			}
+			hc.HealthChecker = &envoy_config_core_v3.HealthCheck_TcpHealthCheck_{TcpHealthCheck: tcp}
+		}
+
+		hcs = append(hcs, hc)
+	}
+
+	return hcs
+}
+
+func OutlierDetection_v1(opts *saasv1alpha1.ClusterOutlierDetection) *envoy_config_cluster_v3.OutlierDetection {
+	if opts == nil {
+		return nil
+	}
+
+	od := &envoy_config_cluster_v3.OutlierDetection{}
+	if opts.Consecutive5xx != nil {
+		od.Consecutive_5Xx = wrapperspb.UInt32(*opts.Consecutive5xx)
+	}
+	if opts.ConsecutiveGatewayFailure != nil {
+		od.ConsecutiveGatewayFailure = wrapperspb.UInt32(*opts.ConsecutiveGatewayFailure)
+	}
+	if opts.Interval != nil {
+		od.Interval = durationpb.New(opts.Interval.Duration)
+	}
+	if opts.BaseEjectionTime != nil {
+		od.BaseEjectionTime = durationpb.New(opts.BaseEjectionTime.Duration)
+	}
+	if opts.MaxEjectionPercent != nil {
+		od.MaxEjectionPercent = wrapperspb.UInt32(*opts.MaxEjectionPercent)
+	}
+
+	return od
+}
+
+func CircuitBreakers_v1(opts *saasv1alpha1.ClusterCircuitBreakers) *envoy_config_cluster_v3.CircuitBreakers {
+	if opts == nil {
+		return nil
+	}
+
+	thresholds := &envoy_config_cluster_v3.CircuitBreakers_Thresholds{
+		Priority: envoy_config_core_v3.RoutingPriority_DEFAULT,
+	}
+	if opts.MaxConnections != nil {
+		thresholds.MaxConnections = wrapperspb.UInt32(*opts.MaxConnections)
+	}
+	if opts.MaxPendingRequests != nil {
+		thresholds.MaxPendingRequests = wrapperspb.UInt32(*opts.MaxPendingRequests)
+	}
+	if opts.MaxRequests != nil {
+		thresholds.MaxRequests = wrapperspb.UInt32(*opts.MaxRequests)
+	}
+	if opts.MaxRetries != nil {
+		thresholds.MaxRetries = wrapperspb.UInt32(*opts.MaxRetries)
+	}
+	if opts.RetryBudget != nil {
+		thresholds.RetryBudget = &envoy_config_cluster_v3.CircuitBreakers_Thresholds_RetryBudget{}
+		if opts.RetryBudget.BudgetPercent != nil {
+			thresholds.RetryBudget.BudgetPercent = &envoy_type_v3.Percent{Value: float64(*opts.RetryBudget.BudgetPercent)}
+		}
+		if opts.RetryBudget.MinRetryConcurrency != nil {
+			thresholds.RetryBudget.MinRetryConcurrency = wrapperspb.UInt32(*opts.RetryBudget.MinRetryConcurrency)
+		}
+	}
+
+	return &envoy_config_cluster_v3.CircuitBreakers{
+		Thresholds: []*envoy_config_cluster_v3.CircuitBreakers_Thresholds{thresholds},
+	}
+}
+
+func UpstreamTransportSocket_v1(opts *saasv1alpha1.ClusterUpstreamTLS, http2 bool) *envoy_config_core_v3.TransportSocket {
+	return &envoy_config_core_v3.TransportSocket{
+		Name: "envoy.transport_sockets.tls",
+		ConfigType: &envoy_config_core_v3.TransportSocket_TypedConfig{
+			TypedConfig: func() *anypb.Any {
+				tlsContext := &envoy_extensions_transport_sockets_tls_v3.UpstreamTlsContext{
+					CommonTlsContext: &envoy_extensions_transport_sockets_tls_v3.CommonTlsContext{
+						TlsParams: &envoy_extensions_transport_sockets_tls_v3.TlsParameters{
+							TlsMinimumProtocolVersion: envoy_extensions_transport_sockets_tls_v3.TlsParameters_TLSv1_2,
+							TlsMaximumProtocolVersion: envoy_extensions_transport_sockets_tls_v3.TlsParameters_TLSv1_3,
+						},
+						AlpnProtocols: func() []string {
+							if http2 {
+								return []string{"h2"}
+							}
+							return []string{"http/1.1"}
+						}(),
+					},
+				}
+				if opts.SNI != nil {
+					tlsContext.Sni = *opts.SNI
+				}
+				if opts.Validation != nil && opts.Validation.TrustedCASecretName != nil {
+					// the CA is served through SDS and merged with the
+					// subject alt names of the static validation context
+					tlsContext.CommonTlsContext.ValidationContextType = &envoy_extensions_transport_sockets_tls_v3.CommonTlsContext_CombinedValidationContext{
+						CombinedValidationContext: &envoy_extensions_transport_sockets_tls_v3.CommonTlsContext_CombinedCertificateValidationContext{
+							DefaultValidationContext:         UpstreamValidationContext_v1(opts.Validation),
+							ValidationContextSdsSecretConfig: sdsSecretConfig_v1(*opts.Validation.TrustedCASecretName),
+						},
+					}
+				} else if opts.Validation != nil {
+					tlsContext.CommonTlsContext.ValidationContextType = &envoy_extensions_transport_sockets_tls_v3.CommonTlsContext_ValidationContext{
+						ValidationContext: UpstreamValidationContext_v1(opts.Validation),
+					}
+				}
+				if opts.ClientCertificateSecretName != nil {
+					tlsContext.CommonTlsContext.TlsCertificateSdsSecretConfigs = []*envoy_extensions_transport_sockets_tls_v3.SdsSecretConfig{
+						sdsSecretConfig_v1(*opts.ClientCertificateSecretName),
+					}
+				}
+				any, err := anypb.New(tlsContext)
+				if err != nil {
+					panic(err)
+				}
+				return any
+			}(),
+		},
+	}
+}
+
+// defaultTrustedCAFile is the path of the system CA bundle in the envoy image
+const defaultTrustedCAFile string = "/etc/ssl/certs/ca-certificates.crt"
+
+// sdsSecretConfig_v1 returns the config to fetch the named secret
+// from the discovery service
+func sdsSecretConfig_v1(name string) *envoy_extensions_transport_sockets_tls_v3.SdsSecretConfig {
+	return &envoy_extensions_transport_sockets_tls_v3.SdsSecretConfig{
+		Name: name,
+		SdsConfig: &envoy_config_core_v3.ConfigSource{
+			ConfigSourceSpecifier: &envoy_config_core_v3.ConfigSource_Ads{
+				Ads: &envoy_config_core_v3.AggregatedConfigSource{},
+			},
+			ResourceApiVersion: envoy_config_core_v3.ApiVersion_V3,
+		},
+	}
+}
+
+// UpstreamValidationContext_v1 returns the validation context of the upstream certificates. The
+// trusted CA is left unset when it is served through the discovery service (TrustedCASecretName).
+func UpstreamValidationContext_v1(opts *saasv1alpha1.UpstreamTLSValidation) *envoy_extensions_transport_sockets_tls_v3.CertificateValidationContext {
+	vc := &envoy_extensions_transport_sockets_tls_v3.CertificateValidationContext{}
+	if opts.TrustedCASecretName == nil {
+		trustedCA := defaultTrustedCAFile
+		if opts.TrustedCAFile != nil {
+			trustedCA = *opts.TrustedCAFile
+		}
+		vc.TrustedCa = &envoy_config_core_v3.DataSource{
+			Specifier: &envoy_config_core_v3.DataSource_Filename{Filename: trustedCA},
+		}
+	}
+	for _, san := range opts.MatchSubjectAltNames {
+		vc.MatchTypedSubjectAltNames = append(vc.MatchTypedSubjectAltNames, &envoy_extensions_transport_sockets_tls_v3.SubjectAltNameMatcher{
+			SanType: envoy_extensions_transport_sockets_tls_v3.SubjectAltNameMatcher_DNS,
+			Matcher: &envoy_type_matcher_v3.StringMatcher{
+				MatchPattern: &envoy_type_matcher_v3.StringMatcher_Exact{Exact: san},
+			},
+		})
+	}
+	return vc
+}
@@ -2,11 +2,13 @@ package templates
 
 import (
 	"testing"
+	"time"
 
 	"github.com/3scale-ops/basereconciler/util"
 	envoy_serializer_v3 "github.com/3scale-ops/marin3r/pkg/envoy/serializer/v3"
 	saasv1alpha1 "github.com/3scale-ops/saas-operator/api/v1alpha1"
 	"github.com/MakeNowJust/heredoc"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/yaml"
 )
 
@@ -16,9 +18,10 @@ func TestCluster_v1(t *testing.T) {
 		opts interface{}
 	}
 	tests := []struct {
-		name string
-		args args
-		want string
+		name    string
+		args    args
+		want    string
+		wantErr bool
 	}{
 		{
 			name: "Generates http 1.1 cluster",
@@ -79,10 +82,24 @@ func TestCluster_v1(t *testing.T) {
                         initial_stream_window_size: 65536
 			`),
 		},
+		{
+			name: "Fails if the port is not set",
+			args: args{
+				name: "my_cluster",
+				opts: &saasv1alpha1.Cluster{Host: "localhost", IsHttp2: util.Pointer(false)},
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, _ := Cluster_v1(tt.args.name, tt.args.opts)
+			got, err := Cluster_v1(tt.args.name, tt.args.opts)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Cluster_v1() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
 			j, err := envoy_serializer_v3.JSON{}.Marshal(got)
 			if err != nil {
 				t.Error(err)
@@ -98,3 +115,309 @@ func TestCluster_v1(t *testing.T) {
 		})
 	}
 }
+
+func TestCluster_v2(t *testing.T) {
+	type args struct {
+		name string
+		opts interface{}
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "Generates a cluster from host and port with defaults",
+			args: args{
+				name: "my_cluster",
+				opts: &saasv1alpha1.Cluster{
+					Host:    "localhost",
+					Port:    8080,
+					IsHttp2: util.Pointer(false),
+				},
+			},
+			want: heredoc.Doc(`
+                connect_timeout: 1s
+                dns_lookup_family: V4_ONLY
+                load_assignment:
+                  cluster_name: my_cluster
+                  endpoints:
+                  - lb_endpoints:
+                    - endpoint:
+                        address:
+                          socket_address:
+                            address: localhost
+                            port_value: 8080
+                name: my_cluster
+                type: STRICT_DNS
+			`),
+		},
+		{
+			name: "Generates a cluster with all the options",
+			args: args{
+				name: "my_cluster",
+				opts: &saasv1alpha1.Cluster{
+					Endpoints: []saasv1alpha1.ClusterEndpoint{
+						{Host: "host1", Port: 8080, Weight: util.Pointer(uint32(2))},
+						{Host: "host2", Port: 8080},
+					},
+					IsHttp2:        util.Pointer(true),
+					ConnectTimeout: &metav1.Duration{Duration: 2 * time.Second},
+					LbPolicy:       util.Pointer(saasv1alpha1.ClusterLbPolicyLeastRequest),
+					HealthChecks: []saasv1alpha1.ClusterHealthCheck{{
+						Timeout:            metav1.Duration{Duration: time.Second},
+						Interval:           metav1.Duration{Duration: 5 * time.Second},
+						UnhealthyThreshold: 3,
+						HealthyThreshold:   1,
+						Http:               &saasv1alpha1.ClusterHttpHealthCheck{Path: "/status"},
+					}},
+					OutlierDetection: &saasv1alpha1.ClusterOutlierDetection{
+						Consecutive5xx:   util.Pointer(uint32(10)),
+						BaseEjectionTime: &metav1.Duration{Duration: time.Minute},
+					},
+					CircuitBreakers: &saasv1alpha1.ClusterCircuitBreakers{
+						MaxConnections: util.Pointer(uint32(1000)),
+						RetryBudget: &saasv1alpha1.ClusterRetryBudget{
+							BudgetPercent:       util.Pointer(uint32(25)),
+							MinRetryConcurrency: util.Pointer(uint32(5)),
+						},
+					},
+					UpstreamTLS: &saasv1alpha1.ClusterUpstreamTLS{SNI: util.Pointer("example.com")},
+				},
+			},
+			want: heredoc.Doc(`
+                circuit_breakers:
+                  thresholds:
+                  - max_connections: 1000
+                    retry_budget:
+                      budget_percent:
+                        value: 25
+                      min_retry_concurrency: 5
+                connect_timeout: 2s
+                dns_lookup_family: V4_ONLY
+                health_checks:
+                - healthy_threshold: 1
+                  http_health_check:
+                    host: my_cluster
+                    path: /status
+                  interval: 5s
+                  timeout: 1s
+                  unhealthy_threshold: 3
+                lb_policy: LEAST_REQUEST
+                load_assignment:
+                  cluster_name: my_cluster
+                  endpoints:
+                  - lb_endpoints:
+                    - endpoint:
+                        address:
+                          socket_address:
+                            address: host1
+                            port_value: 8080
+                      load_balancing_weight: 2
+                    - endpoint:
+                        address:
+                          socket_address:
+                            address: host2
+                            port_value: 8080
+                name: my_cluster
+                outlier_detection:
+                  base_ejection_time: 60s
+                  consecutive_5xx: 10
+                transport_socket:
+                  name: envoy.transport_sockets.tls
+                  typed_config:
+                    '@type': type.googleapis.com/envoy.extensions.transport_sockets.tls.v3.UpstreamTlsContext
+                    common_tls_context:
+                      alpn_protocols:
+                      - h2
+                      tls_params:
+                        tls_maximum_protocol_version: TLSv1_3
+                        tls_minimum_protocol_version: TLSv1_2
+                    sni: example.com
+                type: STRICT_DNS
+                typed_extension_protocol_options:
+                  envoy.extensions.upstreams.http.v3.HttpProtocolOptions:
+                    '@type': type.googleapis.com/envoy.extensions.upstreams.http.v3.HttpProtocolOptions
+                    explicit_http_config:
+                      http2_protocol_options:
+                        initial_connection_window_size: 1048576
+                        initial_stream_window_size: 65536
+			`),
+		},
+		{
+			name: "Generates a cluster with mutual TLS to the upstream",
+			args: args{
+				name: "my_cluster",
+				opts: &saasv1alpha1.Cluster{
+					Host:    "upstream.example.com",
+					Port:    8443,
+					IsHttp2: util.Pointer(false),
+					UpstreamTLS: &saasv1alpha1.ClusterUpstreamTLS{
+						SNI: util.Pointer("upstream.example.com"),
+						Validation: &saasv1alpha1.UpstreamTLSValidation{
+							MatchSubjectAltNames: []string{"upstream.example.com"},
+						},
+						ClientCertificateSecretName: util.Pointer("client-cert"),
+					},
+				},
+			},
+			want: heredoc.Doc(`
+                connect_timeout: 1s
+                dns_lookup_family: V4_ONLY
+                load_assignment:
+                  cluster_name: my_cluster
+                  endpoints:
+                  - lb_endpoints:
+                    - endpoint:
+                        address:
+                          socket_address:
+                            address: upstream.example.com
+                            port_value: 8443
+                name: my_cluster
+                transport_socket:
+                  name: envoy.transport_sockets.tls
+                  typed_config:
+                    '@type': type.googleapis.com/envoy.extensions.transport_sockets.tls.v3.UpstreamTlsContext
+                    common_tls_context:
+                      alpn_protocols:
+                      - http/1.1
+                      tls_certificate_sds_secret_configs:
+                      - name: client-cert
+                        sds_config:
+                          ads: {}
+                          resource_api_version: V3
+                      tls_params:
+                        tls_maximum_protocol_version: TLSv1_3
+                        tls_minimum_protocol_version: TLSv1_2
+                      validation_context:
+                        match_typed_subject_alt_names:
+                        - matcher:
+                            exact: upstream.example.com
+                          san_type: DNS
+                        trusted_ca:
+                          filename: /etc/ssl/certs/ca-certificates.crt
+                    sni: upstream.example.com
+                type: STRICT_DNS
+			`),
+		},
+		{
+			name: "Generates a cluster that validates the upstream with a CA from the discovery service",
+			args: args{
+				name: "my_cluster",
+				opts: &saasv1alpha1.Cluster{
+					Host:    "upstream.example.com",
+					Port:    8443,
+					IsHttp2: util.Pointer(false),
+					UpstreamTLS: &saasv1alpha1.ClusterUpstreamTLS{
+						Validation: &saasv1alpha1.UpstreamTLSValidation{
+							TrustedCASecretName:  util.Pointer("upstream-ca"),
+							MatchSubjectAltNames: []string{"upstream.example.com"},
+						},
+					},
+				},
+			},
+			want: heredoc.Doc(`
+                connect_timeout: 1s
+                dns_lookup_family: V4_ONLY
+                load_assignment:
+                  cluster_name: my_cluster
+                  endpoints:
+                  - lb_endpoints:
+                    - endpoint:
+                        address:
+                          socket_address:
+                            address: upstream.example.com
+                            port_value: 8443
+                name: my_cluster
+                transport_socket:
+                  name: envoy.transport_sockets.tls
+                  typed_config:
+                    '@type': type.googleapis.com/envoy.extensions.transport_sockets.tls.v3.UpstreamTlsContext
+                    common_tls_context:
+                      alpn_protocols:
+                      - http/1.1
+                      combined_validation_context:
+                        default_validation_context:
+                          match_typed_subject_alt_names:
+                          - matcher:
+                              exact: upstream.example.com
+                            san_type: DNS
+                        validation_context_sds_secret_config:
+                          name: upstream-ca
+                          sds_config:
+                            ads: {}
+                            resource_api_version: V3
+                      tls_params:
+                        tls_maximum_protocol_version: TLSv1_3
+                        tls_minimum_protocol_version: TLSv1_2
+                type: STRICT_DNS
+			`),
+		},
+		{
+			name: "Fails if no endpoints are defined",
+			args: args{
+				name: "my_cluster",
+				opts: &saasv1alpha1.Cluster{IsHttp2: util.Pointer(false)},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Fails if the port is not set",
+			args: args{
+				name: "my_cluster",
+				opts: &saasv1alpha1.Cluster{Host: "localhost", IsHttp2: util.Pointer(false)},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Fails if both host and endpoints are defined",
+			args: args{
+				name: "my_cluster",
+				opts: &saasv1alpha1.Cluster{
+					Host:      "localhost",
+					Port:      8080,
+					Endpoints: []saasv1alpha1.ClusterEndpoint{{Host: "host1", Port: 8080}},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Fails if the proto is not valid",
+			args: args{
+				name: "my_cluster",
+				opts: &saasv1alpha1.Cluster{
+					Host: "localhost",
+					Port: 8080,
+					HealthChecks: []saasv1alpha1.ClusterHealthCheck{{
+						Timeout:  metav1.Duration{Duration: time.Second},
+						Interval: metav1.Duration{Duration: 5 * time.Second},
+					}},
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Cluster_v2(tt.args.name, tt.args.opts)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Cluster_v2() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			j, err := envoy_serializer_v3.JSON{}.Marshal(got)
+			if err != nil {
+				t.Error(err)
+			}
+			y, err := yaml.JSONToYAML([]byte(j))
+			if err != nil {
+				t.Error(err)
+			}
+			if string(y) != tt.want {
+				t.Errorf("Cluster_v2():\n# got:\n%v\n# want:\n%v", string(y), tt.want)
+			}
+		})
+	}
+}
@@ -1,6 +1,9 @@
 package templates
 
 import (
+	"fmt"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/3scale-ops/marin3r/pkg/envoy"
@@ -9,15 +12,29 @@ import (
 	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoy_config_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
 	envoy_config_ratelimit_v3 "github.com/envoyproxy/go-control-plane/envoy/config/ratelimit/v3"
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_config_trace_v3 "github.com/envoyproxy/go-control-plane/envoy/config/trace/v3"
 	envoy_extensions_access_loggers_file_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/access_loggers/file/v3"
+	envoy_extensions_access_loggers_grpc_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/access_loggers/grpc/v3"
+	envoy_extensions_access_loggers_open_telemetry_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/access_loggers/open_telemetry/v3"
+	envoy_extensions_filters_http_ext_authz_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ext_authz/v3"
+	envoy_extensions_filters_http_jwt_authn_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/jwt_authn/v3"
+	envoy_extensions_filters_http_local_ratelimit_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/local_ratelimit/v3"
 	envoy_extensions_filters_http_ratelimit_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ratelimit/v3"
 	envoy_extensions_filters_http_router_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/router/v3"
 	envoy_extensions_filters_listener_proxy_protocol_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/listener/proxy_protocol/v3"
 	envoy_extensions_filters_listener_tls_inspector_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/listener/tls_inspector/v3"
 	http_connection_manager_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	envoy_extensions_filters_network_tcp_proxy_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/tcp_proxy/v3"
 	envoy_extensions_transport_sockets_tls_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	envoy_type_matcher_v3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	envoy_type_tracing_v3 "github.com/envoyproxy/go-control-plane/envoy/type/tracing/v3"
+	envoy_type_v3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	otlp_common_v1 "go.opentelemetry.io/proto/otlp/common/v1"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
@@ -25,6 +42,16 @@ import (
 func ListenerHTTP_v1(name string, opts interface{}) (envoy.Resource, error) {
 	o := opts.(*saasv1alpha1.ListenerHttp)
 
+	httpFilters, err := HttpFilters_v1(name, o)
+	if err != nil {
+		return nil, err
+	}
+
+	tracing, err := Tracing_v1(o.Tracing)
+	if err != nil {
+		return nil, err
+	}
+
 	listener := &envoy_config_listener_v3.Listener{
 		Name:            name,
 		Address:         Address_v1("0.0.0.0", o.Port),
@@ -36,7 +63,7 @@ func ListenerHTTP_v1(name string, opts interface{}) (envoy.Resource, error) {
 					TypedConfig: func() *anypb.Any {
 						any, err := anypb.New(
 							&http_connection_manager_v3.HttpConnectionManager{
-								AccessLog: AccessLogConfig_v1(name, o.CertificateSecretName != nil),
+								AccessLog: AccessLogConfig_v1(name, o.CertificateSecretName != nil, o.AccessLog),
 								CommonHttpProtocolOptions: func() *envoy_config_core_v3.HttpProtocolOptions {
 									po := &envoy_config_core_v3.HttpProtocolOptions{
 										IdleTimeout: durationpb.New(3600 * time.Second),
@@ -52,7 +79,7 @@ func ListenerHTTP_v1(name string, opts interface{}) (envoy.Resource, error) {
 									return po
 								}(),
 
-								HttpFilters: HttpFilters_v1(o.RateLimitOptions),
+								HttpFilters: httpFilters,
 								HttpProtocolOptions: func() *envoy_config_core_v3.Http1ProtocolOptions {
 									if o.DefaultHostForHttp10 != nil {
 										return &envoy_config_core_v3.Http1ProtocolOptions{
@@ -71,6 +98,7 @@ func ListenerHTTP_v1(name string, opts interface{}) (envoy.Resource, error) {
 								RouteSpecifier:    RouteConfigFromAds_v1(o.RouteConfigName),
 								StatPrefix:        name,
 								StreamIdleTimeout: durationpb.New(300 * time.Second),
+								Tracing:           tracing,
 								UseRemoteAddress:  wrapperspb.Bool(*o.ProxyProtocol),
 							})
 						if err != nil {
@@ -92,43 +120,123 @@ func ListenerHTTP_v1(name string, opts interface{}) (envoy.Resource, error) {
 	return listener, nil
 }
 
-func ListenerFilters_v1(tls, proxyProtocol bool) []*envoy_config_listener_v3.ListenerFilter {
+func ListenerTCP_v1(name string, opts interface{}) (envoy.Resource, error) {
+	o := opts.(*saasv1alpha1.ListenerTcp)
+
+	if o.Cluster == nil && len(o.FilterChains) == 0 {
+		return nil, fmt.Errorf("at least one of 'cluster' or 'filterChains' must be set")
+	}
+
+	// the proxy protocol header goes before the TLS handshake, so
+	// the proxy_protocol filter must run before the tls_inspector
 	filters := []*envoy_config_listener_v3.ListenerFilter{}
-	if tls {
-		filters = append(filters, &envoy_config_listener_v3.ListenerFilter{
-			Name: "envoy.filters.listener.tls_inspector",
-			ConfigType: &envoy_config_listener_v3.ListenerFilter_TypedConfig{
-				TypedConfig: func() *anypb.Any {
-					any, err := anypb.New(
-						&envoy_extensions_filters_listener_tls_inspector_v3.TlsInspector{},
-					)
-					if err != nil {
-						panic(err)
-					}
-					return any
-				}(),
+	if *o.ProxyProtocol {
+		filters = append(filters, ProxyProtocolListenerFilter_v1())
+	}
+	if len(o.FilterChains) > 0 {
+		filters = append(filters, TlsInspectorListenerFilter_v1())
+	}
+
+	chains := make([]*envoy_config_listener_v3.FilterChain, 0, len(o.FilterChains)+1)
+	for _, fc := range o.FilterChains {
+		chains = append(chains, &envoy_config_listener_v3.FilterChain{
+			FilterChainMatch: &envoy_config_listener_v3.FilterChainMatch{
+				ServerNames: fc.ServerNames,
 			},
+			Filters: []*envoy_config_listener_v3.Filter{TcpProxyFilter_v1(name, fc.Cluster, o)},
 		})
 	}
-	if proxyProtocol {
-		filters = append(filters, &envoy_config_listener_v3.ListenerFilter{
-			Name: "envoy.filters.listener.proxy_protocol",
-			ConfigType: &envoy_config_listener_v3.ListenerFilter_TypedConfig{
-				TypedConfig: func() *anypb.Any {
-					any, err := anypb.New(
-						&envoy_extensions_filters_listener_proxy_protocol_v3.ProxyProtocol{},
-					)
-					if err != nil {
-						panic(err)
-					}
-					return any
-				}(),
-			},
+	if o.Cluster != nil {
+		chains = append(chains, &envoy_config_listener_v3.FilterChain{
+			Filters: []*envoy_config_listener_v3.Filter{TcpProxyFilter_v1(name, *o.Cluster, o)},
 		})
 	}
+
+	listener := &envoy_config_listener_v3.Listener{
+		Name:                          name,
+		Address:                       Address_v1("0.0.0.0", o.Port),
+		ListenerFilters:               filters,
+		FilterChains:                  chains,
+		PerConnectionBufferLimitBytes: wrapperspb.UInt32(32768), // 32 KiB
+	}
+
+	if err := listener.ValidateAll(); err != nil {
+		return nil, err
+	}
+
+	return listener, nil
+}
+
+func TcpProxyFilter_v1(name, cluster string, opts *saasv1alpha1.ListenerTcp) *envoy_config_listener_v3.Filter {
+	return &envoy_config_listener_v3.Filter{
+		Name: "envoy.filters.network.tcp_proxy",
+		ConfigType: &envoy_config_listener_v3.Filter_TypedConfig{
+			TypedConfig: func() *anypb.Any {
+				tcpProxy := &envoy_extensions_filters_network_tcp_proxy_v3.TcpProxy{
+					StatPrefix: name,
+					ClusterSpecifier: &envoy_extensions_filters_network_tcp_proxy_v3.TcpProxy_Cluster{
+						Cluster: cluster,
+					},
+					AccessLog: TcpAccessLogConfig_v1(name, opts.AccessLog),
+				}
+				if opts.IdleTimeout != nil {
+					tcpProxy.IdleTimeout = durationpb.New(opts.IdleTimeout.Duration)
+				}
+				any, err := anypb.New(tcpProxy)
+				if err != nil {
+					panic(err)
+				}
+				return any
+			}(),
+		},
+	}
+}
+
+func ListenerFilters_v1(tls, proxyProtocol bool) []*envoy_config_listener_v3.ListenerFilter {
+	filters := []*envoy_config_listener_v3.ListenerFilter{}
+	if tls {
+		filters = append(filters, TlsInspectorListenerFilter_v1())
+	}
+	if proxyProtocol {
+		filters = append(filters, ProxyProtocolListenerFilter_v1())
+	}
 	return filters
 }
 
+func TlsInspectorListenerFilter_v1() *envoy_config_listener_v3.ListenerFilter {
+	return &envoy_config_listener_v3.ListenerFilter{
+		Name: "envoy.filters.listener.tls_inspector",
+		ConfigType: &envoy_config_listener_v3.ListenerFilter_TypedConfig{
+			TypedConfig: func() *anypb.Any {
+				any, err := anypb.New(
+					&envoy_extensions_filters_listener_tls_inspector_v3.TlsInspector{},
+				)
+				if err != nil {
+					panic(err)
+				}
+				return any
+			}(),
+		},
+	}
+}
+
+func ProxyProtocolListenerFilter_v1() *envoy_config_listener_v3.ListenerFilter {
+	return &envoy_config_listener_v3.ListenerFilter{
+		Name: "envoy.filters.listener.proxy_protocol",
+		ConfigType: &envoy_config_listener_v3.ListenerFilter_TypedConfig{
+			TypedConfig: func() *anypb.Any {
+				any, err := anypb.New(
+					&envoy_extensions_filters_listener_proxy_protocol_v3.ProxyProtocol{},
+				)
+				if err != nil {
+					panic(err)
+				}
+				return any
+			}(),
+		},
+	}
+}
+
 func RouteConfigFromAds_v1(name string) *http_connection_manager_v3.HttpConnectionManager_Rds {
 	return &http_connection_manager_v3.HttpConnectionManager_Rds{
 		Rds: &http_connection_manager_v3.Rds{
@@ -143,11 +251,32 @@ func RouteConfigFromAds_v1(name string) *http_connection_manager_v3.HttpConnecti
 	}
 }
 
-func HttpFilters_v1(rlOpts *saasv1alpha1.RateLimitOptions) []*http_connection_manager_v3.HttpFilter {
+// HttpFilters_v1 returns the http filters of the http connection manager. The
+// local rate limit goes first so it protects the authentication and authorization
+// services. The jwt_authn filter goes before ext_authz so the authorization service
+// receives the payload of the verified JWTs in the metadata.
+func HttpFilters_v1(name string, opts *saasv1alpha1.ListenerHttp) ([]*http_connection_manager_v3.HttpFilter, error) {
 
 	filters := []*http_connection_manager_v3.HttpFilter{}
-	if rlOpts != nil {
-		filters = append(filters, HttpFilterRateLimit_v1(rlOpts))
+	if opts.LocalRateLimit != nil {
+		filters = append(filters, HttpFilterLocalRateLimit_v1(name, opts.LocalRateLimit))
+	}
+	if opts.JwtAuthn != nil {
+		filter, err := HttpFilterJwtAuthn_v1(opts.JwtAuthn)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, filter)
+	}
+	if opts.ExtAuthz != nil {
+		filter, err := HttpFilterExtAuthz_v1(opts.ExtAuthz, opts.JwtAuthn != nil)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, filter)
+	}
+	if opts.RateLimitOptions != nil {
+		filters = append(filters, HttpFilterRateLimit_v1(opts.RateLimitOptions))
 	}
 	filters = append(filters, &http_connection_manager_v3.HttpFilter{
 		Name: "envoy.filters.http.router",
@@ -163,7 +292,7 @@ func HttpFilters_v1(rlOpts *saasv1alpha1.RateLimitOptions) []*http_connection_ma
 			}(),
 		},
 	})
-	return filters
+	return filters, nil
 }
 
 func HttpFilterRateLimit_v1(opts *saasv1alpha1.RateLimitOptions) *http_connection_manager_v3.HttpFilter {
@@ -197,9 +326,316 @@ func HttpFilterRateLimit_v1(opts *saasv1alpha1.RateLimitOptions) *http_connectio
 	}
 }
 
-func AccessLogConfig_v1(name string, tls bool) []*envoy_config_accesslog_v3.AccessLog {
-	return []*envoy_config_accesslog_v3.AccessLog{{
-		Name: "envoy.access_loggers.file",
+func HttpFilterLocalRateLimit_v1(name string, opts *saasv1alpha1.LocalRateLimit) *http_connection_manager_v3.HttpFilter {
+	return &http_connection_manager_v3.HttpFilter{
+		Name: "envoy.filters.http.local_ratelimit",
+		ConfigType: &http_connection_manager_v3.HttpFilter_TypedConfig{
+			TypedConfig: func() *anypb.Any {
+				any, err := anypb.New(LocalRateLimitConfig_v1(name, opts))
+				if err != nil {
+					panic(err)
+				}
+				return any
+			}(),
+		},
+	}
+}
+
+// LocalRateLimitConfig_v1 returns the config of the local rate limit filter. It is used
+// both for the filter in the listener and for the per route configurations. The
+// runtime keys are scoped by 'scope' (the listener name, or the route configuration,
+// virtual host and route for the per route configurations) so each rate limit can
+// be disabled or switched to dry run through the runtime independently.
+func LocalRateLimitConfig_v1(scope string, opts *saasv1alpha1.LocalRateLimit) *envoy_extensions_filters_http_local_ratelimit_v3.LocalRateLimit {
+	config := &envoy_extensions_filters_http_local_ratelimit_v3.LocalRateLimit{
+		StatPrefix: "http_local_rate_limiter",
+	}
+	// without token bucket the filter is left disabled, so
+	// only the per route configurations apply
+	if opts.TokenBucket == nil {
+		return config
+	}
+
+	config.TokenBucket = &envoy_type_v3.TokenBucket{
+		MaxTokens:    opts.TokenBucket.MaxTokens,
+		FillInterval: durationpb.New(opts.TokenBucket.FillInterval.Duration),
+	}
+	if opts.TokenBucket.TokensPerFill != nil {
+		config.TokenBucket.TokensPerFill = wrapperspb.UInt32(*opts.TokenBucket.TokensPerFill)
+	}
+
+	config.FilterEnabled = &envoy_config_core_v3.RuntimeFractionalPercent{
+		DefaultValue: &envoy_type_v3.FractionalPercent{Numerator: 100, Denominator: envoy_type_v3.FractionalPercent_HUNDRED},
+		RuntimeKey:   fmt.Sprintf("local_rate_limit.%s.enabled", scope),
+	}
+	config.FilterEnforced = &envoy_config_core_v3.RuntimeFractionalPercent{
+		DefaultValue: &envoy_type_v3.FractionalPercent{
+			Numerator: func() uint32 {
+				if opts.DryRun != nil && *opts.DryRun {
+					return 0
+				}
+				return 100
+			}(),
+			Denominator: envoy_type_v3.FractionalPercent_HUNDRED,
+		},
+		RuntimeKey: fmt.Sprintf("local_rate_limit.%s.enforced", scope),
+	}
+
+	return config
+}
+
+func HttpFilterExtAuthz_v1(opts *saasv1alpha1.ExtAuthz, jwtMetadata bool) (*http_connection_manager_v3.HttpFilter, error) {
+	timeout := durationpb.New(200 * time.Millisecond)
+	if opts.Timeout != nil {
+		timeout = durationpb.New(opts.Timeout.Duration)
+	}
+
+	config := &envoy_extensions_filters_http_ext_authz_v3.ExtAuthz{
+		TransportApiVersion:    envoy_config_core_v3.ApiVersion_V3,
+		FailureModeAllow:       opts.FailureModeAllow != nil && *opts.FailureModeAllow,
+		IncludePeerCertificate: opts.IncludePeerCertificate != nil && *opts.IncludePeerCertificate,
+	}
+	if jwtMetadata {
+		config.MetadataContextNamespaces = []string{"envoy.filters.http.jwt_authn"}
+	}
+
+	switch opts.Type {
+	case saasv1alpha1.ExtAuthzTypeGrpc:
+		if opts.PathPrefix != nil || len(opts.AllowedRequestHeaders) > 0 || len(opts.AllowedUpstreamHeaders) > 0 {
+			return nil, fmt.Errorf("extAuthz: 'pathPrefix', 'allowedRequestHeaders' and 'allowedUpstreamHeaders' are only supported with type 'Http'")
+		}
+		config.Services = &envoy_extensions_filters_http_ext_authz_v3.ExtAuthz_GrpcService{
+			GrpcService: &envoy_config_core_v3.GrpcService{
+				TargetSpecifier: &envoy_config_core_v3.GrpcService_EnvoyGrpc_{
+					EnvoyGrpc: &envoy_config_core_v3.GrpcService_EnvoyGrpc{
+						ClusterName: opts.Cluster,
+					},
+				},
+				Timeout: timeout,
+			},
+		}
+
+	case saasv1alpha1.ExtAuthzTypeHttp:
+		service := &envoy_extensions_filters_http_ext_authz_v3.HttpService{
+			ServerUri: &envoy_config_core_v3.HttpUri{
+				// the uri is only used for the host header, requests
+				// are always sent to the cluster
+				Uri:              "http://" + opts.Cluster,
+				HttpUpstreamType: &envoy_config_core_v3.HttpUri_Cluster{Cluster: opts.Cluster},
+				Timeout:          timeout,
+			},
+		}
+		if opts.PathPrefix != nil {
+			service.PathPrefix = *opts.PathPrefix
+		}
+		if len(opts.AllowedRequestHeaders) > 0 {
+			service.AuthorizationRequest = &envoy_extensions_filters_http_ext_authz_v3.AuthorizationRequest{
+				AllowedHeaders: exactStringMatchers(opts.AllowedRequestHeaders),
+			}
+		}
+		if len(opts.AllowedUpstreamHeaders) > 0 {
+			service.AuthorizationResponse = &envoy_extensions_filters_http_ext_authz_v3.AuthorizationResponse{
+				AllowedUpstreamHeaders: exactStringMatchers(opts.AllowedUpstreamHeaders),
+			}
+		}
+		config.Services = &envoy_extensions_filters_http_ext_authz_v3.ExtAuthz_HttpService{HttpService: service}
+
+	default:
+		return nil, fmt.Errorf("extAuthz: unknown type '%s'", opts.Type)
+	}
+
+	if err := config.ValidateAll(); err != nil {
+		return nil, fmt.Errorf("extAuthz: %w", err)
+	}
+	any, err := anypb.New(config)
+	if err != nil {
+		return nil, err
+	}
+	return &http_connection_manager_v3.HttpFilter{
+		Name:       "envoy.filters.http.ext_authz",
+		ConfigType: &http_connection_manager_v3.HttpFilter_TypedConfig{TypedConfig: any},
+	}, nil
+}
+
+func exactStringMatchers(values []string) *envoy_type_matcher_v3.ListStringMatcher {
+	patterns := make([]*envoy_type_matcher_v3.StringMatcher, 0, len(values))
+	for _, v := range values {
+		patterns = append(patterns, &envoy_type_matcher_v3.StringMatcher{
+			MatchPattern: &envoy_type_matcher_v3.StringMatcher_Exact{Exact: v},
+			IgnoreCase:   true,
+		})
+	}
+	return &envoy_type_matcher_v3.ListStringMatcher{Patterns: patterns}
+}
+
+func HttpFilterJwtAuthn_v1(opts *saasv1alpha1.JwtAuthn) (*http_connection_manager_v3.HttpFilter, error) {
+	config := &envoy_extensions_filters_http_jwt_authn_v3.JwtAuthentication{
+		Providers: map[string]*envoy_extensions_filters_http_jwt_authn_v3.JwtProvider{},
+	}
+
+	requirements := []*envoy_extensions_filters_http_jwt_authn_v3.JwtRequirement{}
+	for i, p := range opts.Providers {
+		if _, ok := config.Providers[p.Name]; ok {
+			return nil, fmt.Errorf("jwtAuthn: providers[%d]: duplicate provider name '%s'", i, p.Name)
+		}
+		provider, err := JwtProvider_v1(&p)
+		if err != nil {
+			return nil, fmt.Errorf("jwtAuthn: providers[%d]: %w", i, err)
+		}
+		config.Providers[p.Name] = provider
+		requirements = append(requirements, &envoy_extensions_filters_http_jwt_authn_v3.JwtRequirement{
+			RequiresType: &envoy_extensions_filters_http_jwt_authn_v3.JwtRequirement_ProviderName{ProviderName: p.Name},
+		})
+	}
+	if opts.AllowMissing != nil && *opts.AllowMissing {
+		requirements = append(requirements, &envoy_extensions_filters_http_jwt_authn_v3.JwtRequirement{
+			RequiresType: &envoy_extensions_filters_http_jwt_authn_v3.JwtRequirement_AllowMissing{AllowMissing: &emptypb.Empty{}},
+		})
+	}
+
+	var requirement *envoy_extensions_filters_http_jwt_authn_v3.JwtRequirement
+	switch len(requirements) {
+	case 0:
+		return nil, fmt.Errorf("jwtAuthn: at least one provider must be set")
+	case 1:
+		requirement = requirements[0]
+	default:
+		requirement = &envoy_extensions_filters_http_jwt_authn_v3.JwtRequirement{
+			RequiresType: &envoy_extensions_filters_http_jwt_authn_v3.JwtRequirement_RequiresAny{
+				RequiresAny: &envoy_extensions_filters_http_jwt_authn_v3.JwtRequirementOrList{Requirements: requirements},
+			},
+		}
+	}
+
+	// the requirement applies to all routes. Routes can
+	// opt out using the per route configuration.
+	config.Rules = []*envoy_extensions_filters_http_jwt_authn_v3.RequirementRule{{
+		Match: &envoy_config_route_v3.RouteMatch{
+			PathSpecifier: &envoy_config_route_v3.RouteMatch_Prefix{Prefix: "/"},
+		},
+		RequirementType: &envoy_extensions_filters_http_jwt_authn_v3.RequirementRule_Requires{Requires: requirement},
+	}}
+
+	if err := config.ValidateAll(); err != nil {
+		return nil, fmt.Errorf("jwtAuthn: %w", err)
+	}
+	any, err := anypb.New(config)
+	if err != nil {
+		return nil, err
+	}
+	return &http_connection_manager_v3.HttpFilter{
+		Name:       "envoy.filters.http.jwt_authn",
+		ConfigType: &http_connection_manager_v3.HttpFilter_TypedConfig{TypedConfig: any},
+	}, nil
+}
+
+func JwtProvider_v1(opts *saasv1alpha1.JwtProvider) (*envoy_extensions_filters_http_jwt_authn_v3.JwtProvider, error) {
+	provider := &envoy_extensions_filters_http_jwt_authn_v3.JwtProvider{
+		Audiences:         opts.Audiences,
+		Forward:           opts.Forward != nil && *opts.Forward,
+		PayloadInMetadata: opts.Name,
+	}
+	if opts.Issuer != nil {
+		provider.Issuer = *opts.Issuer
+	}
+	if opts.ForwardPayloadHeader != nil {
+		provider.ForwardPayloadHeader = *opts.ForwardPayloadHeader
+	}
+	for _, h := range opts.FromHeaders {
+		header := &envoy_extensions_filters_http_jwt_authn_v3.JwtHeader{Name: h.Name}
+		if h.ValuePrefix != nil {
+			header.ValuePrefix = *h.ValuePrefix
+		}
+		provider.FromHeaders = append(provider.FromHeaders, header)
+	}
+	for _, c := range opts.ClaimToHeaders {
+		provider.ClaimToHeaders = append(provider.ClaimToHeaders, &envoy_extensions_filters_http_jwt_authn_v3.JwtClaimToHeader{
+			HeaderName: c.HeaderName,
+			ClaimName:  c.ClaimName,
+		})
+	}
+
+	switch {
+	case opts.RemoteJwks != nil && opts.LocalJwks != nil:
+		return nil, fmt.Errorf("only one of 'remoteJwks' or 'localJwks' can be set")
+
+	case opts.RemoteJwks != nil:
+		timeout := durationpb.New(1 * time.Second)
+		if opts.RemoteJwks.Timeout != nil {
+			timeout = durationpb.New(opts.RemoteJwks.Timeout.Duration)
+		}
+		cacheDuration := durationpb.New(5 * time.Minute)
+		if opts.RemoteJwks.CacheDuration != nil {
+			cacheDuration = durationpb.New(opts.RemoteJwks.CacheDuration.Duration)
+		}
+		provider.JwksSourceSpecifier = &envoy_extensions_filters_http_jwt_authn_v3.JwtProvider_RemoteJwks{
+			RemoteJwks: &envoy_extensions_filters_http_jwt_authn_v3.RemoteJwks{
+				HttpUri: &envoy_config_core_v3.HttpUri{
+					Uri:              opts.RemoteJwks.URI,
+					HttpUpstreamType: &envoy_config_core_v3.HttpUri_Cluster{Cluster: opts.RemoteJwks.Cluster},
+					Timeout:          timeout,
+				},
+				CacheDuration: cacheDuration,
+			},
+		}
+
+	case opts.LocalJwks != nil:
+		provider.JwksSourceSpecifier = &envoy_extensions_filters_http_jwt_authn_v3.JwtProvider_LocalJwks{
+			LocalJwks: &envoy_config_core_v3.DataSource{
+				Specifier: &envoy_config_core_v3.DataSource_InlineString{InlineString: *opts.LocalJwks},
+			},
+		}
+
+	default:
+		return nil, fmt.Errorf("one of 'remoteJwks' or 'localJwks' must be set")
+	}
+
+	return provider, nil
+}
+
+func AccessLogConfig_v1(name string, tls bool, opts *saasv1alpha1.AccessLogOptions) []*envoy_config_accesslog_v3.AccessLog {
+	fields := accessLogFields(name, tls, opts)
+	filter := AccessLogFilter_v1(name, opts)
+
+	logs := []*envoy_config_accesslog_v3.AccessLog{FileAccessLog_v1(fields, filter)}
+
+	if opts != nil && opts.GrpcSink != nil {
+		logs = append(logs, AccessLogGrpcSink_v1(name, fields, filter, opts))
+	}
+
+	return logs
+}
+
+func TcpAccessLogConfig_v1(name string, opts *saasv1alpha1.TcpAccessLogOptions) []*envoy_config_accesslog_v3.AccessLog {
+	fields := map[string]string{
+		"bytes_received":        "%BYTES_RECEIVED%",
+		"bytes_sent":            "%BYTES_SENT%",
+		"duration":              "%DURATION%",
+		"listener":              name,
+		"requested_server_name": "%REQUESTED_SERVER_NAME%",
+		"response_flags":        "%RESPONSE_FLAGS%",
+		"upstream_cluster":      "%UPSTREAM_CLUSTER%",
+		"upstream_host":         "%UPSTREAM_HOST%",
+		"client_ip":             "%DOWNSTREAM_REMOTE_ADDRESS_WITHOUT_PORT%",
+	}
+	if opts == nil {
+		return []*envoy_config_accesslog_v3.AccessLog{FileAccessLog_v1(fields, nil)}
+	}
+
+	for k, v := range opts.ExtraFields {
+		fields[k] = v
+	}
+	filter := AccessLogFilter_v1(name, &saasv1alpha1.AccessLogOptions{Sampling: opts.Sampling})
+
+	return []*envoy_config_accesslog_v3.AccessLog{FileAccessLog_v1(fields, filter)}
+}
+
+// FileAccessLog_v1 returns an access log that writes the given
+// fields to stdout in json format
+func FileAccessLog_v1(fields map[string]string, filter *envoy_config_accesslog_v3.AccessLogFilter) *envoy_config_accesslog_v3.AccessLog {
+	return &envoy_config_accesslog_v3.AccessLog{
+		Name:   "envoy.access_loggers.file",
+		Filter: filter,
 		ConfigType: &envoy_config_accesslog_v3.AccessLog_TypedConfig{
 			TypedConfig: func() *anypb.Any {
 				logfmt := &envoy_extensions_access_loggers_file_v3.FileAccessLog{
@@ -209,26 +645,9 @@ func AccessLogConfig_v1(name string, tls bool) []*envoy_config_accesslog_v3.Acce
 							Format: &envoy_config_core_v3.SubstitutionFormatString_JsonFormat{
 								JsonFormat: &structpb.Struct{
 									Fields: func() map[string]*structpb.Value {
-										m := map[string]*structpb.Value{
-											"authority":             structpb.NewStringValue("%REQ(:AUTHORITY)%"),
-											"bytes_received":        structpb.NewStringValue("%BYTES_RECEIVED%"),
-											"bytes_sent":            structpb.NewStringValue("%BYTES_SENT%"),
-											"duration":              structpb.NewStringValue("%DURATION%"),
-											"method":                structpb.NewStringValue("%REQ(:METHOD)%"),
-											"path":                  structpb.NewStringValue("%REQ(X-ENVOY-ORIGINAL-PATH?:PATH)%"),
-											"protocol":              structpb.NewStringValue("%PROTOCOL%"),
-											"response_code":         structpb.NewStringValue("%RESPONSE_CODE%"),
-											"response_code_details": structpb.NewStringValue("%RESPONSE_CODE_DETAILS%"),
-											"response_flags":        structpb.NewStringValue("%RESPONSE_FLAGS%"),
-											"listener":              structpb.NewStringValue(name),
-											"upstream_cluster":      structpb.NewStringValue("%UPSTREAM_CLUSTER%"),
-											"upstream_service_time": structpb.NewStringValue("%RESP(X-ENVOY-UPSTREAM-SERVICE-TIME)%"),
-											"user_agent":            structpb.NewStringValue("%REQ(USER-AGENT)%"),
-											"client_ip":             structpb.NewStringValue("%DOWNSTREAM_REMOTE_ADDRESS_WITHOUT_PORT%"),
-										}
-										if tls {
-											m["downstream_tls_cipher"] = structpb.NewStringValue("%DOWNSTREAM_TLS_CIPHER%")
-											m["downstream_tls_version"] = structpb.NewStringValue("%DOWNSTREAM_TLS_VERSION%")
+										m := make(map[string]*structpb.Value, len(fields))
+										for k, v := range fields {
+											m[k] = structpb.NewStringValue(v)
 										}
 										return m
 									}(),
@@ -245,7 +664,300 @@ func AccessLogConfig_v1(name string, tls bool) []*envoy_config_accesslog_v3.Acce
 				return any
 			}(),
 		},
-	}}
+	}
+}
+
+func accessLogFields(name string, tls bool, opts *saasv1alpha1.AccessLogOptions) map[string]string {
+	m := map[string]string{
+		"authority":             "%REQ(:AUTHORITY)%",
+		"bytes_received":        "%BYTES_RECEIVED%",
+		"bytes_sent":            "%BYTES_SENT%",
+		"duration":              "%DURATION%",
+		"method":                "%REQ(:METHOD)%",
+		"path":                  "%REQ(X-ENVOY-ORIGINAL-PATH?:PATH)%",
+		"protocol":              "%PROTOCOL%",
+		"response_code":         "%RESPONSE_CODE%",
+		"response_code_details": "%RESPONSE_CODE_DETAILS%",
+		"response_flags":        "%RESPONSE_FLAGS%",
+		"listener":              name,
+		"upstream_cluster":      "%UPSTREAM_CLUSTER%",
+		"upstream_service_time": "%RESP(X-ENVOY-UPSTREAM-SERVICE-TIME)%",
+		"user_agent":            "%REQ(USER-AGENT)%",
+		"client_ip":             "%DOWNSTREAM_REMOTE_ADDRESS_WITHOUT_PORT%",
+	}
+	if tls {
+		m["downstream_tls_cipher"] = "%DOWNSTREAM_TLS_CIPHER%"
+		m["downstream_tls_version"] = "%DOWNSTREAM_TLS_VERSION%"
+	}
+	if opts == nil {
+		return m
+	}
+
+	for _, header := range opts.RequestHeaders {
+		m[headerFieldName(header)] = fmt.Sprintf("%%REQ(%s)%%", header)
+	}
+	for _, header := range opts.ResponseHeaders {
+		m["response_"+headerFieldName(header)] = fmt.Sprintf("%%RESP(%s)%%", header)
+	}
+	for k, v := range opts.ExtraFields {
+		m[k] = v
+	}
+	return m
+}
+
+func headerFieldName(header string) string {
+	return strings.ReplaceAll(strings.ToLower(header), "-", "_")
+}
+
+// AccessLogFilter_v1 returns the filter for the access logs of a listener,
+// or nil if no filtering has been configured
+func AccessLogFilter_v1(name string, opts *saasv1alpha1.AccessLogOptions) *envoy_config_accesslog_v3.AccessLogFilter {
+	if opts == nil {
+		return nil
+	}
+
+	filters := []*envoy_config_accesslog_v3.AccessLogFilter{}
+
+	if opts.StatusCodeFilter != nil {
+		filters = append(filters, &envoy_config_accesslog_v3.AccessLogFilter{
+			FilterSpecifier: &envoy_config_accesslog_v3.AccessLogFilter_StatusCodeFilter{
+				StatusCodeFilter: &envoy_config_accesslog_v3.StatusCodeFilter{
+					Comparison: comparisonFilter(opts.StatusCodeFilter, fmt.Sprintf("access_log.%s.status_code", name)),
+				},
+			},
+		})
+	}
+
+	if opts.DurationFilter != nil {
+		filters = append(filters, &envoy_config_accesslog_v3.AccessLogFilter{
+			FilterSpecifier: &envoy_config_accesslog_v3.AccessLogFilter_DurationFilter{
+				DurationFilter: &envoy_config_accesslog_v3.DurationFilter{
+					Comparison: comparisonFilter(opts.DurationFilter, fmt.Sprintf("access_log.%s.duration", name)),
+				},
+			},
+		})
+	}
+
+	if opts.Sampling != nil {
+		filters = append(filters, &envoy_config_accesslog_v3.AccessLogFilter{
+			FilterSpecifier: &envoy_config_accesslog_v3.AccessLogFilter_RuntimeFilter{
+				RuntimeFilter: &envoy_config_accesslog_v3.RuntimeFilter{
+					RuntimeKey: func() string {
+						if opts.Sampling.RuntimeKey != nil {
+							return *opts.Sampling.RuntimeKey
+						}
+						return fmt.Sprintf("access_log.%s.sampling", name)
+					}(),
+					PercentSampled: &envoy_type_v3.FractionalPercent{
+						Numerator:   opts.Sampling.Percent,
+						Denominator: envoy_type_v3.FractionalPercent_HUNDRED,
+					},
+				},
+			},
+		})
+	}
+
+	switch len(filters) {
+	case 0:
+		return nil
+	case 1:
+		return filters[0]
+	}
+
+	if opts.FilterOperator != nil && *opts.FilterOperator == saasv1alpha1.AccessLogFilterOperatorOr {
+		return &envoy_config_accesslog_v3.AccessLogFilter{
+			FilterSpecifier: &envoy_config_accesslog_v3.AccessLogFilter_OrFilter{
+				OrFilter: &envoy_config_accesslog_v3.OrFilter{Filters: filters},
+			},
+		}
+	}
+	return &envoy_config_accesslog_v3.AccessLogFilter{
+		FilterSpecifier: &envoy_config_accesslog_v3.AccessLogFilter_AndFilter{
+			AndFilter: &envoy_config_accesslog_v3.AndFilter{Filters: filters},
+		},
+	}
+}
+
+func comparisonFilter(opts *saasv1alpha1.AccessLogComparisonFilter, defaultKey string) *envoy_config_accesslog_v3.ComparisonFilter {
+	op := map[saasv1alpha1.AccessLogComparisonOperator]envoy_config_accesslog_v3.ComparisonFilter_Op{
+		saasv1alpha1.AccessLogComparisonOperatorEQ: envoy_config_accesslog_v3.ComparisonFilter_EQ,
+		saasv1alpha1.AccessLogComparisonOperatorGE: envoy_config_accesslog_v3.ComparisonFilter_GE,
+		saasv1alpha1.AccessLogComparisonOperatorLE: envoy_config_accesslog_v3.ComparisonFilter_LE,
+	}[opts.Operator]
+
+	key := defaultKey
+	if opts.RuntimeKey != nil {
+		key = *opts.RuntimeKey
+	}
+
+	return &envoy_config_accesslog_v3.ComparisonFilter{
+		Op: op,
+		Value: &envoy_config_core_v3.RuntimeUInt32{
+			DefaultValue: opts.Value,
+			RuntimeKey:   key,
+		},
+	}
+}
+
+// Tracing_v1 returns the tracing configuration of the http connection manager. The
+// spans are sent to the generated "tracing_collector" cluster. Returns nil if tracing
+// is not enabled.
+func Tracing_v1(opts *saasv1alpha1.TracingOptions) (*http_connection_manager_v3.HttpConnectionManager_Tracing, error) {
+	if opts == nil {
+		return nil, nil
+	}
+
+	var tracerName string
+	var config proto.Message
+
+	switch opts.Provider {
+	case saasv1alpha1.TracingProviderOpenTelemetry:
+		tracerName = "envoy.tracers.opentelemetry"
+		otel := &envoy_config_trace_v3.OpenTelemetryConfig{
+			GrpcService: &envoy_config_core_v3.GrpcService{
+				TargetSpecifier: &envoy_config_core_v3.GrpcService_EnvoyGrpc_{
+					EnvoyGrpc: &envoy_config_core_v3.GrpcService_EnvoyGrpc{
+						ClusterName: saasv1alpha1.TracingCollectorClusterName,
+					},
+				},
+			},
+		}
+		if opts.ServiceName != nil {
+			otel.ServiceName = *opts.ServiceName
+		}
+		config = otel
+	case saasv1alpha1.TracingProviderZipkin:
+		tracerName = "envoy.tracers.zipkin"
+		config = &envoy_config_trace_v3.ZipkinConfig{
+			CollectorCluster: saasv1alpha1.TracingCollectorClusterName,
+			CollectorEndpoint: func() string {
+				if opts.ZipkinCollectorEndpoint != nil {
+					return *opts.ZipkinCollectorEndpoint
+				}
+				return "/api/v2/spans"
+			}(),
+			CollectorEndpointVersion: envoy_config_trace_v3.ZipkinConfig_HTTP_JSON,
+			SharedSpanContext:        wrapperspb.Bool(false),
+			TraceId_128Bit:           true,
+		}
+	default:
+		return nil, fmt.Errorf("unknown tracing provider '%s'", opts.Provider)
+	}
+
+	any, err := anypb.New(config)
+	if err != nil {
+		return nil, err
+	}
+
+	tracing := &http_connection_manager_v3.HttpConnectionManager_Tracing{
+		Provider: &envoy_config_trace_v3.Tracing_Http{
+			Name:       tracerName,
+			ConfigType: &envoy_config_trace_v3.Tracing_Http_TypedConfig{TypedConfig: any},
+		},
+	}
+
+	if opts.SamplingPercent != nil {
+		tracing.RandomSampling = &envoy_type_v3.Percent{Value: float64(*opts.SamplingPercent)}
+	}
+
+	for _, tag := range opts.CustomTags {
+		ct := &envoy_type_tracing_v3.CustomTag{Tag: tag.Tag}
+		switch {
+		case tag.Literal != nil && tag.RequestHeader == nil && tag.Environment == nil:
+			ct.Type = &envoy_type_tracing_v3.CustomTag_Literal_{
+				Literal: &envoy_type_tracing_v3.CustomTag_Literal{Value: *tag.Literal},
+			}
+		case tag.RequestHeader != nil && tag.Literal == nil && tag.Environment == nil:
+			ct.Type = &envoy_type_tracing_v3.CustomTag_RequestHeader{
+				RequestHeader: &envoy_type_tracing_v3.CustomTag_Header{Name: *tag.RequestHeader},
+			}
+		case tag.Environment != nil && tag.Literal == nil && tag.RequestHeader == nil:
+			ct.Type = &envoy_type_tracing_v3.CustomTag_Environment_{
+				Environment: &envoy_type_tracing_v3.CustomTag_Environment{Name: *tag.Environment},
+			}
+		default:
+			return nil, fmt.Errorf("custom tag '%s': exactly one of 'literal', 'requestHeader' or 'environment' must be set", tag.Tag)
+		}
+		tracing.CustomTags = append(tracing.CustomTags, ct)
+	}
+
+	if err := tracing.ValidateAll(); err != nil {
+		return nil, err
+	}
+
+	return tracing, nil
+}
+
+// AccessLogGrpcSink_v1 returns an access log that sends the logs to either
+// an envoy gRPC access log service or an OpenTelemetry collector
+func AccessLogGrpcSink_v1(name string, fields map[string]string, filter *envoy_config_accesslog_v3.AccessLogFilter,
+	opts *saasv1alpha1.AccessLogOptions) *envoy_config_accesslog_v3.AccessLog {
+
+	common := &envoy_extensions_access_loggers_grpc_v3.CommonGrpcAccessLogConfig{
+		LogName: func() string {
+			if opts.GrpcSink.LogName != nil {
+				return *opts.GrpcSink.LogName
+			}
+			return name
+		}(),
+		GrpcService: &envoy_config_core_v3.GrpcService{
+			TargetSpecifier: &envoy_config_core_v3.GrpcService_EnvoyGrpc_{
+				EnvoyGrpc: &envoy_config_core_v3.GrpcService_EnvoyGrpc{
+					ClusterName: opts.GrpcSink.Cluster,
+				},
+			},
+		},
+		TransportApiVersion: envoy_config_core_v3.ApiVersion_V3,
+	}
+
+	var loggerName string
+	var config proto.Message
+
+	switch opts.GrpcSink.Type {
+	case saasv1alpha1.AccessLogGrpcSinkTypeOpenTelemetry:
+		loggerName = "envoy.access_loggers.open_telemetry"
+		config = &envoy_extensions_access_loggers_open_telemetry_v3.OpenTelemetryAccessLogConfig{
+			CommonConfig: common,
+			Attributes: &otlp_common_v1.KeyValueList{
+				Values: func() []*otlp_common_v1.KeyValue {
+					keys := make([]string, 0, len(fields))
+					for k := range fields {
+						keys = append(keys, k)
+					}
+					sort.Strings(keys)
+					kvs := make([]*otlp_common_v1.KeyValue, 0, len(keys))
+					for _, k := range keys {
+						kvs = append(kvs, &otlp_common_v1.KeyValue{
+							Key:   k,
+							Value: &otlp_common_v1.AnyValue{Value: &otlp_common_v1.AnyValue_StringValue{StringValue: fields[k]}},
+						})
+					}
+					return kvs
+				}(),
+			},
+		}
+	default:
+		loggerName = "envoy.access_loggers.http_grpc"
+		config = &envoy_extensions_access_loggers_grpc_v3.HttpGrpcAccessLogConfig{
+			CommonConfig:                   common,
+			AdditionalRequestHeadersToLog:  opts.RequestHeaders,
+			AdditionalResponseHeadersToLog: opts.ResponseHeaders,
+		}
+	}
+
+	return &envoy_config_accesslog_v3.AccessLog{
+		Name:   loggerName,
+		Filter: filter,
+		ConfigType: &envoy_config_accesslog_v3.AccessLog_TypedConfig{
+			TypedConfig: func() *anypb.Any {
+				any, err := anypb.New(config)
+				if err != nil {
+					panic(err)
+				}
+				return any
+			}(),
+		},
+	}
 }
 
 func TransportSocket_v1(secretName string, http2 bool) *envoy_config_core_v3.TransportSocket {
@@ -1,6 +1,7 @@
 package templates
 
 import (
+	"strings"
 	"testing"
 	"time"
 
@@ -248,3 +249,753 @@ func TestListenerHTTP_v1(t *testing.T) {
 		})
 	}
 }
+
+func TestAccessLogConfig_v1(t *testing.T) {
+	type args struct {
+		name string
+		tls  bool
+		opts *saasv1alpha1.AccessLogOptions
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{
+			name: "Generates access logs with filters and a gRPC ALS sink",
+			args: args{
+				name: "test",
+				tls:  false,
+				opts: &saasv1alpha1.AccessLogOptions{
+					ExtraFields:      map[string]string{"request_id": "%REQ(X-REQUEST-ID)%"},
+					RequestHeaders:   []string{"X-Forwarded-For"},
+					StatusCodeFilter: &saasv1alpha1.AccessLogComparisonFilter{Operator: saasv1alpha1.AccessLogComparisonOperatorGE, Value: 400},
+					DurationFilter: &saasv1alpha1.AccessLogComparisonFilter{
+						Operator: saasv1alpha1.AccessLogComparisonOperatorGE, Value: 1000, RuntimeKey: util.Pointer("my_key"),
+					},
+					GrpcSink: &saasv1alpha1.AccessLogGrpcSink{
+						Type: saasv1alpha1.AccessLogGrpcSinkTypeGrpcALS, Cluster: "als", LogName: util.Pointer("my_log"),
+					},
+				},
+			},
+			want: heredoc.Doc(`
+                - filter:
+                    and_filter:
+                      filters:
+                      - status_code_filter:
+                          comparison:
+                            op: GE
+                            value:
+                              default_value: 400
+                              runtime_key: access_log.test.status_code
+                      - duration_filter:
+                          comparison:
+                            op: GE
+                            value:
+                              default_value: 1000
+                              runtime_key: my_key
+                  name: envoy.access_loggers.file
+                  typed_config:
+                    '@type': type.googleapis.com/envoy.extensions.access_loggers.file.v3.FileAccessLog
+                    log_format:
+                      json_format:
+                        authority: '%REQ(:AUTHORITY)%'
+                        bytes_received: '%BYTES_RECEIVED%'
+                        bytes_sent: '%BYTES_SENT%'
+                        client_ip: '%DOWNSTREAM_REMOTE_ADDRESS_WITHOUT_PORT%'
+                        duration: '%DURATION%'
+                        listener: test
+                        method: '%REQ(:METHOD)%'
+                        path: '%REQ(X-ENVOY-ORIGINAL-PATH?:PATH)%'
+                        protocol: '%PROTOCOL%'
+                        request_id: '%REQ(X-REQUEST-ID)%'
+                        response_code: '%RESPONSE_CODE%'
+                        response_code_details: '%RESPONSE_CODE_DETAILS%'
+                        response_flags: '%RESPONSE_FLAGS%'
+                        upstream_cluster: '%UPSTREAM_CLUSTER%'
+                        upstream_service_time: '%RESP(X-ENVOY-UPSTREAM-SERVICE-TIME)%'
+                        user_agent: '%REQ(USER-AGENT)%'
+                        x_forwarded_for: '%REQ(X-Forwarded-For)%'
+                    path: /dev/stdout
+                - filter:
+                    and_filter:
+                      filters:
+                      - status_code_filter:
+                          comparison:
+                            op: GE
+                            value:
+                              default_value: 400
+                              runtime_key: access_log.test.status_code
+                      - duration_filter:
+                          comparison:
+                            op: GE
+                            value:
+                              default_value: 1000
+                              runtime_key: my_key
+                  name: envoy.access_loggers.http_grpc
+                  typed_config:
+                    '@type': type.googleapis.com/envoy.extensions.access_loggers.grpc.v3.HttpGrpcAccessLogConfig
+                    additional_request_headers_to_log:
+                    - X-Forwarded-For
+                    common_config:
+                      grpc_service:
+                        envoy_grpc:
+                          cluster_name: als
+                      log_name: my_log
+                      transport_api_version: V3
+			`),
+		},
+		{
+			name: "Logs errors and a sample of the other requests",
+			args: args{
+				name: "test",
+				tls:  false,
+				opts: &saasv1alpha1.AccessLogOptions{
+					StatusCodeFilter: &saasv1alpha1.AccessLogComparisonFilter{Operator: saasv1alpha1.AccessLogComparisonOperatorGE, Value: 400},
+					Sampling:         &saasv1alpha1.AccessLogSampling{Percent: 10},
+					FilterOperator:   util.Pointer(saasv1alpha1.AccessLogFilterOperatorOr),
+					ExtraFields:      map[string]string{"authority": "%REQ(HOST)%"},
+				},
+			},
+			want: heredoc.Doc(`
+                - filter:
+                    or_filter:
+                      filters:
+                      - status_code_filter:
+                          comparison:
+                            op: GE
+                            value:
+                              default_value: 400
+                              runtime_key: access_log.test.status_code
+                      - runtime_filter:
+                          percent_sampled:
+                            numerator: 10
+                          runtime_key: access_log.test.sampling
+                  name: envoy.access_loggers.file
+                  typed_config:
+                    '@type': type.googleapis.com/envoy.extensions.access_loggers.file.v3.FileAccessLog
+                    log_format:
+                      json_format:
+                        authority: '%REQ(HOST)%'
+                        bytes_received: '%BYTES_RECEIVED%'
+                        bytes_sent: '%BYTES_SENT%'
+                        client_ip: '%DOWNSTREAM_REMOTE_ADDRESS_WITHOUT_PORT%'
+                        duration: '%DURATION%'
+                        listener: test
+                        method: '%REQ(:METHOD)%'
+                        path: '%REQ(X-ENVOY-ORIGINAL-PATH?:PATH)%'
+                        protocol: '%PROTOCOL%'
+                        response_code: '%RESPONSE_CODE%'
+                        response_code_details: '%RESPONSE_CODE_DETAILS%'
+                        response_flags: '%RESPONSE_FLAGS%'
+                        upstream_cluster: '%UPSTREAM_CLUSTER%'
+                        upstream_service_time: '%RESP(X-ENVOY-UPSTREAM-SERVICE-TIME)%'
+                        user_agent: '%REQ(USER-AGENT)%'
+                    path: /dev/stdout
+			`),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := AccessLogConfig_v1(tt.args.name, tt.args.tls, tt.args.opts)
+			y := []byte{}
+			for _, al := range got {
+				if err := al.ValidateAll(); err != nil {
+					t.Fatal(err)
+				}
+				j, err := envoy_serializer_v3.JSON{}.Marshal(al)
+				if err != nil {
+					t.Error(err)
+				}
+				b, err := yaml.JSONToYAML([]byte(j))
+				if err != nil {
+					t.Error(err)
+				}
+				y = append(y, []byte("- "+strings.ReplaceAll(strings.TrimSuffix(string(b), "\n"), "\n", "\n  ")+"\n")...)
+			}
+			if string(y) != tt.want {
+				t.Errorf("AccessLogConfig_v1():\n# got:\n%v\n# want:\n%v", string(y), tt.want)
+			}
+		})
+	}
+}
+
+func TestListenerTCP_v1(t *testing.T) {
+	type args struct {
+		name string
+		opts *saasv1alpha1.ListenerTcp
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "Generates a tcp proxy listener",
+			args: args{
+				name: "test",
+				opts: &saasv1alpha1.ListenerTcp{
+					Port:          6379,
+					ProxyProtocol: util.Pointer(false),
+					Cluster:       util.Pointer("twemproxy"),
+				},
+			},
+			want: heredoc.Doc(`
+                address:
+                  socket_address:
+                    address: 0.0.0.0
+                    port_value: 6379
+                filter_chains:
+                - filters:
+                  - name: envoy.filters.network.tcp_proxy
+                    typed_config:
+                      '@type': type.googleapis.com/envoy.extensions.filters.network.tcp_proxy.v3.TcpProxy
+                      access_log:
+                      - name: envoy.access_loggers.file
+                        typed_config:
+                          '@type': type.googleapis.com/envoy.extensions.access_loggers.file.v3.FileAccessLog
+                          log_format:
+                            json_format:
+                              bytes_received: '%BYTES_RECEIVED%'
+                              bytes_sent: '%BYTES_SENT%'
+                              client_ip: '%DOWNSTREAM_REMOTE_ADDRESS_WITHOUT_PORT%'
+                              duration: '%DURATION%'
+                              listener: test
+                              requested_server_name: '%REQUESTED_SERVER_NAME%'
+                              response_flags: '%RESPONSE_FLAGS%'
+                              upstream_cluster: '%UPSTREAM_CLUSTER%'
+                              upstream_host: '%UPSTREAM_HOST%'
+                          path: /dev/stdout
+                      cluster: twemproxy
+                      stat_prefix: test
+                name: test
+                per_connection_buffer_limit_bytes: 32768
+			`),
+		},
+		{
+			name: "Generates a tls passthrough listener with sni matching",
+			args: args{
+				name: "test",
+				opts: &saasv1alpha1.ListenerTcp{
+					Port:          6379,
+					ProxyProtocol: util.Pointer(true),
+					Cluster:       util.Pointer("twemproxy"),
+					FilterChains:  []saasv1alpha1.TcpFilterChain{{ServerNames: []string{"redis.example.com"}, Cluster: "redis"}},
+					IdleTimeout:   &metav1.Duration{Duration: 10 * time.Minute},
+					AccessLog:     &saasv1alpha1.TcpAccessLogOptions{Sampling: &saasv1alpha1.AccessLogSampling{Percent: 50}},
+				},
+			},
+			want: heredoc.Doc(`
+                address:
+                  socket_address:
+                    address: 0.0.0.0
+                    port_value: 6379
+                filter_chains:
+                - filter_chain_match:
+                    server_names:
+                    - redis.example.com
+                  filters:
+                  - name: envoy.filters.network.tcp_proxy
+                    typed_config:
+                      '@type': type.googleapis.com/envoy.extensions.filters.network.tcp_proxy.v3.TcpProxy
+                      access_log:
+                      - filter:
+                          runtime_filter:
+                            percent_sampled:
+                              numerator: 50
+                            runtime_key: access_log.test.sampling
+                        name: envoy.access_loggers.file
+                        typed_config:
+                          '@type': type.googleapis.com/envoy.extensions.access_loggers.file.v3.FileAccessLog
+                          log_format:
+                            json_format:
+                              bytes_received: '%BYTES_RECEIVED%'
+                              bytes_sent: '%BYTES_SENT%'
+                              client_ip: '%DOWNSTREAM_REMOTE_ADDRESS_WITHOUT_PORT%'
+                              duration: '%DURATION%'
+                              listener: test
+                              requested_server_name: '%REQUESTED_SERVER_NAME%'
+                              response_flags: '%RESPONSE_FLAGS%'
+                              upstream_cluster: '%UPSTREAM_CLUSTER%'
+                              upstream_host: '%UPSTREAM_HOST%'
+                          path: /dev/stdout
+                      cluster: redis
+                      idle_timeout: 600s
+                      stat_prefix: test
+                - filters:
+                  - name: envoy.filters.network.tcp_proxy
+                    typed_config:
+                      '@type': type.googleapis.com/envoy.extensions.filters.network.tcp_proxy.v3.TcpProxy
+                      access_log:
+                      - filter:
+                          runtime_filter:
+                            percent_sampled:
+                              numerator: 50
+                            runtime_key: access_log.test.sampling
+                        name: envoy.access_loggers.file
+                        typed_config:
+                          '@type': type.googleapis.com/envoy.extensions.access_loggers.file.v3.FileAccessLog
+                          log_format:
+                            json_format:
+                              bytes_received: '%BYTES_RECEIVED%'
+                              bytes_sent: '%BYTES_SENT%'
+                              client_ip: '%DOWNSTREAM_REMOTE_ADDRESS_WITHOUT_PORT%'
+                              duration: '%DURATION%'
+                              listener: test
+                              requested_server_name: '%REQUESTED_SERVER_NAME%'
+                              response_flags: '%RESPONSE_FLAGS%'
+                              upstream_cluster: '%UPSTREAM_CLUSTER%'
+                              upstream_host: '%UPSTREAM_HOST%'
+                          path: /dev/stdout
+                      cluster: twemproxy
+                      idle_timeout: 600s
+                      stat_prefix: test
+                listener_filters:
+                - name: envoy.filters.listener.proxy_protocol
+                  typed_config:
+                    '@type': type.googleapis.com/envoy.extensions.filters.listener.proxy_protocol.v3.ProxyProtocol
+                - name: envoy.filters.listener.tls_inspector
+                  typed_config:
+                    '@type': type.googleapis.com/envoy.extensions.filters.listener.tls_inspector.v3.TlsInspector
+                name: test
+                per_connection_buffer_limit_bytes: 32768
+			`),
+		},
+		{
+			name: "Fails if no cluster is set",
+			args: args{
+				name: "test",
+				opts: &saasv1alpha1.ListenerTcp{
+					Port:          6379,
+					ProxyProtocol: util.Pointer(true),
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ListenerTCP_v1(tt.args.name, tt.args.opts)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ListenerTCP_v1() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			j, err := envoy_serializer_v3.JSON{}.Marshal(got)
+			if err != nil {
+				t.Error(err)
+			}
+			y, err := yaml.JSONToYAML([]byte(j))
+			if err != nil {
+				t.Error(err)
+			}
+			if string(y) != tt.want {
+				t.Errorf("ListenerTCP_v1():\n# got:\n%v\n# want:\n%v", string(y), tt.want)
+			}
+		})
+	}
+}
+
+func TestHttpFilterLocalRateLimit_v1(t *testing.T) {
+	tests := []struct {
+		name string
+		opts *saasv1alpha1.LocalRateLimit
+		want string
+	}{
+		{
+			name: "Generates a local rate limit filter in dry run mode",
+			opts: &saasv1alpha1.LocalRateLimit{
+				TokenBucket: &saasv1alpha1.TokenBucket{
+					MaxTokens:     100,
+					TokensPerFill: util.Pointer(uint32(10)),
+					FillInterval:  metav1.Duration{Duration: time.Second},
+				},
+				DryRun: util.Pointer(true),
+			},
+			want: heredoc.Doc(`
+                name: envoy.filters.http.local_ratelimit
+                typed_config:
+                  '@type': type.googleapis.com/envoy.extensions.filters.http.local_ratelimit.v3.LocalRateLimit
+                  filter_enabled:
+                    default_value:
+                      numerator: 100
+                    runtime_key: local_rate_limit.my_listener.enabled
+                  filter_enforced:
+                    default_value: {}
+                    runtime_key: local_rate_limit.my_listener.enforced
+                  stat_prefix: http_local_rate_limiter
+                  token_bucket:
+                    fill_interval: 1s
+                    max_tokens: 100
+                    tokens_per_fill: 10
+			`),
+		},
+		{
+			name: "Generates a disabled local rate limit filter for per route limits",
+			opts: &saasv1alpha1.LocalRateLimit{},
+			want: heredoc.Doc(`
+                name: envoy.filters.http.local_ratelimit
+                typed_config:
+                  '@type': type.googleapis.com/envoy.extensions.filters.http.local_ratelimit.v3.LocalRateLimit
+                  stat_prefix: http_local_rate_limiter
+			`),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := HttpFilterLocalRateLimit_v1("my_listener", tt.opts)
+			if err := got.ValidateAll(); err != nil {
+				t.Fatal(err)
+			}
+			j, err := envoy_serializer_v3.JSON{}.Marshal(got)
+			if err != nil {
+				t.Error(err)
+			}
+			y, err := yaml.JSONToYAML([]byte(j))
+			if err != nil {
+				t.Error(err)
+			}
+			if string(y) != tt.want {
+				t.Errorf("HttpFilterLocalRateLimit_v1():\n# got:\n%v\n# want:\n%v", string(y), tt.want)
+			}
+		})
+	}
+}
+
+func TestHttpFilterExtAuthz_v1(t *testing.T) {
+	type args struct {
+		opts        *saasv1alpha1.ExtAuthz
+		jwtMetadata bool
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "Generates a grpc ext_authz filter",
+			args: args{
+				opts: &saasv1alpha1.ExtAuthz{
+					Type:                   saasv1alpha1.ExtAuthzTypeGrpc,
+					Cluster:                "authz",
+					FailureModeAllow:       util.Pointer(true),
+					IncludePeerCertificate: util.Pointer(true),
+				},
+				jwtMetadata: true,
+			},
+			want: heredoc.Doc(`
+                name: envoy.filters.http.ext_authz
+                typed_config:
+                  '@type': type.googleapis.com/envoy.extensions.filters.http.ext_authz.v3.ExtAuthz
+                  failure_mode_allow: true
+                  grpc_service:
+                    envoy_grpc:
+                      cluster_name: authz
+                    timeout: 0.200s
+                  include_peer_certificate: true
+                  metadata_context_namespaces:
+                  - envoy.filters.http.jwt_authn
+                  transport_api_version: V3
+			`),
+		},
+		{
+			name: "Generates an http ext_authz filter",
+			args: args{
+				opts: &saasv1alpha1.ExtAuthz{
+					Type:                   saasv1alpha1.ExtAuthzTypeHttp,
+					Cluster:                "authz",
+					Timeout:                &metav1.Duration{Duration: time.Second},
+					PathPrefix:             util.Pointer("/auth"),
+					AllowedRequestHeaders:  []string{"cookie"},
+					AllowedUpstreamHeaders: []string{"x-user-id"},
+				},
+			},
+			want: heredoc.Doc(`
+                name: envoy.filters.http.ext_authz
+                typed_config:
+                  '@type': type.googleapis.com/envoy.extensions.filters.http.ext_authz.v3.ExtAuthz
+                  http_service:
+                    authorization_request:
+                      allowed_headers:
+                        patterns:
+                        - exact: cookie
+                          ignore_case: true
+                    authorization_response:
+                      allowed_upstream_headers:
+                        patterns:
+                        - exact: x-user-id
+                          ignore_case: true
+                    path_prefix: /auth
+                    server_uri:
+                      cluster: authz
+                      timeout: 1s
+                      uri: http://authz
+                  transport_api_version: V3
+			`),
+		},
+		{
+			name: "Fails if http options are used with a grpc service",
+			args: args{
+				opts: &saasv1alpha1.ExtAuthz{
+					Type:       saasv1alpha1.ExtAuthzTypeGrpc,
+					Cluster:    "authz",
+					PathPrefix: util.Pointer("/auth"),
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := HttpFilterExtAuthz_v1(tt.args.opts, tt.args.jwtMetadata)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("HttpFilterExtAuthz_v1() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			j, err := envoy_serializer_v3.JSON{}.Marshal(got)
+			if err != nil {
+				t.Error(err)
+			}
+			y, err := yaml.JSONToYAML([]byte(j))
+			if err != nil {
+				t.Error(err)
+			}
+			if string(y) != tt.want {
+				t.Errorf("HttpFilterExtAuthz_v1():\n# got:\n%v\n# want:\n%v", string(y), tt.want)
+			}
+		})
+	}
+}
+
+func TestHttpFilterJwtAuthn_v1(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    *saasv1alpha1.JwtAuthn
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "Generates a jwt_authn filter with a remote jwks",
+			opts: &saasv1alpha1.JwtAuthn{
+				Providers: []saasv1alpha1.JwtProvider{{
+					Name:                 "sso",
+					Issuer:               util.Pointer("https://sso.example.com"),
+					Audiences:            []string{"backend"},
+					RemoteJwks:           &saasv1alpha1.JwtRemoteJwks{URI: "https://sso.example.com/certs", Cluster: "sso"},
+					FromHeaders:          []saasv1alpha1.JwtHeader{{Name: "x-token"}},
+					ForwardPayloadHeader: util.Pointer("x-jwt-payload"),
+					ClaimToHeaders:       []saasv1alpha1.JwtClaimToHeader{{HeaderName: "x-user", ClaimName: "sub"}},
+				}},
+			},
+			want: heredoc.Doc(`
+                name: envoy.filters.http.jwt_authn
+                typed_config:
+                  '@type': type.googleapis.com/envoy.extensions.filters.http.jwt_authn.v3.JwtAuthentication
+                  providers:
+                    sso:
+                      audiences:
+                      - backend
+                      claim_to_headers:
+                      - claim_name: sub
+                        header_name: x-user
+                      forward_payload_header: x-jwt-payload
+                      from_headers:
+                      - name: x-token
+                      issuer: https://sso.example.com
+                      payload_in_metadata: sso
+                      remote_jwks:
+                        cache_duration: 300s
+                        http_uri:
+                          cluster: sso
+                          timeout: 1s
+                          uri: https://sso.example.com/certs
+                  rules:
+                  - match:
+                      prefix: /
+                    requires:
+                      provider_name: sso
+			`),
+		},
+		{
+			name: "Generates a jwt_authn filter with several providers that allows missing JWTs",
+			opts: &saasv1alpha1.JwtAuthn{
+				AllowMissing: util.Pointer(true),
+				Providers: []saasv1alpha1.JwtProvider{
+					{Name: "a", LocalJwks: util.Pointer(`{"keys":[]}`)},
+					{Name: "b", LocalJwks: util.Pointer(`{"keys":[]}`)},
+				},
+			},
+			want: heredoc.Doc(`
+                name: envoy.filters.http.jwt_authn
+                typed_config:
+                  '@type': type.googleapis.com/envoy.extensions.filters.http.jwt_authn.v3.JwtAuthentication
+                  providers:
+                    a:
+                      local_jwks:
+                        inline_string: '{"keys":[]}'
+                      payload_in_metadata: a
+                    b:
+                      local_jwks:
+                        inline_string: '{"keys":[]}'
+                      payload_in_metadata: b
+                  rules:
+                  - match:
+                      prefix: /
+                    requires:
+                      requires_any:
+                        requirements:
+                        - provider_name: a
+                        - provider_name: b
+                        - allow_missing: {}
+			`),
+		},
+		{
+			name:    "Fails if a provider has no jwks",
+			opts:    &saasv1alpha1.JwtAuthn{Providers: []saasv1alpha1.JwtProvider{{Name: "a"}}},
+			wantErr: true,
+		},
+		{
+			name: "Fails if provider names are duplicated",
+			opts: &saasv1alpha1.JwtAuthn{Providers: []saasv1alpha1.JwtProvider{
+				{Name: "a", LocalJwks: util.Pointer(`{"keys":[]}`)},
+				{Name: "a", LocalJwks: util.Pointer(`{"keys":[]}`)},
+			}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := HttpFilterJwtAuthn_v1(tt.opts)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("HttpFilterJwtAuthn_v1() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			j, err := envoy_serializer_v3.JSON{}.Marshal(got)
+			if err != nil {
+				t.Error(err)
+			}
+			y, err := yaml.JSONToYAML([]byte(j))
+			if err != nil {
+				t.Error(err)
+			}
+			if string(y) != tt.want {
+				t.Errorf("HttpFilterJwtAuthn_v1():\n# got:\n%v\n# want:\n%v", string(y), tt.want)
+			}
+		})
+	}
+}
+
+func TestTracing_v1(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    *saasv1alpha1.TracingOptions
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "Generates an OpenTelemetry tracing config",
+			opts: &saasv1alpha1.TracingOptions{
+				Provider:        saasv1alpha1.TracingProviderOpenTelemetry,
+				CollectorHost:   "otel-collector",
+				CollectorPort:   4317,
+				ServiceName:     util.Pointer("apicast"),
+				SamplingPercent: util.Pointer(uint32(10)),
+				CustomTags: []saasv1alpha1.TracingCustomTag{
+					{Tag: "env", Literal: util.Pointer("prod")},
+					{Tag: "request_id", RequestHeader: util.Pointer("x-request-id")},
+					{Tag: "pod", Environment: util.Pointer("POD_NAME")},
+				},
+			},
+			want: heredoc.Doc(`
+                custom_tags:
+                - literal:
+                    value: prod
+                  tag: env
+                - request_header:
+                    name: x-request-id
+                  tag: request_id
+                - environment:
+                    name: POD_NAME
+                  tag: pod
+                provider:
+                  name: envoy.tracers.opentelemetry
+                  typed_config:
+                    '@type': type.googleapis.com/envoy.config.trace.v3.OpenTelemetryConfig
+                    grpc_service:
+                      envoy_grpc:
+                        cluster_name: tracing_collector
+                    service_name: apicast
+                random_sampling:
+                  value: 10
+			`),
+		},
+		{
+			name: "Generates a Zipkin tracing config",
+			opts: &saasv1alpha1.TracingOptions{
+				Provider:      saasv1alpha1.TracingProviderZipkin,
+				CollectorHost: "zipkin",
+				CollectorPort: 9411,
+			},
+			want: heredoc.Doc(`
+                provider:
+                  name: envoy.tracers.zipkin
+                  typed_config:
+                    '@type': type.googleapis.com/envoy.config.trace.v3.ZipkinConfig
+                    collector_cluster: tracing_collector
+                    collector_endpoint: /api/v2/spans
+                    collector_endpoint_version: HTTP_JSON
+                    shared_span_context: false
+                    trace_id_128bit: true
+			`),
+		},
+		{
+			name: "Fails if a custom tag has more than one value",
+			opts: &saasv1alpha1.TracingOptions{
+				Provider:      saasv1alpha1.TracingProviderZipkin,
+				CollectorHost: "zipkin",
+				CollectorPort: 9411,
+				CustomTags: []saasv1alpha1.TracingCustomTag{
+					{Tag: "env", Literal: util.Pointer("prod"), Environment: util.Pointer("ENV")},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name:    "Fails with an unknown provider",
+			opts:    &saasv1alpha1.TracingOptions{Provider: "Jaeger"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Tracing_v1(tt.opts)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Tracing_v1() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			j, err := envoy_serializer_v3.JSON{}.Marshal(got)
+			if err != nil {
+				t.Error(err)
+			}
+			y, err := yaml.JSONToYAML([]byte(j))
+			if err != nil {
+				t.Error(err)
+			}
+			if string(y) != tt.want {
+				t.Errorf("Tracing_v1():\n# got:\n%v\n# want:\n%v", string(y), tt.want)
+			}
+		})
+	}
+}
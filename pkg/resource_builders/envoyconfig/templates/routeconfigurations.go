@@ -1,11 +1,21 @@
 package templates
 
 import (
+	"fmt"
+
 	"github.com/3scale-ops/marin3r/pkg/envoy"
 	envoy_serializer_v3 "github.com/3scale-ops/marin3r/pkg/envoy/serializer/v3"
 	saasv1alpha1 "github.com/3scale-ops/saas-operator/api/v1alpha1"
 	operatorutils "github.com/3scale-ops/saas-operator/pkg/util"
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_extensions_filters_http_ext_authz_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ext_authz/v3"
+	envoy_extensions_filters_http_jwt_authn_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/jwt_authn/v3"
+	envoy_type_matcher_v3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 func RouteConfiguration_v1(name string, opts interface{}) (envoy.Resource, error) {
@@ -17,11 +27,25 @@ func RouteConfiguration_v1(name string, opts interface{}) (envoy.Resource, error
 	}
 
 	merr := operatorutils.MultiError{}
-	for _, vhost := range o.VirtualHosts {
+	for i, vhost := range o.TypedVirtualHosts {
+		vh, err := VirtualHost_v1(name, &vhost)
+		if err != nil {
+			merr = append(merr, fmt.Errorf("typedVirtualHosts[%d]: %w", i, err))
+			continue
+		}
+		// only the typed virtual hosts are validated, the raw ones
+		// are passed through unchecked as they always have been
+		if err := vh.ValidateAll(); err != nil {
+			merr = append(merr, fmt.Errorf("typedVirtualHosts[%d]: %w", i, err))
+			continue
+		}
+		rc.VirtualHosts = append(rc.VirtualHosts, vh)
+	}
+	for i, vhost := range o.VirtualHosts {
 		vh := &envoy_config_route_v3.VirtualHost{}
 		err := envoy_serializer_v3.JSON{}.Unmarshal(string(vhost.Raw), vh)
 		if err != nil {
-			merr = append(merr, err)
+			merr = append(merr, fmt.Errorf("virtualHosts[%d]: %w", i, err))
 		}
 		rc.VirtualHosts = append(rc.VirtualHosts, vh)
 	}
@@ -31,3 +55,345 @@ func RouteConfiguration_v1(name string, opts interface{}) (envoy.Resource, error
 
 	return rc, nil
 }
+
+func VirtualHost_v1(routeConfigName string, opts *saasv1alpha1.VirtualHost) (*envoy_config_route_v3.VirtualHost, error) {
+	vh := &envoy_config_route_v3.VirtualHost{
+		Name:    opts.Name,
+		Domains: opts.Domains,
+		Routes:  make([]*envoy_config_route_v3.Route, 0, len(opts.Routes)),
+	}
+
+	merr := operatorutils.MultiError{}
+	rls, err := RateLimits_v1(opts.RateLimits)
+	if err != nil {
+		merr = append(merr, err)
+	}
+	vh.RateLimits = rls
+
+	for i, route := range opts.Routes {
+		// unnamed routes are identified by their position
+		// in the local rate limit runtime keys
+		scope := fmt.Sprintf("%s.%s.%d", routeConfigName, opts.Name, i)
+		if route.Name != nil {
+			scope = fmt.Sprintf("%s.%s.%s", routeConfigName, opts.Name, *route.Name)
+		}
+		r, err := Route_v1(scope, &route)
+		if err != nil {
+			merr = append(merr, fmt.Errorf("routes[%d]: %w", i, err))
+			continue
+		}
+		vh.Routes = append(vh.Routes, r)
+	}
+
+	return vh, merr.ErrorOrNil()
+}
+
+func Route_v1(scope string, opts *saasv1alpha1.Route) (*envoy_config_route_v3.Route, error) {
+	match, err := RouteMatch_v1(&opts.Match)
+	if err != nil {
+		return nil, fmt.Errorf("match: %w", err)
+	}
+
+	route := &envoy_config_route_v3.Route{Match: match}
+	if opts.Name != nil {
+		route.Name = *opts.Name
+	}
+
+	switch {
+	case countSet(opts.Route != nil, opts.Redirect != nil, opts.DirectResponse != nil) != 1:
+		return nil, fmt.Errorf("exactly one of 'route', 'redirect' or 'directResponse' must be set")
+
+	case opts.Route != nil:
+		action, err := RouteAction_v1(opts.Route)
+		if err != nil {
+			return nil, fmt.Errorf("route: %w", err)
+		}
+		route.Action = &envoy_config_route_v3.Route_Route{Route: action}
+
+	case opts.Redirect != nil:
+		route.Action = &envoy_config_route_v3.Route_Redirect{Redirect: RedirectAction_v1(opts.Redirect)}
+
+	case opts.DirectResponse != nil:
+		dr := &envoy_config_route_v3.DirectResponseAction{Status: opts.DirectResponse.Status}
+		if opts.DirectResponse.Body != nil {
+			dr.Body = &envoy_config_core_v3.DataSource{
+				Specifier: &envoy_config_core_v3.DataSource_InlineString{InlineString: *opts.DirectResponse.Body},
+			}
+		}
+		route.Action = &envoy_config_route_v3.Route_DirectResponse{DirectResponse: dr}
+	}
+
+	perFilterConfig := map[string]proto.Message{}
+	if opts.LocalRateLimit != nil {
+		if opts.LocalRateLimit.TokenBucket == nil {
+			return nil, fmt.Errorf("localRateLimit: 'tokenBucket' must be set")
+		}
+		perFilterConfig["envoy.filters.http.local_ratelimit"] = LocalRateLimitConfig_v1(scope, opts.LocalRateLimit)
+	}
+	if opts.DisableExtAuthz != nil && *opts.DisableExtAuthz {
+		perFilterConfig["envoy.filters.http.ext_authz"] = &envoy_extensions_filters_http_ext_authz_v3.ExtAuthzPerRoute{
+			Override: &envoy_extensions_filters_http_ext_authz_v3.ExtAuthzPerRoute_Disabled{Disabled: true},
+		}
+	}
+	if opts.DisableJwtAuthn != nil && *opts.DisableJwtAuthn {
+		perFilterConfig["envoy.filters.http.jwt_authn"] = &envoy_extensions_filters_http_jwt_authn_v3.PerRouteConfig{
+			RequirementSpecifier: &envoy_extensions_filters_http_jwt_authn_v3.PerRouteConfig_Disabled{Disabled: true},
+		}
+	}
+	for filter, config := range perFilterConfig {
+		any, err := anypb.New(config)
+		if err != nil {
+			return nil, err
+		}
+		if route.TypedPerFilterConfig == nil {
+			route.TypedPerFilterConfig = map[string]*anypb.Any{}
+		}
+		route.TypedPerFilterConfig[filter] = any
+	}
+
+	return route, nil
+}
+
+func RouteMatch_v1(opts *saasv1alpha1.RouteMatch) (*envoy_config_route_v3.RouteMatch, error) {
+	match := &envoy_config_route_v3.RouteMatch{}
+
+	switch {
+	case countSet(opts.Prefix != nil, opts.Path != nil, opts.Regex != nil) != 1:
+		return nil, fmt.Errorf("exactly one of 'prefix', 'path' or 'regex' must be set")
+	case opts.Prefix != nil:
+		match.PathSpecifier = &envoy_config_route_v3.RouteMatch_Prefix{Prefix: *opts.Prefix}
+	case opts.Path != nil:
+		match.PathSpecifier = &envoy_config_route_v3.RouteMatch_Path{Path: *opts.Path}
+	case opts.Regex != nil:
+		match.PathSpecifier = &envoy_config_route_v3.RouteMatch_SafeRegex{
+			SafeRegex: &envoy_type_matcher_v3.RegexMatcher{Regex: *opts.Regex},
+		}
+	}
+
+	if opts.CaseSensitive != nil {
+		match.CaseSensitive = wrapperspb.Bool(*opts.CaseSensitive)
+	}
+
+	headers, err := HeaderMatchers_v1(opts.Headers)
+	if err != nil {
+		return nil, err
+	}
+	match.Headers = headers
+
+	return match, nil
+}
+
+func HeaderMatchers_v1(opts []saasv1alpha1.HeaderMatcher) ([]*envoy_config_route_v3.HeaderMatcher, error) {
+	if len(opts) == 0 {
+		return nil, nil
+	}
+
+	matchers := make([]*envoy_config_route_v3.HeaderMatcher, 0, len(opts))
+	for i, h := range opts {
+		hm := &envoy_config_route_v3.HeaderMatcher{Name: h.Name}
+		if h.Invert != nil {
+			hm.InvertMatch = *h.Invert
+		}
+
+		switch {
+		case countSet(h.Exact != nil, h.Prefix != nil, h.Regex != nil, h.Present != nil) != 1:
+			return nil, fmt.Errorf("headers[%d]: exactly one of 'exact', 'prefix', 'regex' or 'present' must be set", i)
+		case h.Exact != nil:
+			hm.HeaderMatchSpecifier = &envoy_config_route_v3.HeaderMatcher_StringMatch{
+				StringMatch: &envoy_type_matcher_v3.StringMatcher{
+					MatchPattern: &envoy_type_matcher_v3.StringMatcher_Exact{Exact: *h.Exact},
+				},
+			}
+		case h.Prefix != nil:
+			hm.HeaderMatchSpecifier = &envoy_config_route_v3.HeaderMatcher_StringMatch{
+				StringMatch: &envoy_type_matcher_v3.StringMatcher{
+					MatchPattern: &envoy_type_matcher_v3.StringMatcher_Prefix{Prefix: *h.Prefix},
+				},
+			}
+		case h.Regex != nil:
+			hm.HeaderMatchSpecifier = &envoy_config_route_v3.HeaderMatcher_StringMatch{
+				StringMatch: &envoy_type_matcher_v3.StringMatcher{
+					MatchPattern: &envoy_type_matcher_v3.StringMatcher_SafeRegex{
+						SafeRegex: &envoy_type_matcher_v3.RegexMatcher{Regex: *h.Regex},
+					},
+				},
+			}
+		case h.Present != nil:
+			hm.HeaderMatchSpecifier = &envoy_config_route_v3.HeaderMatcher_PresentMatch{PresentMatch: *h.Present}
+		}
+
+		matchers = append(matchers, hm)
+	}
+
+	return matchers, nil
+}
+
+func RouteAction_v1(opts *saasv1alpha1.RouteAction) (*envoy_config_route_v3.RouteAction, error) {
+	rls, err := RateLimits_v1(opts.RateLimits)
+	if err != nil {
+		return nil, err
+	}
+	action := &envoy_config_route_v3.RouteAction{RateLimits: rls}
+
+	switch {
+	case countSet(opts.Cluster != nil, len(opts.WeightedClusters) > 0) != 1:
+		return nil, fmt.Errorf("exactly one of 'cluster' or 'weightedClusters' must be set")
+	case opts.Cluster != nil:
+		action.ClusterSpecifier = &envoy_config_route_v3.RouteAction_Cluster{Cluster: *opts.Cluster}
+	default:
+		clusters := make([]*envoy_config_route_v3.WeightedCluster_ClusterWeight, 0, len(opts.WeightedClusters))
+		for _, wc := range opts.WeightedClusters {
+			clusters = append(clusters, &envoy_config_route_v3.WeightedCluster_ClusterWeight{
+				Name:   wc.Name,
+				Weight: wrapperspb.UInt32(wc.Weight),
+			})
+		}
+		action.ClusterSpecifier = &envoy_config_route_v3.RouteAction_WeightedClusters{
+			WeightedClusters: &envoy_config_route_v3.WeightedCluster{Clusters: clusters},
+		}
+	}
+
+	if opts.Timeout != nil {
+		action.Timeout = durationpb.New(opts.Timeout.Duration)
+	}
+	if opts.IdleTimeout != nil {
+		action.IdleTimeout = durationpb.New(opts.IdleTimeout.Duration)
+	}
+	if opts.PrefixRewrite != nil {
+		action.PrefixRewrite = *opts.PrefixRewrite
+	}
+	if opts.RegexRewrite != nil {
+		action.RegexRewrite = &envoy_type_matcher_v3.RegexMatchAndSubstitute{
+			Pattern:      &envoy_type_matcher_v3.RegexMatcher{Regex: opts.RegexRewrite.Pattern},
+			Substitution: opts.RegexRewrite.Substitution,
+		}
+	}
+	if opts.HostRewrite != nil {
+		action.HostRewriteSpecifier = &envoy_config_route_v3.RouteAction_HostRewriteLiteral{HostRewriteLiteral: *opts.HostRewrite}
+	}
+	if opts.RetryPolicy != nil {
+		rp := &envoy_config_route_v3.RetryPolicy{
+			RetryOn:              opts.RetryPolicy.RetryOn,
+			RetriableStatusCodes: opts.RetryPolicy.RetriableStatusCodes,
+		}
+		if opts.RetryPolicy.NumRetries != nil {
+			rp.NumRetries = wrapperspb.UInt32(*opts.RetryPolicy.NumRetries)
+		}
+		if opts.RetryPolicy.PerTryTimeout != nil {
+			rp.PerTryTimeout = durationpb.New(opts.RetryPolicy.PerTryTimeout.Duration)
+		}
+		action.RetryPolicy = rp
+	}
+
+	return action, nil
+}
+
+func RedirectAction_v1(opts *saasv1alpha1.RedirectAction) *envoy_config_route_v3.RedirectAction {
+	redirect := &envoy_config_route_v3.RedirectAction{}
+
+	if opts.HttpsRedirect != nil {
+		redirect.SchemeRewriteSpecifier = &envoy_config_route_v3.RedirectAction_HttpsRedirect{HttpsRedirect: *opts.HttpsRedirect}
+	}
+	if opts.HostRedirect != nil {
+		redirect.HostRedirect = *opts.HostRedirect
+	}
+	if opts.PathRedirect != nil {
+		redirect.PathRewriteSpecifier = &envoy_config_route_v3.RedirectAction_PathRedirect{PathRedirect: *opts.PathRedirect}
+	} else if opts.PrefixRewrite != nil {
+		redirect.PathRewriteSpecifier = &envoy_config_route_v3.RedirectAction_PrefixRewrite{PrefixRewrite: *opts.PrefixRewrite}
+	}
+	if opts.ResponseCode != nil {
+		redirect.ResponseCode = map[saasv1alpha1.RedirectResponseCode]envoy_config_route_v3.RedirectAction_RedirectResponseCode{
+			saasv1alpha1.RedirectResponseCodeMovedPermanently:  envoy_config_route_v3.RedirectAction_MOVED_PERMANENTLY,
+			saasv1alpha1.RedirectResponseCodeFound:             envoy_config_route_v3.RedirectAction_FOUND,
+			saasv1alpha1.RedirectResponseCodeSeeOther:          envoy_config_route_v3.RedirectAction_SEE_OTHER,
+			saasv1alpha1.RedirectResponseCodeTemporaryRedirect: envoy_config_route_v3.RedirectAction_TEMPORARY_REDIRECT,
+			saasv1alpha1.RedirectResponseCodePermanentRedirect: envoy_config_route_v3.RedirectAction_PERMANENT_REDIRECT,
+		}[*opts.ResponseCode]
+	}
+	if opts.StripQuery != nil {
+		redirect.StripQuery = *opts.StripQuery
+	}
+
+	return redirect
+}
+
+func RateLimits_v1(opts []saasv1alpha1.RateLimit) ([]*envoy_config_route_v3.RateLimit, error) {
+	if len(opts) == 0 {
+		return nil, nil
+	}
+
+	rls := make([]*envoy_config_route_v3.RateLimit, 0, len(opts))
+	for i, rl := range opts {
+		actions := make([]*envoy_config_route_v3.RateLimit_Action, 0, len(rl.Actions))
+		for j, a := range rl.Actions {
+			action, err := RateLimitAction_v1(&a)
+			if err != nil {
+				return nil, fmt.Errorf("rateLimits[%d].actions[%d]: %w", i, j, err)
+			}
+			actions = append(actions, action)
+		}
+		rls = append(rls, &envoy_config_route_v3.RateLimit{Actions: actions})
+	}
+	return rls, nil
+}
+
+func RateLimitAction_v1(opts *saasv1alpha1.RateLimitAction) (*envoy_config_route_v3.RateLimit_Action, error) {
+	action := &envoy_config_route_v3.RateLimit_Action{}
+
+	switch {
+	case countSet(opts.RemoteAddress != nil, opts.RequestHeader != nil, opts.GenericKey != nil, opts.HeaderValueMatch != nil) != 1:
+		return nil, fmt.Errorf("exactly one of 'remoteAddress', 'requestHeader', 'genericKey' or 'headerValueMatch' must be set")
+
+	case opts.RemoteAddress != nil:
+		action.ActionSpecifier = &envoy_config_route_v3.RateLimit_Action_RemoteAddress_{
+			RemoteAddress: &envoy_config_route_v3.RateLimit_Action_RemoteAddress{},
+		}
+
+	case opts.RequestHeader != nil:
+		rh := &envoy_config_route_v3.RateLimit_Action_RequestHeaders{
+			HeaderName:    opts.RequestHeader.HeaderName,
+			DescriptorKey: opts.RequestHeader.DescriptorKey,
+		}
+		if opts.RequestHeader.SkipIfAbsent != nil {
+			rh.SkipIfAbsent = *opts.RequestHeader.SkipIfAbsent
+		}
+		action.ActionSpecifier = &envoy_config_route_v3.RateLimit_Action_RequestHeaders_{RequestHeaders: rh}
+
+	case opts.GenericKey != nil:
+		gk := &envoy_config_route_v3.RateLimit_Action_GenericKey{
+			DescriptorValue: opts.GenericKey.DescriptorValue,
+		}
+		if opts.GenericKey.DescriptorKey != nil {
+			gk.DescriptorKey = *opts.GenericKey.DescriptorKey
+		}
+		action.ActionSpecifier = &envoy_config_route_v3.RateLimit_Action_GenericKey_{GenericKey: gk}
+
+	case opts.HeaderValueMatch != nil:
+		headers, err := HeaderMatchers_v1(opts.HeaderValueMatch.Headers)
+		if err != nil {
+			return nil, fmt.Errorf("headerValueMatch: %w", err)
+		}
+		hvm := &envoy_config_route_v3.RateLimit_Action_HeaderValueMatch{
+			DescriptorValue: opts.HeaderValueMatch.DescriptorValue,
+			Headers:         headers,
+		}
+		if opts.HeaderValueMatch.ExpectMatch != nil {
+			hvm.ExpectMatch = wrapperspb.Bool(*opts.HeaderValueMatch.ExpectMatch)
+		}
+		action.ActionSpecifier = &envoy_config_route_v3.RateLimit_Action_HeaderValueMatch_{HeaderValueMatch: hvm}
+	}
+
+	return action, nil
+}
+
+// countSet returns how many of the given conditions are true
+func countSet(conditions ...bool) int {
+	n := 0
+	for _, c := range conditions {
+		if c {
+			n++
+		}
+	}
+	return n
+}
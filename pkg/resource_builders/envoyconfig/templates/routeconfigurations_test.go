@@ -2,10 +2,13 @@ package templates
 
 import (
 	"testing"
+	"time"
 
+	"github.com/3scale-ops/basereconciler/util"
 	envoy_serializer_v3 "github.com/3scale-ops/marin3r/pkg/envoy/serializer/v3"
 	saasv1alpha1 "github.com/3scale-ops/saas-operator/api/v1alpha1"
 	"github.com/MakeNowJust/heredoc"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/yaml"
 )
@@ -61,6 +64,279 @@ func TestRouteConfiguration_v1(t *testing.T) {
 			`),
 			wantErr: false,
 		},
+		{
+			name: "Generate a route from typed virtual hosts",
+			args: args{
+				name: "my_route",
+				opts: &saasv1alpha1.RouteConfiguration{
+					TypedVirtualHosts: []saasv1alpha1.VirtualHost{{
+						Name:    "example",
+						Domains: []string{"example.com"},
+						RateLimits: []saasv1alpha1.RateLimit{{
+							Actions: []saasv1alpha1.RateLimitAction{{RemoteAddress: util.Pointer(true)}},
+						}},
+						Routes: []saasv1alpha1.Route{
+							{
+								Match: saasv1alpha1.RouteMatch{
+									Prefix:  util.Pointer("/api/"),
+									Headers: []saasv1alpha1.HeaderMatcher{{Name: "x-canary", Exact: util.Pointer("true")}},
+								},
+								Route: &saasv1alpha1.RouteAction{
+									WeightedClusters: []saasv1alpha1.WeightedCluster{{Name: "stable", Weight: 90}, {Name: "canary", Weight: 10}},
+									Timeout:          &metav1.Duration{Duration: 30 * time.Second},
+									PrefixRewrite:    util.Pointer("/"),
+									RetryPolicy: &saasv1alpha1.RetryPolicy{
+										RetryOn:              "retriable-status-codes",
+										NumRetries:           util.Pointer(uint32(2)),
+										RetriableStatusCodes: []uint32{503},
+									},
+									RateLimits: []saasv1alpha1.RateLimit{{
+										Actions: []saasv1alpha1.RateLimitAction{
+											{GenericKey: &saasv1alpha1.RateLimitGenericKey{DescriptorValue: "api"}},
+											{RequestHeader: &saasv1alpha1.RateLimitRequestHeader{HeaderName: "x-user", DescriptorKey: "user"}},
+										},
+									}},
+								},
+							},
+							{
+								Match: saasv1alpha1.RouteMatch{Regex: util.Pointer("^/v[0-9]+/.*")},
+								Route: &saasv1alpha1.RouteAction{
+									Cluster:      util.Pointer("example_cluster"),
+									RegexRewrite: &saasv1alpha1.RegexRewrite{Pattern: "^/v[0-9]+/(.*)", Substitution: "/\\1"},
+									HostRewrite:  util.Pointer("internal.example.com"),
+								},
+							},
+							{
+								Match:    saasv1alpha1.RouteMatch{Path: util.Pointer("/old")},
+								Redirect: &saasv1alpha1.RedirectAction{PathRedirect: util.Pointer("/new"), ResponseCode: util.Pointer(saasv1alpha1.RedirectResponseCodeFound)},
+							},
+							{
+								Match:          saasv1alpha1.RouteMatch{Prefix: util.Pointer("/")},
+								DirectResponse: &saasv1alpha1.DirectResponseAction{Status: 404, Body: util.Pointer("not found")},
+							},
+						},
+					}},
+				},
+			},
+			want: heredoc.Doc(`
+                name: my_route
+                virtual_hosts:
+                - domains:
+                  - example.com
+                  name: example
+                  rate_limits:
+                  - actions:
+                    - remote_address: {}
+                  routes:
+                  - match:
+                      headers:
+                      - name: x-canary
+                        string_match:
+                          exact: "true"
+                      prefix: /api/
+                    route:
+                      prefix_rewrite: /
+                      rate_limits:
+                      - actions:
+                        - generic_key:
+                            descriptor_value: api
+                        - request_headers:
+                            descriptor_key: user
+                            header_name: x-user
+                      retry_policy:
+                        num_retries: 2
+                        retriable_status_codes:
+                        - 503
+                        retry_on: retriable-status-codes
+                      timeout: 30s
+                      weighted_clusters:
+                        clusters:
+                        - name: stable
+                          weight: 90
+                        - name: canary
+                          weight: 10
+                  - match:
+                      safe_regex:
+                        regex: ^/v[0-9]+/.*
+                    route:
+                      cluster: example_cluster
+                      host_rewrite_literal: internal.example.com
+                      regex_rewrite:
+                        pattern:
+                          regex: ^/v[0-9]+/(.*)
+                        substitution: /\1
+                  - match:
+                      path: /old
+                    redirect:
+                      path_redirect: /new
+                      response_code: FOUND
+                  - direct_response:
+                      body:
+                        inline_string: not found
+                      status: 404
+                    match:
+                      prefix: /
+			`),
+			wantErr: false,
+		},
+		{
+			name: "Fails if more than one path matcher is set",
+			args: args{
+				name: "my_route",
+				opts: &saasv1alpha1.RouteConfiguration{
+					TypedVirtualHosts: []saasv1alpha1.VirtualHost{{
+						Name:    "example",
+						Domains: []string{"example.com"},
+						Routes: []saasv1alpha1.Route{{
+							Match: saasv1alpha1.RouteMatch{Prefix: util.Pointer("/"), Path: util.Pointer("/path")},
+							Route: &saasv1alpha1.RouteAction{Cluster: util.Pointer("example_cluster")},
+						}},
+					}},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Fails if the generated proto is not valid",
+			args: args{
+				name: "my_route",
+				opts: &saasv1alpha1.RouteConfiguration{
+					TypedVirtualHosts: []saasv1alpha1.VirtualHost{{
+						Name:    "example",
+						Domains: []string{"example.com"},
+						Routes: []saasv1alpha1.Route{{
+							Match:          saasv1alpha1.RouteMatch{Prefix: util.Pointer("/")},
+							DirectResponse: &saasv1alpha1.DirectResponseAction{Status: 99},
+						}},
+					}},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Does not validate the raw virtual hosts",
+			args: args{
+				name: "my_route",
+				opts: &saasv1alpha1.RouteConfiguration{
+					VirtualHosts: []runtime.RawExtension{
+						{Raw: []byte(`{"name":"example","routes":[{"direct_response":{"status":"99"},"match":{"prefix":"/"}}]}`)},
+					},
+				},
+			},
+			want: heredoc.Doc(`
+                name: my_route
+                virtual_hosts:
+                - name: example
+                  routes:
+                  - direct_response:
+                      status: 99
+                    match:
+                      prefix: /
+			`),
+		},
+		{
+			name: "Generate a route with a local rate limit",
+			args: args{
+				name: "my_route",
+				opts: &saasv1alpha1.RouteConfiguration{
+					TypedVirtualHosts: []saasv1alpha1.VirtualHost{{
+						Name:    "example",
+						Domains: []string{"example.com"},
+						Routes: []saasv1alpha1.Route{{
+							Match: saasv1alpha1.RouteMatch{Prefix: util.Pointer("/")},
+							Route: &saasv1alpha1.RouteAction{Cluster: util.Pointer("example_cluster")},
+							LocalRateLimit: &saasv1alpha1.LocalRateLimit{
+								TokenBucket: &saasv1alpha1.TokenBucket{MaxTokens: 10, FillInterval: metav1.Duration{Duration: time.Minute}},
+							},
+						}},
+					}},
+				},
+			},
+			want: heredoc.Doc(`
+                name: my_route
+                virtual_hosts:
+                - domains:
+                  - example.com
+                  name: example
+                  routes:
+                  - match:
+                      prefix: /
+                    route:
+                      cluster: example_cluster
+                    typed_per_filter_config:
+                      envoy.filters.http.local_ratelimit:
+                        '@type': type.googleapis.com/envoy.extensions.filters.http.local_ratelimit.v3.LocalRateLimit
+                        filter_enabled:
+                          default_value:
+                            numerator: 100
+                          runtime_key: local_rate_limit.my_route.example.0.enabled
+                        filter_enforced:
+                          default_value:
+                            numerator: 100
+                          runtime_key: local_rate_limit.my_route.example.0.enforced
+                        stat_prefix: http_local_rate_limiter
+                        token_bucket:
+                          fill_interval: 60s
+                          max_tokens: 10
+			`),
+			wantErr: false,
+		},
+		{
+			name: "Generate a route with authentication and authorization disabled",
+			args: args{
+				name: "my_route",
+				opts: &saasv1alpha1.RouteConfiguration{
+					TypedVirtualHosts: []saasv1alpha1.VirtualHost{{
+						Name:    "example",
+						Domains: []string{"example.com"},
+						Routes: []saasv1alpha1.Route{{
+							Match:           saasv1alpha1.RouteMatch{Prefix: util.Pointer("/health")},
+							DirectResponse:  &saasv1alpha1.DirectResponseAction{Status: 200},
+							DisableExtAuthz: util.Pointer(true),
+							DisableJwtAuthn: util.Pointer(true),
+						}},
+					}},
+				},
+			},
+			want: heredoc.Doc(`
+                name: my_route
+                virtual_hosts:
+                - domains:
+                  - example.com
+                  name: example
+                  routes:
+                  - direct_response:
+                      status: 200
+                    match:
+                      prefix: /health
+                    typed_per_filter_config:
+                      envoy.filters.http.ext_authz:
+                        '@type': type.googleapis.com/envoy.extensions.filters.http.ext_authz.v3.ExtAuthzPerRoute
+                        disabled: true
+                      envoy.filters.http.jwt_authn:
+                        '@type': type.googleapis.com/envoy.extensions.filters.http.jwt_authn.v3.PerRouteConfig
+                        disabled: true
+			`),
+			wantErr: false,
+		},
+		{
+			name: "Fails if the local rate limit of a route has no token bucket",
+			args: args{
+				name: "my_route",
+				opts: &saasv1alpha1.RouteConfiguration{
+					TypedVirtualHosts: []saasv1alpha1.VirtualHost{{
+						Name:    "example",
+						Domains: []string{"example.com"},
+						Routes: []saasv1alpha1.Route{{
+							Match:          saasv1alpha1.RouteMatch{Prefix: util.Pointer("/")},
+							Route:          &saasv1alpha1.RouteAction{Cluster: util.Pointer("example_cluster")},
+							LocalRateLimit: &saasv1alpha1.LocalRateLimit{},
+						}},
+					}},
+				},
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -69,6 +345,9 @@ func TestRouteConfiguration_v1(t *testing.T) {
 				t.Errorf("RouteConfiguration_v1() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
+			if tt.wantErr {
+				return
+			}
 
 			j, err := envoy_serializer_v3.JSON{}.Marshal(got)
 			if err != nil {
@@ -54,6 +54,20 @@ func New(key types.NamespacedName, labels map[string]string, cfg saasv1alpha1.Ho
 			}
 		}
 
+		for i := range cfg.PodsMetrics {
+			hpa.Spec.Metrics = append(hpa.Spec.Metrics, autoscalingv2.MetricSpec{
+				Type: autoscalingv2.PodsMetricSourceType,
+				Pods: &cfg.PodsMetrics[i],
+			})
+		}
+
+		for i := range cfg.ExternalMetrics {
+			hpa.Spec.Metrics = append(hpa.Spec.Metrics, autoscalingv2.MetricSpec{
+				Type:     autoscalingv2.ExternalMetricSourceType,
+				External: &cfg.ExternalMetrics[i],
+			})
+		}
+
 		return &hpa, nil
 	}
 }
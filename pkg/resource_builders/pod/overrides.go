@@ -0,0 +1,68 @@
+package pod
+
+import (
+	"github.com/3scale-ops/basereconciler/util"
+	saasv1alpha1 "github.com/3scale-ops/saas-operator/api/v1alpha1"
+	"github.com/samber/lo"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ApplyOverrides adds the pod-level overrides of a workload to a Pod template. Extra env
+// vars and volume mounts are added to the containers already present in the template, replacing
+// env vars with the same name. Topology spread constraints without a label selector are set
+// to select the Pods matching 'selector'.
+func ApplyOverrides(tpl *corev1.PodTemplateSpec, overrides *saasv1alpha1.PodTemplateOverrides, selector map[string]string) {
+
+	for i := range tpl.Spec.Containers {
+		tpl.Spec.Containers[i].Env = mergeEnv(tpl.Spec.Containers[i].Env, overrides.ExtraEnv)
+		tpl.Spec.Containers[i].VolumeMounts = append(tpl.Spec.Containers[i].VolumeMounts, overrides.ExtraVolumeMounts...)
+	}
+	for i := range tpl.Spec.InitContainers {
+		tpl.Spec.InitContainers[i].Env = mergeEnv(tpl.Spec.InitContainers[i].Env, overrides.ExtraEnv)
+		tpl.Spec.InitContainers[i].VolumeMounts = append(tpl.Spec.InitContainers[i].VolumeMounts, overrides.ExtraVolumeMounts...)
+	}
+
+	for _, v := range overrides.ExtraVolumes {
+		tpl.Spec.Volumes = append(tpl.Spec.Volumes, corev1.Volume(v))
+	}
+	for _, c := range overrides.InitContainers {
+		tpl.Spec.InitContainers = append(tpl.Spec.InitContainers, corev1.Container(c))
+	}
+	for _, c := range overrides.Sidecars {
+		tpl.Spec.Containers = append(tpl.Spec.Containers, corev1.Container(c))
+	}
+
+	for _, tsc := range overrides.TopologySpreadConstraints {
+		if tsc.LabelSelector == nil {
+			tsc.LabelSelector = &metav1.LabelSelector{MatchLabels: selector}
+		}
+		tpl.Spec.TopologySpreadConstraints = append(tpl.Spec.TopologySpreadConstraints, tsc)
+	}
+
+	if overrides.PriorityClassName != nil {
+		tpl.Spec.PriorityClassName = *overrides.PriorityClassName
+	}
+	if overrides.ServiceAccountName != nil {
+		tpl.Spec.ServiceAccountName = *overrides.ServiceAccountName
+	}
+	if overrides.SecurityContext != nil {
+		tpl.Spec.SecurityContext = overrides.SecurityContext
+	}
+
+	if len(overrides.PodAnnotations) > 0 {
+		tpl.ObjectMeta.Annotations = util.MergeMaps(map[string]string{}, overrides.PodAnnotations, tpl.ObjectMeta.Annotations)
+	}
+}
+
+// mergeEnv adds the extra env vars to the list, replacing
+// those with the same name
+func mergeEnv(env []corev1.EnvVar, extra []corev1.EnvVar) []corev1.EnvVar {
+	if len(extra) == 0 {
+		return env
+	}
+	merged := lo.Filter(env, func(e corev1.EnvVar, _ int) bool {
+		return !lo.ContainsBy(extra, func(x corev1.EnvVar) bool { return x.Name == e.Name })
+	})
+	return append(merged, extra...)
+}
@@ -0,0 +1,99 @@
+package pod
+
+import (
+	"testing"
+
+	"github.com/3scale-ops/basereconciler/util"
+	saasv1alpha1 "github.com/3scale-ops/saas-operator/api/v1alpha1"
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestApplyOverrides(t *testing.T) {
+	template := func() *corev1.PodTemplateSpec {
+		return &corev1.PodTemplateSpec{
+			ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{"operator": "value"}},
+			Spec: corev1.PodSpec{
+				InitContainers: []corev1.Container{{Name: "init"}},
+				Containers: []corev1.Container{
+					{Name: "main", Env: []corev1.EnvVar{{Name: "A", Value: "a"}, {Name: "B", Value: "b"}}},
+					{Name: "twemproxy"},
+				},
+				Volumes: []corev1.Volume{{Name: "config"}},
+			},
+		}
+	}
+	tests := []struct {
+		name      string
+		overrides *saasv1alpha1.PodTemplateOverrides
+		want      *corev1.PodTemplateSpec
+	}{
+		{
+			name:      "Empty overrides don't change the template",
+			overrides: &saasv1alpha1.PodTemplateOverrides{},
+			want:      template(),
+		},
+		{
+			name: "Applies the overrides",
+			overrides: &saasv1alpha1.PodTemplateOverrides{
+				TopologySpreadConstraints: []corev1.TopologySpreadConstraint{
+					{MaxSkew: 1, TopologyKey: corev1.LabelTopologyZone},
+					{MaxSkew: 2, TopologyKey: corev1.LabelHostname, LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"custom": "selector"}}},
+				},
+				PriorityClassName:  util.Pointer("high"),
+				SecurityContext:    &corev1.PodSecurityContext{RunAsNonRoot: util.Pointer(true)},
+				ServiceAccountName: util.Pointer("sa"),
+				ExtraVolumes:       []saasv1alpha1.SchemalessVolume{{Name: "extra"}},
+				ExtraVolumeMounts:  []corev1.VolumeMount{{Name: "extra", MountPath: "/extra"}},
+				ExtraEnv:           []corev1.EnvVar{{Name: "B", Value: "override"}, {Name: "C", Value: "c"}},
+				PodAnnotations:     map[string]string{"operator": "ignored", "user": "value"},
+				InitContainers:     []saasv1alpha1.SchemalessContainer{{Name: "user-init"}},
+				Sidecars:           []saasv1alpha1.SchemalessContainer{{Name: "sidecar"}},
+			},
+			want: &corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{"operator": "value", "user": "value"}},
+				Spec: corev1.PodSpec{
+					InitContainers: []corev1.Container{
+						{
+							Name:         "init",
+							Env:          []corev1.EnvVar{{Name: "B", Value: "override"}, {Name: "C", Value: "c"}},
+							VolumeMounts: []corev1.VolumeMount{{Name: "extra", MountPath: "/extra"}},
+						},
+						{Name: "user-init"},
+					},
+					Containers: []corev1.Container{
+						{
+							Name:         "main",
+							Env:          []corev1.EnvVar{{Name: "A", Value: "a"}, {Name: "B", Value: "override"}, {Name: "C", Value: "c"}},
+							VolumeMounts: []corev1.VolumeMount{{Name: "extra", MountPath: "/extra"}},
+						},
+						{
+							Name:         "twemproxy",
+							Env:          []corev1.EnvVar{{Name: "B", Value: "override"}, {Name: "C", Value: "c"}},
+							VolumeMounts: []corev1.VolumeMount{{Name: "extra", MountPath: "/extra"}},
+						},
+						{Name: "sidecar"},
+					},
+					Volumes: []corev1.Volume{{Name: "config"}, {Name: "extra"}},
+					TopologySpreadConstraints: []corev1.TopologySpreadConstraint{
+						{MaxSkew: 1, TopologyKey: corev1.LabelTopologyZone, LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"deployment": "workload"}}},
+						{MaxSkew: 2, TopologyKey: corev1.LabelHostname, LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"custom": "selector"}}},
+					},
+					PriorityClassName:  "high",
+					SecurityContext:    &corev1.PodSecurityContext{RunAsNonRoot: util.Pointer(true)},
+					ServiceAccountName: "sa",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := template()
+			ApplyOverrides(got, tt.overrides, map[string]string{"deployment": "workload"})
+			if diff := cmp.Diff(got, tt.want); len(diff) > 0 {
+				t.Errorf("ApplyOverrides() got diff %v", diff)
+			}
+		})
+	}
+}
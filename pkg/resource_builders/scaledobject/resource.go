@@ -0,0 +1,117 @@
+package scaledobject
+
+import (
+	"fmt"
+
+	saasv1alpha1 "github.com/3scale-ops/saas-operator/api/v1alpha1"
+	kedav1alpha1 "github.com/kedacore/keda/v2/apis/keda/v1alpha1"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	redisTriggerType      string = "redis"
+	prometheusTriggerType string = "prometheus"
+)
+
+// New returns a basereconciler_types.GeneratorFunction function that will return a KEDA ScaledObject
+// resource when called. The ScaledObject replaces the HorizontalPodAutoscaler of the workload,
+// so it is configured using the same HorizontalPodAutoscalerSpec.
+func New(key types.NamespacedName, labels map[string]string, cfg saasv1alpha1.HorizontalPodAutoscalerSpec) func(client.Object) (*kedav1alpha1.ScaledObject, error) {
+
+	return func(client.Object) (*kedav1alpha1.ScaledObject, error) {
+		so := kedav1alpha1.ScaledObject{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      key.Name,
+				Namespace: key.Namespace,
+				Labels:    labels,
+			},
+			Spec: kedav1alpha1.ScaledObjectSpec{
+				ScaleTargetRef: &kedav1alpha1.ScaleTarget{
+					APIVersion: appsv1.SchemeGroupVersion.String(),
+					Kind:       "Deployment",
+					Name:       key.Name,
+				},
+				MinReplicaCount: cfg.MinReplicas,
+				MaxReplicaCount: cfg.MaxReplicas,
+				Triggers:        []kedav1alpha1.ScaleTriggers{},
+			},
+		}
+
+		if cfg.Behavior != nil {
+			so.Spec.Advanced = &kedav1alpha1.AdvancedConfig{
+				HorizontalPodAutoscalerConfig: &kedav1alpha1.HorizontalPodAutoscalerConfig{
+					Behavior: cfg.Behavior,
+				},
+			}
+		}
+
+		if cfg.ResourceName != nil && cfg.ResourceUtilization != nil {
+			so.Spec.Triggers = append(so.Spec.Triggers, kedav1alpha1.ScaleTriggers{
+				Type:       *cfg.ResourceName,
+				MetricType: autoscalingv2.UtilizationMetricType,
+				Metadata:   map[string]string{"value": fmt.Sprintf("%d", *cfg.ResourceUtilization)},
+			})
+		}
+
+		if cfg.KEDA == nil {
+			return &so, nil
+		}
+
+		so.Spec.PollingInterval = cfg.KEDA.PollingInterval
+		so.Spec.CooldownPeriod = cfg.KEDA.CooldownPeriod
+
+		for _, t := range cfg.KEDA.Triggers {
+			trigger, err := scaleTrigger(t)
+			if err != nil {
+				return nil, err
+			}
+			so.Spec.Triggers = append(so.Spec.Triggers, trigger)
+		}
+
+		return &so, nil
+	}
+}
+
+func scaleTrigger(t saasv1alpha1.KEDATrigger) (kedav1alpha1.ScaleTriggers, error) {
+	trigger := kedav1alpha1.ScaleTriggers{}
+
+	switch {
+	case t.RedisListLength != nil && t.Prometheus != nil:
+		return trigger, fmt.Errorf("only one scaler type can be set per KEDA trigger")
+
+	case t.RedisListLength != nil:
+		trigger.Type = redisTriggerType
+		trigger.Metadata = map[string]string{
+			"address":    t.RedisListLength.Address,
+			"listName":   t.RedisListLength.ListName,
+			"listLength": fmt.Sprintf("%d", t.RedisListLength.ListLength),
+		}
+		if t.RedisListLength.DatabaseIndex != nil {
+			trigger.Metadata["databaseIndex"] = fmt.Sprintf("%d", *t.RedisListLength.DatabaseIndex)
+		}
+
+	case t.Prometheus != nil:
+		trigger.Type = prometheusTriggerType
+		trigger.Metadata = map[string]string{
+			"serverAddress": t.Prometheus.ServerAddress,
+			"query":         t.Prometheus.Query,
+			"threshold":     t.Prometheus.Threshold,
+		}
+
+	default:
+		return trigger, fmt.Errorf("a scaler type must be set for each KEDA trigger")
+	}
+
+	if t.Name != nil {
+		trigger.Name = *t.Name
+	}
+	if t.AuthenticationRef != nil {
+		trigger.AuthenticationRef = &kedav1alpha1.AuthenticationRef{Name: *t.AuthenticationRef}
+	}
+
+	return trigger, nil
+}
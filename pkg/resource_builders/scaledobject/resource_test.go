@@ -0,0 +1,121 @@
+package scaledobject
+
+import (
+	"testing"
+
+	"github.com/3scale-ops/basereconciler/util"
+	saasv1alpha1 "github.com/3scale-ops/saas-operator/api/v1alpha1"
+	"github.com/google/go-cmp/cmp"
+	kedav1alpha1 "github.com/kedacore/keda/v2/apis/keda/v1alpha1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestNew(t *testing.T) {
+	key := types.NamespacedName{Name: "worker", Namespace: "ns"}
+	tests := []struct {
+		name    string
+		cfg     saasv1alpha1.HorizontalPodAutoscalerSpec
+		want    *kedav1alpha1.ScaledObject
+		wantErr bool
+	}{
+		{
+			name: "Generates a ScaledObject with resource, redis and prometheus triggers",
+			cfg: saasv1alpha1.HorizontalPodAutoscalerSpec{
+				MinReplicas:         util.Pointer[int32](1),
+				MaxReplicas:         util.Pointer[int32](10),
+				ResourceName:        util.Pointer("cpu"),
+				ResourceUtilization: util.Pointer[int32](90),
+				Behavior:            &autoscalingv2.HorizontalPodAutoscalerBehavior{},
+				KEDA: &saasv1alpha1.KEDASpec{
+					PollingInterval: util.Pointer[int32](15),
+					Triggers: []saasv1alpha1.KEDATrigger{
+						{
+							Name: util.Pointer("queue"),
+							RedisListLength: &saasv1alpha1.KEDARedisListLengthTrigger{
+								Address:       "redis:6379",
+								ListName:      "resque:queue:priority",
+								ListLength:    100,
+								DatabaseIndex: util.Pointer[int32](1),
+							},
+							AuthenticationRef: util.Pointer("redis-auth"),
+						},
+						{
+							Prometheus: &saasv1alpha1.KEDAPrometheusTrigger{
+								ServerAddress: "http://prometheus:9090",
+								Query:         "sum(rate(requests[1m]))",
+								Threshold:     "0.5",
+							},
+						},
+					},
+				},
+			},
+			want: &kedav1alpha1.ScaledObject{
+				ObjectMeta: metav1.ObjectMeta{Name: "worker", Namespace: "ns", Labels: map[string]string{"app": "worker"}},
+				Spec: kedav1alpha1.ScaledObjectSpec{
+					ScaleTargetRef:  &kedav1alpha1.ScaleTarget{APIVersion: "apps/v1", Kind: "Deployment", Name: "worker"},
+					PollingInterval: util.Pointer[int32](15),
+					MinReplicaCount: util.Pointer[int32](1),
+					MaxReplicaCount: util.Pointer[int32](10),
+					Advanced: &kedav1alpha1.AdvancedConfig{
+						HorizontalPodAutoscalerConfig: &kedav1alpha1.HorizontalPodAutoscalerConfig{
+							Behavior: &autoscalingv2.HorizontalPodAutoscalerBehavior{},
+						},
+					},
+					Triggers: []kedav1alpha1.ScaleTriggers{
+						{Type: "cpu", MetricType: autoscalingv2.UtilizationMetricType, Metadata: map[string]string{"value": "90"}},
+						{
+							Type: "redis",
+							Name: "queue",
+							Metadata: map[string]string{
+								"address":       "redis:6379",
+								"listName":      "resque:queue:priority",
+								"listLength":    "100",
+								"databaseIndex": "1",
+							},
+							AuthenticationRef: &kedav1alpha1.AuthenticationRef{Name: "redis-auth"},
+						},
+						{
+							Type: "prometheus",
+							Metadata: map[string]string{
+								"serverAddress": "http://prometheus:9090",
+								"query":         "sum(rate(requests[1m]))",
+								"threshold":     "0.5",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Fails when a trigger has no scaler",
+			cfg: saasv1alpha1.HorizontalPodAutoscalerSpec{
+				KEDA: &saasv1alpha1.KEDASpec{Triggers: []saasv1alpha1.KEDATrigger{{Name: util.Pointer("empty")}}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Fails when a trigger has several scalers",
+			cfg: saasv1alpha1.HorizontalPodAutoscalerSpec{
+				KEDA: &saasv1alpha1.KEDASpec{Triggers: []saasv1alpha1.KEDATrigger{{
+					RedisListLength: &saasv1alpha1.KEDARedisListLengthTrigger{},
+					Prometheus:      &saasv1alpha1.KEDAPrometheusTrigger{},
+				}}},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := New(key, map[string]string{"app": "worker"}, tt.cfg)(nil)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("New() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if diff := cmp.Diff(got, tt.want); len(diff) > 0 {
+				t.Errorf("New() diff = %v", diff)
+			}
+		})
+	}
+}
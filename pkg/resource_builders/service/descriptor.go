@@ -20,13 +20,22 @@ func (sd *ServiceDescriptor) Service(prefix, suffix string) *corev1.Service {
 	strategy := sd.PublishingStrategy.Strategy
 
 	// generate Service resource
-	if strategy == saasv1alpha1.SimpleStrategy || strategy == saasv1alpha1.Marin3rSidecarStrategy {
+	if strategy == saasv1alpha1.SimpleStrategy || strategy == saasv1alpha1.Marin3rSidecarStrategy ||
+		strategy == saasv1alpha1.RouteStrategy {
 
 		var spec *saasv1alpha1.Simple
-		if strategy == saasv1alpha1.SimpleStrategy {
+		switch strategy {
+		case saasv1alpha1.SimpleStrategy:
 			spec = sd.PublishingStrategy.Simple
-		} else {
+		case saasv1alpha1.Marin3rSidecarStrategy:
 			spec = sd.PublishingStrategy.Marin3rSidecar.Simple
+		case saasv1alpha1.RouteStrategy:
+			// routes point to a ClusterIP Service unless told otherwise
+			if sd.PublishingStrategy.Route.Simple == nil {
+				sd.PublishingStrategy.Route.Simple = &saasv1alpha1.Simple{}
+			}
+			sd.PublishingStrategy.Route.Simple.Default()
+			spec = sd.PublishingStrategy.Route.Simple
 		}
 
 		switch *spec.ServiceType {
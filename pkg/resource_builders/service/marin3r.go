@@ -1,11 +1,15 @@
 package service
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
 	"strings"
 
 	"github.com/3scale-ops/basereconciler/util"
+	envoy_serializer_v3 "github.com/3scale-ops/marin3r/pkg/envoy/serializer/v3"
 	saasv1alpha1 "github.com/3scale-ops/saas-operator/api/v1alpha1"
+	"github.com/3scale-ops/saas-operator/pkg/resource_builders/envoyconfig/templates"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -45,6 +49,7 @@ func AddMarin3rSidecar(dep *appsv1.Deployment, spec saasv1alpha1.Marin3rSidecarS
 		portsAnnotation(spec.Ports),
 		defaultAnnotations,
 		spec.ExtraPodAnnotations,
+		extraArgsAnnotation(spec.StatsSink, spec.ExtraPodAnnotations),
 	)
 
 	return dep
@@ -133,3 +138,33 @@ func portsAnnotation(ports []saasv1alpha1.SidecarPort) map[string]string {
 
 	return nil
 }
+
+// extraArgsAnnotation passes the stats sink configuration to envoy with the '--config-yaml'
+// flag, which merges it into the bootstrap generated by marin3r. Marin3r splits the
+// extra args by spaces, so the configuration is passed as compact JSON. Extra args
+// set through the extra pod annotations are kept.
+func extraArgsAnnotation(statsSink *saasv1alpha1.StatsSinkOptions, extra map[string]string) map[string]string {
+	if statsSink == nil {
+		return nil
+	}
+
+	bootstrap, err := templates.StatsSinkBootstrap_v1(statsSink)
+	if err != nil {
+		// host and port are enforced by the CRD schema
+		return nil
+	}
+	j, err := envoy_serializer_v3.JSON{}.Marshal(bootstrap)
+	if err != nil {
+		return nil
+	}
+	compact := &bytes.Buffer{}
+	if err := json.Compact(compact, []byte(j)); err != nil {
+		return nil
+	}
+
+	args := "--config-yaml " + compact.String()
+	if value, ok := extra[marin3rDomain+"/envoy-extra-args"]; ok && value != "" {
+		args = value + " " + args
+	}
+	return map[string]string{marin3rDomain + "/envoy-extra-args": args}
+}
@@ -2,6 +2,7 @@ package service
 
 import (
 	"testing"
+	"time"
 
 	"github.com/3scale-ops/basereconciler/util"
 	saasv1alpha1 "github.com/3scale-ops/saas-operator/api/v1alpha1"
@@ -151,6 +152,47 @@ func TestAddMarin3rSidecar(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Adds the stats sink to the envoy extra args",
+			args: args{
+				dep: &appsv1.Deployment{
+					ObjectMeta: metav1.ObjectMeta{Name: "dep"},
+					Spec: appsv1.DeploymentSpec{
+						Template: corev1.PodTemplateSpec{
+							ObjectMeta: metav1.ObjectMeta{},
+						},
+					},
+				},
+				spec: saasv1alpha1.Marin3rSidecarSpec{
+					StatsSink: &saasv1alpha1.StatsSinkOptions{
+						CollectorHost: "otel-collector",
+						CollectorPort: 4317,
+						FlushInterval: &metav1.Duration{Duration: 10 * time.Second},
+					},
+					ExtraPodAnnotations: map[string]string{
+						"marin3r.3scale.net/envoy-extra-args": "--log-level debug",
+					},
+				},
+			},
+			want: &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Name: "dep"},
+				Spec: appsv1.DeploymentSpec{
+					Template: corev1.PodTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{
+							Annotations: map[string]string{
+								"marin3r.3scale.net/shutdown-manager.enabled": "true",
+								"marin3r.3scale.net/node-id":                  "dep",
+								"marin3r.3scale.net/envoy-extra-args": "--log-level debug --config-yaml " +
+									`{"static_resources":{"clusters":[{"name":"stats_collector","type":"STRICT_DNS","connect_timeout":"1s","load_assignment":{"cluster_name":"stats_collector","endpoints":[{"lb_endpoints":[{"endpoint":{"address":{"socket_address":{"address":"otel-collector","port_value":4317}}}}]}]},"typed_extension_protocol_options":{"envoy.extensions.upstreams.http.v3.HttpProtocolOptions":{"@type":"type.googleapis.com/envoy.extensions.upstreams.http.v3.HttpProtocolOptions","explicit_http_config":{"http2_protocol_options":{"initial_stream_window_size":65536,"initial_connection_window_size":1048576}}}},"dns_lookup_family":"V4_ONLY"}]},"stats_sinks":[{"name":"envoy.stat_sinks.open_telemetry","typed_config":{"@type":"type.googleapis.com/envoy.extensions.stat_sinks.open_telemetry.v3.SinkConfig","grpc_service":{"envoy_grpc":{"cluster_name":"stats_collector"}}}}],"stats_flush_interval":"10s"}`,
+							},
+							Labels: map[string]string{
+								"marin3r.3scale.net/status": "enabled",
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -105,8 +105,17 @@ func MergeWithDefaultPublishingStrategy(defaults []ServiceDescriptor, in *saasv1
 			switch i.PublishingStrategy.Strategy {
 			case saasv1alpha1.SimpleStrategy:
 				i.Marin3rSidecar = nil
+				i.Route = nil
 			case saasv1alpha1.Marin3rSidecarStrategy:
 				i.Simple = nil
+				i.Route = nil
+			case saasv1alpha1.RouteStrategy:
+				// keep the Service options of the default strategy
+				if i.Route != nil && i.Route.Simple == nil {
+					i.Route.Simple = i.Simple
+				}
+				i.Simple = nil
+				i.Marin3rSidecar = nil
 			}
 			out[index] = i
 		})
@@ -0,0 +1,218 @@
+package service
+
+import (
+	"fmt"
+
+	"github.com/3scale-ops/basereconciler/util"
+	saasv1alpha1 "github.com/3scale-ops/saas-operator/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+// RouteKind returns the kind of object used to publish the endpoint
+// when the Route strategy is used. Defaults to HTTPRoute.
+func (sd *ServiceDescriptor) RouteKind() saasv1alpha1.RouteKind {
+	if sd.Route == nil || sd.Route.Kind == nil {
+		return saasv1alpha1.RouteKindHTTPRoute
+	}
+	return *sd.Route.Kind
+}
+
+// HTTPRoute returns a Gateway API HTTPRoute that routes traffic to the given Service.
+// The HTTPRoute has the same name as the Service.
+func (sd *ServiceDescriptor) HTTPRoute(svc *corev1.Service) (*gatewayv1.HTTPRoute, error) {
+	backends, err := sd.routeBackends(svc)
+	if err != nil {
+		return nil, err
+	}
+
+	httpBackends := make([]gatewayv1.HTTPBackendRef, 0, len(backends))
+	for _, b := range backends {
+		httpBackends = append(httpBackends, gatewayv1.HTTPBackendRef{BackendRef: b})
+	}
+
+	return &gatewayv1.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{Name: svc.GetName()},
+		Spec: gatewayv1.HTTPRouteSpec{
+			CommonRouteSpec: gatewayv1.CommonRouteSpec{ParentRefs: sd.routeParentRefs()},
+			Hostnames:       sd.routeHostnames(),
+			Rules: []gatewayv1.HTTPRouteRule{{
+				Matches: []gatewayv1.HTTPRouteMatch{{
+					Path: &gatewayv1.HTTPPathMatch{
+						Type:  util.Pointer(gatewayv1.PathMatchPathPrefix),
+						Value: util.Pointer(sd.routePathPrefix()),
+					},
+				}},
+				BackendRefs: httpBackends,
+			}},
+		},
+	}, nil
+}
+
+// TLSRoute returns a Gateway API TLSRoute that routes TLS connections to the given
+// Service, matching them by SNI. The TLSRoute has the same name as the Service.
+func (sd *ServiceDescriptor) TLSRoute(svc *corev1.Service) (*gatewayv1alpha2.TLSRoute, error) {
+	backends, err := sd.routeBackends(svc)
+	if err != nil {
+		return nil, err
+	}
+
+	return &gatewayv1alpha2.TLSRoute{
+		ObjectMeta: metav1.ObjectMeta{Name: svc.GetName()},
+		Spec: gatewayv1alpha2.TLSRouteSpec{
+			CommonRouteSpec: gatewayv1.CommonRouteSpec{ParentRefs: sd.routeParentRefs()},
+			Hostnames:       sd.routeHostnames(),
+			Rules:           []gatewayv1alpha2.TLSRouteRule{{BackendRefs: backends}},
+		},
+	}, nil
+}
+
+// Ingress returns an Ingress that routes traffic to the given Service. Ingresses
+// don't support weighted backends, so ExtraBackends are ignored. The Ingress has
+// the same name as the Service.
+func (sd *ServiceDescriptor) Ingress(svc *corev1.Service) (*networkingv1.Ingress, error) {
+	port, err := sd.routePort(svc)
+	if err != nil {
+		return nil, err
+	}
+
+	backend := networkingv1.IngressBackend{
+		Service: &networkingv1.IngressServiceBackend{
+			Name: svc.GetName(),
+			Port: networkingv1.ServiceBackendPort{Number: port},
+		},
+	}
+	http := &networkingv1.HTTPIngressRuleValue{
+		Paths: []networkingv1.HTTPIngressPath{{
+			Path:     sd.routePathPrefix(),
+			PathType: util.Pointer(networkingv1.PathTypePrefix),
+			Backend:  backend,
+		}},
+	}
+
+	rules := []networkingv1.IngressRule{}
+	for _, host := range sd.Route.Hostnames {
+		rules = append(rules, networkingv1.IngressRule{
+			Host:             host,
+			IngressRuleValue: networkingv1.IngressRuleValue{HTTP: http.DeepCopy()},
+		})
+	}
+	if len(rules) == 0 {
+		rules = append(rules, networkingv1.IngressRule{IngressRuleValue: networkingv1.IngressRuleValue{HTTP: http}})
+	}
+
+	ingress := &networkingv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{Name: svc.GetName()},
+		Spec: networkingv1.IngressSpec{
+			IngressClassName: sd.Route.IngressClassName,
+			Rules:            rules,
+		},
+	}
+	if sd.Route.TLSSecretName != nil {
+		ingress.Spec.TLS = []networkingv1.IngressTLS{{
+			Hosts:      sd.Route.Hostnames,
+			SecretName: *sd.Route.TLSSecretName,
+		}}
+	}
+
+	return ingress, nil
+}
+
+// routeParentRefs returns the references to the Gateways. The defaults
+// of the Gateway API CRDs are explicitly set so the objects can be
+// compared with the ones stored in the API server.
+func (sd *ServiceDescriptor) routeParentRefs() []gatewayv1.ParentReference {
+	refs := make([]gatewayv1.ParentReference, 0, len(sd.Route.ParentRefs))
+	for _, ref := range sd.Route.ParentRefs {
+		refs = append(refs, gatewayv1.ParentReference{
+			Group:       util.Pointer(gatewayv1.Group(gatewayv1.GroupVersion.Group)),
+			Kind:        util.Pointer(gatewayv1.Kind("Gateway")),
+			Namespace:   (*gatewayv1.Namespace)(ref.Namespace),
+			Name:        gatewayv1.ObjectName(ref.Name),
+			SectionName: (*gatewayv1.SectionName)(ref.SectionName),
+		})
+	}
+	return refs
+}
+
+// routeHostnames returns the hostnames of the endpoint
+func (sd *ServiceDescriptor) routeHostnames() []gatewayv1.Hostname {
+	if sd.Route.Hostnames == nil {
+		return nil
+	}
+	hostnames := make([]gatewayv1.Hostname, 0, len(sd.Route.Hostnames))
+	for _, h := range sd.Route.Hostnames {
+		hostnames = append(hostnames, gatewayv1.Hostname(h))
+	}
+	return hostnames
+}
+
+// routeBackends returns the Service of the endpoint and the extra
+// backends, with the defaults of the Gateway API CRDs explicitly set
+func (sd *ServiceDescriptor) routeBackends(svc *corev1.Service) ([]gatewayv1.BackendRef, error) {
+	port, err := sd.routePort(svc)
+	if err != nil {
+		return nil, err
+	}
+
+	backend := func(name string, port int32, weight *int32) gatewayv1.BackendRef {
+		if weight == nil {
+			weight = util.Pointer(int32(1))
+		}
+		return gatewayv1.BackendRef{
+			BackendObjectReference: gatewayv1.BackendObjectReference{
+				Group: util.Pointer(gatewayv1.Group("")),
+				Kind:  util.Pointer(gatewayv1.Kind("Service")),
+				Name:  gatewayv1.ObjectName(name),
+				Port:  util.Pointer(gatewayv1.PortNumber(port)),
+			},
+			Weight: weight,
+		}
+	}
+
+	weight := sd.Route.Weight
+	if sd.Route.CanaryWeight != nil {
+		weight = util.Pointer(100 - *sd.Route.CanaryWeight)
+	}
+
+	backends := []gatewayv1.BackendRef{backend(svc.GetName(), port, weight)}
+	if sd.Route.CanaryWeight != nil {
+		backends = append(backends, backend(CanaryServiceName(svc.GetName()), port, sd.Route.CanaryWeight))
+	}
+	for _, extra := range sd.Route.ExtraBackends {
+		backends = append(backends, backend(extra.Name, extra.Port, extra.Weight))
+	}
+	return backends, nil
+}
+
+// CanaryServiceName returns the name of the Service that selects the canary
+// Pods when traffic is shifted to the canary using route weights
+func CanaryServiceName(name string) string {
+	return name + "-canary"
+}
+
+// routePort returns the port of the Service that the route points to
+func (sd *ServiceDescriptor) routePort(svc *corev1.Service) (int32, error) {
+	if len(svc.Spec.Ports) == 0 {
+		return 0, fmt.Errorf("service '%s' has no ports", svc.GetName())
+	}
+	if sd.Route.Port == nil {
+		return svc.Spec.Ports[0].Port, nil
+	}
+	for _, p := range svc.Spec.Ports {
+		if p.Name == *sd.Route.Port {
+			return p.Port, nil
+		}
+	}
+	return 0, fmt.Errorf("service '%s' has no port named '%s'", svc.GetName(), *sd.Route.Port)
+}
+
+func (sd *ServiceDescriptor) routePathPrefix() string {
+	if sd.Route.PathPrefix != nil {
+		return *sd.Route.PathPrefix
+	}
+	return "/"
+}
@@ -0,0 +1,316 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/3scale-ops/basereconciler/util"
+	saasv1alpha1 "github.com/3scale-ops/saas-operator/api/v1alpha1"
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+func testRouteService() *corev1.Service {
+	return &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "backend-listener", Namespace: "ns"},
+		Spec: corev1.ServiceSpec{
+			Ports: []corev1.ServicePort{
+				{Name: "http", Port: 80},
+				{Name: "https", Port: 443},
+			},
+		},
+	}
+}
+
+func routeDescriptor(spec *saasv1alpha1.RouteStrategySpec) *ServiceDescriptor {
+	return &ServiceDescriptor{
+		PublishingStrategy: saasv1alpha1.PublishingStrategy{
+			Strategy:     saasv1alpha1.RouteStrategy,
+			EndpointName: "HTTP",
+			Route:        spec,
+		},
+	}
+}
+
+func TestServiceDescriptor_HTTPRoute(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    *saasv1alpha1.RouteStrategySpec
+		want    *gatewayv1.HTTPRoute
+		wantErr bool
+	}{
+		{
+			name: "Generates an HTTPRoute with defaults",
+			spec: &saasv1alpha1.RouteStrategySpec{
+				ParentRefs: []saasv1alpha1.RouteParentRef{{Name: "gw", Namespace: util.Pointer("gateways")}},
+				Hostnames:  []string{"backend.example.com"},
+			},
+			want: &gatewayv1.HTTPRoute{
+				ObjectMeta: metav1.ObjectMeta{Name: "backend-listener"},
+				Spec: gatewayv1.HTTPRouteSpec{
+					CommonRouteSpec: gatewayv1.CommonRouteSpec{
+						ParentRefs: []gatewayv1.ParentReference{{
+							Group:     util.Pointer(gatewayv1.Group("gateway.networking.k8s.io")),
+							Kind:      util.Pointer(gatewayv1.Kind("Gateway")),
+							Namespace: util.Pointer(gatewayv1.Namespace("gateways")),
+							Name:      "gw",
+						}},
+					},
+					Hostnames: []gatewayv1.Hostname{"backend.example.com"},
+					Rules: []gatewayv1.HTTPRouteRule{{
+						Matches: []gatewayv1.HTTPRouteMatch{{
+							Path: &gatewayv1.HTTPPathMatch{
+								Type:  util.Pointer(gatewayv1.PathMatchPathPrefix),
+								Value: util.Pointer("/"),
+							},
+						}},
+						BackendRefs: []gatewayv1.HTTPBackendRef{{
+							BackendRef: gatewayv1.BackendRef{
+								BackendObjectReference: gatewayv1.BackendObjectReference{
+									Group: util.Pointer(gatewayv1.Group("")),
+									Kind:  util.Pointer(gatewayv1.Kind("Service")),
+									Name:  "backend-listener",
+									Port:  util.Pointer(gatewayv1.PortNumber(80)),
+								},
+								Weight: util.Pointer(int32(1)),
+							},
+						}},
+					}},
+				},
+			},
+		},
+		{
+			name: "Generates an HTTPRoute with weighted backends",
+			spec: &saasv1alpha1.RouteStrategySpec{
+				ParentRefs:    []saasv1alpha1.RouteParentRef{{Name: "gw", SectionName: util.Pointer("https")}},
+				PathPrefix:    util.Pointer("/api"),
+				Port:          util.Pointer("https"),
+				Weight:        util.Pointer(int32(90)),
+				ExtraBackends: []saasv1alpha1.RouteBackend{{Name: "backend-listener-canary", Port: 443, Weight: util.Pointer(int32(10))}},
+			},
+			want: &gatewayv1.HTTPRoute{
+				ObjectMeta: metav1.ObjectMeta{Name: "backend-listener"},
+				Spec: gatewayv1.HTTPRouteSpec{
+					CommonRouteSpec: gatewayv1.CommonRouteSpec{
+						ParentRefs: []gatewayv1.ParentReference{{
+							Group:       util.Pointer(gatewayv1.Group("gateway.networking.k8s.io")),
+							Kind:        util.Pointer(gatewayv1.Kind("Gateway")),
+							Name:        "gw",
+							SectionName: util.Pointer(gatewayv1.SectionName("https")),
+						}},
+					},
+					Rules: []gatewayv1.HTTPRouteRule{{
+						Matches: []gatewayv1.HTTPRouteMatch{{
+							Path: &gatewayv1.HTTPPathMatch{
+								Type:  util.Pointer(gatewayv1.PathMatchPathPrefix),
+								Value: util.Pointer("/api"),
+							},
+						}},
+						BackendRefs: []gatewayv1.HTTPBackendRef{
+							{BackendRef: gatewayv1.BackendRef{
+								BackendObjectReference: gatewayv1.BackendObjectReference{
+									Group: util.Pointer(gatewayv1.Group("")),
+									Kind:  util.Pointer(gatewayv1.Kind("Service")),
+									Name:  "backend-listener",
+									Port:  util.Pointer(gatewayv1.PortNumber(443)),
+								},
+								Weight: util.Pointer(int32(90)),
+							}},
+							{BackendRef: gatewayv1.BackendRef{
+								BackendObjectReference: gatewayv1.BackendObjectReference{
+									Group: util.Pointer(gatewayv1.Group("")),
+									Kind:  util.Pointer(gatewayv1.Kind("Service")),
+									Name:  "backend-listener-canary",
+									Port:  util.Pointer(gatewayv1.PortNumber(443)),
+								},
+								Weight: util.Pointer(int32(10)),
+							}},
+						},
+					}},
+				},
+			},
+		},
+		{
+			name: "Splits traffic with the canary Service",
+			spec: &saasv1alpha1.RouteStrategySpec{
+				ParentRefs:   []saasv1alpha1.RouteParentRef{{Name: "gw"}},
+				CanaryWeight: util.Pointer(int32(20)),
+			},
+			want: &gatewayv1.HTTPRoute{
+				ObjectMeta: metav1.ObjectMeta{Name: "backend-listener"},
+				Spec: gatewayv1.HTTPRouteSpec{
+					CommonRouteSpec: gatewayv1.CommonRouteSpec{
+						ParentRefs: []gatewayv1.ParentReference{{
+							Group: util.Pointer(gatewayv1.Group("gateway.networking.k8s.io")),
+							Kind:  util.Pointer(gatewayv1.Kind("Gateway")),
+							Name:  "gw",
+						}},
+					},
+					Rules: []gatewayv1.HTTPRouteRule{{
+						Matches: []gatewayv1.HTTPRouteMatch{{
+							Path: &gatewayv1.HTTPPathMatch{
+								Type:  util.Pointer(gatewayv1.PathMatchPathPrefix),
+								Value: util.Pointer("/"),
+							},
+						}},
+						BackendRefs: []gatewayv1.HTTPBackendRef{
+							{BackendRef: gatewayv1.BackendRef{
+								BackendObjectReference: gatewayv1.BackendObjectReference{
+									Group: util.Pointer(gatewayv1.Group("")),
+									Kind:  util.Pointer(gatewayv1.Kind("Service")),
+									Name:  "backend-listener",
+									Port:  util.Pointer(gatewayv1.PortNumber(80)),
+								},
+								Weight: util.Pointer(int32(80)),
+							}},
+							{BackendRef: gatewayv1.BackendRef{
+								BackendObjectReference: gatewayv1.BackendObjectReference{
+									Group: util.Pointer(gatewayv1.Group("")),
+									Kind:  util.Pointer(gatewayv1.Kind("Service")),
+									Name:  "backend-listener-canary",
+									Port:  util.Pointer(gatewayv1.PortNumber(80)),
+								},
+								Weight: util.Pointer(int32(20)),
+							}},
+						},
+					}},
+				},
+			},
+		},
+		{
+			name: "Fails if the port does not exist in the Service",
+			spec: &saasv1alpha1.RouteStrategySpec{
+				ParentRefs: []saasv1alpha1.RouteParentRef{{Name: "gw"}},
+				Port:       util.Pointer("grpc"),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := routeDescriptor(tt.spec).HTTPRoute(testRouteService())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ServiceDescriptor.HTTPRoute() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if diff := cmp.Diff(got, tt.want); len(diff) > 0 {
+				t.Errorf("ServiceDescriptor.HTTPRoute() diff = %v", diff)
+			}
+		})
+	}
+}
+
+func TestServiceDescriptor_TLSRoute(t *testing.T) {
+	spec := &saasv1alpha1.RouteStrategySpec{
+		Kind:       util.Pointer(saasv1alpha1.RouteKindTLSRoute),
+		ParentRefs: []saasv1alpha1.RouteParentRef{{Name: "gw"}},
+		Hostnames:  []string{"backend.example.com"},
+		Port:       util.Pointer("https"),
+	}
+	want := &gatewayv1alpha2.TLSRoute{
+		ObjectMeta: metav1.ObjectMeta{Name: "backend-listener"},
+		Spec: gatewayv1alpha2.TLSRouteSpec{
+			CommonRouteSpec: gatewayv1.CommonRouteSpec{
+				ParentRefs: []gatewayv1.ParentReference{{
+					Group: util.Pointer(gatewayv1.Group("gateway.networking.k8s.io")),
+					Kind:  util.Pointer(gatewayv1.Kind("Gateway")),
+					Name:  "gw",
+				}},
+			},
+			Hostnames: []gatewayv1.Hostname{"backend.example.com"},
+			Rules: []gatewayv1alpha2.TLSRouteRule{{
+				BackendRefs: []gatewayv1.BackendRef{{
+					BackendObjectReference: gatewayv1.BackendObjectReference{
+						Group: util.Pointer(gatewayv1.Group("")),
+						Kind:  util.Pointer(gatewayv1.Kind("Service")),
+						Name:  "backend-listener",
+						Port:  util.Pointer(gatewayv1.PortNumber(443)),
+					},
+					Weight: util.Pointer(int32(1)),
+				}},
+			}},
+		},
+	}
+
+	got, err := routeDescriptor(spec).TLSRoute(testRouteService())
+	if err != nil {
+		t.Fatalf("ServiceDescriptor.TLSRoute() error = %v", err)
+	}
+	if diff := cmp.Diff(got, want); len(diff) > 0 {
+		t.Errorf("ServiceDescriptor.TLSRoute() diff = %v", diff)
+	}
+}
+
+func TestServiceDescriptor_Ingress(t *testing.T) {
+	backend := networkingv1.IngressBackend{
+		Service: &networkingv1.IngressServiceBackend{
+			Name: "backend-listener",
+			Port: networkingv1.ServiceBackendPort{Number: 80},
+		},
+	}
+	http := &networkingv1.HTTPIngressRuleValue{
+		Paths: []networkingv1.HTTPIngressPath{{
+			Path:     "/",
+			PathType: util.Pointer(networkingv1.PathTypePrefix),
+			Backend:  backend,
+		}},
+	}
+
+	tests := []struct {
+		name string
+		spec *saasv1alpha1.RouteStrategySpec
+		want *networkingv1.Ingress
+	}{
+		{
+			name: "Generates an Ingress without hostnames",
+			spec: &saasv1alpha1.RouteStrategySpec{
+				Kind:             util.Pointer(saasv1alpha1.RouteKindIngress),
+				IngressClassName: util.Pointer("nginx"),
+			},
+			want: &networkingv1.Ingress{
+				ObjectMeta: metav1.ObjectMeta{Name: "backend-listener"},
+				Spec: networkingv1.IngressSpec{
+					IngressClassName: util.Pointer("nginx"),
+					Rules: []networkingv1.IngressRule{{
+						IngressRuleValue: networkingv1.IngressRuleValue{HTTP: http},
+					}},
+				},
+			},
+		},
+		{
+			name: "Generates an Ingress with TLS",
+			spec: &saasv1alpha1.RouteStrategySpec{
+				Kind:          util.Pointer(saasv1alpha1.RouteKindIngress),
+				Hostnames:     []string{"a.example.com", "b.example.com"},
+				TLSSecretName: util.Pointer("backend-tls"),
+			},
+			want: &networkingv1.Ingress{
+				ObjectMeta: metav1.ObjectMeta{Name: "backend-listener"},
+				Spec: networkingv1.IngressSpec{
+					TLS: []networkingv1.IngressTLS{{
+						Hosts:      []string{"a.example.com", "b.example.com"},
+						SecretName: "backend-tls",
+					}},
+					Rules: []networkingv1.IngressRule{
+						{Host: "a.example.com", IngressRuleValue: networkingv1.IngressRuleValue{HTTP: http}},
+						{Host: "b.example.com", IngressRuleValue: networkingv1.IngressRuleValue{HTTP: http}},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := routeDescriptor(tt.spec).Ingress(testRouteService())
+			if err != nil {
+				t.Fatalf("ServiceDescriptor.Ingress() error = %v", err)
+			}
+			if diff := cmp.Diff(got, tt.want); len(diff) > 0 {
+				t.Errorf("ServiceDescriptor.Ingress() diff = %v", diff)
+			}
+		})
+	}
+}
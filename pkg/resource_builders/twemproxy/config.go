@@ -2,6 +2,7 @@ package twemproxy
 
 import (
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -13,8 +14,17 @@ const (
 	HealthBindAddress string = "127.0.0.1:22333"
 )
 
+var (
+	// Hashes is the list of hash functions supported by nutcracker
+	Hashes = []saasv1alpha1.TwemproxyHash{"one_at_a_time", "md5", "crc16", "crc32", "crc32a",
+		"fnv1_64", "fnv1a_64", "fnv1_32", "fnv1a_32", "hsieh", "murmur", "jenkins"}
+	// Distributions is the list of key distribution modes supported by nutcracker
+	Distributions = []saasv1alpha1.TwemproxyDistribution{"ketama", "modula", "random"}
+)
+
 type Server struct {
 	alias    string
+	reason   string
 	Address  string
 	Priority int
 	Name     string
@@ -45,6 +55,17 @@ func (srv *Server) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// WithReason stores the reason why the server was selected
+func (srv Server) WithReason(reason string) Server {
+	srv.reason = reason
+	return srv
+}
+
+// Reason returns the reason why the server was selected
+func (srv *Server) Reason() string {
+	return srv.reason
+}
+
 func (srv *Server) Alias() string {
 	if srv.alias != "" {
 		return srv.alias
@@ -59,13 +80,27 @@ type ServerPoolConfig struct {
 	Distribution       string   `json:"distribution,omitempty"`
 	Timeout            int      `json:"timeout,omitempty"`
 	Backlog            int      `json:"backlog,omitempty"`
+	ClientConnections  int      `json:"client_connections,omitempty"`
 	PreConnect         bool     `json:"preconnect"`
 	Redis              bool     `json:"redis"`
+	RedisAuth          string   `json:"redis_auth,omitempty"`
 	AutoEjectHosts     bool     `json:"auto_eject_hosts"`
+	ServerRetryTimeout int      `json:"server_retry_timeout,omitempty"`
 	ServerFailureLimit int      `json:"server_failure_limit,omitempty"`
 	Servers            []Server `json:"servers"`
 }
 
+// KeyDistribution returns a string that identifies how keys are distributed
+// across the servers of the pool. Two pools with the same list of servers and
+// the same KeyDistribution store each key in the same server.
+func (cfg ServerPoolConfig) KeyDistribution() string {
+	return fmt.Sprintf("hash=%s, hash_tag=%s, distribution=%s, auto_eject_hosts=%t",
+		cfg.Hash, cfg.HashTag, cfg.Distribution, cfg.AutoEjectHosts)
+}
+
+// GenerateServerPool returns the nutcracker configuration of a server pool. The
+// password for redis_auth is not part of the TwemproxyServerPool so it must be set
+// by the caller.
 func GenerateServerPool(pool saasv1alpha1.TwemproxyServerPool, targets map[string]Server) ServerPoolConfig {
 
 	servers := make([]Server, 0, len(pool.Topology))
@@ -76,19 +111,54 @@ func GenerateServerPool(pool saasv1alpha1.TwemproxyServerPool, targets map[strin
 	}
 
 	return ServerPoolConfig{
-		// The following parameters cannot be changed
-		Hash:           "fnv1a_64",
-		HashTag:        "{}",
-		Distribution:   "ketama",
-		AutoEjectHosts: false,
+		// The following parameters determine the distribution of keys
+		// across shards and should not be changed in a running pool
+		Hash:           string(valueOrDefault(pool.Hash, saasv1alpha1.TwemproxyDefaultHash)),
+		HashTag:        valueOrDefault(pool.HashTag, saasv1alpha1.TwemproxyDefaultHashTag),
+		Distribution:   string(valueOrDefault(pool.Distribution, saasv1alpha1.TwemproxyDefaultDistribution)),
+		AutoEjectHosts: valueOrDefault(pool.AutoEjectHosts, false),
 		Redis:          true,
-		// The following parameters could be safely modified or exposed in the CR
-		Listen:     pool.BindAddress,
-		Backlog:    pool.TCPBacklog,
-		PreConnect: pool.PreConnect,
-		Timeout:    pool.Timeout,
+		// The following parameters can be safely modified
+		Listen:             pool.BindAddress,
+		Backlog:            pool.TCPBacklog,
+		PreConnect:         pool.PreConnect,
+		Timeout:            pool.Timeout,
+		ClientConnections:  valueOrDefault(pool.ClientConnections, 0),
+		ServerRetryTimeout: valueOrDefault(pool.ServerRetryTimeout, 0),
+		ServerFailureLimit: valueOrDefault(pool.ServerFailureLimit, 0),
 		// The list of servers is generated from the
 		// list fo shards provided by the user in the Backend spec
 		Servers: servers,
 	}
 }
+
+// ValidateServerPool checks that the options of the server pool
+// are accepted by nutcracker
+func ValidateServerPool(pool saasv1alpha1.TwemproxyServerPool) error {
+	if pool.Hash != nil && !slices.Contains(Hashes, *pool.Hash) {
+		return fmt.Errorf("server pool '%s': unsupported hash '%s'", pool.Name, *pool.Hash)
+	}
+	if pool.HashTag != nil && len(*pool.HashTag) != 2 {
+		return fmt.Errorf("server pool '%s': hash tag '%s' must be two characters long", pool.Name, *pool.HashTag)
+	}
+	if pool.Distribution != nil && !slices.Contains(Distributions, *pool.Distribution) {
+		return fmt.Errorf("server pool '%s': unsupported distribution '%s'", pool.Name, *pool.Distribution)
+	}
+	if pool.ServerRetryTimeout != nil && *pool.ServerRetryTimeout <= 0 {
+		return fmt.Errorf("server pool '%s': server retry timeout must be a positive integer", pool.Name)
+	}
+	if pool.ServerFailureLimit != nil && *pool.ServerFailureLimit <= 0 {
+		return fmt.Errorf("server pool '%s': server failure limit must be a positive integer", pool.Name)
+	}
+	if pool.ClientConnections != nil && *pool.ClientConnections < 0 {
+		return fmt.Errorf("server pool '%s': client connections cannot be negative", pool.Name)
+	}
+	return nil
+}
+
+func valueOrDefault[T any](value *T, defValue T) T {
+	if value != nil {
+		return *value
+	}
+	return defValue
+}
@@ -4,6 +4,8 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/3scale-ops/basereconciler/util"
+	saasv1alpha1 "github.com/3scale-ops/saas-operator/api/v1alpha1"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 )
@@ -99,3 +101,138 @@ func TestTwemproxyServer_UnmarshalJSON(t *testing.T) {
 		})
 	}
 }
+
+func TestGenerateServerPool(t *testing.T) {
+	type args struct {
+		pool    saasv1alpha1.TwemproxyServerPool
+		targets map[string]Server
+	}
+	tests := []struct {
+		name string
+		args args
+		want ServerPoolConfig
+	}{
+		{
+			name: "Uses the default key distribution",
+			args: args{
+				pool: saasv1alpha1.TwemproxyServerPool{
+					Name:        "pool",
+					Topology:    []saasv1alpha1.ShardedRedisTopology{{ShardName: "l-shard00", PhysicalShard: "shard0"}},
+					BindAddress: "0.0.0.0:22121",
+					Timeout:     5000,
+					TCPBacklog:  512,
+				},
+				targets: map[string]Server{"shard0": {Address: "127.0.0.1:6379", Priority: 1}},
+			},
+			want: ServerPoolConfig{
+				Listen:         "0.0.0.0:22121",
+				Hash:           "fnv1a_64",
+				HashTag:        "{}",
+				Distribution:   "ketama",
+				Timeout:        5000,
+				Backlog:        512,
+				Redis:          true,
+				AutoEjectHosts: false,
+				Servers:        []Server{{Address: "127.0.0.1:6379", Priority: 1, Name: "l-shard00"}},
+			},
+		},
+		{
+			name: "Sets all the pool options",
+			args: args{
+				pool: saasv1alpha1.TwemproxyServerPool{
+					Name:               "pool",
+					Topology:           []saasv1alpha1.ShardedRedisTopology{{ShardName: "l-shard00", PhysicalShard: "shard0"}},
+					BindAddress:        "0.0.0.0:22121",
+					Timeout:            5000,
+					TCPBacklog:         512,
+					PreConnect:         true,
+					Hash:               util.Pointer(saasv1alpha1.TwemproxyHash("murmur")),
+					HashTag:            util.Pointer("::"),
+					Distribution:       util.Pointer(saasv1alpha1.TwemproxyDistribution("modula")),
+					ServerRetryTimeout: util.Pointer(2000),
+					ServerFailureLimit: util.Pointer(3),
+					AutoEjectHosts:     util.Pointer(true),
+					ClientConnections:  util.Pointer(100),
+				},
+				targets: map[string]Server{"shard0": {Address: "127.0.0.1:6379", Priority: 1}},
+			},
+			want: ServerPoolConfig{
+				Listen:             "0.0.0.0:22121",
+				Hash:               "murmur",
+				HashTag:            "::",
+				Distribution:       "modula",
+				Timeout:            5000,
+				Backlog:            512,
+				ClientConnections:  100,
+				PreConnect:         true,
+				Redis:              true,
+				AutoEjectHosts:     true,
+				ServerRetryTimeout: 2000,
+				ServerFailureLimit: 3,
+				Servers:            []Server{{Address: "127.0.0.1:6379", Priority: 1, Name: "l-shard00"}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := GenerateServerPool(tt.args.pool, tt.args.targets)
+			if diff := cmp.Diff(got, tt.want, cmpopts.IgnoreUnexported(Server{})); len(diff) != 0 {
+				t.Errorf("GenerateServerPool() diff = %v", diff)
+			}
+		})
+	}
+}
+
+func TestValidateServerPool(t *testing.T) {
+	tests := []struct {
+		name    string
+		pool    saasv1alpha1.TwemproxyServerPool
+		wantErr bool
+	}{
+		{
+			name:    "Accepts a pool with default options",
+			pool:    saasv1alpha1.TwemproxyServerPool{Name: "pool"},
+			wantErr: false,
+		},
+		{
+			name: "Accepts valid options",
+			pool: saasv1alpha1.TwemproxyServerPool{
+				Name:               "pool",
+				Hash:               util.Pointer(saasv1alpha1.TwemproxyHash("crc32a")),
+				HashTag:            util.Pointer("{}"),
+				Distribution:       util.Pointer(saasv1alpha1.TwemproxyDistribution("random")),
+				ServerRetryTimeout: util.Pointer(30000),
+				ServerFailureLimit: util.Pointer(2),
+				ClientConnections:  util.Pointer(0),
+			},
+			wantErr: false,
+		},
+		{
+			name:    "Rejects an unknown hash",
+			pool:    saasv1alpha1.TwemproxyServerPool{Name: "pool", Hash: util.Pointer(saasv1alpha1.TwemproxyHash("sha1"))},
+			wantErr: true,
+		},
+		{
+			name:    "Rejects a hash tag that is not two characters long",
+			pool:    saasv1alpha1.TwemproxyServerPool{Name: "pool", HashTag: util.Pointer("{")},
+			wantErr: true,
+		},
+		{
+			name:    "Rejects an unknown distribution",
+			pool:    saasv1alpha1.TwemproxyServerPool{Name: "pool", Distribution: util.Pointer(saasv1alpha1.TwemproxyDistribution("consistent"))},
+			wantErr: true,
+		},
+		{
+			name:    "Rejects a non positive server failure limit",
+			pool:    saasv1alpha1.TwemproxyServerPool{Name: "pool", ServerFailureLimit: util.Pointer(0)},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ValidateServerPool(tt.pool); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateServerPool() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
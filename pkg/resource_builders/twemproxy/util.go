@@ -16,6 +16,9 @@ const (
 	healthCommand              = "health"
 )
 
+// ContainerName is the name of the twemproxy sidecar container
+const ContainerName = twemproxy
+
 func TwemproxyContainer(twemproxySpec *saasv1alpha1.TwemproxySpec) corev1.Container {
 
 	return corev1.Container{
@@ -47,14 +50,23 @@ func TwemproxyContainer(twemproxySpec *saasv1alpha1.TwemproxySpec) corev1.Contai
 }
 
 func TwemproxyContainerVolume(twemproxySpec *saasv1alpha1.TwemproxySpec) corev1.Volume {
+	if twemproxySpec.ConfigSecretName != nil {
+		return corev1.Volume{
+			Name: twemproxy + "-config",
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName:  *twemproxySpec.ConfigSecretName,
+					DefaultMode: util.Pointer[int32](420),
+				},
+			},
+		}
+	}
 	return corev1.Volume{
 		Name: twemproxy + "-config",
 		VolumeSource: corev1.VolumeSource{
 			ConfigMap: &corev1.ConfigMapVolumeSource{
-				LocalObjectReference: corev1.LocalObjectReference{
-					Name: twemproxySpec.ConfigMapName(),
-				},
-				DefaultMode: util.Pointer[int32](420),
+				LocalObjectReference: corev1.LocalObjectReference{Name: twemproxySpec.ConfigMapName()},
+				DefaultMode:          util.Pointer[int32](420),
 			},
 		},
 	}
@@ -106,3 +118,12 @@ func AddTwemproxyTaskSidecar(taskSpec pipelinev1beta1.TaskSpec, twemproxySpec *s
 
 	return taskSpec
 }
+
+// VPASpecs returns the resource policy of the twemproxy sidecar container,
+// keyed by container name, or nil if it doesn't have one
+func VPASpecs(twemproxySpec *saasv1alpha1.TwemproxySpec) map[string]saasv1alpha1.VerticalPodAutoscalerResourcePolicy {
+	if twemproxySpec == nil || twemproxySpec.VPA == nil {
+		return nil
+	}
+	return map[string]saasv1alpha1.VerticalPodAutoscalerResourcePolicy{ContainerName: *twemproxySpec.VPA}
+}
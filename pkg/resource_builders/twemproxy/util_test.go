@@ -12,6 +12,7 @@ import (
 	"github.com/go-test/deep"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -154,3 +155,73 @@ func Test_AddTwemproxySidecar(t *testing.T) {
 		})
 	}
 }
+
+func TestTwemproxyContainerVolume(t *testing.T) {
+	tests := []struct {
+		name string
+		spec *saasv1alpha1.TwemproxySpec
+		want corev1.VolumeSource
+	}{
+		{
+			name: "Mounts the ConfigMap by default",
+			spec: &saasv1alpha1.TwemproxySpec{TwemproxyConfigRef: "twem-config"},
+			want: corev1.VolumeSource{
+				ConfigMap: &corev1.ConfigMapVolumeSource{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "twem-config"},
+					DefaultMode:          util.Pointer[int32](420),
+				},
+			},
+		},
+		{
+			name: "Mounts the Secret when the config uses redis auth",
+			spec: &saasv1alpha1.TwemproxySpec{TwemproxyConfigRef: "twem-config", ConfigSecretName: util.Pointer("twem-config")},
+			want: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName:  "twem-config",
+					DefaultMode: util.Pointer[int32](420),
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if diff := deep.Equal(TwemproxyContainerVolume(tt.spec).VolumeSource, tt.want); len(diff) > 0 {
+				t.Errorf("TwemproxyContainerVolume() = diff %v", diff)
+			}
+		})
+	}
+}
+
+func TestVPASpecs(t *testing.T) {
+	policy := saasv1alpha1.VerticalPodAutoscalerResourcePolicy{
+		MaxAllowed: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1Gi")},
+	}
+	tests := []struct {
+		name string
+		spec *saasv1alpha1.TwemproxySpec
+		want map[string]saasv1alpha1.VerticalPodAutoscalerResourcePolicy
+	}{
+		{
+			name: "No twemproxy sidecar",
+			spec: nil,
+			want: nil,
+		},
+		{
+			name: "Twemproxy sidecar without vpa",
+			spec: &saasv1alpha1.TwemproxySpec{},
+			want: nil,
+		},
+		{
+			name: "Returns the policy of the twemproxy container",
+			spec: &saasv1alpha1.TwemproxySpec{VPA: &policy},
+			want: map[string]saasv1alpha1.VerticalPodAutoscalerResourcePolicy{"twemproxy": policy},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if diff := deep.Equal(VPASpecs(tt.spec), tt.want); len(diff) > 0 {
+				t.Errorf("VPASpecs() = diff %v", diff)
+			}
+		})
+	}
+}
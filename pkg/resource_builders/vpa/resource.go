@@ -0,0 +1,82 @@
+package vpa
+
+import (
+	"sort"
+
+	saasv1alpha1 "github.com/3scale-ops/saas-operator/api/v1alpha1"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	vpav1 "k8s.io/autoscaler/vertical-pod-autoscaler/pkg/apis/autoscaling.k8s.io/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// New returns a basereconciler_types.GeneratorFunction function that will return a VerticalPodAutoscaler
+// resource when called. The cfg applies to all the containers of the Pod, while the containers map
+// holds the resource policies for specific containers (eg sidecars). If cfg is nil, recommendations are
+// only computed for the containers in the map. The VerticalPodAutoscaler defaults to recommendation-only
+// mode, so the resources of the Pods are not changed unless explicitly requested.
+func New(key types.NamespacedName, labels map[string]string, cfg *saasv1alpha1.VerticalPodAutoscalerSpec,
+	containers map[string]saasv1alpha1.VerticalPodAutoscalerResourcePolicy) func(client.Object) (*vpav1.VerticalPodAutoscaler, error) {
+
+	return func(client.Object) (*vpav1.VerticalPodAutoscaler, error) {
+		mode := vpav1.UpdateModeOff
+		defaultPolicy := vpav1.ContainerResourcePolicy{
+			ContainerName: vpav1.DefaultContainerResourcePolicy,
+			Mode:          containerScalingMode(vpav1.ContainerScalingModeOff),
+		}
+		if cfg != nil {
+			if cfg.UpdateMode != nil {
+				mode = vpav1.UpdateMode(*cfg.UpdateMode)
+			}
+			defaultPolicy = containerPolicy(vpav1.DefaultContainerResourcePolicy, cfg.VerticalPodAutoscalerResourcePolicy)
+		}
+
+		policies := []vpav1.ContainerResourcePolicy{defaultPolicy}
+		names := make([]string, 0, len(containers))
+		for name := range containers {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			policies = append(policies, containerPolicy(name, containers[name]))
+		}
+
+		return &vpav1.VerticalPodAutoscaler{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      key.Name,
+				Namespace: key.Namespace,
+				Labels:    labels,
+			},
+			Spec: vpav1.VerticalPodAutoscalerSpec{
+				TargetRef: &autoscalingv1.CrossVersionObjectReference{
+					APIVersion: appsv1.SchemeGroupVersion.String(),
+					Kind:       "Deployment",
+					Name:       key.Name,
+				},
+				UpdatePolicy:   &vpav1.PodUpdatePolicy{UpdateMode: &mode},
+				ResourcePolicy: &vpav1.PodResourcePolicy{ContainerPolicies: policies},
+			},
+		}, nil
+	}
+}
+
+func containerPolicy(name string, cfg saasv1alpha1.VerticalPodAutoscalerResourcePolicy) vpav1.ContainerResourcePolicy {
+	policy := vpav1.ContainerResourcePolicy{
+		ContainerName: name,
+		Mode:          containerScalingMode(vpav1.ContainerScalingModeAuto),
+		MinAllowed:    cfg.MinAllowed,
+		MaxAllowed:    cfg.MaxAllowed,
+	}
+	if len(cfg.ControlledResources) > 0 {
+		resources := append([]corev1.ResourceName{}, cfg.ControlledResources...)
+		policy.ControlledResources = &resources
+	}
+	return policy
+}
+
+func containerScalingMode(mode vpav1.ContainerScalingMode) *vpav1.ContainerScalingMode {
+	return &mode
+}
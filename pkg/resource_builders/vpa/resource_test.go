@@ -0,0 +1,103 @@
+package vpa
+
+import (
+	"testing"
+
+	"github.com/3scale-ops/basereconciler/util"
+	saasv1alpha1 "github.com/3scale-ops/saas-operator/api/v1alpha1"
+	"github.com/google/go-cmp/cmp"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	vpav1 "k8s.io/autoscaler/vertical-pod-autoscaler/pkg/apis/autoscaling.k8s.io/v1"
+)
+
+func TestNew(t *testing.T) {
+	key := types.NamespacedName{Name: "listener", Namespace: "ns"}
+	targetRef := &autoscalingv1.CrossVersionObjectReference{APIVersion: "apps/v1", Kind: "Deployment", Name: "listener"}
+	tests := []struct {
+		name       string
+		cfg        *saasv1alpha1.VerticalPodAutoscalerSpec
+		containers map[string]saasv1alpha1.VerticalPodAutoscalerResourcePolicy
+		want       *vpav1.VerticalPodAutoscaler
+	}{
+		{
+			name: "Defaults to recommendation-only mode",
+			cfg:  &saasv1alpha1.VerticalPodAutoscalerSpec{},
+			want: &vpav1.VerticalPodAutoscaler{
+				ObjectMeta: metav1.ObjectMeta{Name: "listener", Namespace: "ns", Labels: map[string]string{"app": "listener"}},
+				Spec: vpav1.VerticalPodAutoscalerSpec{
+					TargetRef:    targetRef,
+					UpdatePolicy: &vpav1.PodUpdatePolicy{UpdateMode: util.Pointer(vpav1.UpdateModeOff)},
+					ResourcePolicy: &vpav1.PodResourcePolicy{ContainerPolicies: []vpav1.ContainerResourcePolicy{
+						{ContainerName: "*", Mode: util.Pointer(vpav1.ContainerScalingModeAuto)},
+					}},
+				},
+			},
+		},
+		{
+			name: "Generates a VerticalPodAutoscaler with a policy for a sidecar",
+			cfg: &saasv1alpha1.VerticalPodAutoscalerSpec{
+				UpdateMode: util.Pointer(saasv1alpha1.VerticalPodAutoscalerUpdateModeInitial),
+				VerticalPodAutoscalerResourcePolicy: saasv1alpha1.VerticalPodAutoscalerResourcePolicy{
+					MinAllowed:          corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("100m")},
+					ControlledResources: []corev1.ResourceName{corev1.ResourceCPU},
+				},
+			},
+			containers: map[string]saasv1alpha1.VerticalPodAutoscalerResourcePolicy{
+				"twemproxy": {MaxAllowed: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("128Mi")}},
+			},
+			want: &vpav1.VerticalPodAutoscaler{
+				ObjectMeta: metav1.ObjectMeta{Name: "listener", Namespace: "ns", Labels: map[string]string{"app": "listener"}},
+				Spec: vpav1.VerticalPodAutoscalerSpec{
+					TargetRef:    targetRef,
+					UpdatePolicy: &vpav1.PodUpdatePolicy{UpdateMode: util.Pointer(vpav1.UpdateModeInitial)},
+					ResourcePolicy: &vpav1.PodResourcePolicy{ContainerPolicies: []vpav1.ContainerResourcePolicy{
+						{
+							ContainerName:       "*",
+							Mode:                util.Pointer(vpav1.ContainerScalingModeAuto),
+							MinAllowed:          corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("100m")},
+							ControlledResources: &[]corev1.ResourceName{corev1.ResourceCPU},
+						},
+						{
+							ContainerName: "twemproxy",
+							Mode:          util.Pointer(vpav1.ContainerScalingModeAuto),
+							MaxAllowed:    corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("128Mi")},
+						},
+					}},
+				},
+			},
+		},
+		{
+			name: "Only computes recommendations for the sidecar",
+			containers: map[string]saasv1alpha1.VerticalPodAutoscalerResourcePolicy{
+				"twemproxy": {},
+			},
+			want: &vpav1.VerticalPodAutoscaler{
+				ObjectMeta: metav1.ObjectMeta{Name: "listener", Namespace: "ns", Labels: map[string]string{"app": "listener"}},
+				Spec: vpav1.VerticalPodAutoscalerSpec{
+					TargetRef:    targetRef,
+					UpdatePolicy: &vpav1.PodUpdatePolicy{UpdateMode: util.Pointer(vpav1.UpdateModeOff)},
+					ResourcePolicy: &vpav1.PodResourcePolicy{ContainerPolicies: []vpav1.ContainerResourcePolicy{
+						{ContainerName: "*", Mode: util.Pointer(vpav1.ContainerScalingModeOff)},
+						{ContainerName: "twemproxy", Mode: util.Pointer(vpav1.ContainerScalingModeAuto)},
+					}},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := New(key, map[string]string{"app": "listener"}, tt.cfg, tt.containers)(nil)
+			if err != nil {
+				t.Errorf("New() error = %v", err)
+				return
+			}
+			if diff := cmp.Diff(got, tt.want); len(diff) > 0 {
+				t.Errorf("New() diff = %v", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,143 @@
+package scaling
+
+import (
+	"fmt"
+	"time"
+
+	saasv1alpha1 "github.com/3scale-ops/saas-operator/api/v1alpha1"
+	"github.com/robfig/cron/v3"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Target is a workload with a scaling schedule
+type Target struct {
+	// Name of the workload
+	Name string
+	// Windows is the scaling schedule of the workload
+	Windows []saasv1alpha1.ScalingWindow
+	// Replicas points to the replicas field of the workload
+	// spec, which is overridden by Apply
+	Replicas **int32
+	// HPA is the hpa spec of the workload, which is overridden by Apply
+	HPA *saasv1alpha1.HorizontalPodAutoscalerSpec
+}
+
+// ValidateWindow checks that the schedule of the scaling window
+// is a valid cron expression and that its duration is positive
+func ValidateWindow(w saasv1alpha1.ScalingWindow) (cron.Schedule, error) {
+	schedule, err := cron.ParseStandard(w.Schedule)
+	if err != nil {
+		return nil, fmt.Errorf("invalid schedule: %w", err)
+	}
+	if w.Duration.Duration <= 0 {
+		return nil, fmt.Errorf("invalid duration: must be greater than zero")
+	}
+	return schedule, nil
+}
+
+// Evaluate returns the scaling windows active at the given time for each one of the targets,
+// together with the time after which the schedules need to be evaluated again (zero if no
+// target has a scaling schedule). If several windows of a target are active, the first one
+// in the list is used. Invalid windows are skipped and reported in the returned errors, so
+// they don't prevent the rest of the schedule from being honoured.
+func Evaluate(targets []Target, now time.Time) (saasv1alpha1.ScalingWindowStatuses, time.Duration, []error) {
+	var statuses saasv1alpha1.ScalingWindowStatuses
+	var next time.Time
+	var errs []error
+
+	for _, t := range targets {
+		var status *saasv1alpha1.ScalingWindowStatus
+
+		for _, w := range t.Windows {
+			schedule, err := ValidateWindow(w)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("scaling window '%s' of '%s' skipped: %w", w.Name, t.Name, err))
+				continue
+			}
+
+			// the schedule needs to be evaluated again when the next window starts
+			next = earliest(next, schedule.Next(now))
+
+			start, active := lastStart(schedule, w.Duration.Duration, now)
+			if !active {
+				continue
+			}
+			end := start.Add(w.Duration.Duration)
+			// the schedule also needs to be evaluated again when the window ends
+			next = earliest(next, end)
+
+			if status == nil {
+				status = &saasv1alpha1.ScalingWindowStatus{
+					Name:   t.Name,
+					Window: w.Name,
+					Start:  metav1.NewTime(start),
+					End:    metav1.NewTime(end),
+				}
+			}
+		}
+
+		if status != nil {
+			statuses = append(statuses, *status)
+		}
+	}
+
+	if next.IsZero() {
+		return statuses, 0, errs
+	}
+	// wait an extra second so the window has already started (or ended)
+	return statuses, next.Sub(now) + time.Second, errs
+}
+
+// Apply overrides the replicas and hpa limits of the targets
+// with the values of their active scaling window
+func Apply(targets []Target, statuses saasv1alpha1.ScalingWindowStatuses) {
+	for _, t := range targets {
+		status := statuses.Get(t.Name)
+		if status == nil {
+			continue
+		}
+		for _, w := range t.Windows {
+			if w.Name != status.Window {
+				continue
+			}
+			if w.Replicas != nil && t.Replicas != nil {
+				*t.Replicas = w.Replicas
+			}
+			if t.HPA != nil && !t.HPA.IsDeactivated() {
+				if w.MinReplicas != nil {
+					t.HPA.MinReplicas = w.MinReplicas
+				}
+				if w.MaxReplicas != nil {
+					t.HPA.MaxReplicas = w.MaxReplicas
+				}
+				// keep the limits consistent when only one of them is overridden
+				if t.HPA.MinReplicas != nil && t.HPA.MaxReplicas != nil && *t.HPA.MinReplicas > *t.HPA.MaxReplicas {
+					max := *t.HPA.MinReplicas
+					t.HPA.MaxReplicas = &max
+				}
+			}
+			break
+		}
+	}
+}
+
+// lastStart returns the latest start of the schedule that is still active at the
+// given time, this is, within the duration of the window. The second return value
+// is false if there is no window active.
+func lastStart(schedule cron.Schedule, duration time.Duration, now time.Time) (time.Time, bool) {
+	start := schedule.Next(now.Add(-duration))
+	if start.IsZero() || start.After(now) {
+		return time.Time{}, false
+	}
+	for t := schedule.Next(start); !t.IsZero() && !t.After(now); t = schedule.Next(t) {
+		start = t
+	}
+	return start, true
+}
+
+func earliest(a, b time.Time) time.Time {
+	if a.IsZero() || (!b.IsZero() && b.Before(a)) {
+		return b
+	}
+	return a
+}
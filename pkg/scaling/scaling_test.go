@@ -0,0 +1,149 @@
+package scaling
+
+import (
+	"testing"
+	"time"
+
+	"github.com/3scale-ops/basereconciler/util"
+	saasv1alpha1 "github.com/3scale-ops/saas-operator/api/v1alpha1"
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestEvaluate(t *testing.T) {
+	// Monday
+	day := time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)
+	windows := []saasv1alpha1.ScalingWindow{
+		{Name: "peak", Schedule: "0 8 * * 1-5", Duration: metav1.Duration{Duration: 10 * time.Hour}, MinReplicas: util.Pointer[int32](10)},
+		{Name: "business", Schedule: "0 7 * * *", Duration: metav1.Duration{Duration: 12 * time.Hour}, MinReplicas: util.Pointer[int32](5)},
+	}
+	tests := []struct {
+		name        string
+		targets     []Target
+		now         time.Time
+		want        saasv1alpha1.ScalingWindowStatuses
+		wantRequeue time.Duration
+		wantErrs    int
+	}{
+		{
+			name:        "No windows",
+			targets:     []Target{{Name: "backend-listener"}},
+			now:         day,
+			want:        nil,
+			wantRequeue: 0,
+		},
+		{
+			name:        "No active window",
+			targets:     []Target{{Name: "backend-listener", Windows: windows}},
+			now:         day.Add(6 * time.Hour),
+			want:        nil,
+			wantRequeue: 1*time.Hour + time.Second,
+		},
+		{
+			name:    "The first active window is used",
+			targets: []Target{{Name: "backend-listener", Windows: windows}},
+			now:     day.Add(9 * time.Hour),
+			want: saasv1alpha1.ScalingWindowStatuses{{
+				Name:   "backend-listener",
+				Window: "peak",
+				Start:  metav1.NewTime(day.Add(8 * time.Hour)),
+				End:    metav1.NewTime(day.Add(18 * time.Hour)),
+			}},
+			wantRequeue: 9*time.Hour + time.Second,
+		},
+		{
+			name:    "A window is active until it ends",
+			targets: []Target{{Name: "backend-listener", Windows: windows}},
+			now:     day.Add(18 * time.Hour),
+			want: saasv1alpha1.ScalingWindowStatuses{{
+				Name:   "backend-listener",
+				Window: "business",
+				Start:  metav1.NewTime(day.Add(7 * time.Hour)),
+				End:    metav1.NewTime(day.Add(19 * time.Hour)),
+			}},
+			wantRequeue: 1*time.Hour + time.Second,
+		},
+		{
+			name: "Honours the timezone of the schedule",
+			targets: []Target{{Name: "apicast-production", Windows: []saasv1alpha1.ScalingWindow{
+				{Name: "peak", Schedule: "CRON_TZ=Europe/Madrid 0 8 * * *", Duration: metav1.Duration{Duration: time.Hour}},
+			}}},
+			now: day.Add(7*time.Hour + 30*time.Minute),
+			want: saasv1alpha1.ScalingWindowStatuses{{
+				Name:   "apicast-production",
+				Window: "peak",
+				Start:  metav1.NewTime(day.Add(7 * time.Hour)),
+				End:    metav1.NewTime(day.Add(8 * time.Hour)),
+			}},
+			wantRequeue: 30*time.Minute + time.Second,
+		},
+		{
+			name: "Skips and reports invalid windows",
+			targets: []Target{{Name: "backend-listener", Windows: []saasv1alpha1.ScalingWindow{
+				{Name: "invalid", Schedule: "0 8 * *", Duration: metav1.Duration{Duration: time.Hour}},
+				{Name: "zero", Schedule: "0 8 * * *"},
+				{Name: "business", Schedule: "0 7 * * *", Duration: metav1.Duration{Duration: 12 * time.Hour}},
+			}}},
+			now: day.Add(9 * time.Hour),
+			want: saasv1alpha1.ScalingWindowStatuses{{
+				Name:   "backend-listener",
+				Window: "business",
+				Start:  metav1.NewTime(day.Add(7 * time.Hour)),
+				End:    metav1.NewTime(day.Add(19 * time.Hour)),
+			}},
+			wantRequeue: 10*time.Hour + time.Second,
+			wantErrs:    2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, requeue, errs := Evaluate(tt.targets, tt.now)
+			if len(errs) != tt.wantErrs {
+				t.Errorf("Evaluate() errors = %v, want %d errors", errs, tt.wantErrs)
+			}
+			if diff := cmp.Diff(got, tt.want); len(diff) > 0 {
+				t.Errorf("Evaluate() diff = %v", diff)
+			}
+			if requeue != tt.wantRequeue {
+				t.Errorf("Evaluate() requeue = %v, want %v", requeue, tt.wantRequeue)
+			}
+		})
+	}
+}
+
+func TestApply(t *testing.T) {
+	windows := []saasv1alpha1.ScalingWindow{
+		{Name: "off", Replicas: util.Pointer[int32](1), MinReplicas: util.Pointer[int32](1)},
+		{Name: "peak", Replicas: util.Pointer[int32](10), MinReplicas: util.Pointer[int32](10)},
+	}
+	statuses := saasv1alpha1.ScalingWindowStatuses{
+		{Name: "static", Window: "peak"},
+		{Name: "autoscaled", Window: "peak"},
+	}
+
+	staticReplicas := util.Pointer[int32](2)
+	staticHPA := &saasv1alpha1.HorizontalPodAutoscalerSpec{}
+	autoscaledReplicas := util.Pointer[int32](2)
+	autoscaledHPA := &saasv1alpha1.HorizontalPodAutoscalerSpec{MinReplicas: util.Pointer[int32](2), MaxReplicas: util.Pointer[int32](4)}
+	idleReplicas := util.Pointer[int32](2)
+
+	Apply([]Target{
+		{Name: "static", Windows: windows, Replicas: &staticReplicas, HPA: staticHPA},
+		{Name: "autoscaled", Windows: windows, Replicas: &autoscaledReplicas, HPA: autoscaledHPA},
+		{Name: "idle", Windows: windows, Replicas: &idleReplicas},
+	}, statuses)
+
+	if *staticReplicas != 10 {
+		t.Errorf("Apply() static replicas = %d, want 10", *staticReplicas)
+	}
+	if diff := cmp.Diff(staticHPA, &saasv1alpha1.HorizontalPodAutoscalerSpec{}); len(diff) > 0 {
+		t.Errorf("Apply() a deactivated hpa must not change, diff = %v", diff)
+	}
+	if diff := cmp.Diff(autoscaledHPA, &saasv1alpha1.HorizontalPodAutoscalerSpec{
+		MinReplicas: util.Pointer[int32](10), MaxReplicas: util.Pointer[int32](10)}); len(diff) > 0 {
+		t.Errorf("Apply() hpa diff = %v", diff)
+	}
+	if *idleReplicas != 2 {
+		t.Errorf("Apply() idle replicas = %d, want 2", *idleReplicas)
+	}
+}
@@ -0,0 +1,57 @@
+package status
+
+import (
+	"sort"
+
+	saasv1alpha1 "github.com/3scale-ops/saas-operator/api/v1alpha1"
+	appsv1 "k8s.io/api/apps/v1"
+	vpav1 "k8s.io/autoscaler/vertical-pod-autoscaler/pkg/apis/autoscaling.k8s.io/v1"
+)
+
+// Recommendations projects the recommendations computed by the VerticalPodAutoscalers into
+// a list of per container recommendations. The resource requests currently set for each
+// container are taken from the Deployment targeted by the VerticalPodAutoscaler.
+func Recommendations(vpas []vpav1.VerticalPodAutoscaler, deployments []appsv1.Deployment) saasv1alpha1.ResourceRecommendations {
+	var recommendations saasv1alpha1.ResourceRecommendations
+
+	for _, vpa := range vpas {
+		if vpa.Spec.TargetRef == nil || vpa.Status.Recommendation == nil {
+			continue
+		}
+		name := vpa.Spec.TargetRef.Name
+		var deployment *appsv1.Deployment
+		for i := range deployments {
+			if deployments[i].GetName() == name {
+				deployment = &deployments[i]
+				break
+			}
+		}
+
+		for _, cr := range vpa.Status.Recommendation.ContainerRecommendations {
+			recommendation := saasv1alpha1.ResourceRecommendation{
+				Deployment: name,
+				Container:  cr.ContainerName,
+				Target:     cr.Target,
+				LowerBound: cr.LowerBound,
+				UpperBound: cr.UpperBound,
+			}
+			if deployment != nil {
+				for _, c := range deployment.Spec.Template.Spec.Containers {
+					if c.Name == cr.ContainerName {
+						recommendation.Requests = c.Resources.Requests
+					}
+				}
+			}
+			recommendations = append(recommendations, recommendation)
+		}
+	}
+
+	sort.Slice(recommendations, func(i, j int) bool {
+		if recommendations[i].Deployment != recommendations[j].Deployment {
+			return recommendations[i].Deployment < recommendations[j].Deployment
+		}
+		return recommendations[i].Container < recommendations[j].Container
+	})
+
+	return recommendations
+}
@@ -0,0 +1,78 @@
+package status
+
+import (
+	"testing"
+
+	saasv1alpha1 "github.com/3scale-ops/saas-operator/api/v1alpha1"
+	"github.com/google/go-cmp/cmp"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	vpav1 "k8s.io/autoscaler/vertical-pod-autoscaler/pkg/apis/autoscaling.k8s.io/v1"
+)
+
+func testVPA(name string, recommendations ...vpav1.RecommendedContainerResources) vpav1.VerticalPodAutoscaler {
+	vpa := vpav1.VerticalPodAutoscaler{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: vpav1.VerticalPodAutoscalerSpec{
+			TargetRef: &autoscalingv1.CrossVersionObjectReference{APIVersion: "apps/v1", Kind: "Deployment", Name: name},
+		},
+	}
+	if len(recommendations) > 0 {
+		vpa.Status.Recommendation = &vpav1.RecommendedPodResources{ContainerRecommendations: recommendations}
+	}
+	return vpa
+}
+
+func TestRecommendations(t *testing.T) {
+	cpu := func(q string) corev1.ResourceList {
+		return corev1.ResourceList{corev1.ResourceCPU: resource.MustParse(q)}
+	}
+
+	listener := testDeployment("backend-listener", 1, 1, appsv1.DeploymentStatus{})
+	listener.Spec.Template.Spec.Containers[0].Resources.Requests = cpu("500m")
+
+	tests := []struct {
+		name        string
+		vpas        []vpav1.VerticalPodAutoscaler
+		deployments []appsv1.Deployment
+		want        saasv1alpha1.ResourceRecommendations
+	}{
+		{
+			name:        "No recommendations yet",
+			vpas:        []vpav1.VerticalPodAutoscaler{testVPA("backend-listener")},
+			deployments: []appsv1.Deployment{listener},
+			want:        nil,
+		},
+		{
+			name: "Projects the recommendations of each container",
+			vpas: []vpav1.VerticalPodAutoscaler{
+				testVPA("backend-worker",
+					vpav1.RecommendedContainerResources{ContainerName: "backend-worker", Target: cpu("100m")},
+				),
+				testVPA("backend-listener",
+					vpav1.RecommendedContainerResources{ContainerName: "twemproxy", Target: cpu("50m")},
+					vpav1.RecommendedContainerResources{ContainerName: "backend-listener", Target: cpu("250m"),
+						LowerBound: cpu("200m"), UpperBound: cpu("1")},
+				),
+			},
+			deployments: []appsv1.Deployment{listener},
+			want: saasv1alpha1.ResourceRecommendations{
+				{Deployment: "backend-listener", Container: "backend-listener", Requests: cpu("500m"),
+					Target: cpu("250m"), LowerBound: cpu("200m"), UpperBound: cpu("1")},
+				{Deployment: "backend-listener", Container: "twemproxy", Target: cpu("50m")},
+				{Deployment: "backend-worker", Container: "backend-worker", Target: cpu("100m")},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Recommendations(tt.vpas, tt.deployments)
+			if diff := cmp.Diff(got, tt.want); len(diff) > 0 {
+				t.Errorf("Recommendations() diff = %v", diff)
+			}
+		})
+	}
+}
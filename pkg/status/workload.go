@@ -0,0 +1,173 @@
+package status
+
+import (
+	"sort"
+	"strings"
+
+	saasv1alpha1 "github.com/3scale-ops/saas-operator/api/v1alpha1"
+	"github.com/samber/lo"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const (
+	reasonAvailable                = "DeploymentsAvailable"
+	reasonUnavailable              = "DeploymentsUnavailable"
+	reasonRollingOut               = "RollingOut"
+	reasonCanaryInProgress         = "CanaryInProgress"
+	reasonStable                   = "Stable"
+	reasonProgressDeadlineExceeded = "ProgressDeadlineExceeded"
+	reasonReplicaFailure           = "ReplicaFailure"
+	reasonHealthy                  = "Healthy"
+)
+
+// Workload computes the status of the workloads of a custom resource from the Deployments
+// and Services that it owns. The conditions already in the current status are updated,
+// keeping their transition times if they have not changed.
+func Workload(generation int64, current saasv1alpha1.WorkloadStatus, deployments []appsv1.Deployment,
+	services []corev1.Service, canaries saasv1alpha1.CanaryStatuses) saasv1alpha1.WorkloadStatus {
+
+	status := saasv1alpha1.WorkloadStatus{
+		ObservedGeneration: generation,
+		Conditions:         append([]metav1.Condition{}, current.Conditions...),
+	}
+
+	sort.Slice(deployments, func(i, j int) bool { return deployments[i].GetName() < deployments[j].GetName() })
+
+	unavailable, rollingOut, failing := []string{}, []string{}, []string{}
+	degradedReason := ""
+	for _, dep := range deployments {
+		status.Deployments = append(status.Deployments, deploymentSummary(dep, services, canaries))
+
+		if !isAvailable(dep) {
+			unavailable = append(unavailable, dep.GetName())
+		}
+		if isRollingOut(dep) {
+			rollingOut = append(rollingOut, dep.GetName())
+		}
+		if reason := failure(dep); reason != "" {
+			failing = append(failing, dep.GetName())
+			if degradedReason == "" {
+				degradedReason = reason
+			}
+		}
+	}
+
+	canariesInProgress := []string{}
+	for _, c := range canaries {
+		if !c.IsFinished() {
+			canariesInProgress = append(canariesInProgress, c.Name)
+		}
+	}
+
+	ready := metav1.Condition{Type: saasv1alpha1.WorkloadReadyCondition, Status: metav1.ConditionTrue,
+		Reason: reasonAvailable, Message: "all Deployments are available"}
+	if len(unavailable) > 0 || len(rollingOut) > 0 {
+		ready.Status = metav1.ConditionFalse
+		ready.Reason = reasonUnavailable
+		notReady := lo.Uniq(append(unavailable, rollingOut...))
+		sort.Strings(notReady)
+		ready.Message = "Deployments not ready: " + strings.Join(notReady, ", ")
+	}
+
+	progressing := metav1.Condition{Type: saasv1alpha1.WorkloadProgressingCondition, Status: metav1.ConditionFalse,
+		Reason: reasonStable, Message: "all Deployments are up to date"}
+	if len(rollingOut) > 0 {
+		progressing.Status = metav1.ConditionTrue
+		progressing.Reason = reasonRollingOut
+		progressing.Message = "Deployments rolling out: " + strings.Join(rollingOut, ", ")
+	} else if len(canariesInProgress) > 0 {
+		progressing.Status = metav1.ConditionTrue
+		progressing.Reason = reasonCanaryInProgress
+		progressing.Message = "canaries in progress: " + strings.Join(canariesInProgress, ", ")
+	}
+
+	degraded := metav1.Condition{Type: saasv1alpha1.WorkloadDegradedCondition, Status: metav1.ConditionFalse,
+		Reason: reasonHealthy, Message: "no Deployment is failing"}
+	if len(failing) > 0 {
+		degraded.Status = metav1.ConditionTrue
+		degraded.Reason = degradedReason
+		degraded.Message = "Deployments failing: " + strings.Join(failing, ", ")
+	}
+
+	for _, c := range []metav1.Condition{ready, progressing, degraded} {
+		c.ObservedGeneration = generation
+		meta.SetStatusCondition(&status.Conditions, c)
+	}
+
+	return status
+}
+
+func deploymentSummary(dep appsv1.Deployment, services []corev1.Service,
+	canaries saasv1alpha1.CanaryStatuses) saasv1alpha1.WorkloadDeploymentStatus {
+
+	summary := saasv1alpha1.WorkloadDeploymentStatus{
+		Name:            dep.GetName(),
+		Replicas:        desiredReplicas(dep),
+		ReadyReplicas:   dep.Status.ReadyReplicas,
+		UpdatedReplicas: dep.Status.UpdatedReplicas,
+	}
+
+	if containers := dep.Spec.Template.Spec.Containers; len(containers) > 0 {
+		summary.Image = containers[0].Image
+	}
+
+	if c := canaries.Get(dep.GetName()); c != nil {
+		summary.CanaryPhase = c.Phase
+	}
+
+	podLabels := labels.Set(dep.Spec.Template.GetLabels())
+	for _, svc := range services {
+		if len(svc.Spec.Selector) == 0 || !labels.SelectorFromSet(svc.Spec.Selector).Matches(podLabels) {
+			continue
+		}
+		endpoint := saasv1alpha1.WorkloadEndpointStatus{Service: svc.GetName(), Type: svc.Spec.Type}
+		for _, ingress := range svc.Status.LoadBalancer.Ingress {
+			if ingress.Hostname != "" {
+				endpoint.LoadBalancer = append(endpoint.LoadBalancer, ingress.Hostname)
+			} else if ingress.IP != "" {
+				endpoint.LoadBalancer = append(endpoint.LoadBalancer, ingress.IP)
+			}
+		}
+		summary.Endpoints = append(summary.Endpoints, endpoint)
+	}
+	sort.Slice(summary.Endpoints, func(i, j int) bool { return summary.Endpoints[i].Service < summary.Endpoints[j].Service })
+
+	return summary
+}
+
+func desiredReplicas(dep appsv1.Deployment) int32 {
+	if dep.Spec.Replicas == nil {
+		return 1
+	}
+	return *dep.Spec.Replicas
+}
+
+func isAvailable(dep appsv1.Deployment) bool {
+	return dep.Status.AvailableReplicas >= desiredReplicas(dep)
+}
+
+// isRollingOut returns true if the Deployment controller has not yet observed the
+// latest spec or there are still replicas running an old version of the Pod template
+func isRollingOut(dep appsv1.Deployment) bool {
+	return dep.Status.ObservedGeneration < dep.GetGeneration() ||
+		dep.Status.UpdatedReplicas < desiredReplicas(dep) ||
+		dep.Status.Replicas > dep.Status.UpdatedReplicas
+}
+
+// failure returns the reason why a Deployment is failing, or
+// an empty string if the Deployment is not failing
+func failure(dep appsv1.Deployment) string {
+	for _, c := range dep.Status.Conditions {
+		switch {
+		case c.Type == appsv1.DeploymentProgressing && c.Status == corev1.ConditionFalse && c.Reason == "ProgressDeadlineExceeded":
+			return reasonProgressDeadlineExceeded
+		case c.Type == appsv1.DeploymentReplicaFailure && c.Status == corev1.ConditionTrue:
+			return reasonReplicaFailure
+		}
+	}
+	return ""
+}
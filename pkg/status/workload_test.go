@@ -0,0 +1,157 @@
+package status
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/3scale-ops/basereconciler/util"
+	saasv1alpha1 "github.com/3scale-ops/saas-operator/api/v1alpha1"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func testDeployment(name string, generation int64, replicas int32, status appsv1.DeploymentStatus) appsv1.Deployment {
+	return appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Generation: generation},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: util.Pointer(replicas),
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"deployment": name, "traffic": strings.TrimSuffix(name, "-canary")}},
+				Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: name, Image: "backend:v1"}, {Name: "envoy-sidecar", Image: "envoy"}}},
+			},
+		},
+		Status: status,
+	}
+}
+
+func TestWorkload(t *testing.T) {
+	available := appsv1.DeploymentStatus{ObservedGeneration: 2, Replicas: 2, ReadyReplicas: 2, UpdatedReplicas: 2, AvailableReplicas: 2}
+	services := []corev1.Service{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "backend-listener-nlb"},
+			Spec:       corev1.ServiceSpec{Type: corev1.ServiceTypeLoadBalancer, Selector: map[string]string{"traffic": "backend-listener"}},
+			Status: corev1.ServiceStatus{LoadBalancer: corev1.LoadBalancerStatus{
+				Ingress: []corev1.LoadBalancerIngress{{Hostname: "nlb.example.com"}, {IP: "10.0.0.1"}},
+			}},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "backend-listener-internal"},
+			Spec:       corev1.ServiceSpec{Type: corev1.ServiceTypeClusterIP, Selector: map[string]string{"deployment": "backend-listener"}},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "backend-worker"},
+			Spec:       corev1.ServiceSpec{Type: corev1.ServiceTypeClusterIP, Selector: map[string]string{"deployment": "backend-worker"}},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		deployments []appsv1.Deployment
+		canaries    saasv1alpha1.CanaryStatuses
+		want        saasv1alpha1.WorkloadStatus
+	}{
+		{
+			name:        "Ready",
+			deployments: []appsv1.Deployment{testDeployment("backend-listener", 2, 2, available)},
+			want: saasv1alpha1.WorkloadStatus{
+				ObservedGeneration: 5,
+				Conditions: []metav1.Condition{
+					{Type: "Ready", Status: metav1.ConditionTrue, Reason: "DeploymentsAvailable", Message: "all Deployments are available", ObservedGeneration: 5},
+					{Type: "Progressing", Status: metav1.ConditionFalse, Reason: "Stable", Message: "all Deployments are up to date", ObservedGeneration: 5},
+					{Type: "Degraded", Status: metav1.ConditionFalse, Reason: "Healthy", Message: "no Deployment is failing", ObservedGeneration: 5},
+				},
+				Deployments: []saasv1alpha1.WorkloadDeploymentStatus{{
+					Name: "backend-listener", Image: "backend:v1", Replicas: 2, ReadyReplicas: 2, UpdatedReplicas: 2,
+					Endpoints: []saasv1alpha1.WorkloadEndpointStatus{
+						{Service: "backend-listener-internal", Type: corev1.ServiceTypeClusterIP},
+						{Service: "backend-listener-nlb", Type: corev1.ServiceTypeLoadBalancer, LoadBalancer: []string{"nlb.example.com", "10.0.0.1"}},
+					},
+				}},
+			},
+		},
+		{
+			name: "Rolling out a canary",
+			deployments: []appsv1.Deployment{
+				testDeployment("backend-listener-canary", 1, 1, appsv1.DeploymentStatus{ObservedGeneration: 1, Replicas: 1, UpdatedReplicas: 1}),
+				testDeployment("backend-listener", 3, 2, available),
+			},
+			canaries: saasv1alpha1.CanaryStatuses{{Name: "backend-listener-canary", Phase: saasv1alpha1.CanaryPhaseProgressing}},
+			want: saasv1alpha1.WorkloadStatus{
+				ObservedGeneration: 5,
+				Conditions: []metav1.Condition{
+					{Type: "Ready", Status: metav1.ConditionFalse, Reason: "DeploymentsUnavailable", Message: "Deployments not ready: backend-listener, backend-listener-canary", ObservedGeneration: 5},
+					{Type: "Progressing", Status: metav1.ConditionTrue, Reason: "RollingOut", Message: "Deployments rolling out: backend-listener", ObservedGeneration: 5},
+					{Type: "Degraded", Status: metav1.ConditionFalse, Reason: "Healthy", Message: "no Deployment is failing", ObservedGeneration: 5},
+				},
+				Deployments: []saasv1alpha1.WorkloadDeploymentStatus{
+					{
+						Name: "backend-listener", Image: "backend:v1", Replicas: 2, ReadyReplicas: 2, UpdatedReplicas: 2,
+						Endpoints: []saasv1alpha1.WorkloadEndpointStatus{
+							{Service: "backend-listener-internal", Type: corev1.ServiceTypeClusterIP},
+							{Service: "backend-listener-nlb", Type: corev1.ServiceTypeLoadBalancer, LoadBalancer: []string{"nlb.example.com", "10.0.0.1"}},
+						},
+					},
+					{
+						Name: "backend-listener-canary", Image: "backend:v1", Replicas: 1, UpdatedReplicas: 1,
+						CanaryPhase: saasv1alpha1.CanaryPhaseProgressing,
+						Endpoints: []saasv1alpha1.WorkloadEndpointStatus{
+							{Service: "backend-listener-nlb", Type: corev1.ServiceTypeLoadBalancer, LoadBalancer: []string{"nlb.example.com", "10.0.0.1"}},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Degraded",
+			deployments: []appsv1.Deployment{
+				testDeployment("backend-worker", 2, 2, appsv1.DeploymentStatus{
+					ObservedGeneration: 2, Replicas: 3, UpdatedReplicas: 1, ReadyReplicas: 2, AvailableReplicas: 2,
+					Conditions: []appsv1.DeploymentCondition{
+						{Type: appsv1.DeploymentProgressing, Status: corev1.ConditionFalse, Reason: "ProgressDeadlineExceeded"},
+					},
+				}),
+			},
+			want: saasv1alpha1.WorkloadStatus{
+				ObservedGeneration: 5,
+				Conditions: []metav1.Condition{
+					{Type: "Ready", Status: metav1.ConditionFalse, Reason: "DeploymentsUnavailable", Message: "Deployments not ready: backend-worker", ObservedGeneration: 5},
+					{Type: "Progressing", Status: metav1.ConditionTrue, Reason: "RollingOut", Message: "Deployments rolling out: backend-worker", ObservedGeneration: 5},
+					{Type: "Degraded", Status: metav1.ConditionTrue, Reason: "ProgressDeadlineExceeded", Message: "Deployments failing: backend-worker", ObservedGeneration: 5},
+				},
+				Deployments: []saasv1alpha1.WorkloadDeploymentStatus{{
+					Name: "backend-worker", Image: "backend:v1", Replicas: 2, ReadyReplicas: 2, UpdatedReplicas: 1,
+					Endpoints: []saasv1alpha1.WorkloadEndpointStatus{
+						{Service: "backend-worker", Type: corev1.ServiceTypeClusterIP},
+					},
+				}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Workload(5, saasv1alpha1.WorkloadStatus{}, tt.deployments, services, tt.canaries)
+			if diff := cmp.Diff(got, tt.want, cmpopts.IgnoreFields(metav1.Condition{}, "LastTransitionTime")); len(diff) > 0 {
+				t.Errorf("Workload() diff = %v", diff)
+			}
+		})
+	}
+}
+
+func TestWorkload_KeepsTransitionTimes(t *testing.T) {
+	deployments := []appsv1.Deployment{testDeployment("echo-api", 1, 1,
+		appsv1.DeploymentStatus{ObservedGeneration: 1, Replicas: 1, ReadyReplicas: 1, UpdatedReplicas: 1, AvailableReplicas: 1})}
+	first := Workload(1, saasv1alpha1.WorkloadStatus{}, deployments, nil, nil)
+	past := metav1.NewTime(time.Now().Add(-time.Hour).Truncate(time.Second))
+	for i := range first.Conditions {
+		first.Conditions[i].LastTransitionTime = past
+	}
+
+	second := Workload(1, first, deployments, nil, nil)
+	if diff := cmp.Diff(second, first); len(diff) > 0 {
+		t.Errorf("Workload() status must not change, diff = %v", diff)
+	}
+}
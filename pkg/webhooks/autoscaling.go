@@ -0,0 +1,96 @@
+package webhooks
+
+import (
+	saasv1alpha1 "github.com/3scale-ops/saas-operator/api/v1alpha1"
+	"github.com/3scale-ops/saas-operator/pkg/scaling"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// Autoscaler holds the autoscaling options of a workload found
+// in a custom resource, along with the path of the workload
+type Autoscaler struct {
+	Path            *field.Path
+	HPA             *saasv1alpha1.HorizontalPodAutoscalerSpec
+	ScalingSchedule []saasv1alpha1.ScalingWindow
+}
+
+// Autoscalers returns the autoscaling options of the workloads of a custom resource
+func Autoscalers(obj runtime.Object) []Autoscaler {
+	spec := field.NewPath("spec")
+	list := []Autoscaler{}
+	add := func(fldPath *field.Path, hpa *saasv1alpha1.HorizontalPodAutoscalerSpec, schedule []saasv1alpha1.ScalingWindow) {
+		if hpa != nil || len(schedule) > 0 {
+			list = append(list, Autoscaler{Path: fldPath, HPA: hpa, ScalingSchedule: schedule})
+		}
+	}
+
+	switch o := obj.(type) {
+	case *saasv1alpha1.Apicast:
+		add(spec.Child("staging"), o.Spec.Staging.HPA, o.Spec.Staging.ScalingSchedule)
+		add(spec.Child("production"), o.Spec.Production.HPA, o.Spec.Production.ScalingSchedule)
+	case *saasv1alpha1.AutoSSL:
+		add(spec, o.Spec.HPA, nil)
+	case *saasv1alpha1.Backend:
+		add(spec.Child("listener"), o.Spec.Listener.HPA, o.Spec.Listener.ScalingSchedule)
+		if o.Spec.Worker != nil {
+			add(spec.Child("worker"), o.Spec.Worker.HPA, o.Spec.Worker.ScalingSchedule)
+		}
+	case *saasv1alpha1.CORSProxy:
+		add(spec, o.Spec.HPA, nil)
+	case *saasv1alpha1.EchoAPI:
+		add(spec, o.Spec.HPA, nil)
+	case *saasv1alpha1.MappingService:
+		add(spec, o.Spec.HPA, nil)
+	case *saasv1alpha1.System:
+		if o.Spec.App != nil {
+			add(spec.Child("app"), o.Spec.App.HPA, nil)
+		}
+		if o.Spec.SidekiqDefault != nil {
+			add(spec.Child("sidekiqDefault"), o.Spec.SidekiqDefault.HPA, nil)
+		}
+		if o.Spec.SidekiqBilling != nil {
+			add(spec.Child("sidekiqBilling"), o.Spec.SidekiqBilling.HPA, nil)
+		}
+		if o.Spec.SidekiqLow != nil {
+			add(spec.Child("sidekiqLow"), o.Spec.SidekiqLow.HPA, nil)
+		}
+	case *saasv1alpha1.Zync:
+		if o.Spec.API != nil {
+			add(spec.Child("api"), o.Spec.API.HPA, nil)
+		}
+		if o.Spec.Que != nil {
+			add(spec.Child("que"), o.Spec.Que.HPA, nil)
+		}
+	}
+
+	return list
+}
+
+// ValidateAutoscaler validates the autoscaling options of a workload
+func ValidateAutoscaler(as Autoscaler) field.ErrorList {
+	errs := field.ErrorList{}
+
+	// the ScaledObject only gets the resource metric, the
+	// other metrics must be configured as KEDA triggers
+	if hpa := as.HPA; hpa != nil && hpa.KEDA != nil {
+		fldPath := as.Path.Child("hpa")
+		if len(hpa.PodsMetrics) > 0 {
+			errs = append(errs, field.Forbidden(fldPath.Child("podsMetrics"),
+				"not supported with keda, use keda triggers instead"))
+		}
+		if len(hpa.ExternalMetrics) > 0 {
+			errs = append(errs, field.Forbidden(fldPath.Child("externalMetrics"),
+				"not supported with keda, use keda triggers instead"))
+		}
+	}
+
+	// invalid windows are skipped at runtime, reject them upfront
+	for i, w := range as.ScalingSchedule {
+		if _, err := scaling.ValidateWindow(w); err != nil {
+			errs = append(errs, field.Invalid(as.Path.Child("scalingSchedule").Index(i), w.Name, err.Error()))
+		}
+	}
+
+	return errs
+}
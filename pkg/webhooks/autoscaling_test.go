@@ -0,0 +1,101 @@
+package webhooks
+
+import (
+	"testing"
+	"time"
+
+	saasv1alpha1 "github.com/3scale-ops/saas-operator/api/v1alpha1"
+	"github.com/go-test/deep"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func TestAutoscalers(t *testing.T) {
+	tests := []struct {
+		name      string
+		obj       runtime.Object
+		wantPaths []string
+	}{
+		{
+			name: "Returns the autoscalers of the workloads",
+			obj: &saasv1alpha1.System{Spec: saasv1alpha1.SystemSpec{
+				App:            &saasv1alpha1.SystemAppSpec{HPA: &saasv1alpha1.HorizontalPodAutoscalerSpec{}},
+				SidekiqDefault: &saasv1alpha1.SystemSidekiqSpec{},
+				SidekiqLow:     &saasv1alpha1.SystemSidekiqSpec{HPA: &saasv1alpha1.HorizontalPodAutoscalerSpec{}},
+			}},
+			wantPaths: []string{"spec.app", "spec.sidekiqLow"},
+		},
+		{
+			name: "Returns the workloads with a scaling schedule",
+			obj: &saasv1alpha1.Backend{Spec: saasv1alpha1.BackendSpec{
+				Listener: saasv1alpha1.ListenerSpec{ScalingSchedule: []saasv1alpha1.ScalingWindow{{Name: "peak"}}},
+				Worker:   &saasv1alpha1.WorkerSpec{},
+			}},
+			wantPaths: []string{"spec.listener"},
+		},
+		{
+			name:      "Returns nothing for kinds without workloads",
+			obj:       &saasv1alpha1.RedisShard{},
+			wantPaths: []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := []string{}
+			for _, as := range Autoscalers(tt.obj) {
+				got = append(got, as.Path.String())
+			}
+			if diff := deep.Equal(got, tt.wantPaths); len(diff) > 0 {
+				t.Errorf("Autoscalers() = diff %v", diff)
+			}
+		})
+	}
+}
+
+func TestValidateAutoscaler(t *testing.T) {
+	tests := []struct {
+		name     string
+		as       Autoscaler
+		wantErrs []string
+	}{
+		{
+			name: "Allows metrics without keda",
+			as: Autoscaler{Path: field.NewPath("spec"), HPA: &saasv1alpha1.HorizontalPodAutoscalerSpec{
+				PodsMetrics:     []autoscalingv2.PodsMetricSource{{}},
+				ExternalMetrics: []autoscalingv2.ExternalMetricSource{{}},
+			}},
+			wantErrs: []string{},
+		},
+		{
+			name: "Rejects pods and external metrics with keda",
+			as: Autoscaler{Path: field.NewPath("spec"), HPA: &saasv1alpha1.HorizontalPodAutoscalerSpec{
+				PodsMetrics:     []autoscalingv2.PodsMetricSource{{}},
+				ExternalMetrics: []autoscalingv2.ExternalMetricSource{{}},
+				KEDA:            &saasv1alpha1.KEDASpec{},
+			}},
+			wantErrs: []string{"spec.hpa.podsMetrics", "spec.hpa.externalMetrics"},
+		},
+		{
+			name: "Rejects invalid scaling windows",
+			as: Autoscaler{Path: field.NewPath("spec", "listener"), ScalingSchedule: []saasv1alpha1.ScalingWindow{
+				{Name: "peak", Schedule: "0 8 * * 1-5", Duration: metav1.Duration{Duration: time.Hour}},
+				{Name: "invalid", Schedule: "0 8 * *", Duration: metav1.Duration{Duration: time.Hour}},
+				{Name: "zero", Schedule: "0 8 * * *"},
+			}},
+			wantErrs: []string{"spec.listener.scalingSchedule[1]", "spec.listener.scalingSchedule[2]"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := []string{}
+			for _, err := range ValidateAutoscaler(tt.as) {
+				got = append(got, err.Field)
+			}
+			if diff := deep.Equal(got, tt.wantErrs); len(diff) > 0 {
+				t.Errorf("ValidateAutoscaler() = diff %v", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,323 @@
+package webhooks
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/3scale-ops/marin3r/pkg/envoy"
+	saasv1alpha1 "github.com/3scale-ops/saas-operator/api/v1alpha1"
+	"github.com/3scale-ops/saas-operator/pkg/resource_builders/envoyconfig"
+	"github.com/3scale-ops/saas-operator/pkg/resource_builders/envoyconfig/factory"
+	"github.com/3scale-ops/saas-operator/pkg/resource_builders/envoyconfig/templates"
+	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// dynamicConfigIndex holds the names of the envoy resources generated
+// from a MapOfEnvoyDynamicConfig, used to cross check the references
+// between them
+type dynamicConfigIndex struct {
+	clusters      map[string]bool
+	routeConfigs  map[string]bool
+	listenerPorts map[uint32]string
+	certificates  map[string]*field.Path
+	sidecarPorts  map[int32]bool
+}
+
+// ValidateMarin3rSidecar validates a Marin3rSidecarSpec. It generates all the envoy
+// resources with the given factory and cross checks the references between them. It
+// returns the list of errors and a list of warnings for things that might be intentional.
+// The names of the Secrets referenced in listeners and clusters are returned so the caller can check them.
+func ValidateMarin3rSidecar(fldPath *field.Path, spec *saasv1alpha1.Marin3rSidecarSpec,
+	f factory.EnvoyDynamicConfigFactory) (field.ErrorList, []string, map[string]*field.Path) {
+
+	errs := field.ErrorList{}
+	warnings := []string{}
+
+	if spec == nil || spec.IsDeactivated() {
+		return errs, warnings, nil
+	}
+
+	idx := &dynamicConfigIndex{
+		clusters:      map[string]bool{},
+		routeConfigs:  map[string]bool{},
+		listenerPorts: map[uint32]string{},
+		certificates:  map[string]*field.Path{},
+		sidecarPorts:  map[int32]bool{},
+	}
+
+	// sidecar ports
+	names := map[string]bool{}
+	for i, port := range spec.Ports {
+		p := fldPath.Child("ports").Index(i)
+		if names[port.Name] {
+			errs = append(errs, field.Duplicate(p.Child("name"), port.Name))
+		}
+		if idx.sidecarPorts[port.Port] {
+			errs = append(errs, field.Duplicate(p.Child("port"), port.Port))
+		}
+		names[port.Name] = true
+		idx.sidecarPorts[port.Port] = true
+	}
+	if spec.ShutdownManagerPort != nil && idx.sidecarPorts[int32(*spec.ShutdownManagerPort)] {
+		errs = append(errs, field.Invalid(fldPath.Child("shtdnmgrPort"), *spec.ShutdownManagerPort,
+			"the shutdown manager port is already used by one of the sidecar ports"))
+	}
+
+	// generate each resource independently so errors can be reported
+	// with the path of the dynamic config that caused them
+	dcPath := fldPath.Child("dynamicConfigs")
+	for _, name := range sortedKeys(spec.EnvoyDynamicConfig) {
+		conf := spec.EnvoyDynamicConfig[name]
+		p := dcPath.Key(name)
+
+		if conf.GeneratorVersion == nil {
+			errs = append(errs, field.Required(p.Child("generatorVersion"), ""))
+			continue
+		}
+		if conf.GetOptions() == nil {
+			errs = append(errs, field.Required(p, "one of the dynamic config types must be set"))
+			continue
+		}
+		dc := conf.DeepCopy()
+		dc.Default()
+		res, err := f.NewResource(dc.AsEnvoyDynamicConfigDescriptor(name))
+		if err != nil {
+			errs = append(errs, field.Invalid(p, name, err.Error()))
+			continue
+		}
+		idx.add(res)
+
+		errs = append(errs, idx.addListener(p, name, &conf)...)
+		if conf.Cluster != nil && *conf.GeneratorVersion == "v1" {
+			for _, f := range clusterV2Fields(conf.Cluster) {
+				warnings = append(warnings, fmt.Sprintf("%s: %s is ignored by generator version v1", p, f))
+			}
+		}
+		if conf.Cluster != nil && conf.Cluster.UpstreamTLS != nil {
+			errs = append(errs, idx.addUpstreamTLS(p.Child("cluster", "upstreamTLS"), conf.Cluster.UpstreamTLS)...)
+		}
+	}
+
+	// tracing
+	if spec.Tracing != nil {
+		p := fldPath.Child("tracing")
+		if _, ok := spec.EnvoyDynamicConfig[saasv1alpha1.TracingCollectorClusterName]; ok {
+			errs = append(errs, field.Duplicate(dcPath.Key(saasv1alpha1.TracingCollectorClusterName),
+				"the name is reserved for the cluster of the tracing collector"))
+		}
+		if spec.Tracing.CollectorHost == "" {
+			errs = append(errs, field.Required(p.Child("collectorHost"), ""))
+		}
+		if _, err := templates.Tracing_v1(spec.Tracing); err != nil {
+			errs = append(errs, field.Invalid(p, "", err.Error()))
+		}
+	}
+
+	// stats sink
+	if spec.StatsSink != nil {
+		if _, ok := spec.EnvoyDynamicConfig[saasv1alpha1.StatsCollectorClusterName]; ok {
+			errs = append(errs, field.Duplicate(dcPath.Key(saasv1alpha1.StatsCollectorClusterName),
+				"the name is reserved for the cluster of the stats collector"))
+		}
+		if _, err := templates.StatsSinkBootstrap_v1(spec.StatsSink); err != nil {
+			errs = append(errs, field.Invalid(fldPath.Child("statsSink"), "", err.Error()))
+		}
+	}
+
+	// cross check references between resources
+	for _, name := range sortedKeys(spec.EnvoyDynamicConfig) {
+		conf := spec.EnvoyDynamicConfig[name]
+		errs = append(errs, idx.checkReferences(dcPath.Key(name), &conf)...)
+	}
+
+	// listener ports should be exposed by the sidecar
+	if len(spec.Ports) > 0 {
+		ports := make([]int, 0, len(idx.listenerPorts))
+		for port := range idx.listenerPorts {
+			ports = append(ports, int(port))
+		}
+		sort.Ints(ports)
+		for _, port := range ports {
+			if !idx.sidecarPorts[int32(port)] {
+				warnings = append(warnings, fmt.Sprintf("%s: port %d is not exposed in %s",
+					dcPath.Key(idx.listenerPorts[uint32(port)]), port, fldPath.Child("ports")))
+			}
+		}
+	}
+
+	// generate the whole EnvoyConfig to catch errors that involve several resources
+	if len(errs) == 0 && len(spec.EnvoyDynamicConfig) > 0 {
+		if dcs, err := spec.DynamicConfigs(); err != nil {
+			errs = append(errs, field.Invalid(dcPath, "", err.Error()))
+		} else if _, err := envoyconfig.New(types.NamespacedName{}, "", f, dcs...)(nil); err != nil {
+			errs = append(errs, field.Invalid(dcPath, "", err.Error()))
+		}
+	}
+
+	return errs, warnings, idx.certificates
+}
+
+// add indexes the names of the generated resources. Resources
+// defined using RawConfig are indexed too.
+func (idx *dynamicConfigIndex) add(res envoy.Resource) {
+	switch o := res.(type) {
+	case *envoy_config_cluster_v3.Cluster:
+		idx.clusters[o.GetName()] = true
+	case *envoy_config_route_v3.RouteConfiguration:
+		idx.routeConfigs[o.GetName()] = true
+	}
+}
+
+// clusterV2Fields returns the fields of a cluster that are set
+// but only used by the v2 generator
+func clusterV2Fields(c *saasv1alpha1.Cluster) []string {
+	fields := []string{}
+	if len(c.Endpoints) > 0 {
+		fields = append(fields, "endpoints")
+	}
+	if c.DiscoveryType != nil {
+		fields = append(fields, "discoveryType")
+	}
+	if c.ConnectTimeout != nil {
+		fields = append(fields, "connectTimeout")
+	}
+	if c.LbPolicy != nil {
+		fields = append(fields, "lbPolicy")
+	}
+	if len(c.HealthChecks) > 0 {
+		fields = append(fields, "healthChecks")
+	}
+	if c.OutlierDetection != nil {
+		fields = append(fields, "outlierDetection")
+	}
+	if c.CircuitBreakers != nil {
+		fields = append(fields, "circuitBreakers")
+	}
+	if c.UpstreamTLS != nil {
+		fields = append(fields, "upstreamTLS")
+	}
+	return fields
+}
+
+func (idx *dynamicConfigIndex) addUpstreamTLS(fldPath *field.Path, tls *saasv1alpha1.ClusterUpstreamTLS) field.ErrorList {
+	errs := field.ErrorList{}
+	if tls.ClientCertificateSecretName != nil {
+		p := fldPath.Child("clientCertificateSecretName")
+		if *tls.ClientCertificateSecretName == "" {
+			errs = append(errs, field.Required(p, "must not be empty if set"))
+		} else {
+			idx.certificates[*tls.ClientCertificateSecretName] = p
+		}
+	}
+	if tls.Validation != nil && tls.Validation.TrustedCASecretName != nil {
+		idx.certificates[*tls.Validation.TrustedCASecretName] = fldPath.Child("validation", "trustedCASecretName")
+	}
+	return errs
+}
+
+func (idx *dynamicConfigIndex) addListener(fldPath *field.Path, name string, conf *saasv1alpha1.EnvoyDynamicConfig) field.ErrorList {
+	errs := field.ErrorList{}
+
+	var port uint32
+	var portPath *field.Path
+	switch {
+	case conf.ListenerHttp != nil:
+		port, portPath = conf.ListenerHttp.Port, fldPath.Child("listenerHttp", "port")
+		if conf.ListenerHttp.CertificateSecretName != nil {
+			p := fldPath.Child("listenerHttp", "certificateSecretName")
+			if *conf.ListenerHttp.CertificateSecretName == "" {
+				errs = append(errs, field.Required(p, "must not be empty if set"))
+			} else {
+				idx.certificates[*conf.ListenerHttp.CertificateSecretName] = p
+			}
+		}
+	case conf.ListenerTcp != nil:
+		port, portPath = conf.ListenerTcp.Port, fldPath.Child("listenerTcp", "port")
+	default:
+		return errs
+	}
+
+	if other, ok := idx.listenerPorts[port]; ok {
+		errs = append(errs, field.Invalid(portPath, port, fmt.Sprintf("port already used by listener '%s'", other)))
+	} else {
+		idx.listenerPorts[port] = name
+	}
+	return errs
+}
+
+func (idx *dynamicConfigIndex) checkReferences(fldPath *field.Path, conf *saasv1alpha1.EnvoyDynamicConfig) field.ErrorList {
+	errs := field.ErrorList{}
+
+	cluster := func(p *field.Path, name string) {
+		if !idx.clusters[name] {
+			errs = append(errs, field.NotFound(p, name))
+		}
+	}
+	routeConfig := func(p *field.Path, name string) {
+		if !idx.routeConfigs[name] {
+			errs = append(errs, field.NotFound(p, name))
+		}
+	}
+
+	switch {
+	case conf.ListenerHttp != nil:
+		p := fldPath.Child("listenerHttp")
+		routeConfig(p.Child("routeConfigName"), conf.ListenerHttp.RouteConfigName)
+		if conf.ListenerHttp.RateLimitOptions != nil {
+			cluster(p.Child("rateLimitOptions", "rateLimitCluster"), conf.ListenerHttp.RateLimitOptions.RateLimitCluster)
+		}
+		if conf.ListenerHttp.AccessLog != nil && conf.ListenerHttp.AccessLog.GrpcSink != nil {
+			cluster(p.Child("accessLog", "grpcSink", "cluster"), conf.ListenerHttp.AccessLog.GrpcSink.Cluster)
+		}
+		if conf.ListenerHttp.ExtAuthz != nil {
+			cluster(p.Child("extAuthz", "cluster"), conf.ListenerHttp.ExtAuthz.Cluster)
+		}
+		if conf.ListenerHttp.JwtAuthn != nil {
+			for i, provider := range conf.ListenerHttp.JwtAuthn.Providers {
+				if provider.RemoteJwks != nil {
+					cluster(p.Child("jwtAuthn", "providers").Index(i).Child("remoteJwks", "cluster"), provider.RemoteJwks.Cluster)
+				}
+			}
+		}
+
+	case conf.ListenerTcp != nil:
+		p := fldPath.Child("listenerTcp")
+		if conf.ListenerTcp.Cluster != nil {
+			cluster(p.Child("cluster"), *conf.ListenerTcp.Cluster)
+		}
+		for i, fc := range conf.ListenerTcp.FilterChains {
+			cluster(p.Child("filterChains").Index(i).Child("cluster"), fc.Cluster)
+		}
+
+	case conf.RouteConfiguration != nil:
+		for i, vh := range conf.RouteConfiguration.TypedVirtualHosts {
+			vhPath := fldPath.Child("routeConfiguration", "typedVirtualHosts").Index(i)
+			for j, route := range vh.Routes {
+				if route.Route == nil {
+					continue
+				}
+				p := vhPath.Child("routes").Index(j).Child("route")
+				if route.Route.Cluster != nil {
+					cluster(p.Child("cluster"), *route.Route.Cluster)
+				}
+				for k, wc := range route.Route.WeightedClusters {
+					cluster(p.Child("weightedClusters").Index(k).Child("name"), wc.Name)
+				}
+			}
+		}
+	}
+
+	return errs
+}
+
+func sortedKeys(m saasv1alpha1.MapOfEnvoyDynamicConfig) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,291 @@
+package webhooks
+
+import (
+	"testing"
+	"time"
+
+	"github.com/3scale-ops/basereconciler/util"
+	saasv1alpha1 "github.com/3scale-ops/saas-operator/api/v1alpha1"
+	"github.com/3scale-ops/saas-operator/pkg/resource_builders/envoyconfig/factory"
+	"github.com/go-test/deep"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func testSidecarSpec() *saasv1alpha1.Marin3rSidecarSpec {
+	return &saasv1alpha1.Marin3rSidecarSpec{
+		Ports: []saasv1alpha1.SidecarPort{{Name: "http", Port: 8080}},
+		EnvoyDynamicConfig: saasv1alpha1.MapOfEnvoyDynamicConfig{
+			"http": {
+				GeneratorVersion: util.Pointer("v1"),
+				ListenerHttp: &saasv1alpha1.ListenerHttp{
+					Port:            8080,
+					RouteConfigName: "router",
+					ProxyProtocol:   util.Pointer(true),
+					EnableHttp2:     util.Pointer(false),
+					RateLimitOptions: &saasv1alpha1.RateLimitOptions{
+						Domain:           "domain",
+						FailureModeDeny:  util.Pointer(false),
+						Timeout:          metav1.Duration{Duration: 10000000},
+						RateLimitCluster: "limitador",
+					},
+				},
+			},
+			"router": {
+				GeneratorVersion: util.Pointer("v1"),
+				RouteConfiguration: &saasv1alpha1.RouteConfiguration{
+					TypedVirtualHosts: []saasv1alpha1.VirtualHost{{
+						Name:    "vhost",
+						Domains: []string{"*"},
+						Routes: []saasv1alpha1.Route{{
+							Match: saasv1alpha1.RouteMatch{Prefix: util.Pointer("/")},
+							Route: &saasv1alpha1.RouteAction{Cluster: util.Pointer("backend")},
+						}},
+					}},
+				},
+			},
+			"backend": {
+				GeneratorVersion: util.Pointer("v1"),
+				Cluster:          &saasv1alpha1.Cluster{Host: "127.0.0.1", Port: 3000, IsHttp2: util.Pointer(false)},
+			},
+			"limitador": {
+				GeneratorVersion: util.Pointer("v1"),
+				Cluster:          &saasv1alpha1.Cluster{Host: "limitador", Port: 8081, IsHttp2: util.Pointer(true)},
+			},
+		},
+	}
+}
+
+func TestValidateMarin3rSidecar(t *testing.T) {
+	fldPath := field.NewPath("spec", "marin3rSidecar")
+	tests := []struct {
+		name         string
+		spec         func() *saasv1alpha1.Marin3rSidecarSpec
+		wantErrs     []string
+		wantWarnings []string
+		wantSecrets  []string
+	}{
+		{
+			name:         "Valid config",
+			spec:         testSidecarSpec,
+			wantErrs:     []string{},
+			wantWarnings: []string{},
+			wantSecrets:  []string{},
+		},
+		{
+			name: "Deactivated sidecar",
+			spec: func() *saasv1alpha1.Marin3rSidecarSpec {
+				return &saasv1alpha1.Marin3rSidecarSpec{}
+			},
+			wantErrs:     []string{},
+			wantWarnings: []string{},
+			wantSecrets:  []string{},
+		},
+		{
+			name: "Unknown route configuration",
+			spec: func() *saasv1alpha1.Marin3rSidecarSpec {
+				spec := testSidecarSpec()
+				spec.EnvoyDynamicConfig["http"].ListenerHttp.RouteConfigName = "unknown"
+				return spec
+			},
+			wantErrs:     []string{"spec.marin3rSidecar.dynamicConfigs[http].listenerHttp.routeConfigName"},
+			wantWarnings: []string{},
+			wantSecrets:  []string{},
+		},
+		{
+			name: "Unknown clusters",
+			spec: func() *saasv1alpha1.Marin3rSidecarSpec {
+				spec := testSidecarSpec()
+				delete(spec.EnvoyDynamicConfig, "limitador")
+				delete(spec.EnvoyDynamicConfig, "backend")
+				return spec
+			},
+			wantErrs: []string{
+				"spec.marin3rSidecar.dynamicConfigs[http].listenerHttp.rateLimitOptions.rateLimitCluster",
+				"spec.marin3rSidecar.dynamicConfigs[router].routeConfiguration.typedVirtualHosts[0].routes[0].route.cluster",
+			},
+			wantWarnings: []string{},
+			wantSecrets:  []string{},
+		},
+		{
+			name: "Unknown authentication and authorization clusters",
+			spec: func() *saasv1alpha1.Marin3rSidecarSpec {
+				spec := testSidecarSpec()
+				spec.EnvoyDynamicConfig["http"].ListenerHttp.ExtAuthz = &saasv1alpha1.ExtAuthz{
+					Type: saasv1alpha1.ExtAuthzTypeGrpc, Cluster: "authz",
+				}
+				spec.EnvoyDynamicConfig["http"].ListenerHttp.JwtAuthn = &saasv1alpha1.JwtAuthn{
+					Providers: []saasv1alpha1.JwtProvider{{
+						Name:       "sso",
+						RemoteJwks: &saasv1alpha1.JwtRemoteJwks{URI: "https://sso.example.com/certs", Cluster: "sso"},
+					}},
+				}
+				return spec
+			},
+			wantErrs: []string{
+				"spec.marin3rSidecar.dynamicConfigs[http].listenerHttp.extAuthz.cluster",
+				"spec.marin3rSidecar.dynamicConfigs[http].listenerHttp.jwtAuthn.providers[0].remoteJwks.cluster",
+			},
+			wantWarnings: []string{},
+			wantSecrets:  []string{},
+		},
+		{
+			name: "Duplicate listener and sidecar ports",
+			spec: func() *saasv1alpha1.Marin3rSidecarSpec {
+				spec := testSidecarSpec()
+				spec.Ports = append(spec.Ports, saasv1alpha1.SidecarPort{Name: "http", Port: 8080})
+				spec.ShutdownManagerPort = util.Pointer(uint32(8080))
+				spec.EnvoyDynamicConfig["other"] = saasv1alpha1.EnvoyDynamicConfig{
+					GeneratorVersion: util.Pointer("v1"),
+					ListenerHttp: &saasv1alpha1.ListenerHttp{
+						Port: 8080, RouteConfigName: "router",
+						ProxyProtocol: util.Pointer(false), EnableHttp2: util.Pointer(false),
+					},
+				}
+				return spec
+			},
+			wantErrs: []string{
+				"spec.marin3rSidecar.ports[1].name",
+				"spec.marin3rSidecar.ports[1].port",
+				"spec.marin3rSidecar.shtdnmgrPort",
+				"spec.marin3rSidecar.dynamicConfigs[other].listenerHttp.port",
+			},
+			wantWarnings: []string{},
+			wantSecrets:  []string{},
+		},
+		{
+			name: "Unknown generator version",
+			spec: func() *saasv1alpha1.Marin3rSidecarSpec {
+				spec := testSidecarSpec()
+				spec.EnvoyDynamicConfig["backend"] = saasv1alpha1.EnvoyDynamicConfig{
+					GeneratorVersion: util.Pointer("v9"),
+					Cluster:          &saasv1alpha1.Cluster{Host: "127.0.0.1", Port: 3000, IsHttp2: util.Pointer(false)},
+				}
+				return spec
+			},
+			wantErrs: []string{
+				"spec.marin3rSidecar.dynamicConfigs[backend]",
+				"spec.marin3rSidecar.dynamicConfigs[router].routeConfiguration.typedVirtualHosts[0].routes[0].route.cluster",
+			},
+			wantWarnings: []string{},
+			wantSecrets:  []string{},
+		},
+		{
+			name: "Warns about listener ports not exposed and returns the secrets",
+			spec: func() *saasv1alpha1.Marin3rSidecarSpec {
+				spec := testSidecarSpec()
+				spec.EnvoyDynamicConfig["http"].ListenerHttp.Port = 8443
+				spec.EnvoyDynamicConfig["http"].ListenerHttp.CertificateSecretName = util.Pointer("my-cert")
+				return spec
+			},
+			wantErrs: []string{},
+			wantWarnings: []string{
+				"spec.marin3rSidecar.dynamicConfigs[http]: port 8443 is not exposed in spec.marin3rSidecar.ports",
+			},
+			wantSecrets: []string{"my-cert"},
+		},
+		{
+			name: "Returns the upstream client certificates",
+			spec: func() *saasv1alpha1.Marin3rSidecarSpec {
+				spec := testSidecarSpec()
+				spec.EnvoyDynamicConfig["backend"] = saasv1alpha1.EnvoyDynamicConfig{
+					GeneratorVersion: util.Pointer("v2"),
+					Cluster: &saasv1alpha1.Cluster{
+						Host: "127.0.0.1", Port: 3000, IsHttp2: util.Pointer(false),
+						UpstreamTLS: &saasv1alpha1.ClusterUpstreamTLS{ClientCertificateSecretName: util.Pointer("client-cert")},
+					},
+				}
+				spec.EnvoyDynamicConfig["limitador"].Cluster.UpstreamTLS = &saasv1alpha1.ClusterUpstreamTLS{}
+				return spec
+			},
+			wantErrs: []string{},
+			wantWarnings: []string{
+				"spec.marin3rSidecar.dynamicConfigs[limitador]: upstreamTLS is ignored by generator version v1",
+			},
+			wantSecrets: []string{"client-cert"},
+		},
+		{
+			name: "Warns about cluster fields ignored by generator version v1",
+			spec: func() *saasv1alpha1.Marin3rSidecarSpec {
+				spec := testSidecarSpec()
+				spec.EnvoyDynamicConfig["limitador"].Cluster.ConnectTimeout = &metav1.Duration{Duration: time.Second}
+				spec.EnvoyDynamicConfig["limitador"].Cluster.CircuitBreakers = &saasv1alpha1.ClusterCircuitBreakers{}
+				return spec
+			},
+			wantErrs: []string{},
+			wantWarnings: []string{
+				"spec.marin3rSidecar.dynamicConfigs[limitador]: connectTimeout is ignored by generator version v1",
+				"spec.marin3rSidecar.dynamicConfigs[limitador]: circuitBreakers is ignored by generator version v1",
+			},
+			wantSecrets: []string{},
+		},
+		{
+			name: "Tracing with a valid config",
+			spec: func() *saasv1alpha1.Marin3rSidecarSpec {
+				spec := testSidecarSpec()
+				spec.Tracing = &saasv1alpha1.TracingOptions{
+					Provider: saasv1alpha1.TracingProviderOpenTelemetry, CollectorHost: "otel-collector", CollectorPort: 4317,
+				}
+				return spec
+			},
+			wantErrs:     []string{},
+			wantWarnings: []string{},
+			wantSecrets:  []string{},
+		},
+		{
+			name: "Tracing with a reserved cluster name and invalid options",
+			spec: func() *saasv1alpha1.Marin3rSidecarSpec {
+				spec := testSidecarSpec()
+				spec.EnvoyDynamicConfig[saasv1alpha1.TracingCollectorClusterName] = spec.EnvoyDynamicConfig["backend"]
+				spec.Tracing = &saasv1alpha1.TracingOptions{
+					Provider:   saasv1alpha1.TracingProviderZipkin,
+					CustomTags: []saasv1alpha1.TracingCustomTag{{Tag: "env"}},
+				}
+				return spec
+			},
+			wantErrs: []string{
+				"spec.marin3rSidecar.dynamicConfigs[tracing_collector]",
+				"spec.marin3rSidecar.tracing.collectorHost",
+				"spec.marin3rSidecar.tracing",
+			},
+			wantWarnings: []string{},
+			wantSecrets:  []string{},
+		},
+		{
+			name: "Stats sink with a reserved cluster name",
+			spec: func() *saasv1alpha1.Marin3rSidecarSpec {
+				spec := testSidecarSpec()
+				spec.EnvoyDynamicConfig[saasv1alpha1.StatsCollectorClusterName] = spec.EnvoyDynamicConfig["backend"]
+				spec.StatsSink = &saasv1alpha1.StatsSinkOptions{CollectorHost: "otel-collector", CollectorPort: 4317}
+				return spec
+			},
+			wantErrs: []string{
+				"spec.marin3rSidecar.dynamicConfigs[stats_collector]",
+			},
+			wantWarnings: []string{},
+			wantSecrets:  []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs, warnings, secrets := ValidateMarin3rSidecar(fldPath, tt.spec(), factory.Default())
+			gotErrs := []string{}
+			for _, err := range errs {
+				gotErrs = append(gotErrs, err.Field)
+			}
+			if diff := deep.Equal(gotErrs, tt.wantErrs); len(diff) > 0 {
+				t.Errorf("ValidateMarin3rSidecar() errors = diff %v (%v)", diff, errs)
+			}
+			if diff := deep.Equal(warnings, tt.wantWarnings); len(diff) > 0 {
+				t.Errorf("ValidateMarin3rSidecar() warnings = diff %v", diff)
+			}
+			gotSecrets := []string{}
+			for name := range secrets {
+				gotSecrets = append(gotSecrets, name)
+			}
+			if diff := deep.Equal(gotSecrets, tt.wantSecrets); len(diff) > 0 {
+				t.Errorf("ValidateMarin3rSidecar() secrets = diff %v", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package webhooks
+
+import (
+	saasv1alpha1 "github.com/3scale-ops/saas-operator/api/v1alpha1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ValidateRouteStrategy validates the options of the Route publishing strategy
+// against the kind of object that is generated to publish the endpoint
+func ValidateRouteStrategy(fldPath *field.Path, spec *saasv1alpha1.RouteStrategySpec) field.ErrorList {
+	errs := field.ErrorList{}
+
+	kind := saasv1alpha1.RouteKindHTTPRoute
+	if spec.Kind != nil {
+		kind = *spec.Kind
+	}
+
+	switch kind {
+	case saasv1alpha1.RouteKindHTTPRoute, saasv1alpha1.RouteKindTLSRoute:
+		if len(spec.ParentRefs) == 0 {
+			errs = append(errs, field.Required(fldPath.Child("parentRefs"), "required when kind is "+string(kind)))
+		}
+		if kind == saasv1alpha1.RouteKindTLSRoute && len(spec.Hostnames) == 0 {
+			errs = append(errs, field.Required(fldPath.Child("hostnames"), "required when kind is "+string(kind)))
+		}
+		if kind == saasv1alpha1.RouteKindTLSRoute && spec.PathPrefix != nil {
+			errs = append(errs, field.Forbidden(fldPath.Child("pathPrefix"), "not supported when kind is "+string(kind)))
+		}
+		if spec.IngressClassName != nil {
+			errs = append(errs, field.Forbidden(fldPath.Child("ingressClassName"), "only supported when kind is Ingress"))
+		}
+		if spec.TLSSecretName != nil {
+			errs = append(errs, field.Forbidden(fldPath.Child("tlsSecretName"),
+				"only supported when kind is Ingress, configure TLS in the listeners of the Gateway instead"))
+		}
+
+	case saasv1alpha1.RouteKindIngress:
+		if len(spec.ParentRefs) > 0 {
+			errs = append(errs, field.Forbidden(fldPath.Child("parentRefs"), "not supported when kind is Ingress"))
+		}
+		if spec.Weight != nil {
+			errs = append(errs, field.Forbidden(fldPath.Child("weight"), "weighted backends are not supported when kind is Ingress"))
+		}
+		if len(spec.ExtraBackends) > 0 {
+			errs = append(errs, field.Forbidden(fldPath.Child("extraBackends"), "weighted backends are not supported when kind is Ingress"))
+		}
+		if spec.TLSSecretName != nil && len(spec.Hostnames) == 0 {
+			errs = append(errs, field.Required(fldPath.Child("hostnames"), "required when tlsSecretName is set"))
+		}
+	}
+
+	return errs
+}
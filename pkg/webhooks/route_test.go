@@ -0,0 +1,57 @@
+package webhooks
+
+import (
+	"testing"
+
+	"github.com/3scale-ops/basereconciler/util"
+	saasv1alpha1 "github.com/3scale-ops/saas-operator/api/v1alpha1"
+	"github.com/go-test/deep"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func TestValidateRouteStrategy(t *testing.T) {
+	tests := []struct {
+		name     string
+		spec     *saasv1alpha1.RouteStrategySpec
+		wantErrs []string
+	}{
+		{
+			name: "Valid HTTPRoute",
+			spec: &saasv1alpha1.RouteStrategySpec{
+				ParentRefs: []saasv1alpha1.RouteParentRef{{Name: "gw"}},
+				Weight:     util.Pointer(int32(90)),
+			},
+			wantErrs: []string{},
+		},
+		{
+			name: "TLSRoute requires parentRefs and hostnames",
+			spec: &saasv1alpha1.RouteStrategySpec{
+				Kind:          util.Pointer(saasv1alpha1.RouteKindTLSRoute),
+				TLSSecretName: util.Pointer("tls"),
+			},
+			wantErrs: []string{"route.parentRefs", "route.hostnames", "route.tlsSecretName"},
+		},
+		{
+			name: "Ingress does not support gateways or weights",
+			spec: &saasv1alpha1.RouteStrategySpec{
+				Kind:          util.Pointer(saasv1alpha1.RouteKindIngress),
+				ParentRefs:    []saasv1alpha1.RouteParentRef{{Name: "gw"}},
+				Weight:        util.Pointer(int32(90)),
+				ExtraBackends: []saasv1alpha1.RouteBackend{{Name: "canary", Port: 80}},
+				TLSSecretName: util.Pointer("tls"),
+			},
+			wantErrs: []string{"route.parentRefs", "route.weight", "route.extraBackends", "route.hostnames"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotErrs := []string{}
+			for _, err := range ValidateRouteStrategy(field.NewPath("route"), tt.spec) {
+				gotErrs = append(gotErrs, err.Field)
+			}
+			if diff := deep.Equal(gotErrs, tt.wantErrs); len(diff) > 0 {
+				t.Errorf("ValidateRouteStrategy() errors = diff %v", diff)
+			}
+		})
+	}
+}
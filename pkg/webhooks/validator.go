@@ -0,0 +1,212 @@
+package webhooks
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	saasv1alpha1 "github.com/3scale-ops/saas-operator/api/v1alpha1"
+	"github.com/3scale-ops/saas-operator/pkg/resource_builders/envoyconfig/factory"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// +kubebuilder:webhook:path=/validate-saas-3scale-net-v1alpha1-apicast,mutating=false,failurePolicy=fail,sideEffects=None,groups=saas.3scale.net,resources=apicasts,verbs=create;update,versions=v1alpha1,name=vapicast.saas.3scale.net,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/validate-saas-3scale-net-v1alpha1-autossl,mutating=false,failurePolicy=fail,sideEffects=None,groups=saas.3scale.net,resources=autossls,verbs=create;update,versions=v1alpha1,name=vautossl.saas.3scale.net,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/validate-saas-3scale-net-v1alpha1-backend,mutating=false,failurePolicy=fail,sideEffects=None,groups=saas.3scale.net,resources=backends,verbs=create;update,versions=v1alpha1,name=vbackend.saas.3scale.net,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/validate-saas-3scale-net-v1alpha1-corsproxy,mutating=false,failurePolicy=fail,sideEffects=None,groups=saas.3scale.net,resources=corsproxies,verbs=create;update,versions=v1alpha1,name=vcorsproxy.saas.3scale.net,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/validate-saas-3scale-net-v1alpha1-echoapi,mutating=false,failurePolicy=fail,sideEffects=None,groups=saas.3scale.net,resources=echoapis,verbs=create;update,versions=v1alpha1,name=vechoapi.saas.3scale.net,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/validate-saas-3scale-net-v1alpha1-mappingservice,mutating=false,failurePolicy=fail,sideEffects=None,groups=saas.3scale.net,resources=mappingservices,verbs=create;update,versions=v1alpha1,name=vmappingservice.saas.3scale.net,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/validate-saas-3scale-net-v1alpha1-system,mutating=false,failurePolicy=fail,sideEffects=None,groups=saas.3scale.net,resources=systems,verbs=create;update,versions=v1alpha1,name=vsystem.saas.3scale.net,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/validate-saas-3scale-net-v1alpha1-zync,mutating=false,failurePolicy=fail,sideEffects=None,groups=saas.3scale.net,resources=zyncs,verbs=create;update,versions=v1alpha1,name=vzync.saas.3scale.net,admissionReviewVersions=v1
+
+// Marin3rSidecarValidator validates the marin3r sidecar configurations
+// of the custom resources that can publish endpoints through a sidecar,
+// together with the autoscaling options of their workloads
+type Marin3rSidecarValidator struct {
+	Client  client.Reader
+	Factory factory.EnvoyDynamicConfigFactory
+}
+
+var _ admission.CustomValidator = &Marin3rSidecarValidator{}
+
+// SetupWebhooks registers the validation webhooks with the manager
+func SetupWebhooks(mgr ctrl.Manager) error {
+	v := &Marin3rSidecarValidator{Client: mgr.GetAPIReader(), Factory: factory.Default()}
+
+	for _, obj := range []runtime.Object{
+		&saasv1alpha1.Apicast{},
+		&saasv1alpha1.AutoSSL{},
+		&saasv1alpha1.Backend{},
+		&saasv1alpha1.CORSProxy{},
+		&saasv1alpha1.EchoAPI{},
+		&saasv1alpha1.MappingService{},
+		&saasv1alpha1.System{},
+		&saasv1alpha1.Zync{},
+	} {
+		if err := ctrl.NewWebhookManagedBy(mgr).For(obj).WithValidator(v).Complete(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ValidateCreate implements admission.CustomValidator
+func (v *Marin3rSidecarValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return v.validate(ctx, obj)
+}
+
+// ValidateUpdate implements admission.CustomValidator
+func (v *Marin3rSidecarValidator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	return v.validate(ctx, newObj)
+}
+
+// ValidateDelete implements admission.CustomValidator
+func (v *Marin3rSidecarValidator) ValidateDelete(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (v *Marin3rSidecarValidator) validate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	o, ok := obj.(client.Object)
+	if !ok {
+		return nil, fmt.Errorf("unexpected object type %T", obj)
+	}
+
+	errs, warnings, secrets := Validate(obj, v.Factory)
+	if len(errs) > 0 {
+		return warnings, apierrors.NewInvalid(obj.GetObjectKind().GroupVersionKind().GroupKind(), o.GetName(), errs)
+	}
+
+	// a missing Secret is not an error, it might be created
+	// afterwards by an ExternalSecret or cert-manager
+	if v.Client != nil {
+		for _, name := range sortedPathKeys(secrets) {
+			err := v.Client.Get(ctx, types.NamespacedName{Name: name, Namespace: o.GetNamespace()}, &corev1.Secret{})
+			if apierrors.IsNotFound(err) {
+				warnings = append(warnings, fmt.Sprintf("%s: Secret '%s' not found", secrets[name], name))
+			} else if err != nil {
+				return warnings, err
+			}
+		}
+	}
+
+	return warnings, nil
+}
+
+// Validate validates the marin3r sidecars and the autoscaling options of a custom
+// resource without querying the API. It returns the list of errors, a list of warnings
+// and the names of the Secrets referenced by the sidecars. It is used by the webhook and,
+// as the webhook is opt-in, by the controllers to report invalid specs at reconcile time.
+func Validate(obj runtime.Object, f factory.EnvoyDynamicConfigFactory) (field.ErrorList, admission.Warnings, map[string]*field.Path) {
+	sidecars, errs := Marin3rSidecars(obj)
+	warnings := admission.Warnings{}
+	secrets := map[string]*field.Path{}
+
+	for _, as := range Autoscalers(obj) {
+		errs = append(errs, ValidateAutoscaler(as)...)
+	}
+
+	for _, sc := range sidecars {
+		e, w, s := ValidateMarin3rSidecar(sc.Path, sc.Spec, f)
+		errs = append(errs, e...)
+		warnings = append(warnings, w...)
+		for name, p := range s {
+			secrets[name] = p
+		}
+	}
+
+	return errs, warnings, secrets
+}
+
+// Marin3rSidecar is a Marin3rSidecarSpec found in a
+// custom resource, along with its path
+type Marin3rSidecar struct {
+	Path *field.Path
+	Spec *saasv1alpha1.Marin3rSidecarSpec
+}
+
+// Marin3rSidecars returns the Marin3rSidecarSpecs of a custom resource,
+// both from the publishing strategies and the deprecated "marin3r" fields
+func Marin3rSidecars(obj runtime.Object) ([]Marin3rSidecar, field.ErrorList) {
+	spec := field.NewPath("spec")
+	list := &sidecarList{}
+
+	switch o := obj.(type) {
+	case *saasv1alpha1.Apicast:
+		list.addStrategies(spec.Child("staging", "publishingStrategies"), o.Spec.Staging.PublishingStrategies)
+		list.add(spec.Child("staging", "marin3r"), o.Spec.Staging.Marin3r)
+		list.addStrategies(spec.Child("production", "publishingStrategies"), o.Spec.Production.PublishingStrategies)
+		list.add(spec.Child("production", "marin3r"), o.Spec.Production.Marin3r)
+	case *saasv1alpha1.AutoSSL:
+		list.addStrategies(spec.Child("publishingStrategies"), o.Spec.PublishingStrategies)
+	case *saasv1alpha1.Backend:
+		list.addStrategies(spec.Child("listener", "publishingStrategies"), o.Spec.Listener.PublishingStrategies)
+		list.add(spec.Child("listener", "marin3r"), o.Spec.Listener.Marin3r)
+	case *saasv1alpha1.CORSProxy:
+		list.addStrategies(spec.Child("publishingStrategies"), o.Spec.PublishingStrategies)
+	case *saasv1alpha1.EchoAPI:
+		list.addStrategies(spec.Child("publishingStrategies"), o.Spec.PublishingStrategies)
+		list.add(spec.Child("marin3r"), o.Spec.Marin3r)
+	case *saasv1alpha1.MappingService:
+		list.addStrategies(spec.Child("publishingStrategies"), o.Spec.PublishingStrategies)
+	case *saasv1alpha1.System:
+		if o.Spec.App != nil {
+			list.addStrategies(spec.Child("app", "publishingStrategies"), o.Spec.App.PublishingStrategies)
+		}
+	case *saasv1alpha1.Zync:
+		if o.Spec.API != nil {
+			list.addStrategies(spec.Child("api", "publishingStrategies"), o.Spec.API.PublishingStrategies)
+		}
+	}
+
+	return list.sidecars, list.errs
+}
+
+type sidecarList struct {
+	sidecars []Marin3rSidecar
+	errs     field.ErrorList
+}
+
+func (l *sidecarList) add(fldPath *field.Path, spec *saasv1alpha1.Marin3rSidecarSpec) {
+	if spec != nil {
+		l.sidecars = append(l.sidecars, Marin3rSidecar{Path: fldPath, Spec: spec})
+	}
+}
+
+func (l *sidecarList) addStrategies(fldPath *field.Path, ps *saasv1alpha1.PublishingStrategies) {
+	if ps == nil {
+		return
+	}
+	for i, endpoint := range ps.Endpoints {
+		p := fldPath.Child("endpoints").Index(i)
+		if endpoint.Strategy == saasv1alpha1.Marin3rSidecarStrategy && endpoint.Marin3rSidecar == nil {
+			l.errs = append(l.errs, field.Required(p.Child("marin3rSidecar"),
+				fmt.Sprintf("required when strategy is '%s'", saasv1alpha1.Marin3rSidecarStrategy)))
+			continue
+		}
+		if endpoint.Strategy == saasv1alpha1.RouteStrategy {
+			if endpoint.Route == nil {
+				l.errs = append(l.errs, field.Required(p.Child("route"),
+					fmt.Sprintf("required when strategy is '%s'", saasv1alpha1.RouteStrategy)))
+				continue
+			}
+			l.errs = append(l.errs, ValidateRouteStrategy(p.Child("route"), endpoint.Route)...)
+		}
+		l.add(p.Child("marin3rSidecar"), endpoint.Marin3rSidecar)
+	}
+}
+
+func sortedPathKeys(m map[string]*field.Path) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,125 @@
+package webhooks
+
+import (
+	"testing"
+
+	saasv1alpha1 "github.com/3scale-ops/saas-operator/api/v1alpha1"
+	"github.com/3scale-ops/saas-operator/pkg/resource_builders/envoyconfig/factory"
+	"github.com/go-test/deep"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestMarin3rSidecars(t *testing.T) {
+	tests := []struct {
+		name      string
+		obj       runtime.Object
+		wantPaths []string
+		wantErrs  []string
+	}{
+		{
+			name: "Returns the sidecars of the publishing strategies and the deprecated fields",
+			obj: &saasv1alpha1.Backend{Spec: saasv1alpha1.BackendSpec{
+				Listener: saasv1alpha1.ListenerSpec{
+					Marin3r: &saasv1alpha1.Marin3rSidecarSpec{},
+					PublishingStrategies: &saasv1alpha1.PublishingStrategies{
+						Endpoints: []saasv1alpha1.PublishingStrategy{
+							{Strategy: saasv1alpha1.SimpleStrategy, EndpointName: "Internal"},
+							{Strategy: saasv1alpha1.Marin3rSidecarStrategy, EndpointName: "HTTP", Marin3rSidecar: &saasv1alpha1.Marin3rSidecarSpec{}},
+						},
+					},
+				},
+			}},
+			wantPaths: []string{"spec.listener.publishingStrategies.endpoints[1].marin3rSidecar", "spec.listener.marin3r"},
+			wantErrs:  []string{},
+		},
+		{
+			name: "Returns error if the sidecar spec is missing",
+			obj: &saasv1alpha1.Zync{Spec: saasv1alpha1.ZyncSpec{
+				API: &saasv1alpha1.APISpec{
+					PublishingStrategies: &saasv1alpha1.PublishingStrategies{
+						Endpoints: []saasv1alpha1.PublishingStrategy{
+							{Strategy: saasv1alpha1.Marin3rSidecarStrategy, EndpointName: "HTTP"},
+						},
+					},
+				},
+			}},
+			wantPaths: []string{},
+			wantErrs:  []string{"spec.api.publishingStrategies.endpoints[0].marin3rSidecar"},
+		},
+		{
+			name: "Returns errors for invalid route strategies",
+			obj: &saasv1alpha1.Apicast{Spec: saasv1alpha1.ApicastSpec{
+				Production: saasv1alpha1.ApicastEnvironmentSpec{
+					PublishingStrategies: &saasv1alpha1.PublishingStrategies{
+						Endpoints: []saasv1alpha1.PublishingStrategy{
+							{Strategy: saasv1alpha1.RouteStrategy, EndpointName: "Gateway"},
+							{Strategy: saasv1alpha1.RouteStrategy, EndpointName: "Management", Route: &saasv1alpha1.RouteStrategySpec{}},
+						},
+					},
+				},
+			}},
+			wantPaths: []string{},
+			wantErrs: []string{
+				"spec.production.publishingStrategies.endpoints[0].route",
+				"spec.production.publishingStrategies.endpoints[1].route.parentRefs",
+			},
+		},
+		{
+			name:      "Returns nothing for other kinds",
+			obj:       &saasv1alpha1.Sentinel{},
+			wantPaths: []string{},
+			wantErrs:  []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sidecars, errs := Marin3rSidecars(tt.obj)
+			gotPaths := []string{}
+			for _, sc := range sidecars {
+				gotPaths = append(gotPaths, sc.Path.String())
+			}
+			if diff := deep.Equal(gotPaths, tt.wantPaths); len(diff) > 0 {
+				t.Errorf("Marin3rSidecars() = diff %v", diff)
+			}
+			gotErrs := []string{}
+			for _, err := range errs {
+				gotErrs = append(gotErrs, err.Field)
+			}
+			if diff := deep.Equal(gotErrs, tt.wantErrs); len(diff) > 0 {
+				t.Errorf("Marin3rSidecars() errors = diff %v", diff)
+			}
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	obj := &saasv1alpha1.Backend{Spec: saasv1alpha1.BackendSpec{
+		Listener: saasv1alpha1.ListenerSpec{
+			HPA: &saasv1alpha1.HorizontalPodAutoscalerSpec{
+				KEDA:        &saasv1alpha1.KEDASpec{},
+				PodsMetrics: []autoscalingv2.PodsMetricSource{{}},
+			},
+			PublishingStrategies: &saasv1alpha1.PublishingStrategies{
+				Endpoints: []saasv1alpha1.PublishingStrategy{
+					{Strategy: saasv1alpha1.Marin3rSidecarStrategy, EndpointName: "HTTP", Marin3rSidecar: &saasv1alpha1.Marin3rSidecarSpec{
+						Ports: []saasv1alpha1.SidecarPort{{Name: "http", Port: 8080}, {Name: "http", Port: 8081}},
+					}},
+				},
+			},
+		},
+	}}
+
+	errs, _, _ := Validate(obj, factory.Default())
+	got := []string{}
+	for _, err := range errs {
+		got = append(got, err.Field)
+	}
+	want := []string{
+		"spec.listener.hpa.podsMetrics",
+		"spec.listener.publishingStrategies.endpoints[0].marin3rSidecar.ports[1].name",
+	}
+	if diff := deep.Equal(got, want); len(diff) > 0 {
+		t.Errorf("Validate() errors = diff %v", diff)
+	}
+}
@@ -0,0 +1,289 @@
+package deployment
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/3scale-ops/basereconciler/mutators"
+	"github.com/3scale-ops/basereconciler/resource"
+	"github.com/3scale-ops/basereconciler/util"
+	saasv1alpha1 "github.com/3scale-ops/saas-operator/api/v1alpha1"
+	"github.com/3scale-ops/saas-operator/pkg/resource_builders/hpa"
+	"github.com/3scale-ops/saas-operator/pkg/resource_builders/pdb"
+	"github.com/3scale-ops/saas-operator/pkg/resource_builders/podmonitor"
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/samber/lo"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// BlueGreenGreenSuffix is appended to the name of the workload
+	// to name the resources of the green colour
+	BlueGreenGreenSuffix string = "-green"
+	// BlueGreenPreviewSuffix is appended to the name of the Services
+	// to name the Services that point to the standby colour
+	BlueGreenPreviewSuffix string = "-preview"
+)
+
+// SmokeTestJobName returns the name of the smoke test Job of
+// the given revision of a blue/green workload
+func SmokeTestJobName(workload string, revision string) string {
+	return fmt.Sprintf("%s-smoke-test-%s", workload, revision)
+}
+
+// BlueGreenRevision returns a hash of the Pod template of the workload, used to detect
+// the changes that need to be deployed to the standby colour. Changes applied through
+// template mutations (eg rollout triggers) are rolled out in place to the active colour.
+func BlueGreenRevision(w DeploymentWorkload) (string, error) {
+	dep, err := w.Deployment().TemplateBuilder(&appsv1.Deployment{})
+	if err != nil {
+		return "", err
+	}
+	return util.Hash(dep.Spec.Template), nil
+}
+
+// NewBlueGreen returns the resources of a workload delivered using blue/green. The blue
+// workload uses the name of the workload, so enabling blue/green on an existing workload
+// keeps its Deployment, and the green one uses the same name with the "-green" suffix.
+// The state of the delivery is read from spec.State:
+//   - While stable, the active colour gets the desired spec and the standby colour keeps
+//     its live spec until the rollback window expires, when it is deleted.
+//   - While the new revision is being deployed and tested, the active colour keeps its
+//     live spec and the standby colour gets the desired spec, scaled to the replicas of the
+//     active colour. The standby Pods can be reached through the preview Services.
+//   - If the smoke test failed, the active colour keeps its live Pod template but gets the
+//     rest of the desired spec, so the failed revision never reaches it. The standby colour
+//     keeps running the failed revision until a new one is deployed.
+//
+// Replicas are never frozen unless autoscaling manages them, so changes in the replicas (eg
+// scaling to zero during a maintenance) apply to both colours in every phase. The Services
+// always select the Pods of the active colour. Publishing strategies are taken from the
+// blue workload.
+func NewBlueGreen(blue DeploymentWorkload, green DeploymentWorkload, spec *saasv1alpha1.BlueGreenSpec,
+	image string) ([]resource.TemplateInterface, error) {
+
+	state := spec.State
+	if state == nil {
+		state = &saasv1alpha1.BlueGreenStatus{Phase: saasv1alpha1.BlueGreenPhaseStable, Active: saasv1alpha1.BlueGreenColourBlue}
+	}
+
+	active, standby := blue, green
+	if state.Active == saasv1alpha1.BlueGreenColourGreen {
+		active, standby = green, blue
+	}
+	stable := state.Phase == saasv1alpha1.BlueGreenPhaseStable
+	autoscaled := !active.HPASpec().IsDeactivated()
+
+	var activeDeployment, standbyDeployment *resource.Template[*appsv1.Deployment]
+	resources := []resource.TemplateInterface{}
+
+	switch state.Phase {
+	case saasv1alpha1.BlueGreenPhaseStable:
+		activeDeployment = blueGreenDeployment(active)
+		standbyDeployment = blueGreenDeployment(standby).
+			WithMutation(freezeDeployment(false)).
+			WithEnabled(state.StandbyRevision != "")
+	case saasv1alpha1.BlueGreenPhaseFailed:
+		activeDeployment = blueGreenDeployment(active).
+			WithMutation(freezePodTemplate())
+		standbyDeployment = blueGreenDeployment(standby)
+	default:
+		activeDeployment = blueGreenDeployment(active).
+			WithMutation(freezeDeployment(!autoscaled))
+		standbyDeployment = blueGreenDeployment(standby)
+	}
+	if !stable && autoscaled {
+		standbyDeployment = standbyDeployment.WithMutation(replicasFromDeployment(active.GetKey()))
+	}
+	resources = append(resources, activeDeployment)
+	resources = append(resources, blueGreenWorkloadResources(active, true, true)...)
+	resources = append(resources, standbyDeployment)
+	resources = append(resources, blueGreenWorkloadResources(standby, standbyDeployment.Enabled(), false)...)
+
+	services, published, err := publishingStrategyResources(blue, nil, activeDeployment, standbyDeployment)
+	if err != nil {
+		return nil, err
+	}
+	resources = append(resources, published...)
+
+	for _, svc := range services {
+		previewSvc := svc
+		resources = append(resources,
+			resource.NewTemplateFromObjectFunction(svc).
+				WithMutation(mutators.SetServiceLiveValues()).
+				Apply(meta[*corev1.Service](blue)).
+				Apply(blueGreenSelectorToService(blue.(WithCanary), active)),
+			resource.NewTemplateFromObjectFunction(func() *corev1.Service { return previewService(previewSvc()) }).
+				WithMutation(mutators.SetServiceLiveValues()).
+				WithEnabled(!stable).
+				Apply(meta[*corev1.Service](blue)).
+				Apply(blueGreenSelectorToService(blue.(WithCanary), standby)),
+		)
+	}
+
+	if spec.SmokeTest != nil {
+		resources = append(resources,
+			resource.NewTemplateFromObjectFunction(func() *batchv1.Job {
+				return smokeTestJob(blue, spec.SmokeTest, image, state.StandbyRevision)
+			}).
+				WithEnabled(state.Phase == saasv1alpha1.BlueGreenPhaseTesting || state.Phase == saasv1alpha1.BlueGreenPhaseFailed),
+		)
+	}
+
+	return resources, nil
+}
+
+// blueGreenDeployment returns the Deployment template of a colour
+func blueGreenDeployment(workload DeploymentWorkload) *resource.Template[*appsv1.Deployment] {
+	return workload.Deployment().
+		Apply(meta[*appsv1.Deployment](workload)).
+		Apply(selector[*appsv1.Deployment](workload)).
+		Apply(trafficSelectorToDeployment(workload)).
+		Apply(podTemplateOverridesToDeployment(workload))
+}
+
+// blueGreenWorkloadResources returns the resources of a colour other than the Deployment.
+// The HorizontalPodAutoscaler (or KEDA ScaledObject) is only enabled for the active colour,
+// while the VerticalPodAutoscaler computes recommendations for both of them.
+func blueGreenWorkloadResources(workload DeploymentWorkload, enabled bool, active bool) []resource.TemplateInterface {
+	resources := []resource.TemplateInterface{
+		resource.NewTemplate(
+			pdb.New(EmptyKey, EmptyLabel, EmptySelector, *workload.PDBSpec())).
+			WithEnabled(enabled && !workload.PDBSpec().IsDeactivated()).
+			Apply(meta[*policyv1.PodDisruptionBudget](workload)).
+			Apply(selector[*policyv1.PodDisruptionBudget](workload)),
+
+		resource.NewTemplate(
+			hpa.New(EmptyKey, EmptyLabel, *workload.HPASpec())).
+			WithEnabled(enabled && active && !workload.HPASpec().IsDeactivated() && workload.HPASpec().KEDA == nil).
+			Apply(meta[*autoscalingv2.HorizontalPodAutoscaler](workload)).
+			Apply(scaleTargetRefToHPA(workload)),
+
+		resource.NewTemplate(
+			podmonitor.New(EmptyKey, EmptyLabel, EmptySelector, workload.MonitoredEndpoints()...)).
+			WithEnabled(enabled && len(workload.MonitoredEndpoints()) > 0).
+			Apply(meta[*monitoringv1.PodMonitor](workload)).
+			Apply(selector[*monitoringv1.PodMonitor](workload)),
+	}
+
+	if usesKEDA(workload) {
+		resources = append(resources, scaledObject(workload).WithEnabled(enabled && active))
+	}
+
+	if usesVPA(workload) {
+		resources = append(resources, verticalPodAutoscaler(workload).WithEnabled(enabled))
+	}
+
+	return resources
+}
+
+// freezeDeployment keeps the live spec of a Deployment, so the colour that is not
+// being deployed is left untouched. The desired replicas are kept if keepReplicas is true.
+func freezeDeployment(keepReplicas bool) resource.TemplateMutationFunction {
+	return func(ctx context.Context, cl client.Client, desired client.Object) error {
+		live := &appsv1.Deployment{}
+		if err := cl.Get(ctx, client.ObjectKeyFromObject(desired), live); err != nil {
+			if errors.IsNotFound(err) {
+				return nil
+			}
+			return fmt.Errorf("unable to retrieve live object: %w", err)
+		}
+		replicas := desired.(*appsv1.Deployment).Spec.Replicas
+		desired.(*appsv1.Deployment).Spec = live.Spec
+		if keepReplicas {
+			desired.(*appsv1.Deployment).Spec.Replicas = replicas
+		}
+		return nil
+	}
+}
+
+// freezePodTemplate keeps the live Pod template of a Deployment, so the
+// revision it runs is not changed while the rest of the spec is reconciled
+func freezePodTemplate() resource.TemplateMutationFunction {
+	return func(ctx context.Context, cl client.Client, desired client.Object) error {
+		live := &appsv1.Deployment{}
+		if err := cl.Get(ctx, client.ObjectKeyFromObject(desired), live); err != nil {
+			if errors.IsNotFound(err) {
+				return nil
+			}
+			return fmt.Errorf("unable to retrieve live object: %w", err)
+		}
+		desired.(*appsv1.Deployment).Spec.Template = live.Spec.Template
+		return nil
+	}
+}
+
+// replicasFromDeployment sets the replicas of the Deployment to the live
+// replicas of another Deployment, so the standby colour is deployed full size
+func replicasFromDeployment(key types.NamespacedName) resource.TemplateMutationFunction {
+	return func(ctx context.Context, cl client.Client, desired client.Object) error {
+		live := &appsv1.Deployment{}
+		if err := cl.Get(ctx, key, live); err != nil {
+			if errors.IsNotFound(err) {
+				return nil
+			}
+			return fmt.Errorf("unable to retrieve live object: %w", err)
+		}
+		desired.(*appsv1.Deployment).Spec.Replicas = live.Spec.Replicas
+		return nil
+	}
+}
+
+func blueGreenSelectorToService(main WithCanary, colour WithSelector) resource.TemplateBuilderFunction[*corev1.Service] {
+	return func(o client.Object) (*corev1.Service, error) {
+		svc := o.(*corev1.Service)
+		svc.Spec.Selector = util.MergeMaps(map[string]string{}, colour.GetSelector(), main.TrafficSelector())
+		return svc, nil
+	}
+}
+
+// previewService returns a ClusterIP Service, that points to the standby colour, with
+// the ports of the given Service. Annotations are dropped as they usually configure
+// the external load balancers.
+func previewService(svc *corev1.Service) *corev1.Service {
+	svc.SetName(svc.GetName() + BlueGreenPreviewSuffix)
+	svc.SetAnnotations(nil)
+	svc.Spec.Type = corev1.ServiceTypeClusterIP
+	svc.Spec.ExternalTrafficPolicy = ""
+	svc.Spec.LoadBalancerSourceRanges = nil
+	for i := range svc.Spec.Ports {
+		svc.Spec.Ports[i].NodePort = 0
+	}
+	return svc
+}
+
+func smokeTestJob(w WithWorkloadMeta, spec *saasv1alpha1.BlueGreenSmokeTest, image string, revision string) *batchv1.Job {
+	labels := util.MergeMaps(map[string]string{}, w.GetLabels())
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      SmokeTestJobName(w.GetKey().Name, revision),
+			Namespace: w.GetKey().Namespace,
+			Labels:    labels,
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit:          util.Pointer(spec.GetBackoffLimit()),
+			ActiveDeadlineSeconds: util.Pointer(spec.GetActiveDeadlineSeconds()),
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: labels},
+				Spec: corev1.PodSpec{
+					RestartPolicy: corev1.RestartPolicyNever,
+					Containers: []corev1.Container{{
+						Name:    "smoke-test",
+						Image:   lo.FromPtrOr(spec.Image, image),
+						Command: spec.Command,
+						Args:    spec.Args,
+						Env:     spec.Env,
+					}},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,249 @@
+package deployment
+
+import (
+	"context"
+	"testing"
+
+	"github.com/3scale-ops/basereconciler/resource"
+	"github.com/3scale-ops/basereconciler/util"
+	saasv1alpha1 "github.com/3scale-ops/saas-operator/api/v1alpha1"
+	"github.com/3scale-ops/saas-operator/pkg/resource_builders/service"
+	"github.com/google/go-cmp/cmp"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func testBlueGreenWorkloads() (*TestWorkloadGenerator, *TestWorkloadGenerator) {
+	blue := &TestWorkloadGenerator{
+		TName:            "my-workload",
+		TNamespace:       "ns",
+		TTraffic:         true,
+		TLabels:          map[string]string{"l-key": "l-value"},
+		TSelector:        map[string]string{"deployment": "my-workload"},
+		TTrafficSelector: map[string]string{"traffic": "yes"},
+		TPublishingStrategy: []service.ServiceDescriptor{{
+			PortDefinitions: ports,
+			PublishingStrategy: saasv1alpha1.PublishingStrategy{
+				Strategy:     saasv1alpha1.SimpleStrategy,
+				EndpointName: "HTTP",
+				Simple:       &saasv1alpha1.Simple{ServiceType: util.Pointer(saasv1alpha1.ServiceTypeClusterIP)},
+			},
+		}},
+	}
+	green := *blue
+	green.TName = "my-workload-green"
+	green.TSelector = map[string]string{"deployment": "my-workload-green"}
+	return blue, &green
+}
+
+// liveDeployment is a Deployment running a previous version of the workload
+func liveDeployment(name string) *appsv1.Deployment {
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "ns"},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: util.Pointer[int32](5),
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "container", Image: "example.com:previous"}}},
+			},
+		},
+	}
+}
+
+type builtResource struct {
+	enabled bool
+	object  client.Object
+}
+
+func buildBlueGreen(t *testing.T, cl client.Client, templates []resource.TemplateInterface) map[string]builtResource {
+	got := map[string]builtResource{}
+	for _, tpl := range templates {
+		o, err := tpl.Build(context.TODO(), cl, nil)
+		if err != nil {
+			t.Fatalf("Build() error = %v", err)
+		}
+		kind := ""
+		switch o.(type) {
+		case *appsv1.Deployment:
+			kind = "Deployment"
+		case *corev1.Service:
+			kind = "Service"
+		case *autoscalingv2.HorizontalPodAutoscaler:
+			kind = "HorizontalPodAutoscaler"
+		case *batchv1.Job:
+			kind = "Job"
+		default:
+			continue
+		}
+		got[kind+"/"+o.GetName()] = builtResource{enabled: tpl.Enabled(), object: o}
+	}
+	return got
+}
+
+func TestNewBlueGreen(t *testing.T) {
+	type want struct {
+		enabled  bool
+		image    string
+		replicas int32
+		selector map[string]string
+	}
+	tests := []struct {
+		name  string
+		state *saasv1alpha1.BlueGreenStatus
+		// stopped scales the workload to zero as a
+		// maintenance does in the in-memory spec
+		stopped bool
+		live    []client.Object
+		want    map[string]want
+	}{
+		{
+			name:  "Only blue exists without state",
+			state: nil,
+			want: map[string]want{
+				"Deployment/my-workload":                    {enabled: true, image: "example.com:latest", replicas: 1},
+				"Deployment/my-workload-green":              {enabled: false},
+				"HorizontalPodAutoscaler/my-workload":       {enabled: true},
+				"HorizontalPodAutoscaler/my-workload-green": {enabled: false},
+				"Service/my-workload-http-svc":              {enabled: true, selector: map[string]string{"deployment": "my-workload", "traffic": "yes"}},
+				"Service/my-workload-http-svc-preview":      {enabled: false, selector: map[string]string{"deployment": "my-workload-green", "traffic": "yes"}},
+				"Job/my-workload-smoke-test-":               {enabled: false},
+			},
+		},
+		{
+			name: "Deploys the new revision to green and keeps blue untouched",
+			state: &saasv1alpha1.BlueGreenStatus{
+				Phase: saasv1alpha1.BlueGreenPhaseTesting, Active: saasv1alpha1.BlueGreenColourBlue,
+				ActiveRevision: "aaa", StandbyRevision: "bbb",
+			},
+			live: []client.Object{liveDeployment("my-workload")},
+			want: map[string]want{
+				"Deployment/my-workload":                    {enabled: true, image: "example.com:previous", replicas: 5},
+				"Deployment/my-workload-green":              {enabled: true, image: "example.com:latest", replicas: 5},
+				"HorizontalPodAutoscaler/my-workload":       {enabled: true},
+				"HorizontalPodAutoscaler/my-workload-green": {enabled: false},
+				"Service/my-workload-http-svc":              {enabled: true, selector: map[string]string{"deployment": "my-workload", "traffic": "yes"}},
+				"Service/my-workload-http-svc-preview":      {enabled: true, selector: map[string]string{"deployment": "my-workload-green", "traffic": "yes"}},
+				"Job/my-workload-smoke-test-bbb":            {enabled: true, image: "example.com:smoke"},
+			},
+		},
+		{
+			name: "Sends traffic to green and keeps blue during the rollback window",
+			state: &saasv1alpha1.BlueGreenStatus{
+				Phase: saasv1alpha1.BlueGreenPhaseStable, Active: saasv1alpha1.BlueGreenColourGreen,
+				ActiveRevision: "bbb", StandbyRevision: "aaa",
+			},
+			live: []client.Object{liveDeployment("my-workload")},
+			want: map[string]want{
+				"Deployment/my-workload":                    {enabled: true, image: "example.com:previous", replicas: 5},
+				"Deployment/my-workload-green":              {enabled: true, image: "example.com:latest", replicas: 1},
+				"HorizontalPodAutoscaler/my-workload":       {enabled: false},
+				"HorizontalPodAutoscaler/my-workload-green": {enabled: true},
+				"Service/my-workload-http-svc":              {enabled: true, selector: map[string]string{"deployment": "my-workload-green", "traffic": "yes"}},
+				"Service/my-workload-http-svc-preview":      {enabled: false, selector: map[string]string{"deployment": "my-workload", "traffic": "yes"}},
+				"Job/my-workload-smoke-test-aaa":            {enabled: false},
+			},
+		},
+		{
+			name: "Stops both colours in maintenance while deploying",
+			state: &saasv1alpha1.BlueGreenStatus{
+				Phase: saasv1alpha1.BlueGreenPhaseDeploying, Active: saasv1alpha1.BlueGreenColourBlue,
+				ActiveRevision: "aaa", StandbyRevision: "bbb",
+			},
+			stopped: true,
+			live:    []client.Object{liveDeployment("my-workload")},
+			want: map[string]want{
+				"Deployment/my-workload":                    {enabled: true, image: "example.com:previous", replicas: 0},
+				"Deployment/my-workload-green":              {enabled: true, image: "example.com:latest", replicas: 0},
+				"HorizontalPodAutoscaler/my-workload":       {enabled: false},
+				"HorizontalPodAutoscaler/my-workload-green": {enabled: false},
+			},
+		},
+		{
+			name: "Keeps the revision of the active colour but applies the maintenance when the smoke test failed",
+			state: &saasv1alpha1.BlueGreenStatus{
+				Phase: saasv1alpha1.BlueGreenPhaseFailed, Active: saasv1alpha1.BlueGreenColourBlue,
+				ActiveRevision: "aaa", StandbyRevision: "bbb",
+			},
+			stopped: true,
+			live:    []client.Object{liveDeployment("my-workload"), liveDeployment("my-workload-green")},
+			want: map[string]want{
+				"Deployment/my-workload":                    {enabled: true, image: "example.com:previous", replicas: 0},
+				"Deployment/my-workload-green":              {enabled: true, image: "example.com:latest", replicas: 0},
+				"HorizontalPodAutoscaler/my-workload":       {enabled: false},
+				"HorizontalPodAutoscaler/my-workload-green": {enabled: false},
+				"Service/my-workload-http-svc":              {enabled: true, selector: map[string]string{"deployment": "my-workload", "traffic": "yes"}},
+				"Job/my-workload-smoke-test-bbb":            {enabled: true, image: "example.com:smoke"},
+			},
+		},
+		{
+			name: "Applies the desired replicas to the active colour when the smoke test failed",
+			state: &saasv1alpha1.BlueGreenStatus{
+				Phase: saasv1alpha1.BlueGreenPhaseFailed, Active: saasv1alpha1.BlueGreenColourBlue,
+				ActiveRevision: "aaa", StandbyRevision: "bbb",
+			},
+			live: []client.Object{liveDeployment("my-workload")},
+			want: map[string]want{
+				"Deployment/my-workload":       {enabled: true, image: "example.com:previous", replicas: 1},
+				"Deployment/my-workload-green": {enabled: true, image: "example.com:latest", replicas: 5},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			blue, green := testBlueGreenWorkloads()
+			if tt.stopped {
+				for _, w := range []*TestWorkloadGenerator{blue, green} {
+					w.TReplicas = util.Pointer[int32](0)
+					w.THPA = &saasv1alpha1.HorizontalPodAutoscalerSpec{}
+				}
+			}
+			spec := &saasv1alpha1.BlueGreenSpec{
+				SmokeTest: &saasv1alpha1.BlueGreenSmokeTest{Image: util.Pointer("example.com:smoke")},
+				State:     tt.state,
+			}
+			templates, err := NewBlueGreen(blue, green, spec, "example.com:latest")
+			if err != nil {
+				t.Fatalf("NewBlueGreen() error = %v", err)
+			}
+			got := buildBlueGreen(t, fake.NewClientBuilder().WithObjects(tt.live...).Build(), templates)
+
+			for key, w := range tt.want {
+				r, ok := got[key]
+				if !ok {
+					t.Errorf("NewBlueGreen() missing %s", key)
+					continue
+				}
+				if r.enabled != w.enabled {
+					t.Errorf("NewBlueGreen() %s enabled = %v, want %v", key, r.enabled, w.enabled)
+				}
+				switch o := r.object.(type) {
+				case *appsv1.Deployment:
+					if !w.enabled {
+						continue
+					}
+					if image := o.Spec.Template.Spec.Containers[0].Image; image != w.image {
+						t.Errorf("NewBlueGreen() %s image = %v, want %v", key, image, w.image)
+					}
+					if *o.Spec.Replicas != w.replicas {
+						t.Errorf("NewBlueGreen() %s replicas = %v, want %v", key, *o.Spec.Replicas, w.replicas)
+					}
+				case *corev1.Service:
+					if diff := cmp.Diff(o.Spec.Selector, w.selector); len(diff) > 0 {
+						t.Errorf("NewBlueGreen() %s selector diff %v", key, diff)
+					}
+				case *batchv1.Job:
+					if !w.enabled {
+						continue
+					}
+					if image := o.Spec.Template.Spec.Containers[0].Image; image != w.image {
+						t.Errorf("NewBlueGreen() %s image = %v, want %v", key, image, w.image)
+					}
+				}
+			}
+		})
+	}
+}
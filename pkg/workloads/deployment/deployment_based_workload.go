@@ -2,6 +2,7 @@ package deployment
 
 import (
 	"fmt"
+	"sort"
 
 	"github.com/3scale-ops/basereconciler/mutators"
 	"github.com/3scale-ops/basereconciler/resource"
@@ -12,17 +13,27 @@ import (
 	"github.com/3scale-ops/saas-operator/pkg/resource_builders/envoyconfig/factory"
 	"github.com/3scale-ops/saas-operator/pkg/resource_builders/hpa"
 	"github.com/3scale-ops/saas-operator/pkg/resource_builders/pdb"
+	"github.com/3scale-ops/saas-operator/pkg/resource_builders/pod"
 	"github.com/3scale-ops/saas-operator/pkg/resource_builders/podmonitor"
+	"github.com/3scale-ops/saas-operator/pkg/resource_builders/scaledobject"
 	"github.com/3scale-ops/saas-operator/pkg/resource_builders/service"
+	"github.com/3scale-ops/saas-operator/pkg/resource_builders/vpa"
+	kedav1alpha1 "github.com/kedacore/keda/v2/apis/keda/v1alpha1"
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	"github.com/samber/lo"
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
 	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	policyv1 "k8s.io/api/policy/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	vpav1 "k8s.io/autoscaler/vertical-pod-autoscaler/pkg/apis/autoscaling.k8s.io/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
 )
 
 func New(main DeploymentWorkload, canary DeploymentWorkload) ([]resource.TemplateInterface, error) {
@@ -33,66 +44,167 @@ func New(main DeploymentWorkload, canary DeploymentWorkload) ([]resource.Templat
 		resources = append(resources, workloadResources(canary)...)
 	}
 
-	services := []*resource.Template[*corev1.Service]{}
+	// NOTE: Deployment is always the first resource
+	// TODO: a proper mechanism to identify resource templates should exists. Basereconciler
+	// should provide one.
+	deployment, ok := resources[0].(*resource.Template[*appsv1.Deployment])
+	if !ok {
+		return nil, fmt.Errorf("expected a Deployment but found something else")
+	}
+
+	services, published, err := publishingStrategyResources(main, canary, deployment)
+	if err != nil {
+		return nil, err
+	}
+	resources = append(resources, published...)
+
+	// Apply traffic routing logic (canary yes/no)
+	for _, svc := range services {
+		resources = append(resources,
+			resource.NewTemplateFromObjectFunction(svc).
+				WithMutation(mutators.SetServiceLiveValues()).
+				Apply(meta[*corev1.Service](main)).
+				Apply(trafficSelectorToService(main.(WithCanary), toWithCanaryOrNil(canary))),
+		)
+	}
+
+	return resources, nil
+}
+
+// publishingStrategyResources returns the functions that generate the Services of the
+// publishing strategies of the main workload, together with the rest of resources required
+// to implement the strategies. The Marin3r sidecar is added to the given Deployments.
+func publishingStrategyResources(main DeploymentWorkload, canary DeploymentWorkload,
+	deployments ...*resource.Template[*appsv1.Deployment]) ([]func() *corev1.Service, []resource.TemplateInterface, error) {
+
+	services := []func() *corev1.Service{}
+	resources := []resource.TemplateInterface{}
 
 	// Generate resources to implement the desired publishing strategies
-	if _, ok := main.(WithPublishingStrategies); ok {
+	if _, ok := main.(WithPublishingStrategies); !ok {
+		return services, resources, nil
+	}
 
-		strategies, err := main.(WithPublishingStrategies).PublishingStrategies()
-		if err != nil {
-			return nil, err
-		}
+	strategies, err := main.(WithPublishingStrategies).PublishingStrategies()
+	if err != nil {
+		return nil, nil, err
+	}
 
-		for _, item := range strategies {
-			descriptor := item
-			switch descriptor.Strategy {
+	for _, item := range strategies {
+		descriptor := item
+		switch descriptor.Strategy {
 
-			case saasv1alpha1.SimpleStrategy:
-				services = append(services,
-					resource.NewTemplateFromObjectFunction(func() *corev1.Service { return descriptor.Service(main.GetKey().Name, "svc") }).
-						WithMutation(mutators.SetServiceLiveValues()),
-				)
+		case saasv1alpha1.SimpleStrategy:
+			services = append(services, func() *corev1.Service { return descriptor.Service(main.GetKey().Name, "svc") })
 
-			case saasv1alpha1.Marin3rSidecarStrategy:
-				if descriptor.Marin3rSidecar == nil {
-					return nil, fmt.Errorf("Marin3rSidecarSpec is missing, can't implement strategy without it")
-				}
-				services = append(services,
-					resource.NewTemplateFromObjectFunction(func() *corev1.Service { return descriptor.Service(main.GetKey().Name, "marin3r") }).
-						WithMutation(mutators.SetServiceLiveValues()),
+		case saasv1alpha1.Marin3rSidecarStrategy:
+			if descriptor.Marin3rSidecar == nil {
+				return nil, nil, fmt.Errorf("Marin3rSidecarSpec is missing, can't implement strategy without it")
+			}
+			services = append(services, func() *corev1.Service { return descriptor.Service(main.GetKey().Name, "marin3r") })
+
+			// Add a Service that exposes the workload in the canary Pods when traffic is
+			// shifted to the canary using weighted clusters in the routes of the sidecar
+			if descriptor.Marin3rSidecar.CanaryWeight != nil && !lo.IsNil(canary) {
+				name := service.CanaryServiceName(descriptor.Service(main.GetKey().Name, "marin3r").GetName())
+				spec := *descriptor.Marin3rSidecar
+				spec.CanaryHost = util.Pointer(fmt.Sprintf("%s.%s.svc.cluster.local", name, main.GetKey().Namespace))
+				descriptor.Marin3rSidecar = &spec
+				clusters := spec.CanaryClusters()
+				resources = append(resources,
+					resource.NewTemplateFromObjectFunction(func() *corev1.Service { return canaryUpstreamService(name, clusters) }).
+						WithMutation(mutators.SetServiceLiveValues()).
+						WithEnabled(len(clusters) > 0).
+						Apply(meta[*corev1.Service](canary)).
+						Apply(canarySelectorToService(main.(WithCanary), canary.(WithCanary))),
 				)
+			}
 
-				// Add Marin3r sidecar to Deployment
-				// NOTE: Deployment is always the first resource
-				// TODO: a proper mechanism to identify resource templates should exists. Basereconciler
-				// should provide one.
-				if deployment, ok := resources[0].(*resource.Template[*appsv1.Deployment]); ok {
-					deployment.Apply(marin3rSidecarToDeployment(descriptor))
-				} else {
-					return nil, fmt.Errorf("expected a Deployment but found something else")
-				}
-				// Add EnvoyConfig resource
-				dynamicConfigurations := descriptor.Marin3rSidecar.EnvoyDynamicConfig.AsList()
+			// Add Marin3r sidecar to Deployments. All of them use the
+			// EnvoyConfig of the main workload, so the node-id defaults
+			// to the main workload name.
+			sidecar := descriptor
+			if sidecar.Marin3rSidecar.NodeID == nil {
+				spec := *sidecar.Marin3rSidecar
+				spec.NodeID = util.Pointer(main.GetKey().Name)
+				sidecar.Marin3rSidecar = &spec
+			}
+			for _, deployment := range deployments {
+				deployment.Apply(marin3rSidecarToDeployment(sidecar))
+			}
+			// Add EnvoyConfig resource
+			dynamicConfigurations, err := descriptor.Marin3rSidecar.DynamicConfigs()
+			if err != nil {
+				return nil, nil, err
+			}
+			resources = append(resources,
+				resource.NewTemplate(
+					envoyconfig.New(EmptyKey, EmptyKey.Name, factory.Default(), dynamicConfigurations...)).
+					WithEnabled(len(dynamicConfigurations) > 0).
+					Apply(meta[*marin3rv1alpha1.EnvoyConfig](main)).
+					Apply(nodeIdToEnvoyConfig(descriptor)),
+			)
+
+		case saasv1alpha1.RouteStrategy:
+			if descriptor.Route == nil {
+				return nil, nil, fmt.Errorf("RouteStrategySpec is missing, can't implement strategy without it")
+			}
+			services = append(services, func() *corev1.Service { return descriptor.Service(main.GetKey().Name, "svc") })
+			// Add the route pointing to the Service
+			resources = append(resources, routeTemplate(main, descriptor))
+
+			// Add a Service for the canary Pods when traffic is shifted
+			// to the canary using weighted backends in the route
+			if descriptor.Route.CanaryWeight != nil && !lo.IsNil(canary) {
 				resources = append(resources,
-					resource.NewTemplate(
-						envoyconfig.New(EmptyKey, EmptyKey.Name, factory.Default(), dynamicConfigurations...)).
-						WithEnabled(len(dynamicConfigurations) > 0).
-						Apply(meta[*marin3rv1alpha1.EnvoyConfig](main)).
-						Apply(nodeIdToEnvoyConfig(descriptor)),
+					resource.NewTemplateFromObjectFunction(func() *corev1.Service {
+						svc := descriptor.Service(main.GetKey().Name, "svc")
+						svc.SetName(service.CanaryServiceName(svc.GetName()))
+						return svc
+					}).
+						WithMutation(mutators.SetServiceLiveValues()).
+						Apply(meta[*corev1.Service](canary)).
+						Apply(canarySelectorToService(main.(WithCanary), canary.(WithCanary))),
 				)
 			}
 		}
 	}
 
-	// Apply traffic routing logic (canary yes/no)
-	for _, svct := range services {
-		resources = append(resources,
-			svct.Apply(meta[*corev1.Service](main)).
-				Apply(trafficSelectorToService(main.(WithCanary), toWithCanaryOrNil(canary))),
-		)
-	}
+	return services, resources, nil
+}
 
-	return resources, nil
+// canaryUpstreamService returns a ClusterIP Service that exposes the ports of the given
+// local clusters of the marin3r sidecar, so the sidecars of the main Pods can send the
+// canary share of the traffic directly to the workload in the canary Pods
+func canaryUpstreamService(name string, clusters map[string]*saasv1alpha1.Cluster) *corev1.Service {
+	ports := []uint32{}
+	for _, cluster := range clusters {
+		if cluster.Port != 0 {
+			ports = append(ports, cluster.Port)
+		}
+		for _, ep := range cluster.Endpoints {
+			ports = append(ports, ep.Port)
+		}
+	}
+	ports = lo.Uniq(ports)
+	sort.Slice(ports, func(a, b int) bool { return ports[a] < ports[b] })
+
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: corev1.ServiceSpec{
+			Type:  corev1.ServiceTypeClusterIP,
+			Ports: make([]corev1.ServicePort, 0, len(ports)),
+		},
+	}
+	for _, port := range ports {
+		svc.Spec.Ports = append(svc.Spec.Ports, corev1.ServicePort{
+			Name:       fmt.Sprintf("upstream-%d", port),
+			Port:       int32(port),
+			TargetPort: intstr.FromInt(int(port)),
+			Protocol:   corev1.ProtocolTCP,
+		})
+	}
+	return svc
 }
 
 var (
@@ -108,7 +220,8 @@ func workloadResources(workload DeploymentWorkload) []resource.TemplateInterface
 		workload.Deployment().
 			Apply(meta[*appsv1.Deployment](workload)).
 			Apply(selector[*appsv1.Deployment](workload)).
-			Apply(trafficSelectorToDeployment(workload)),
+			Apply(trafficSelectorToDeployment(workload)).
+			Apply(podTemplateOverridesToDeployment(workload)),
 
 		resource.NewTemplate(
 			pdb.New(EmptyKey, EmptyLabel, EmptySelector, *workload.PDBSpec())).
@@ -118,7 +231,7 @@ func workloadResources(workload DeploymentWorkload) []resource.TemplateInterface
 
 		resource.NewTemplate(
 			hpa.New(EmptyKey, EmptyLabel, *workload.HPASpec())).
-			WithEnabled(!workload.HPASpec().IsDeactivated()).
+			WithEnabled(!workload.HPASpec().IsDeactivated() && workload.HPASpec().KEDA == nil).
 			Apply(meta[*autoscalingv2.HorizontalPodAutoscaler](workload)).
 			Apply(scaleTargetRefToHPA(workload)),
 
@@ -129,17 +242,60 @@ func workloadResources(workload DeploymentWorkload) []resource.TemplateInterface
 			Apply(selector[*monitoringv1.PodMonitor](workload)),
 	}
 
+	if usesKEDA(workload) {
+		resources = append(resources, scaledObject(workload))
+	}
+
+	if usesVPA(workload) {
+		resources = append(resources, verticalPodAutoscaler(workload))
+	}
+
 	return resources
 }
 
+// usesKEDA returns true if the workload is autoscaled with a KEDA ScaledObject
+// instead of an HorizontalPodAutoscaler. The ScaledObject template is only added
+// when in use so the KEDA CRDs are not required in clusters that don't use it.
+func usesKEDA(workload DeploymentWorkload) bool {
+	return !workload.HPASpec().IsDeactivated() && workload.HPASpec().KEDA != nil
+}
+
+func scaledObject(workload DeploymentWorkload) *resource.Template[*kedav1alpha1.ScaledObject] {
+	return resource.NewTemplate(
+		scaledobject.New(EmptyKey, EmptyLabel, *workload.HPASpec())).
+		Apply(meta[*kedav1alpha1.ScaledObject](workload)).
+		Apply(scaleTargetRefToScaledObject(workload))
+}
+
+// usesVPA returns true if a VerticalPodAutoscaler computes resource recommendations
+// for the workload or any of its sidecars. As with KEDA, the template is only added
+// when in use so the VerticalPodAutoscaler CRDs are not required in the cluster.
+func usesVPA(workload DeploymentWorkload) bool {
+	return workload.VPASpec() != nil || len(sidecarVPASpecs(workload)) > 0
+}
+
+func sidecarVPASpecs(workload DeploymentWorkload) map[string]saasv1alpha1.VerticalPodAutoscalerResourcePolicy {
+	if w, ok := workload.(WithSidecarVerticalPodAutoscaler); ok {
+		return w.SidecarVPASpecs()
+	}
+	return nil
+}
+
+func verticalPodAutoscaler(workload DeploymentWorkload) *resource.Template[*vpav1.VerticalPodAutoscaler] {
+	return resource.NewTemplate(
+		vpa.New(EmptyKey, EmptyLabel, workload.VPASpec(), sidecarVPASpecs(workload))).
+		Apply(meta[*vpav1.VerticalPodAutoscaler](workload)).
+		Apply(targetRefToVPA(workload))
+}
+
 func meta[T client.Object](w WithWorkloadMeta) resource.TemplateBuilderFunction[T] {
 	return func(o client.Object) (T, error) {
 
 		switch o.(type) {
-		case *corev1.Service:
+		case *corev1.Service, *gatewayv1.HTTPRoute, *gatewayv1alpha2.TLSRoute, *networkingv1.Ingress:
 			// Do not enforce metadata.name:
-			//   Services are special because there can be more than one of them, so the Name
-			//   is relevant and must be provided by the service template
+			//   Services (and the routes pointing to them) are special because there can be more
+			//   than one of them, so the Name is relevant and must be provided by the template
 		default:
 			o.SetName(w.GetKey().Name)
 		}
@@ -158,6 +314,14 @@ func trafficSelectorToService(main WithCanary, canary WithCanary) resource.Templ
 	}
 }
 
+func canarySelectorToService(main WithCanary, canary WithCanary) resource.TemplateBuilderFunction[*corev1.Service] {
+	return func(o client.Object) (*corev1.Service, error) {
+		svc := o.(*corev1.Service)
+		svc.Spec.Selector = util.MergeMaps(map[string]string{}, canary.GetSelector(), main.TrafficSelector())
+		return svc, nil
+	}
+}
+
 func trafficSwitcher(main WithCanary, canary WithCanary) map[string]string {
 
 	// NOTE: due to the fact that services do not yet support set-based selectors, only MatchLabels selectors
@@ -202,6 +366,30 @@ func scaleTargetRefToHPA(w WithWorkloadMeta) resource.TemplateBuilderFunction[*a
 	}
 }
 
+func scaleTargetRefToScaledObject(w WithWorkloadMeta) resource.TemplateBuilderFunction[*kedav1alpha1.ScaledObject] {
+	return func(o client.Object) (*kedav1alpha1.ScaledObject, error) {
+		so := o.(*kedav1alpha1.ScaledObject)
+		so.Spec.ScaleTargetRef = &kedav1alpha1.ScaleTarget{
+			Kind:       "Deployment",
+			Name:       w.GetKey().Name,
+			APIVersion: appsv1.SchemeGroupVersion.String(),
+		}
+		return so, nil
+	}
+}
+
+func targetRefToVPA(w WithWorkloadMeta) resource.TemplateBuilderFunction[*vpav1.VerticalPodAutoscaler] {
+	return func(o client.Object) (*vpav1.VerticalPodAutoscaler, error) {
+		vpa := o.(*vpav1.VerticalPodAutoscaler)
+		vpa.Spec.TargetRef = &autoscalingv1.CrossVersionObjectReference{
+			Kind:       "Deployment",
+			Name:       w.GetKey().Name,
+			APIVersion: appsv1.SchemeGroupVersion.String(),
+		}
+		return vpa, nil
+	}
+}
+
 func selector[T client.Object](w DeploymentWorkload) resource.TemplateBuilderFunction[T] {
 	return func(o client.Object) (T, error) {
 
@@ -228,6 +416,16 @@ func trafficSelectorToDeployment(w DeploymentWorkload) resource.TemplateBuilderF
 	}
 }
 
+func podTemplateOverridesToDeployment(w DeploymentWorkload) resource.TemplateBuilderFunction[*appsv1.Deployment] {
+	return func(o client.Object) (*appsv1.Deployment, error) {
+		dep := o.(*appsv1.Deployment)
+		if overrides := w.PodTemplateOverrides(); overrides != nil {
+			pod.ApplyOverrides(&dep.Spec.Template, overrides, w.GetSelector())
+		}
+		return dep, nil
+	}
+}
+
 func marin3rSidecarToDeployment(sd service.ServiceDescriptor) resource.TemplateBuilderFunction[*appsv1.Deployment] {
 	return func(o client.Object) (*appsv1.Deployment, error) {
 		return service.AddMarin3rSidecar(o.(*appsv1.Deployment), *sd.Marin3rSidecar), nil
@@ -246,6 +444,24 @@ func nodeIdToEnvoyConfig(sd service.ServiceDescriptor) resource.TemplateBuilderF
 	}
 }
 
+// routeTemplate returns the template of the object that publishes the
+// endpoint when the Route strategy is used
+func routeTemplate(main DeploymentWorkload, sd service.ServiceDescriptor) resource.TemplateInterface {
+	svc := func() *corev1.Service { return sd.Service(main.GetKey().Name, "svc") }
+
+	switch sd.RouteKind() {
+	case saasv1alpha1.RouteKindTLSRoute:
+		return resource.NewTemplate(func(client.Object) (*gatewayv1alpha2.TLSRoute, error) { return sd.TLSRoute(svc()) }).
+			Apply(meta[*gatewayv1alpha2.TLSRoute](main))
+	case saasv1alpha1.RouteKindIngress:
+		return resource.NewTemplate(func(client.Object) (*networkingv1.Ingress, error) { return sd.Ingress(svc()) }).
+			Apply(meta[*networkingv1.Ingress](main))
+	default:
+		return resource.NewTemplate(func(client.Object) (*gatewayv1.HTTPRoute, error) { return sd.HTTPRoute(svc()) }).
+			Apply(meta[*gatewayv1.HTTPRoute](main))
+	}
+}
+
 func toWithCanaryOrNil(w DeploymentWorkload) WithCanary {
 	if lo.IsNil(w) {
 		return nil
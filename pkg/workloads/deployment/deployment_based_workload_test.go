@@ -15,14 +15,17 @@ import (
 	descriptor "github.com/3scale-ops/saas-operator/pkg/resource_builders/envoyconfig/descriptor"
 	"github.com/3scale-ops/saas-operator/pkg/resource_builders/service"
 	"github.com/google/go-cmp/cmp"
+	kedav1alpha1 "github.com/kedacore/keda/v2/apis/keda/v1alpha1"
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
 	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
 	policyv1 "k8s.io/api/policy/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	vpav1 "k8s.io/autoscaler/vertical-pod-autoscaler/pkg/apis/autoscaling.k8s.io/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
@@ -36,6 +39,9 @@ type TestWorkloadGenerator struct {
 	TSelector           map[string]string
 	TTrafficSelector    map[string]string
 	TPublishingStrategy []service.ServiceDescriptor
+	TOverrides          *saasv1alpha1.PodTemplateOverrides
+	TReplicas           *int32
+	THPA                *saasv1alpha1.HorizontalPodAutoscalerSpec
 }
 
 var _ DeploymentWorkload = &TestWorkloadGenerator{}
@@ -47,7 +53,12 @@ func (gen *TestWorkloadGenerator) Deployment() *resource.Template[*appsv1.Deploy
 		TemplateBuilder: func(client.Object) (*appsv1.Deployment, error) {
 			return &appsv1.Deployment{
 				Spec: appsv1.DeploymentSpec{
-					Replicas: util.Pointer[int32](1),
+					Replicas: func() *int32 {
+						if gen.TReplicas != nil {
+							return gen.TReplicas
+						}
+						return util.Pointer[int32](1)
+					}(),
 					Template: corev1.PodTemplateSpec{
 						ObjectMeta: metav1.ObjectMeta{
 							Labels: map[string]string{"orig-key": "orig-value"},
@@ -84,6 +95,9 @@ func (gen *TestWorkloadGenerator) GetSelector() map[string]string {
 	return gen.TSelector
 }
 func (gen *TestWorkloadGenerator) HPASpec() *saasv1alpha1.HorizontalPodAutoscalerSpec {
+	if gen.THPA != nil {
+		return gen.THPA
+	}
 	return &saasv1alpha1.HorizontalPodAutoscalerSpec{
 		MinReplicas:         util.Pointer[int32](1),
 		MaxReplicas:         util.Pointer[int32](2),
@@ -91,11 +105,17 @@ func (gen *TestWorkloadGenerator) HPASpec() *saasv1alpha1.HorizontalPodAutoscale
 		ResourceName:        util.Pointer("cpu"),
 	}
 }
+func (gen *TestWorkloadGenerator) VPASpec() *saasv1alpha1.VerticalPodAutoscalerSpec {
+	return nil
+}
 func (gen *TestWorkloadGenerator) PDBSpec() *saasv1alpha1.PodDisruptionBudgetSpec {
 	return &saasv1alpha1.PodDisruptionBudgetSpec{
 		MaxUnavailable: util.Pointer(intstr.FromInt(1)),
 	}
 }
+func (gen *TestWorkloadGenerator) PodTemplateOverrides() *saasv1alpha1.PodTemplateOverrides {
+	return gen.TOverrides
+}
 func (gen *TestWorkloadGenerator) SendTraffic() bool { return gen.TTraffic }
 
 func (gen *TestWorkloadGenerator) PublishingStrategies() ([]service.ServiceDescriptor, error) {
@@ -629,6 +649,68 @@ func Test_applyTrafficSelectorToDeployment(t *testing.T) {
 	}
 }
 
+func Test_podTemplateOverridesToDeployment(t *testing.T) {
+	type args struct {
+		w DeploymentWorkload
+	}
+	tests := []struct {
+		name     string
+		template *resource.Template[*appsv1.Deployment]
+		args     args
+		want     *appsv1.Deployment
+	}{
+		{
+			name: "Applies the pod template overrides to a Deployment",
+			template: resource.NewTemplate[*appsv1.Deployment](
+				func(client.Object) (*appsv1.Deployment, error) {
+					return &appsv1.Deployment{}, nil
+				}),
+			args: args{
+				w: &TestWorkloadGenerator{
+					TSelector: map[string]string{"sel-key": "sel-value"},
+					TOverrides: &saasv1alpha1.PodTemplateOverrides{
+						PriorityClassName: util.Pointer("high"),
+						TopologySpreadConstraints: []corev1.TopologySpreadConstraint{
+							{MaxSkew: 1, TopologyKey: corev1.LabelTopologyZone},
+						},
+					},
+				},
+			},
+			want: &appsv1.Deployment{
+				Spec: appsv1.DeploymentSpec{
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							PriorityClassName: "high",
+							TopologySpreadConstraints: []corev1.TopologySpreadConstraint{{
+								MaxSkew:       1,
+								TopologyKey:   corev1.LabelTopologyZone,
+								LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"sel-key": "sel-value"}},
+							}},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Does nothing without overrides",
+			template: resource.NewTemplate[*appsv1.Deployment](
+				func(client.Object) (*appsv1.Deployment, error) {
+					return &appsv1.Deployment{}, nil
+				}),
+			args: args{w: &TestWorkloadGenerator{}},
+			want: &appsv1.Deployment{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, _ := tt.template.Apply(podTemplateOverridesToDeployment(tt.args.w)).Build(context.TODO(), nil, nil)
+			if diff := cmp.Diff(got, tt.want); len(diff) > 0 {
+				t.Errorf("podTemplateOverridesToDeployment() got diff %v", diff)
+			}
+		})
+	}
+}
+
 func Test_applyHPAScaleTargetRef(t *testing.T) {
 	type args struct {
 		w WithWorkloadMeta
@@ -675,6 +757,48 @@ func Test_applyHPAScaleTargetRef(t *testing.T) {
 	}
 }
 
+func Test_applyScaledObjectScaleTargetRef(t *testing.T) {
+	template := resource.NewTemplate[*kedav1alpha1.ScaledObject](
+		func(client.Object) (*kedav1alpha1.ScaledObject, error) {
+			return &kedav1alpha1.ScaledObject{}, nil
+		})
+	w := &TestWorkloadGenerator{TName: "test", TNamespace: "ns"}
+	want := &kedav1alpha1.ScaledObject{
+		Spec: kedav1alpha1.ScaledObjectSpec{
+			ScaleTargetRef: &kedav1alpha1.ScaleTarget{
+				Kind:       "Deployment",
+				Name:       "test",
+				APIVersion: "apps/v1",
+			},
+		},
+	}
+	got, _ := template.Apply(scaleTargetRefToScaledObject(w)).Build(context.TODO(), nil, nil)
+	if diff := cmp.Diff(got, want); len(diff) > 0 {
+		t.Errorf("scaleTargetRefToScaledObject() got diff %v", diff)
+	}
+}
+
+func Test_applyVPATargetRef(t *testing.T) {
+	template := resource.NewTemplate[*vpav1.VerticalPodAutoscaler](
+		func(client.Object) (*vpav1.VerticalPodAutoscaler, error) {
+			return &vpav1.VerticalPodAutoscaler{}, nil
+		})
+	w := &TestWorkloadGenerator{TName: "test", TNamespace: "ns"}
+	want := &vpav1.VerticalPodAutoscaler{
+		Spec: vpav1.VerticalPodAutoscalerSpec{
+			TargetRef: &autoscalingv1.CrossVersionObjectReference{
+				Kind:       "Deployment",
+				Name:       "test",
+				APIVersion: "apps/v1",
+			},
+		},
+	}
+	got, _ := template.Apply(targetRefToVPA(w)).Build(context.TODO(), nil, nil)
+	if diff := cmp.Diff(got, want); len(diff) > 0 {
+		t.Errorf("targetRefToVPA() got diff %v", diff)
+	}
+}
+
 func Test_applyMeta(t *testing.T) {
 	type args struct {
 		w WithWorkloadMeta
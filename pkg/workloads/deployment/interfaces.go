@@ -44,6 +44,18 @@ type WithHorizontalPodAutoscaler interface {
 	HPASpec() *saasv1alpha1.HorizontalPodAutoscalerSpec
 }
 
+type WithVerticalPodAutoscaler interface {
+	VPASpec() *saasv1alpha1.VerticalPodAutoscalerSpec
+}
+
+type WithSidecarVerticalPodAutoscaler interface {
+	SidecarVPASpecs() map[string]saasv1alpha1.VerticalPodAutoscalerResourcePolicy
+}
+
+type WithPodTemplateOverrides interface {
+	PodTemplateOverrides() *saasv1alpha1.PodTemplateOverrides
+}
+
 type WithCanary interface {
 	WithWorkloadMeta
 	WithSelector
@@ -67,6 +79,8 @@ type DeploymentWorkload interface {
 	WithWorkloadMeta
 	WithMonitoring
 	WithHorizontalPodAutoscaler
+	WithVerticalPodAutoscaler
 	WithPodDisruptionBadget
+	WithPodTemplateOverrides
 	Deployment() *resource.Template[*appsv1.Deployment]
 }
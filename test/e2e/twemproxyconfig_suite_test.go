@@ -157,19 +157,23 @@ var _ = Describe("twemproxyconfig e2e suite", func() {
 		It("deploys a ConfigMap with twemproxy configuration that points to redis masters", func() {
 			Eventually(assertTwemproxyConfigStatus(&twemproxyconfig, &sentinel,
 				&saasv1alpha1.TwemproxyConfigStatus{
-					SelectedTargets: map[string]saasv1alpha1.TargetServer{
-						shards[0].GetName(): {
-							ServerAlias:   util.Pointer(shards[0].Status.ShardNodes.GetAliasByPodIndex(0)),
-							ServerAddress: shards[0].Status.ShardNodes.GetHostPortByPodIndex(0),
-						},
-						shards[1].GetName(): {
-							ServerAlias:   util.Pointer(shards[1].Status.ShardNodes.GetAliasByPodIndex(0)),
-							ServerAddress: shards[1].Status.ShardNodes.GetHostPortByPodIndex(0),
+					SelectedTargets: map[string]saasv1alpha1.ServerPoolTargets{
+						"test-pool": {
+							shards[0].GetName(): {
+								ServerAlias:   util.Pointer(shards[0].Status.ShardNodes.GetAliasByPodIndex(0)),
+								ServerAddress: shards[0].Status.ShardNodes.GetHostPortByPodIndex(0),
+								Reason:        "master",
+							},
+							shards[1].GetName(): {
+								ServerAlias:   util.Pointer(shards[1].Status.ShardNodes.GetAliasByPodIndex(0)),
+								ServerAddress: shards[1].Status.ShardNodes.GetHostPortByPodIndex(0),
+								Reason:        "master",
+							},
 						},
 					},
 				}), timeout, poll).Should(Not(HaveOccurred()))
 
-			Eventually(assertTwemproxyConfigServerPool(&twemproxyconfig,
+			Eventually(assertTwemproxyConfigServerPool(&twemproxyconfig, "test-pool",
 				[]twemproxy.Server{
 					{Address: shards[0].Status.ShardNodes.GetHostPortByPodIndex(0), Priority: 1, Name: "l-shard00"},
 					{Address: shards[0].Status.ShardNodes.GetHostPortByPodIndex(0), Priority: 1, Name: "l-shard01"},
@@ -229,19 +233,23 @@ var _ = Describe("twemproxyconfig e2e suite", func() {
 
 				Eventually(assertTwemproxyConfigStatus(&twemproxyconfig, &sentinel,
 					&saasv1alpha1.TwemproxyConfigStatus{
-						SelectedTargets: map[string]saasv1alpha1.TargetServer{
-							shards[0].GetName(): {
-								ServerAlias:   util.Pointer(shards[0].Status.ShardNodes.GetAliasByPodIndex(1)),
-								ServerAddress: shards[0].Status.ShardNodes.GetHostPortByPodIndex(1),
-							},
-							shards[1].GetName(): {
-								ServerAlias:   util.Pointer(shards[1].Status.ShardNodes.GetAliasByPodIndex(0)),
-								ServerAddress: shards[1].Status.ShardNodes.GetHostPortByPodIndex(0),
+						SelectedTargets: map[string]saasv1alpha1.ServerPoolTargets{
+							"test-pool": {
+								shards[0].GetName(): {
+									ServerAlias:   util.Pointer(shards[0].Status.ShardNodes.GetAliasByPodIndex(1)),
+									ServerAddress: shards[0].Status.ShardNodes.GetHostPortByPodIndex(1),
+									Reason:        "master",
+								},
+								shards[1].GetName(): {
+									ServerAlias:   util.Pointer(shards[1].Status.ShardNodes.GetAliasByPodIndex(0)),
+									ServerAddress: shards[1].Status.ShardNodes.GetHostPortByPodIndex(0),
+									Reason:        "master",
+								},
 							},
 						},
 					}), timeout, poll).Should(Not(HaveOccurred()))
 
-				Eventually(assertTwemproxyConfigServerPool(&twemproxyconfig,
+				Eventually(assertTwemproxyConfigServerPool(&twemproxyconfig, "test-pool",
 					[]twemproxy.Server{
 						{Address: shards[0].Status.ShardNodes.GetHostPortByPodIndex(1), Priority: 1, Name: "l-shard00"},
 						{Address: shards[0].Status.ShardNodes.GetHostPortByPodIndex(1), Priority: 1, Name: "l-shard01"},
@@ -329,19 +337,23 @@ var _ = Describe("twemproxyconfig e2e suite", func() {
 
 			Eventually(assertTwemproxyConfigStatus(&twemproxyconfig, &sentinel,
 				&saasv1alpha1.TwemproxyConfigStatus{
-					SelectedTargets: map[string]saasv1alpha1.TargetServer{
-						shards[0].GetName(): {
-							ServerAlias:   util.Pointer(shards[0].Status.ShardNodes.GetAliasByPodIndex(2)),
-							ServerAddress: shards[0].Status.ShardNodes.GetHostPortByPodIndex(2),
-						},
-						shards[1].GetName(): {
-							ServerAlias:   util.Pointer(shards[1].Status.ShardNodes.GetAliasByPodIndex(2)),
-							ServerAddress: shards[1].Status.ShardNodes.GetHostPortByPodIndex(2),
+					SelectedTargets: map[string]saasv1alpha1.ServerPoolTargets{
+						"test-pool": {
+							shards[0].GetName(): {
+								ServerAlias:   util.Pointer(shards[0].Status.ShardNodes.GetAliasByPodIndex(2)),
+								ServerAddress: shards[0].Status.ShardNodes.GetHostPortByPodIndex(2),
+								Reason:        "rw slave",
+							},
+							shards[1].GetName(): {
+								ServerAlias:   util.Pointer(shards[1].Status.ShardNodes.GetAliasByPodIndex(2)),
+								ServerAddress: shards[1].Status.ShardNodes.GetHostPortByPodIndex(2),
+								Reason:        "rw slave",
+							},
 						},
 					},
 				}), timeout, poll).Should(Not(HaveOccurred()))
 
-			Eventually(assertTwemproxyConfigServerPool(&twemproxyconfig,
+			Eventually(assertTwemproxyConfigServerPool(&twemproxyconfig, "test-pool",
 				[]twemproxy.Server{
 					{Address: shards[0].Status.ShardNodes.GetHostPortByPodIndex(2), Priority: 1, Name: "l-shard00"},
 					{Address: shards[0].Status.ShardNodes.GetHostPortByPodIndex(2), Priority: 1, Name: "l-shard01"},
@@ -379,19 +391,23 @@ var _ = Describe("twemproxyconfig e2e suite", func() {
 
 					Eventually(assertTwemproxyConfigStatus(&twemproxyconfig, &sentinel,
 						&saasv1alpha1.TwemproxyConfigStatus{
-							SelectedTargets: map[string]saasv1alpha1.TargetServer{
-								shards[0].GetName(): {
-									ServerAlias:   util.Pointer(shards[0].Status.ShardNodes.GetAliasByPodIndex(0)),
-									ServerAddress: shards[0].Status.ShardNodes.GetHostPortByPodIndex(0),
-								},
-								shards[1].GetName(): {
-									ServerAlias:   util.Pointer(shards[1].Status.ShardNodes.GetAliasByPodIndex(2)),
-									ServerAddress: shards[1].Status.ShardNodes.GetHostPortByPodIndex(2),
+							SelectedTargets: map[string]saasv1alpha1.ServerPoolTargets{
+								"test-pool": {
+									shards[0].GetName(): {
+										ServerAlias:   util.Pointer(shards[0].Status.ShardNodes.GetAliasByPodIndex(0)),
+										ServerAddress: shards[0].Status.ShardNodes.GetHostPortByPodIndex(0),
+										Reason:        "no rw slaves available, fallback to master",
+									},
+									shards[1].GetName(): {
+										ServerAlias:   util.Pointer(shards[1].Status.ShardNodes.GetAliasByPodIndex(2)),
+										ServerAddress: shards[1].Status.ShardNodes.GetHostPortByPodIndex(2),
+										Reason:        "rw slave",
+									},
 								},
 							},
 						}), timeout, poll).Should(Not(HaveOccurred()))
 
-					Eventually(assertTwemproxyConfigServerPool(&twemproxyconfig,
+					Eventually(assertTwemproxyConfigServerPool(&twemproxyconfig, "test-pool",
 						[]twemproxy.Server{
 							{Address: shards[0].Status.ShardNodes.GetHostPortByPodIndex(0), Priority: 1, Name: "l-shard00"},
 							{Address: shards[0].Status.ShardNodes.GetHostPortByPodIndex(0), Priority: 1, Name: "l-shard01"},
@@ -405,19 +421,23 @@ var _ = Describe("twemproxyconfig e2e suite", func() {
 
 					Eventually(assertTwemproxyConfigStatus(&twemproxyconfig, &sentinel,
 						&saasv1alpha1.TwemproxyConfigStatus{
-							SelectedTargets: map[string]saasv1alpha1.TargetServer{
-								shards[0].GetName(): {
-									ServerAlias:   util.Pointer(shards[0].Status.ShardNodes.GetAliasByPodIndex(2)),
-									ServerAddress: shards[0].Status.ShardNodes.GetHostPortByPodIndex(2),
-								},
-								shards[1].GetName(): {
-									ServerAlias:   util.Pointer(shards[1].Status.ShardNodes.GetAliasByPodIndex(2)),
-									ServerAddress: shards[1].Status.ShardNodes.GetHostPortByPodIndex(2),
+							SelectedTargets: map[string]saasv1alpha1.ServerPoolTargets{
+								"test-pool": {
+									shards[0].GetName(): {
+										ServerAlias:   util.Pointer(shards[0].Status.ShardNodes.GetAliasByPodIndex(2)),
+										ServerAddress: shards[0].Status.ShardNodes.GetHostPortByPodIndex(2),
+										Reason:        "rw slave",
+									},
+									shards[1].GetName(): {
+										ServerAlias:   util.Pointer(shards[1].Status.ShardNodes.GetAliasByPodIndex(2)),
+										ServerAddress: shards[1].Status.ShardNodes.GetHostPortByPodIndex(2),
+										Reason:        "rw slave",
+									},
 								},
 							},
 						}), timeout, poll).Should(Not(HaveOccurred()))
 
-					Eventually(assertTwemproxyConfigServerPool(&twemproxyconfig,
+					Eventually(assertTwemproxyConfigServerPool(&twemproxyconfig, "test-pool",
 						[]twemproxy.Server{
 							{Address: shards[0].Status.ShardNodes.GetHostPortByPodIndex(2), Priority: 1, Name: "l-shard00"},
 							{Address: shards[0].Status.ShardNodes.GetHostPortByPodIndex(2), Priority: 1, Name: "l-shard01"},
@@ -467,19 +487,23 @@ var _ = Describe("twemproxyconfig e2e suite", func() {
 
 					Eventually(assertTwemproxyConfigStatus(&twemproxyconfig, &sentinel,
 						&saasv1alpha1.TwemproxyConfigStatus{
-							SelectedTargets: map[string]saasv1alpha1.TargetServer{
-								shards[0].GetName(): {
-									ServerAlias:   util.Pointer(shards[0].Status.ShardNodes.GetAliasByPodIndex(idx)),
-									ServerAddress: shards[0].Status.ShardNodes.GetHostPortByPodIndex(idx),
-								},
-								shards[1].GetName(): {
-									ServerAlias:   util.Pointer(shards[1].Status.ShardNodes.GetAliasByPodIndex(2)),
-									ServerAddress: shards[1].Status.ShardNodes.GetHostPortByPodIndex(2),
+							SelectedTargets: map[string]saasv1alpha1.ServerPoolTargets{
+								"test-pool": {
+									shards[0].GetName(): {
+										ServerAlias:   util.Pointer(shards[0].Status.ShardNodes.GetAliasByPodIndex(idx)),
+										ServerAddress: shards[0].Status.ShardNodes.GetHostPortByPodIndex(idx),
+										Reason:        "rw slave",
+									},
+									shards[1].GetName(): {
+										ServerAlias:   util.Pointer(shards[1].Status.ShardNodes.GetAliasByPodIndex(2)),
+										ServerAddress: shards[1].Status.ShardNodes.GetHostPortByPodIndex(2),
+										Reason:        "rw slave",
+									},
 								},
 							},
 						}), timeout, poll).Should(Not(HaveOccurred()))
 
-					Eventually(assertTwemproxyConfigServerPool(&twemproxyconfig,
+					Eventually(assertTwemproxyConfigServerPool(&twemproxyconfig, "test-pool",
 						[]twemproxy.Server{
 							{Address: shards[0].Status.ShardNodes.GetHostPortByPodIndex(idx), Priority: 1, Name: "l-shard00"},
 							{Address: shards[0].Status.ShardNodes.GetHostPortByPodIndex(idx), Priority: 1, Name: "l-shard01"},
@@ -531,7 +555,8 @@ func assertTwemproxyConfigStatus(tmc *saasv1alpha1.TwemproxyConfig, sentinel *sa
 		selectedTargets, _ := yaml.Marshal(tmc.Status.SelectedTargets)
 		GinkgoWriter.Printf("[debug] selected targets:\n\n %s\n", selectedTargets)
 
-		if diff := cmp.Diff(*want, tmc.Status); diff != "" {
+		// the deprecated targets field mirrors the first server pool
+		if diff := cmp.Diff(*want, tmc.Status, cmpopts.IgnoreFields(saasv1alpha1.TwemproxyConfigStatus{}, "Targets")); diff != "" {
 			return fmt.Errorf("got unexpected status %s", diff)
 		}
 
@@ -539,7 +564,7 @@ func assertTwemproxyConfigStatus(tmc *saasv1alpha1.TwemproxyConfig, sentinel *sa
 	}
 }
 
-func assertTwemproxyConfigServerPool(tmc *saasv1alpha1.TwemproxyConfig, want []twemproxy.Server) func() error {
+func assertTwemproxyConfigServerPool(tmc *saasv1alpha1.TwemproxyConfig, pool string, want []twemproxy.Server) func() error {
 
 	return func() error {
 		cm := &corev1.ConfigMap{}
@@ -552,7 +577,7 @@ func assertTwemproxyConfigServerPool(tmc *saasv1alpha1.TwemproxyConfig, want []t
 			return err
 		}
 
-		if diff := cmp.Diff(want, config["test-pool"].Servers, cmpopts.IgnoreUnexported(twemproxy.Server{})); diff != "" {
+		if diff := cmp.Diff(want, config[pool].Servers, cmpopts.IgnoreUnexported(twemproxy.Server{})); diff != "" {
 			return fmt.Errorf("got unexpected pool servers %s", diff)
 		}
 